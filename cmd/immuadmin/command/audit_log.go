@@ -0,0 +1,165 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/spf13/cobra"
+)
+
+const auditLogColumns = "id, ts, username, action, dbname, target, success, error_message, client"
+
+func (cl *commandline) auditLog(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Browse and verify the audit log of administrative and security relevant actions",
+		Long: `Browse and verify the audit log of administrative and security relevant actions.
+The audit log is recorded by servers started with --audit-log into the read-only '` + server.AuditDBName + `' database.
+Entries are listed starting from the most recent one, when --verify is given
+each entry is cryptographically verified against the state of the database.`,
+		Example: `immuadmin audit-log --user jane --limit 20
+immuadmin audit-log --action Login --failed
+immuadmin audit-log --verify`,
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username, err := cmd.Flags().GetString("user")
+			if err != nil {
+				return err
+			}
+			action, err := cmd.Flags().GetString("action")
+			if err != nil {
+				return err
+			}
+			failed, err := cmd.Flags().GetBool("failed")
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetInt("limit")
+			if err != nil {
+				return err
+			}
+			verify, err := cmd.Flags().GetBool("verify")
+			if err != nil {
+				return err
+			}
+
+			rows, err := cl.auditLogEntries(username, action, failed, limit, verify)
+			if err != nil {
+				return err
+			}
+
+			c.PrintTable(
+				cmd.OutOrStdout(),
+				[]string{"ID", "Time", "User", "Action", "Database", "Target", "Client", "Result"},
+				len(rows),
+				func(i int) []string {
+					return auditLogRow(rows[i])
+				},
+				fmt.Sprintf("%d entries", len(rows)),
+			)
+
+			if verify {
+				fmt.Fprintf(cmd.OutOrStdout(), "%d entries verified\n", len(rows))
+			}
+
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
+	ccmd.Flags().String("user", "", "only list the actions performed by the given user")
+	ccmd.Flags().String("action", "", "only list the given action, e.g. Login, CreateUser or ChangePermission")
+	ccmd.Flags().Bool("failed", false, "only list the actions which failed")
+	ccmd.Flags().Int("limit", 100, "maximum number of entries to list")
+	ccmd.Flags().Bool("verify", false, "verify the integrity of the listed entries")
+	cmd.AddCommand(ccmd)
+}
+
+func (cl *commandline) auditLogEntries(username, action string, failed bool, limit int, verify bool) ([]*schema.Row, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than zero")
+	}
+
+	_, err := cl.immuClient.UseDatabase(cl.context, &schema.Database{DatabaseName: server.AuditDBName})
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []string
+	params := make(map[string]interface{})
+
+	if username != "" {
+		conditions = append(conditions, "username = @username")
+		params["username"] = username
+	}
+	if action != "" {
+		conditions = append(conditions, "action = @action")
+		params["action"] = action
+	}
+	if failed {
+		conditions = append(conditions, "success = false")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", auditLogColumns, server.AuditTableName)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY id DESC LIMIT %d", limit)
+
+	res, err := cl.immuClient.SQLQuery(cl.context, query, params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if verify {
+		for _, row := range res.Rows {
+			err := cl.immuClient.VerifyRow(cl.context, row, server.AuditTableName, []*schema.SQLValue{row.Values[0]})
+			if err != nil {
+				return nil, fmt.Errorf("audit log entry %d could not be verified: %w", row.Values[0].GetN(), err)
+			}
+		}
+	}
+
+	return res.Rows, nil
+}
+
+func auditLogRow(row *schema.Row) []string {
+	v := row.Values
+
+	result := "ok"
+	if !v[6].GetB() {
+		result = "failed: " + v[7].GetS()
+	}
+
+	return []string{
+		fmt.Sprintf("%d", v[0].GetN()),
+		sql.TimeFromInt64(v[1].GetTs()).Format(time.RFC3339),
+		v[2].GetS(),
+		v[3].GetS(),
+		v[4].GetS(),
+		v[5].GetS(),
+		v[8].GetS(),
+		result,
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/tokenservice"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestCommandLine_AuditLog(t *testing.T) {
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(t.TempDir()).
+		WithAuth(true).
+		WithAuditLog(true),
+	)
+
+	bs.Start()
+	defer bs.Stop()
+
+	cliOpts := client.
+		DefaultOptions().
+		WithDir(t.TempDir()).
+		WithDialOptions([]grpc.DialOption{grpc.WithContextDialer(bs.Dialer), grpc.WithTransportCredentials(insecure.NewCredentials())})
+
+	immuClient, err := client.NewImmuClient(cliOpts)
+	require.NoError(t, err)
+	defer immuClient.Disconnect()
+
+	immuClient.WithTokenService(tokenservice.NewInmemoryTokenService())
+
+	_, err = immuClient.Login(context.Background(), []byte("immudb"), []byte("wrong"))
+	require.Error(t, err)
+
+	_, err = immuClient.Login(context.Background(), []byte("immudb"), []byte("immudb"))
+	require.NoError(t, err)
+
+	err = immuClient.CreateDatabase(context.Background(), &schema.DatabaseSettings{DatabaseName: "db1"})
+	require.NoError(t, err)
+
+	auditLog := func(args ...string) (string, error) {
+		cl := &commandline{
+			immuClient: immuClient,
+			context:    context.Background(),
+		}
+
+		cmd := &cobra.Command{}
+		cl.auditLog(cmd)
+
+		auditCmd := cmd.Commands()[0]
+		auditCmd.PersistentPreRunE = nil
+		auditCmd.PersistentPostRun = nil
+
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetErr(b)
+		cmd.SetArgs(append([]string{"audit-log"}, args...))
+
+		err := cmd.Execute()
+		return b.String(), err
+	}
+
+	out, err := auditLog("--verify")
+	require.NoError(t, err)
+	require.Contains(t, out, "CreateDatabaseV2")
+	require.Contains(t, out, "3 entries verified")
+
+	out, err = auditLog("--user", "immudb", "--action", "Login", "--failed")
+	require.NoError(t, err)
+	require.Contains(t, out, "failed: ")
+	require.Contains(t, out, "1 entries")
+	require.NotContains(t, out, "CreateDatabaseV2")
+
+	_, err = auditLog("--limit", "0")
+	require.Error(t, err)
+}
@@ -86,12 +86,15 @@ func (cl *commandline) ConfigChain(post func(cmd *cobra.Command, args []string)
 
 func (cl *commandline) Register(rootCmd *cobra.Command) *cobra.Command {
 	cl.user(rootCmd)
+	cl.role(rootCmd)
 	cl.login(rootCmd)
 	cl.logout(rootCmd)
 	cl.status(rootCmd)
 	cl.stats(rootCmd)
 	cl.serverConfig(rootCmd)
 	cl.database(rootCmd)
+	cl.signingKey(rootCmd)
+	cl.auditLog(rootCmd)
 	return rootCmd
 }
 
@@ -17,7 +17,12 @@ limitations under the License.
 package immuadmin
 
 import (
+	"bytes"
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -34,6 +39,8 @@ import (
 func addDbUpdateFlags(c *cobra.Command) {
 	c.Flags().Bool("exclude-commit-time", false,
 		"do not include server-side timestamps in commit checksums, useful when reproducibility is a desired feature")
+	c.Flags().Bool("authenticated-index", false,
+		"commit the root of the authenticated index into every transaction, required to prove the latest value or the absence of keys")
 	c.Flags().Bool("replication-enabled", false, "set database as a replica") // deprecated, use replication-is-replica instead
 	c.Flags().Bool("replication-is-replica", false, "set database as a replica")
 	c.Flags().Bool("replication-sync-enabled", false, "enable synchronous replication")
@@ -319,6 +326,29 @@ func (cl *commandline) database(cmd *cobra.Command) {
 		Args: cobra.ExactArgs(0),
 	}
 
+	rebuildIndexCmd := &cobra.Command{
+		Use:               "rebuild-index",
+		Short:             "Rebuild database index from the transaction log without blocking reads or writes",
+		Example:           "rebuild-index",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.RebuildIndex(cl.context, func(p *schema.RebuildIndexProgress) {
+				if p.Done {
+					return
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "indexed up to transaction %d of %d\n", p.IndexedTxId, p.CommittedTxId)
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "database index successfully rebuilt\n")
+			return nil
+		},
+		Args: cobra.ExactArgs(0),
+	}
+
 	truncateCmd := &cobra.Command{
 		Use:               "truncate",
 		Short:             "Truncate database (unrecoverable operation)",
@@ -355,6 +385,56 @@ func (cl *commandline) database(cmd *cobra.Command) {
 	}
 	addDbTruncateFlags(truncateCmd)
 
+	importCmd := &cobra.Command{
+		Use:               "import",
+		Short:             "Bulk load key-value pairs from a CSV file (keys must be sorted in ascending order)",
+		Example:           "import {file_name} --batch-size {batch_size}",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			batchSize, err := cmd.Flags().GetInt("batch-size")
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			var firstTxID, lastTxID, entries uint64
+
+			err = importEntries(f, batchSize, func(kvs []*schema.KeyValue) error {
+				res, err := cl.immuClient.BulkLoad(cl.context, kvs)
+				if err != nil {
+					return err
+				}
+
+				if firstTxID == 0 {
+					firstTxID = res.FirstTxId
+				}
+				lastTxID = res.LastTxId
+				entries += res.Entries
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if entries == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "no entries were imported\n")
+				return nil
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d entries successfully imported in transactions %d to %d\n", entries, firstTxID, lastTxID)
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	importCmd.Flags().Int("batch-size", 10_000, "number of entries sent to the server on each request")
+
 	dbCmd.AddCommand(listCmd)
 	dbCmd.AddCommand(createCmd)
 	dbCmd.AddCommand(loadCmd)
@@ -364,11 +444,65 @@ func (cl *commandline) database(cmd *cobra.Command) {
 	dbCmd.AddCommand(updateCmd)
 	dbCmd.AddCommand(flushCmd)
 	dbCmd.AddCommand(compactCmd)
+	dbCmd.AddCommand(rebuildIndexCmd)
 	dbCmd.AddCommand(truncateCmd)
+	dbCmd.AddCommand(importCmd)
 
 	cmd.AddCommand(dbCmd)
 }
 
+var errImportKeysNotSorted = errors.New("keys must be sorted in ascending order")
+
+// importEntries reads key-value pairs from CSV records and loads them in batches.
+// Sorting is validated over the whole input as each batch is loaded independently.
+func importEntries(r io.Reader, batchSize int, load func(kvs []*schema.KeyValue) error) error {
+	if batchSize <= 0 {
+		return fmt.Errorf("illegal batch size: %d", batchSize)
+	}
+
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = 2
+
+	var lastKey []byte
+
+	kvs := make([]*schema.KeyValue, 0, batchSize)
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		key := []byte(record[0])
+
+		if lastKey != nil && bytes.Compare(key, lastKey) <= 0 {
+			line, _ := csvReader.FieldPos(0)
+			return fmt.Errorf("%w: key '%s' at line %d", errImportKeysNotSorted, record[0], line)
+		}
+		lastKey = key
+
+		kvs = append(kvs, &schema.KeyValue{Key: key, Value: []byte(record[1])})
+
+		if len(kvs) == batchSize {
+			err = load(kvs)
+			if err != nil {
+				return err
+			}
+
+			kvs = make([]*schema.KeyValue, 0, batchSize)
+		}
+	}
+
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	return load(kvs)
+}
+
 func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNullableSettings, error) {
 	var err error
 
@@ -425,6 +559,11 @@ func prepareDatabaseNullableSettings(flags *pflag.FlagSet) (*schema.DatabaseNull
 		return nil, err
 	}
 
+	ret.AuthenticatedIndex, err = condBool("authenticated-index")
+	if err != nil {
+		return nil, err
+	}
+
 	ret.ReplicationSettings.Replica, err = condBool("replication-is-replica")
 	if err != nil {
 		return nil, err
@@ -551,6 +690,10 @@ func databaseNullableSettingsStr(settings *schema.DatabaseNullableSettings) stri
 		propertiesStr = append(propertiesStr, fmt.Sprintf("exclude-commit-time: %v", settings.ExcludeCommitTime.GetValue()))
 	}
 
+	if settings.AuthenticatedIndex != nil {
+		propertiesStr = append(propertiesStr, fmt.Sprintf("authenticated-index: %v", settings.AuthenticatedIndex.GetValue()))
+	}
+
 	if settings.WriteTxHeaderVersion != nil {
 		propertiesStr = append(propertiesStr, fmt.Sprintf("write-tx-header-version: %d", settings.WriteTxHeaderVersion.GetValue()))
 	}
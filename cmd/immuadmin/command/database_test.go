@@ -1,5 +1,54 @@
 package immuadmin
 
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportEntries(t *testing.T) {
+	var batches [][]*schema.KeyValue
+
+	load := func(kvs []*schema.KeyValue) error {
+		batches = append(batches, kvs)
+		return nil
+	}
+
+	err := importEntries(strings.NewReader("key1,value1\n"), 0, load)
+	require.Error(t, err)
+
+	err = importEntries(strings.NewReader(""), 2, load)
+	require.NoError(t, err)
+	require.Empty(t, batches)
+
+	err = importEntries(strings.NewReader("key1,value1\nkey2,value2\nkey3,\"value,3\"\n"), 2, load)
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 2)
+	require.Len(t, batches[1], 1)
+	require.Equal(t, []byte("key3"), batches[1][0].Key)
+	require.Equal(t, []byte("value,3"), batches[1][0].Value)
+
+	err = importEntries(strings.NewReader("key1,value1\nkey3,value3\nkey2,value2\n"), 10, load)
+	require.ErrorIs(t, err, errImportKeysNotSorted)
+
+	err = importEntries(strings.NewReader("key1,value1\nkey1,value1\n"), 10, load)
+	require.ErrorIs(t, err, errImportKeysNotSorted)
+
+	err = importEntries(strings.NewReader("key1\n"), 10, load)
+	require.Error(t, err)
+
+	errLoad := errors.New("load error")
+
+	err = importEntries(strings.NewReader("key1,value1\n"), 10, func(kvs []*schema.KeyValue) error {
+		return errLoad
+	})
+	require.ErrorIs(t, err, errLoad)
+}
+
 /*
 func TestDatabaseList(t *testing.T) {
 	options := server.DefaultOptions().WithAuth(true)
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) role(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "role command",
+		Short:             "Issue all role commands",
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+	}
+	roleListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List roles and their privileges",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := cl.roleList(args)
+			if err != nil {
+				c.QuitToStdErr(err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), resp)
+			return nil
+		},
+		Args: cobra.MaximumNArgs(0),
+	}
+	roleCreateCmd := &cobra.Command{
+		Use:     "create {role}",
+		Short:   "Create a new role without privileges",
+		Example: "immuadmin role create analyst",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cl.immuClient.CreateRole(cl.context, args[0], false); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created role %s\n", args[0])
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	roleDropCmd := &cobra.Command{
+		Use:     "drop {role}",
+		Short:   "Drop a role, revoking it from the users it was granted to",
+		Example: "immuadmin role drop analyst",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cl.immuClient.DropRole(cl.context, args[0]); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Dropped role %s\n", args[0])
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	roleGrantCmd := &cobra.Command{
+		Use:   "grant {role} {privilege} {database} [table|key prefix]",
+		Short: "Grant a privilege to a role",
+		Long: `Grant a privilege to a role.
+Table privileges (select, insert, update, delete) apply to the given table,
+key privileges (read, write) apply to the keys starting with the given prefix.
+When no table or key prefix is given, the privilege applies to the whole database.
+Database privileges (truncate, replicate) do not accept a table or key prefix.`,
+		Example: `immuadmin role grant analyst select mydb orders
+immuadmin role grant analyst read mydb invoice:
+immuadmin role grant operator truncate mydb`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cl.changeRolePrivilege(schema.PermissionAction_GRANT, args); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Privilege granted successfully\n")
+			return nil
+		},
+		Args: cobra.RangeArgs(3, 4),
+	}
+	roleRevokeCmd := &cobra.Command{
+		Use:     "revoke {role} {privilege} {database} [table|key prefix]",
+		Short:   "Revoke a privilege from a role",
+		Example: "immuadmin role revoke analyst select mydb orders",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cl.changeRolePrivilege(schema.PermissionAction_REVOKE, args); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Privilege revoked successfully\n")
+			return nil
+		},
+		Args: cobra.RangeArgs(3, 4),
+	}
+	roleAddUserCmd := &cobra.Command{
+		Use:     "add-user {role} {username}",
+		Short:   "Grant a role to a user",
+		Example: "immuadmin role add-user analyst user1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.ChangeRoleMembership(cl.context, schema.PermissionAction_GRANT, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Role %s granted to user %s\n", args[0], args[1])
+			return nil
+		},
+		Args: cobra.ExactArgs(2),
+	}
+	roleRemoveUserCmd := &cobra.Command{
+		Use:     "remove-user {role} {username}",
+		Short:   "Revoke a role from a user",
+		Example: "immuadmin role remove-user analyst user1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.ChangeRoleMembership(cl.context, schema.PermissionAction_REVOKE, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Role %s revoked from user %s\n", args[0], args[1])
+			return nil
+		},
+		Args: cobra.ExactArgs(2),
+	}
+	ccmd.AddCommand(roleListCmd)
+	ccmd.AddCommand(roleCreateCmd)
+	ccmd.AddCommand(roleDropCmd)
+	ccmd.AddCommand(roleGrantCmd)
+	ccmd.AddCommand(roleRevokeCmd)
+	ccmd.AddCommand(roleAddUserCmd)
+	ccmd.AddCommand(roleRemoveUserCmd)
+	cmd.AddCommand(ccmd)
+}
+
+func (cl *commandline) changeRolePrivilege(action schema.PermissionAction, args []string) error {
+	privilege := &schema.RolePrivilege{
+		Privilege: args[1],
+		Database:  args[2],
+	}
+	if len(args) == 4 {
+		privilege.Object = args[3]
+	}
+	return cl.immuClient.ChangeRolePrivilege(cl.context, action, args[0], privilege)
+}
+
+func (cl *commandline) roleList(args []string) (string, error) {
+	rolelist, err := cl.immuClient.ListRoles(cl.context)
+	if err != nil {
+		return "", err
+	}
+	roles := rolelist.GetRoles()
+	rolesAndPrivileges := make([][]string, 0, len(roles))
+	maxColWidths := make([]int, 6)
+	for _, role := range roles {
+		row := make([]string, 6)
+		row[0] = role.Name
+		row[4] = role.CreatedBy
+		row[5] = role.CreatedAt
+		for i, p := range role.Privileges {
+			if i > 0 {
+				// extra rows for other privileges
+				row = make([]string, 6)
+			}
+			row[1] = p.Database
+			row[2] = p.Privilege
+			row[3] = p.Object
+			updateMaxLen(maxColWidths, row)
+			rolesAndPrivileges = append(rolesAndPrivileges, row)
+		}
+		if len(role.Privileges) == 0 {
+			updateMaxLen(maxColWidths, row)
+			rolesAndPrivileges = append(rolesAndPrivileges, row)
+		}
+	}
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+	c.PrintTable(
+		w,
+		[]string{
+			fmt.Sprintf("% -*s", maxColWidths[0], "Role"),
+			fmt.Sprintf("% -*s", maxColWidths[1], "Database"),
+			fmt.Sprintf("% -*s", maxColWidths[2], "Privilege"),
+			fmt.Sprintf("% -*s", maxColWidths[3], "Object"),
+			fmt.Sprintf("% -*s", maxColWidths[4], "Created By"),
+			fmt.Sprintf("% -*s", maxColWidths[5], "Created At"),
+		},
+		len(rolesAndPrivileges),
+		func(i int) []string { return rolesAndPrivileges[i] },
+		fmt.Sprintf("%d role(s)", len(roles)),
+	)
+	w.Flush()
+	return b.String(), nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) signingKey(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:   "signing-key command",
+		Short: "Signing key management",
+	}
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate current-private-key new-public-key key-chain-file",
+		Short: "Append the rotation from the current signing key to the new one into the signing key chain",
+		Long: `Append the rotation from the current signing key to the new one into the signing key chain.
+The chain file is created if it does not exist. Once the server is restarted with the new signing key
+and the updated chain (--signing-key-chain), clients trusting any previous key keep verifying the states it signs.
+This command works offline, the current private key is only used to sign the new public key.`,
+		Example: "immuadmin signing-key rotate ./ec1.key ./ed25519.pub ./signing_key_chain.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			currentSigner, err := signer.NewSigner(args[0])
+			if err != nil {
+				return err
+			}
+
+			newPublicKey, err := signer.ParsePublicKeyFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var rotations []*signer.KeyRotation
+
+			_, err = os.Stat(args[2])
+			if err == nil {
+				rotations, err = signer.ReadKeyChainFile(args[2])
+				if err != nil {
+					return err
+				}
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+
+			rotation, err := signer.RotateKey(currentSigner, newPublicKey)
+			if err != nil {
+				return err
+			}
+
+			if len(rotations) > 0 && !bytes.Equal(rotations[len(rotations)-1].PublicKey, rotation.SignerPublicKey) {
+				return fmt.Errorf("%w: the current private key is not the last key of the chain", signer.ErrInvalidKeyChain)
+			}
+
+			rotations = append(rotations, rotation)
+
+			err = signer.ValidateKeyChain(rotations)
+			if err != nil {
+				return err
+			}
+
+			err = signer.WriteKeyChainFile(args[2], rotations)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "signing key rotation appended to %s, the chain holds %d rotation(s)\n", args[2], len(rotations))
+			return nil
+		},
+		Args: cobra.ExactArgs(3),
+	}
+
+	ccmd.AddCommand(rotateCmd)
+	cmd.AddCommand(ccmd)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandLine_SigningKeyRotate(t *testing.T) {
+	keyChainFile := filepath.Join(t.TempDir(), "keychain.json")
+
+	rotate := func(currentPrivateKey, newPublicKey string) (string, error) {
+		cl := &commandline{}
+
+		cmd := &cobra.Command{}
+		cl.signingKey(cmd)
+
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetErr(b)
+		cmd.SetArgs([]string{"signing-key", "rotate", currentPrivateKey, newPublicKey, keyChainFile})
+
+		err := cmd.Execute()
+		return b.String(), err
+	}
+
+	out, err := rotate("./../../../test/signer/ec1.key", "./../../../test/signer/ed25519.pub")
+	require.NoError(t, err)
+	require.Contains(t, out, "the chain holds 1 rotation(s)")
+
+	out, err = rotate("./../../../test/signer/ed25519.key", "./../../../test/signer/ed25519_rotated.pub")
+	require.NoError(t, err)
+	require.Contains(t, out, "the chain holds 2 rotation(s)")
+
+	_, err = rotate("./../../../test/signer/ec1.key", "./../../../test/signer/ec3.pub")
+	require.ErrorIs(t, err, signer.ErrInvalidKeyChain)
+
+	rootPK, err := signer.ParsePublicKeyFile("./../../../test/signer/ec1.pub")
+	require.NoError(t, err)
+
+	lastPK, err := signer.ParsePublicKeyFile("./../../../test/signer/ed25519_rotated.pub")
+	require.NoError(t, err)
+
+	lastKey, err := signer.MarshalKey(lastPK)
+	require.NoError(t, err)
+
+	rotations, err := signer.ReadKeyChainFile(keyChainFile)
+	require.NoError(t, err)
+	require.Len(t, rotations, 2)
+
+	chained, err := signer.VerifyKeyChain(rootPK, rotations, lastKey)
+	require.NoError(t, err)
+	require.True(t, chained)
+}
@@ -114,6 +114,18 @@ immuadmin user create user1 admin mydb`,
 		},
 		Args: cobra.ExactArgs(1),
 	}
+	userUnlock := &cobra.Command{
+		Use:     "unlock {username}",
+		Short:   "Unlock a user locked out after too many failed login attempts",
+		Example: "immuadmin user unlock user1",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if err = cl.immuClient.UnlockUser(cl.context, args[0]); err == nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "User %s unlocked\n", args[0])
+			}
+			return err
+		},
+		Args: cobra.ExactArgs(1),
+	}
 	userPermission := &cobra.Command{
 		Use:     "permission [grant|revoke] {username} [read|readwrite|admin] {database}",
 		Short:   "Set user permission",
@@ -131,7 +143,9 @@ immuadmin user create user1 admin mydb`,
 	ccmd.AddCommand(userChangePassword)
 	ccmd.AddCommand(userActivate)
 	ccmd.AddCommand(userDeactivate)
+	ccmd.AddCommand(userUnlock)
 	ccmd.AddCommand(userPermission)
+	ccmd.AddCommand(cl.userAPIKey())
 	cmd.AddCommand(ccmd)
 }
 
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"fmt"
+	"time"
+
+	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/spf13/cobra"
+)
+
+func (cl *commandline) userAPIKey() *cobra.Command {
+	ccmd := &cobra.Command{
+		Use:   "apikey command",
+		Short: "Manage the API keys of users",
+		Long: `Manage long-lived API keys, to be used by automated jobs instead of a username and password.
+Each key is bound to a user and restricted to a permission on a single database, it must be provided
+in the ` + auth.APIKeyHeader + ` gRPC metadata or, on the REST API, in the ` + auth.APIKeyHeader + ` HTTP header.`,
+	}
+	apiKeyCreate := &cobra.Command{
+		Use:   "create {username} [read|readwrite|admin] {database}",
+		Short: "Create a new API key",
+		Long: `Create a new API key bound to the user and restricted to the permission on the database.
+The permission can not exceed the one of the user. The key is only shown once, store it safely.`,
+		Example: `immuadmin user apikey create user1 readwrite mydb --name nightly-import --ttl 720h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			ttl, err := cmd.Flags().GetDuration("ttl")
+			if err != nil {
+				return err
+			}
+			permission, err := permissionFromString(args[1])
+			if err != nil {
+				return err
+			}
+			if ttl < 0 {
+				return fmt.Errorf("ttl can not be negative")
+			}
+
+			res, err := cl.immuClient.CreateAPIKey(cl.context, &schema.CreateAPIKeyRequest{
+				Username:   args[0],
+				Name:       name,
+				Database:   args[2],
+				Permission: permission,
+				Ttl:        int64(ttl / time.Second),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Created API key %s for user %s\n", res.ApiKey.Id, res.ApiKey.Username)
+			if res.ApiKey.ExpiresAt != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Expires at: %s\n", res.ApiKey.ExpiresAt)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Key (it will not be shown again): %s\n", res.Key)
+			return nil
+		},
+		Args: cobra.ExactArgs(3),
+	}
+	apiKeyCreate.Flags().String("name", "", "descriptive name of the key")
+	apiKeyCreate.Flags().Duration("ttl", 0, "validity of the key, e.g. 720h, the key never expires if not set")
+
+	apiKeyList := &cobra.Command{
+		Use:   "list [username]",
+		Short: "List API keys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var username string
+			if len(args) > 0 {
+				username = args[0]
+			}
+
+			res, err := cl.immuClient.ListAPIKeys(cl.context, username)
+			if err != nil {
+				return err
+			}
+
+			apiKeys := res.GetApiKeys()
+
+			c.PrintTable(
+				cmd.OutOrStdout(),
+				[]string{"ID", "Name", "User", "Database", "Permission", "Created By", "Created At", "Expires At", "Last Used At", "Status"},
+				len(apiKeys),
+				func(i int) []string {
+					return apiKeyRow(apiKeys[i])
+				},
+				fmt.Sprintf("%d API key(s)", len(apiKeys)),
+			)
+			return nil
+		},
+		Args: cobra.MaximumNArgs(1),
+	}
+	apiKeyRevoke := &cobra.Command{
+		Use:     "revoke {id}",
+		Short:   "Revoke an API key",
+		Example: "immuadmin user apikey revoke cbq4k2ahhqm3kuv7b1pg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cl.immuClient.RevokeAPIKey(cl.context, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "API key %s revoked\n", args[0])
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+	ccmd.AddCommand(apiKeyCreate)
+	ccmd.AddCommand(apiKeyList)
+	ccmd.AddCommand(apiKeyRevoke)
+	return ccmd
+}
+
+func apiKeyRow(apiKey *schema.APIKey) []string {
+	status := "active"
+	if apiKey.Revoked {
+		status = "revoked"
+	}
+
+	return []string{
+		apiKey.Id,
+		apiKey.Name,
+		apiKey.Username,
+		apiKey.Database,
+		permissionToString(apiKey.Permission),
+		apiKey.CreatedBy,
+		apiKey.CreatedAt,
+		apiKey.ExpiresAt,
+		apiKey.LastUsedAt,
+		status,
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuadmin
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/tokenservice"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestCommandLine_UserAPIKey(t *testing.T) {
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(t.TempDir()).
+		WithAuth(true),
+	)
+
+	bs.Start()
+	defer bs.Stop()
+
+	cliOpts := client.
+		DefaultOptions().
+		WithDir(t.TempDir()).
+		WithDialOptions([]grpc.DialOption{grpc.WithContextDialer(bs.Dialer), grpc.WithTransportCredentials(insecure.NewCredentials())})
+
+	immuClient, err := client.NewImmuClient(cliOpts)
+	require.NoError(t, err)
+	defer immuClient.Disconnect()
+
+	immuClient.WithTokenService(tokenservice.NewInmemoryTokenService())
+
+	_, err = immuClient.Login(context.Background(), []byte("immudb"), []byte("immudb"))
+	require.NoError(t, err)
+
+	err = immuClient.CreateUser(context.Background(), []byte("user1"), []byte("Pa$$w0rd"), auth.PermissionR, "defaultdb")
+	require.NoError(t, err)
+
+	apiKey := func(args ...string) (string, error) {
+		cl := &commandline{
+			immuClient: immuClient,
+			context:    context.Background(),
+		}
+
+		cmd := &cobra.Command{}
+		cmd.AddCommand(cl.userAPIKey())
+
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetErr(b)
+		cmd.SetArgs(append([]string{"apikey"}, args...))
+
+		err := cmd.Execute()
+		return b.String(), err
+	}
+
+	_, err = apiKey("create", "user1", "readwrite", "defaultdb")
+	require.Error(t, err)
+
+	out, err := apiKey("create", "user1", "read", "defaultdb", "--name", "reports", "--ttl", "24h")
+	require.NoError(t, err)
+	require.Contains(t, out, "Expires at: ")
+
+	id := regexp.MustCompile(`Created API key (\S+) for user user1`).FindStringSubmatch(out)
+	require.Len(t, id, 2)
+	require.Regexp(t, `Key \(it will not be shown again\): immudb_`+id[1]+`_\S+`, out)
+
+	out, err = apiKey("list", "user1")
+	require.NoError(t, err)
+	require.Contains(t, out, "reports")
+	require.Contains(t, out, "active")
+	require.Contains(t, out, "1 API key(s)")
+
+	out, err = apiKey("revoke", id[1])
+	require.NoError(t, err)
+	require.Contains(t, out, "API key "+id[1]+" revoked")
+
+	out, err = apiKey("list")
+	require.NoError(t, err)
+	require.Contains(t, out, "revoked")
+}
@@ -18,7 +18,7 @@ package audit
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"crypto"
 	"errors"
 	"fmt"
 	"os"
@@ -108,7 +108,7 @@ func (cAgent *auditAgent) InitAgent() (AuditAgent, error) {
 		"%s:%d",
 		viper.GetString("audit-monitoring-host"), viper.GetInt("audit-monitoring-port"))
 
-	var pk *ecdsa.PublicKey
+	var pk crypto.PublicKey
 	if cliOpts.ServerSigningPubKey != "" {
 		pk, err = signer.ParsePublicKeyFile(cliOpts.ServerSigningPubKey)
 		if err != nil {
@@ -116,6 +116,14 @@ func (cAgent *auditAgent) InitAgent() (AuditAgent, error) {
 		}
 	}
 
+	var witnessSigner signer.Signer
+	if witnessSigningKey := viper.GetString("audit-witness-signing-key"); witnessSigningKey != "" {
+		witnessSigner, err = signer.NewSigner(witnessSigningKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cAgent.ImmuAudit, err = auditor.DefaultAuditor(time.Duration(cAgent.cycleFrequency)*time.Second,
 		fmt.Sprintf("%s:%v", options().Address, options().Port),
 		cliOpts.DialOptions,
@@ -123,6 +131,7 @@ func (cAgent *auditAgent) InitAgent() (AuditAgent, error) {
 		auditPassword,
 		auditDatabases,
 		pk,
+		witnessSigner,
 		auditor.AuditNotificationConfig{
 			URL:            auditNotificationURL,
 			Username:       auditNotificationUsername,
@@ -53,6 +53,7 @@ func (cl *commandline) Register(rootCmd *cobra.Command) *cobra.Command {
 	cl.safegetTxByID(rootCmd)
 	cl.getKey(rootCmd)
 	cl.safeGetKey(rootCmd)
+	cl.exportProof(rootCmd)
 	// set operations
 	cl.set(rootCmd)
 	cl.safeset(rootCmd)
@@ -99,3 +99,23 @@ func (cl *commandline) safeGetKey(cmd *cobra.Command) {
 	}
 	cmd.AddCommand(ccmd)
 }
+
+func (cl *commandline) exportProof(cmd *cobra.Command) {
+	ccmd := &cobra.Command{
+		Use:               "export-proof key[@revision] file",
+		Short:             "Export the item having the specified key together with its proofs, so that it can be verified offline with immuverify",
+		Aliases:           []string{"ep"},
+		PersistentPreRunE: cl.ConfigChain(cl.connect),
+		PersistentPostRun: cl.disconnect,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resp, err := cl.immucl.ExportProof(args)
+			if err != nil {
+				cl.quit(err)
+			}
+			fprintln(cmd.OutOrStdout(), resp)
+			return nil
+		},
+		Args: cobra.ExactArgs(2),
+	}
+	cmd.AddCommand(ccmd)
+}
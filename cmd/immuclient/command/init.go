@@ -54,9 +54,12 @@ func (cl *commandline) configureFlags(cmd *cobra.Command) error {
 	cmd.PersistentFlags().String("audit-notification-url", "", "If set, auditor will send a POST request at this URL with audit result details.")
 	cmd.PersistentFlags().String("audit-notification-username", "", "Username used to authenticate when publishing audit result to 'audit-notification-url'.")
 	cmd.PersistentFlags().String("audit-notification-password", "", "Password used to authenticate when publishing audit result to 'audit-notification-url'.")
+	cmd.PersistentFlags().String("audit-witness-signing-key", "", "If set, the auditor acts as a witness and cosigns the verified database states with this private key, publishing the cosignatures to the server")
 	cmd.PersistentFlags().String("audit-monitoring-host", "0.0.0.0", "Host for the monitoring HTTP server when running in audit mode (serves endpoints like metrics, health and version).")
 	cmd.PersistentFlags().Int("audit-monitoring-port", 9477, "Port for the monitoring HTTP server when running in audit mode (serves endpoints like metrics, health and version).")
 	cmd.PersistentFlags().String("server-signing-pub-key", "", "Path to the public key to verify signatures when presents")
+	cmd.PersistentFlags().StringSlice("witness-pub-keys", nil, "Comma-separated list of paths to the public keys of the witnesses trusted to cosign server states")
+	cmd.PersistentFlags().Int("min-witness-cosignatures", 0, "If greater than zero, server states not cosigned by at least this number of trusted witnesses are rejected")
 
 	viper.BindPFlag("immudb-port", cmd.PersistentFlags().Lookup("immudb-port"))
 	viper.BindPFlag("immudb-address", cmd.PersistentFlags().Lookup("immudb-address"))
@@ -80,9 +83,12 @@ func (cl *commandline) configureFlags(cmd *cobra.Command) error {
 	viper.BindPFlag("audit-notification-url", cmd.PersistentFlags().Lookup("audit-notification-url"))
 	viper.BindPFlag("audit-notification-username", cmd.PersistentFlags().Lookup("audit-notification-username"))
 	viper.BindPFlag("audit-notification-password", cmd.PersistentFlags().Lookup("audit-notification-password"))
+	viper.BindPFlag("audit-witness-signing-key", cmd.PersistentFlags().Lookup("audit-witness-signing-key"))
 	viper.BindPFlag("audit-monitoring-host", cmd.PersistentFlags().Lookup("audit-monitoring-host"))
 	viper.BindPFlag("audit-monitoring-port", cmd.PersistentFlags().Lookup("audit-monitoring-port"))
 	viper.BindPFlag("server-signing-pub-key", cmd.PersistentFlags().Lookup("server-signing-pub-key"))
+	viper.BindPFlag("witness-pub-keys", cmd.PersistentFlags().Lookup("witness-pub-keys"))
+	viper.BindPFlag("min-witness-cosignatures", cmd.PersistentFlags().Lookup("min-witness-cosignatures"))
 
 	viper.SetDefault("immudb-port", client.DefaultOptions().Port)
 	viper.SetDefault("immudb-address", client.DefaultOptions().Address)
@@ -105,9 +111,12 @@ func (cl *commandline) configureFlags(cmd *cobra.Command) error {
 	viper.SetDefault("audit-notification-url", "")
 	viper.SetDefault("audit-notification-username", "")
 	viper.SetDefault("audit-notification-password", "")
+	viper.SetDefault("audit-witness-signing-key", "")
 	viper.SetDefault("audit-monitoring-host", "0.0.0.0")
 	viper.SetDefault("audit-monitoring-port", 9477)
 	viper.SetDefault("server-signing-pub-key", "")
+	viper.SetDefault("witness-pub-keys", []string{})
+	viper.SetDefault("min-witness-cosignatures", 0)
 	viper.SetDefault("dir", os.TempDir())
 	return nil
 }
@@ -25,6 +25,7 @@ import (
 
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/proofbundle"
 )
 
 var (
@@ -155,3 +156,35 @@ func (i *immuc) VerifiedGet(args []string) (string, error) {
 	entry := response.(*schema.Entry)
 	return PrintKV(entry, true, i.options.valueOnly), nil
 }
+
+func (i *immuc) ExportProof(args []string) (string, error) {
+	key, atRevision, _, err := i.parseKeyArg(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	ctx := context.Background()
+	response, err := i.Execute(func(immuClient client.ImmuClient) (interface{}, error) {
+		return immuClient.ExportProofBundle(ctx, key, client.AtRevision(atRevision))
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			return fmt.Sprintf("key not found: %v ", string(key)), nil
+		}
+		rpcerrors := strings.SplitAfter(err.Error(), "=")
+		if len(rpcerrors) > 1 {
+			return rpcerrors[len(rpcerrors)-1], nil
+		}
+		return "", err
+	}
+
+	bundle := response.(*proofbundle.ProofBundle)
+
+	err = bundle.WriteFile(args[1])
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("proof bundle of key %s at tx %d written to %s\n%s",
+		string(key), bundle.TxHeader.Id, args[1], PrintState(bundle.State)), nil
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package immuc_test
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/codenotary/immudb/pkg/client/proofbundle"
 	"github.com/stretchr/testify/require"
 )
 
@@ -97,3 +99,25 @@ func TestGetByRevision(t *testing.T) {
 	_, err = ic.Imc.Get([]string{"key@notarevision"})
 	require.Error(t, err)
 }
+
+func TestExportProof(t *testing.T) {
+	ic := setupTest(t)
+
+	_, err := ic.Imc.Set([]string{"key", "val"})
+	require.NoError(t, err)
+
+	bundleFile := filepath.Join(t.TempDir(), "proof.json")
+
+	msg, err := ic.Imc.ExportProof([]string{"key", bundleFile})
+	require.NoError(t, err, "ExportProof fail")
+	require.Contains(t, msg, "written to", "ExportProof failed")
+
+	bundle, err := proofbundle.ReadFile(bundleFile)
+	require.NoError(t, err)
+	require.Equal(t, []byte("val"), bundle.Entry.Value)
+	require.NoError(t, bundle.Verify(nil))
+
+	msg, err = ic.Imc.ExportProof([]string{"nonexistent", bundleFile})
+	require.NoError(t, err)
+	require.Contains(t, msg, "key not found")
+}
@@ -48,6 +48,7 @@ type Client interface {
 	VerifiedGetTxByID(args []string) (string, error)
 	Get(args []string) (string, error)
 	VerifiedGet(args []string) (string, error)
+	ExportProof(args []string) (string, error)
 	Login(args []string) (string, error)
 	Logout(args []string) (string, error)
 	History(args []string) (string, error)
@@ -162,7 +163,9 @@ func OptionsFromEnv() *Options {
 		WithDatabase(viper.GetString("database")).
 		WithTokenFileName(viper.GetString("tokenfile")).
 		WithMTLs(viper.GetBool("mtls")).
-		WithServerSigningPubKey(viper.GetString("server-signing-pub-key"))
+		WithServerSigningPubKey(viper.GetString("server-signing-pub-key")).
+		WithWitnessPubKeys(viper.GetStringSlice("witness-pub-keys")).
+		WithMinWitnessCosignatures(viper.GetInt("min-witness-cosignatures"))
 
 	if viper.GetBool("mtls") {
 		// todo https://golang.org/src/crypto/x509/root_linux.go
@@ -20,11 +20,13 @@ import (
 	"bytes"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/codenotary/immudb/cmd/helper"
 	"github.com/codenotary/immudb/cmd/immudb/command/immudbcmdtest"
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -284,3 +286,226 @@ func TestImmudbCommandReplicationFlagsParser(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, options.ReplicationOptions.IsReplica)
 }
+
+func TestImmudbCommandLDAPFlagsParser(t *testing.T) {
+	var options *server.Options
+	var err error
+	cmd := &cobra.Command{
+		Use: "immudb",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			options, err = parseOptions()
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cl := Commandline{}
+	cl.setupFlags(cmd, server.DefaultOptions())
+
+	err = viper.BindPFlags(cmd.Flags())
+	require.NoError(t, err)
+
+	setupDefaults(server.DefaultOptions())
+
+	_, err = executeCommand(cmd,
+		"--ldap-url", "ldap://localhost",
+		"--ldap-starttls",
+		"--ldap-tls-ca", "./../../../test/mtls_certs/ca.cert.pem",
+		"--ldap-user-base-dn", "ou=people,dc=example,dc=com",
+		"--ldap-group-permissions", "engineers=defaultdb:read, engineers=db1:readwrite,dbas=defaultdb:admin",
+	)
+	require.NoError(t, err)
+	require.Equal(t, "ldap://localhost", options.LDAPOptions.URL)
+	require.True(t, options.LDAPOptions.StartTLS)
+	require.False(t, options.LDAPOptions.Cleartext)
+	require.NotNil(t, options.LDAPOptions.TLSConfig.RootCAs)
+	require.Equal(t, "ou=people,dc=example,dc=com", options.LDAPOptions.UserBaseDN)
+	require.Equal(t, "(uid=%s)", options.LDAPOptions.UserFilter)
+	require.Equal(t, map[string][]auth.Permission{
+		"engineers": {
+			{Database: "defaultdb", Permission: auth.PermissionR},
+			{Database: "db1", Permission: auth.PermissionRW},
+		},
+		"dbas": {{Database: "defaultdb", Permission: auth.PermissionAdmin}},
+	}, options.LDAPOptions.GroupPermissions)
+
+	for _, groupPermissions := range []string{"engineers", "engineers=defaultdb", "engineers=defaultdb:sysadmin", "=defaultdb:read"} {
+		_, err = executeCommand(cmd, "--ldap-url", "ldap://localhost", "--ldap-group-permissions", groupPermissions)
+		require.Error(t, err, groupPermissions)
+	}
+
+	_, err = executeCommand(cmd, "--ldap-url", "ldap://localhost", "--ldap-tls-ca", "./missing.pem")
+	require.Error(t, err)
+}
+
+func TestImmudbCommandClientCertificateFlagsParser(t *testing.T) {
+	var options *server.Options
+	var err error
+	cmd := &cobra.Command{
+		Use: "immudb",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			options, err = parseOptions()
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cl := Commandline{}
+	cl.setupFlags(cmd, server.DefaultOptions())
+
+	err = viper.BindPFlags(cmd.Flags())
+	require.NoError(t, err)
+
+	setupDefaults(server.DefaultOptions())
+
+	_, err = executeCommand(cmd,
+		"--clientcrl", "clients.crl",
+		"--mtls-user-mapping", `cn:(.+)\.svc\.example\.com=$1`,
+		"--mtls-user-mapping", `email:(.+)@example\.com,(.+)@example\.org=${1}${2}`,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "clients.crl", options.ClientCRL)
+	require.Equal(t, []*server.CertificateMappingRule{
+		{Field: "cn", Pattern: `(.+)\.svc\.example\.com`, Username: "$1"},
+		{Field: "email", Pattern: `(.+)@example\.com,(.+)@example\.org`, Username: "${1}${2}"},
+	}, options.ClientCertificateMapping)
+
+	_, err = executeCommand(cmd, "--mtls-user-mapping", "cn")
+	require.Error(t, err)
+}
+
+func TestImmudbCommandPasswordPolicyFlagsParser(t *testing.T) {
+	var options *server.Options
+	var err error
+	cmd := &cobra.Command{
+		Use: "immudb",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			options, err = parseOptions()
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cl := Commandline{}
+	cl.setupFlags(cmd, server.DefaultOptions())
+
+	err = viper.BindPFlags(cmd.Flags())
+	require.NoError(t, err)
+
+	setupDefaults(server.DefaultOptions())
+
+	_, err = executeCommand(cmd)
+	require.NoError(t, err)
+	require.Equal(t, auth.DefaultPasswordPolicy(), options.PasswordPolicy)
+	require.Zero(t, options.MaxFailedLogins)
+
+	_, err = executeCommand(cmd,
+		"--password-min-length", "12",
+		"--password-max-length", "64",
+		"--password-require-upper=false",
+		"--password-require-lower",
+		"--password-require-special=false",
+		"--password-history", "5",
+		"--password-max-age", "2160h",
+		"--max-failed-logins", "5",
+		"--lockout-duration", "10m",
+	)
+	require.NoError(t, err)
+	require.Equal(t, &auth.PasswordPolicy{
+		MinLength:    12,
+		MaxLength:    64,
+		RequireLower: true,
+		RequireDigit: true,
+		History:      5,
+		MaxAge:       2160 * time.Hour,
+	}, options.PasswordPolicy)
+	require.Equal(t, 5, options.MaxFailedLogins)
+	require.Equal(t, 10*time.Minute, options.LockoutDuration)
+
+	_, err = executeCommand(cmd, "--password-max-length", "100")
+	require.ErrorIs(t, err, auth.ErrInvalidPasswordPolicy)
+}
+
+func TestImmudbCommandScrubberFlagsParser(t *testing.T) {
+	var options *server.Options
+	var err error
+	cmd := &cobra.Command{
+		Use: "immudb",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			options, err = parseOptions()
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cl := Commandline{}
+	cl.setupFlags(cmd, server.DefaultOptions())
+
+	err = viper.BindPFlags(cmd.Flags())
+	require.NoError(t, err)
+
+	setupDefaults(server.DefaultOptions())
+
+	_, err = executeCommand(cmd)
+	require.NoError(t, err)
+	require.False(t, options.Scrubber)
+
+	_, err = executeCommand(cmd,
+		"--scrubber",
+		"--scrubber-interval", "6h",
+		"--scrubber-rate", "50",
+	)
+	require.NoError(t, err)
+	require.True(t, options.Scrubber)
+	require.Equal(t, 6*time.Hour, options.ScrubberInterval)
+	require.Equal(t, 50, options.ScrubberRate)
+}
+
+func TestImmudbCommandQuotaFlagsParser(t *testing.T) {
+	var options *server.Options
+	var err error
+	cmd := &cobra.Command{
+		Use: "immudb",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			options, err = parseOptions()
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cl := Commandline{}
+	cl.setupFlags(cmd, server.DefaultOptions())
+
+	err = viper.BindPFlags(cmd.Flags())
+	require.NoError(t, err)
+
+	setupDefaults(server.DefaultOptions())
+
+	_, err = executeCommand(cmd,
+		"--quota", "user:jane:rps=10,write-bytes=1048576",
+		"--quota", "database:defaultdb:max-size=1073741824,max-txs=5",
+	)
+	require.NoError(t, err)
+	require.Len(t, options.Quotas, 2)
+	require.Equal(t, &server.QuotaRule{
+		Scope:             server.QuotaScopeUser,
+		Name:              "jane",
+		RequestsPerSecond: 10,
+		WriteBytes:        1048576,
+		WritePeriod:       server.DefaultQuotaWritePeriod,
+	}, options.Quotas[0])
+	require.Equal(t, &server.QuotaRule{
+		Scope:            server.QuotaScopeDatabase,
+		Name:             "defaultdb",
+		MaxDatabaseSize:  1073741824,
+		MaxConcurrentTxs: 5,
+	}, options.Quotas[1])
+
+	_, err = executeCommand(cmd, "--quota", "tenant:jane:rps=10")
+	require.Error(t, err)
+}
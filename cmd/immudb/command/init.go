@@ -20,6 +20,7 @@ import (
 	"time"
 
 	c "github.com/codenotary/immudb/cmd/helper"
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/spf13/cobra"
@@ -57,11 +58,52 @@ func (cl *Commandline) setupFlags(cmd *cobra.Command, options *server.Options) {
 	cmd.Flags().String("certificate", "", "server certificate file path")
 	cmd.Flags().String("pkey", "", "server private key path")
 	cmd.Flags().String("clientcas", "", "clients certificates list. Aka certificate authority")
+	cmd.Flags().String("clientcrl", "", "certificate revocation lists client certificates are checked against, PEM or DER encoded. The file is reloaded whenever it changes")
+	cmd.Flags().StringArray("mtls-user-mapping", nil, "rule mapping verified client certificates to users, in the field:pattern=username form, where field is cn, dns, email or uri and username may refer to the submatches of the pattern, e.g. cn:(.+)\\.svc\\.example\\.com=$1. Users of mapped certificates can authenticate without password. Can be repeated, the first matching rule applies")
 	cmd.Flags().Bool("devmode", options.DevMode, "enable dev mode: accept remote connections without auth")
 	cmd.Flags().String("admin-password", options.AdminPassword, "admin password (default is 'immudb') as plain-text or base64 encoded (must be prefixed with 'enc:' if it is encoded)")
 	cmd.Flags().Bool("force-admin-password", false, "if true, reset the admin password to the one passed through admin-password option upon startup")
 	cmd.Flags().Bool("maintenance", options.GetMaintenance(), "override the authentication flag")
+	cmd.Flags().Bool("audit-log", options.AuditLog, "record administrative and security relevant actions, including failed logins, into the read-only auditdb database")
+	cmd.Flags().Int("password-min-length", options.PasswordPolicy.MinLength, "minimum length of user passwords")
+	cmd.Flags().Int("password-max-length", options.PasswordPolicy.MaxLength, "maximum length of user passwords, up to 72")
+	cmd.Flags().Bool("password-require-upper", options.PasswordPolicy.RequireUpper, "require user passwords to contain an uppercase letter")
+	cmd.Flags().Bool("password-require-lower", options.PasswordPolicy.RequireLower, "require user passwords to contain a lowercase letter")
+	cmd.Flags().Bool("password-require-digit", options.PasswordPolicy.RequireDigit, "require user passwords to contain a digit")
+	cmd.Flags().Bool("password-require-special", options.PasswordPolicy.RequireSpecial, "require user passwords to contain a special character")
+	cmd.Flags().Int("password-history", options.PasswordPolicy.History, "number of previous passwords users can not reuse, including the current one")
+	cmd.Flags().Duration("password-max-age", options.PasswordPolicy.MaxAge, "time after which user passwords expire and must be changed (0 = never)")
+	cmd.Flags().Int("max-failed-logins", options.MaxFailedLogins, "number of consecutive failed login attempts after which users are locked out (0 = no lockout)")
+	cmd.Flags().Duration("lockout-duration", options.LockoutDuration, "how long users are locked out after too many failed login attempts, doubled on every further failed attempt")
 	cmd.Flags().String("signingKey", options.SigningKey, "signature private key path. If a valid one is provided, it enables the cryptographic signature of the root. e.g. \"./../test/signer/ec3.key\"")
+	cmd.Flags().String("signing-plugin", options.SigningPlugin, "external plugin used to sign the root instead of the signature private key, e.g. \"exec:/usr/local/bin/kms-signer\" or \"unix:/var/run/kms-signer.sock\"")
+	cmd.Flags().String("signing-key-chain", options.SigningKeyChain, "path to the file holding the chain of signing key rotations, so that clients trusting a previous key can verify the root signed with the current one")
+	cmd.Flags().StringArray("witness-pub-key", nil, "path to the public key of a witness allowed to cosign the states of the databases. Can be repeated, any witness is allowed if none is provided")
+	cmd.Flags().String("tsa-url", options.TimestampAuthorityURL, "RFC 3161 time-stamping authority URL. If provided, the state of the databases is periodically anchored with time-stamp tokens issued by it")
+	cmd.Flags().Duration("tsa-interval", options.TimestampAuthorityInterval, "how often the state of the databases is anchored with the time-stamping authority")
+	cmd.Flags().StringArray("quota", nil, "rule limiting the requests of a user or to a database, in the scope:name:limit=value,... form, where scope is user or database, name may be * for those without a rule of their own (sysadmin excluded) and limits are rps, write-bytes, write-period, max-size (databases only) and max-txs, e.g. user:alice:rps=100,write-bytes=1048576,write-period=1m,max-txs=4. Can be repeated")
+	cmd.Flags().Bool("scrubber", options.Scrubber, "enables the background verification of the integrity of the data stored by the databases")
+	cmd.Flags().Duration("scrubber-interval", options.ScrubberInterval, "how often the integrity of each database is verified by the scrubber")
+	cmd.Flags().Int("scrubber-rate", options.ScrubberRate, "maximum number of transactions verified per second by the scrubber (0 = unlimited)")
+	cmd.Flags().String("jwt-jwks", "", "path or URL of the JSON Web Key Set used to validate JWT bearer tokens. If provided, users can authenticate with tokens issued by an OpenID Connect provider instead of passwords")
+	cmd.Flags().String("jwt-issuer", "", "issuer JWT bearer tokens must have been issued by, required when jwt-jwks is provided")
+	cmd.Flags().String("jwt-audience", "", "audience JWT bearer tokens must have been issued for, required when jwt-jwks is provided")
+	cmd.Flags().String("jwt-username-claim", auth.DefaultJWTUsernameClaim, "JWT claim holding the immudb username")
+	cmd.Flags().String("jwt-roles-claim", auth.DefaultJWTRolesClaim, "JWT claim holding the immudb roles granted to the user")
+	ldapOptions := server.DefaultLDAPOptions()
+	cmd.Flags().String("ldap-url", "", "URL of the LDAP directory users are authenticated against, ldap://host[:port] or ldaps://host[:port]. ldap:// urls require either ldap-starttls or ldap-allow-cleartext. Users not found in the directory and sysadmin are still authenticated by immudb")
+	cmd.Flags().Bool("ldap-starttls", false, "upgrades the connections to the LDAP directory to TLS with StartTLS, for ldap:// urls")
+	cmd.Flags().Bool("ldap-allow-cleartext", false, "allows ldap:// urls without StartTLS. Passwords are then sent to the LDAP directory in cleartext")
+	cmd.Flags().String("ldap-tls-ca", "", "path to the PEM encoded certificates of the authorities trusted to issue the certificate of the LDAP directory, the system ones are used if not provided")
+	cmd.Flags().Duration("ldap-timeout", ldapOptions.Timeout, "timeout of the connections and operations on the LDAP directory")
+	cmd.Flags().String("ldap-bind-dn", "", "distinguished name of the service account used to look up users and groups in the LDAP directory, anonymous if empty")
+	cmd.Flags().String("ldap-bind-password", "", "password of the LDAP service account")
+	cmd.Flags().String("ldap-user-base-dn", "", "distinguished name users are looked up under")
+	cmd.Flags().String("ldap-user-filter", ldapOptions.UserFilter, "filter users are looked up with, %s is replaced with the username")
+	cmd.Flags().String("ldap-group-base-dn", "", "distinguished name groups are looked up under. If not provided, groups are not looked up")
+	cmd.Flags().String("ldap-group-filter", ldapOptions.GroupFilter, "filter the groups of a user are looked up with, %s is replaced with the distinguished name of the user")
+	cmd.Flags().String("ldap-group-name-attribute", ldapOptions.GroupNameAttribute, "attribute holding the names group permissions are mapped by")
+	cmd.Flags().String("ldap-group-permissions", "", "permissions granted to the members of LDAP groups, as a comma separated list of group=database:permission entries, where permission is read, readwrite or admin")
 	cmd.Flags().Bool("synced", true, "synced mode prevents data lost under unexpected crashes but affects performance")
 	cmd.Flags().Int("token-expiry-time", options.TokenExpiryTimeMin, "client authentication token expiration time. Minutes")
 	cmd.Flags().Bool("metrics-server", options.MetricsServer, "enable or disable Prometheus endpoint")
@@ -117,10 +159,51 @@ func setupDefaults(options *server.Options) {
 	viper.SetDefault("certificate", "")
 	viper.SetDefault("pkey", "")
 	viper.SetDefault("clientcas", "")
+	viper.SetDefault("clientcrl", "")
+	viper.SetDefault("mtls-user-mapping", []string{})
 	viper.SetDefault("devmode", options.DevMode)
 	viper.SetDefault("admin-password", options.AdminPassword)
 	viper.SetDefault("force-admin-password", options.ForceAdminPassword)
 	viper.SetDefault("maintenance", options.GetMaintenance())
+	viper.SetDefault("audit-log", options.AuditLog)
+	viper.SetDefault("password-min-length", options.PasswordPolicy.MinLength)
+	viper.SetDefault("password-max-length", options.PasswordPolicy.MaxLength)
+	viper.SetDefault("password-require-upper", options.PasswordPolicy.RequireUpper)
+	viper.SetDefault("password-require-lower", options.PasswordPolicy.RequireLower)
+	viper.SetDefault("password-require-digit", options.PasswordPolicy.RequireDigit)
+	viper.SetDefault("password-require-special", options.PasswordPolicy.RequireSpecial)
+	viper.SetDefault("password-history", options.PasswordPolicy.History)
+	viper.SetDefault("password-max-age", options.PasswordPolicy.MaxAge)
+	viper.SetDefault("max-failed-logins", options.MaxFailedLogins)
+	viper.SetDefault("lockout-duration", options.LockoutDuration)
+	viper.SetDefault("signing-plugin", options.SigningPlugin)
+	viper.SetDefault("signing-key-chain", options.SigningKeyChain)
+	viper.SetDefault("witness-pub-key", []string{})
+	viper.SetDefault("tsa-url", options.TimestampAuthorityURL)
+	viper.SetDefault("tsa-interval", options.TimestampAuthorityInterval)
+	viper.SetDefault("quota", []string{})
+	viper.SetDefault("scrubber", options.Scrubber)
+	viper.SetDefault("scrubber-interval", options.ScrubberInterval)
+	viper.SetDefault("scrubber-rate", options.ScrubberRate)
+	viper.SetDefault("jwt-jwks", "")
+	viper.SetDefault("jwt-issuer", "")
+	viper.SetDefault("jwt-audience", "")
+	viper.SetDefault("jwt-username-claim", auth.DefaultJWTUsernameClaim)
+	viper.SetDefault("jwt-roles-claim", auth.DefaultJWTRolesClaim)
+	ldapOptions := server.DefaultLDAPOptions()
+	viper.SetDefault("ldap-url", "")
+	viper.SetDefault("ldap-starttls", false)
+	viper.SetDefault("ldap-allow-cleartext", false)
+	viper.SetDefault("ldap-tls-ca", "")
+	viper.SetDefault("ldap-timeout", ldapOptions.Timeout)
+	viper.SetDefault("ldap-bind-dn", "")
+	viper.SetDefault("ldap-bind-password", "")
+	viper.SetDefault("ldap-user-base-dn", "")
+	viper.SetDefault("ldap-user-filter", ldapOptions.UserFilter)
+	viper.SetDefault("ldap-group-base-dn", "")
+	viper.SetDefault("ldap-group-filter", ldapOptions.GroupFilter)
+	viper.SetDefault("ldap-group-name-attribute", ldapOptions.GroupNameAttribute)
+	viper.SetDefault("ldap-group-permissions", "")
 	viper.SetDefault("synced", true)
 	viper.SetDefault("token-expiry-time", options.TokenExpiryTimeMin)
 	viper.SetDefault("metrics-server", options.MetricsServer)
@@ -17,6 +17,10 @@ limitations under the License.
 package immudb
 
 import (
+	"fmt"
+	"strings"
+
+	immuauth "github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/codenotary/immudb/pkg/server/sessions"
 	"github.com/spf13/viper"
@@ -62,12 +66,39 @@ func parseOptions() (options *server.Options, err error) {
 	certificate := viper.GetString("certificate")
 	pkey := viper.GetString("pkey")
 	clientcas := viper.GetString("clientcas")
+	clientcrl := viper.GetString("clientcrl")
 
 	devMode := viper.GetBool("devmode")
 	adminPassword := viper.GetString("admin-password")
 	forceAdminPassword := viper.GetBool("force-admin-password")
 	maintenance := viper.GetBool("maintenance")
+	auditLog := viper.GetBool("audit-log")
+	passwordPolicy := &immuauth.PasswordPolicy{
+		MinLength:      viper.GetInt("password-min-length"),
+		MaxLength:      viper.GetInt("password-max-length"),
+		RequireUpper:   viper.GetBool("password-require-upper"),
+		RequireLower:   viper.GetBool("password-require-lower"),
+		RequireDigit:   viper.GetBool("password-require-digit"),
+		RequireSpecial: viper.GetBool("password-require-special"),
+		History:        viper.GetInt("password-history"),
+		MaxAge:         viper.GetDuration("password-max-age"),
+	}
+	if err := passwordPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	maxFailedLogins := viper.GetInt("max-failed-logins")
+	lockoutDuration := viper.GetDuration("lockout-duration")
 	signingKey := viper.GetString("signingKey")
+	signingPlugin := viper.GetString("signing-plugin")
+	signingKeyChain := viper.GetString("signing-key-chain")
+	witnessPubKeys := viper.GetStringSlice("witness-pub-key")
+	tsaURL := viper.GetString("tsa-url")
+	tsaInterval := viper.GetDuration("tsa-interval")
+	scrubber := viper.GetBool("scrubber")
+	scrubberInterval := viper.GetDuration("scrubber-interval")
+	scrubberRate := viper.GetInt("scrubber-rate")
+	jwks := viper.GetString("jwt-jwks")
+	ldapURL := viper.GetString("ldap-url")
 	synced := viper.GetBool("synced")
 	tokenExpTime := viper.GetInt("token-expiry-time")
 
@@ -106,6 +137,61 @@ func parseOptions() (options *server.Options, err error) {
 		WithMaxSessionAgeTime(viper.GetDuration("max-session-age-time")).
 		WithTimeout(viper.GetDuration("session-timeout"))
 
+	var jwtOptions *immuauth.JWTOptions
+	if jwks != "" {
+		jwtOptions = &immuauth.JWTOptions{
+			JWKS:          jwks,
+			Issuer:        viper.GetString("jwt-issuer"),
+			Audience:      viper.GetString("jwt-audience"),
+			UsernameClaim: viper.GetString("jwt-username-claim"),
+			RolesClaim:    viper.GetString("jwt-roles-claim"),
+		}
+	}
+
+	var ldapOptions *server.LDAPOptions
+	if ldapURL != "" {
+		groupPermissions, err := parseLDAPGroupPermissions(viper.GetString("ldap-group-permissions"))
+		if err != nil {
+			return options, err
+		}
+
+		ldapOptions = server.DefaultLDAPOptions()
+		ldapOptions.URL = ldapURL
+		ldapOptions.StartTLS = viper.GetBool("ldap-starttls")
+		ldapOptions.Cleartext = viper.GetBool("ldap-allow-cleartext")
+		ldapOptions.TLSConfig, err = setUpLDAPTLS(viper.GetString("ldap-tls-ca"))
+		if err != nil {
+			return options, err
+		}
+		ldapOptions.Timeout = viper.GetDuration("ldap-timeout")
+		ldapOptions.BindDN = viper.GetString("ldap-bind-dn")
+		ldapOptions.BindPassword = viper.GetString("ldap-bind-password")
+		ldapOptions.UserBaseDN = viper.GetString("ldap-user-base-dn")
+		ldapOptions.UserFilter = viper.GetString("ldap-user-filter")
+		ldapOptions.GroupBaseDN = viper.GetString("ldap-group-base-dn")
+		ldapOptions.GroupFilter = viper.GetString("ldap-group-filter")
+		ldapOptions.GroupNameAttribute = viper.GetString("ldap-group-name-attribute")
+		ldapOptions.GroupPermissions = groupPermissions
+	}
+
+	var certificateMapping []*server.CertificateMappingRule
+	for _, r := range viper.GetStringSlice("mtls-user-mapping") {
+		rule, err := server.ParseCertificateMappingRule(r)
+		if err != nil {
+			return options, err
+		}
+		certificateMapping = append(certificateMapping, rule)
+	}
+
+	var quotas []*server.QuotaRule
+	for _, r := range viper.GetStringSlice("quota") {
+		rule, err := server.ParseQuotaRule(r)
+		if err != nil {
+			return options, err
+		}
+		quotas = append(quotas, rule)
+	}
+
 	tlsConfig, err := setUpTLS(pkey, certificate, clientcas, mtls)
 	if err != nil {
 		return options, err
@@ -120,6 +206,8 @@ func parseOptions() (options *server.Options, err error) {
 		WithPidfile(pidfile).
 		WithLogfile(logfile).
 		WithTLS(tlsConfig).
+		WithClientCRL(clientcrl).
+		WithClientCertificateMapping(certificateMapping...).
 		WithAuth(auth).
 		WithMaxRecvMsgSize(maxRecvMsgSize).
 		WithNoHistograms(noHistograms).
@@ -128,7 +216,22 @@ func parseOptions() (options *server.Options, err error) {
 		WithAdminPassword(adminPassword).
 		WithForceAdminPassword(forceAdminPassword).
 		WithMaintenance(maintenance).
+		WithAuditLog(auditLog).
+		WithPasswordPolicy(passwordPolicy).
+		WithMaxFailedLogins(maxFailedLogins).
+		WithLockoutDuration(lockoutDuration).
 		WithSigningKey(signingKey).
+		WithSigningPlugin(signingPlugin).
+		WithSigningKeyChain(signingKeyChain).
+		WithWitnessPubKeys(witnessPubKeys...).
+		WithTimestampAuthorityURL(tsaURL).
+		WithTimestampAuthorityInterval(tsaInterval).
+		WithQuotas(quotas...).
+		WithScrubber(scrubber).
+		WithScrubberInterval(scrubberInterval).
+		WithScrubberRate(scrubberRate).
+		WithJWTOptions(jwtOptions).
+		WithLDAPOptions(ldapOptions).
 		WithSynced(synced).
 		WithRemoteStorageOptions(remoteStorageOptions).
 		WithTokenExpiryTime(tokenExpTime).
@@ -144,3 +247,52 @@ func parseOptions() (options *server.Options, err error) {
 
 	return options, nil
 }
+
+// parseLDAPGroupPermissions parses the permissions granted to the members of LDAP groups,
+// as a comma separated list of group=database:permission entries
+func parseLDAPGroupPermissions(s string) (map[string][]immuauth.Permission, error) {
+	groupPermissions := make(map[string][]immuauth.Permission)
+
+	if strings.TrimSpace(s) == "" {
+		return groupPermissions, nil
+	}
+
+	for _, entry := range strings.Split(s, ",") {
+		group, grant, ok := cut(strings.TrimSpace(entry), "=")
+		if !ok || group == "" {
+			return nil, fmt.Errorf("invalid ldap group permission '%s': expected group=database:permission", entry)
+		}
+
+		database, permissionName, ok := cut(grant, ":")
+		if !ok || database == "" {
+			return nil, fmt.Errorf("invalid ldap group permission '%s': expected group=database:permission", entry)
+		}
+
+		var permission uint32
+
+		switch permissionName {
+		case "read":
+			permission = immuauth.PermissionR
+		case "readwrite":
+			permission = immuauth.PermissionRW
+		case "admin":
+			permission = immuauth.PermissionAdmin
+		default:
+			return nil, fmt.Errorf("invalid ldap group permission '%s': allowed permissions are read, readwrite, admin", entry)
+		}
+
+		groupPermissions[group] = append(groupPermissions[group], immuauth.Permission{
+			Database:   database,
+			Permission: permission,
+		})
+	}
+
+	return groupPermissions, nil
+}
+
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
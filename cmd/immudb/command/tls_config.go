@@ -60,3 +60,24 @@ func setUpTLS(pkey, cert, ca string, mtls bool) (*tls.Config, error) {
 
 	return c, nil
 }
+
+// setUpLDAPTLS returns the configuration trusting the certificate authorities of the LDAP directory,
+// the system ones are trusted if the file is not provided
+func setUpLDAPTLS(ca string) (*tls.Config, error) {
+	if ca == "" {
+		return nil, nil
+	}
+
+	bs, err := ioutil.ReadFile(ca)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ldap ca cert: %v", err)
+	}
+
+	certPool := x509.NewCertPool()
+
+	if !certPool.AppendCertsFromPEM(bs) {
+		return nil, errors.New("failed to append ldap ca certs")
+	}
+
+	return &tls.Config{RootCAs: certPool}, nil
+}
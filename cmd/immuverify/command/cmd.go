@@ -0,0 +1,114 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuverify
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/cmd/version"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/proofbundle"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/spf13/cobra"
+)
+
+// NewCmd creates a new immuverify command
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "immuverify bundle",
+		Short: "Verify a proof bundle exported with immuclient export-proof, without access to the server",
+		Long: `Verify a proof bundle exported with immuclient export-proof, without access to the server.
+
+The entry is verified to be included in its transaction and the transaction to be consistent
+with the state stored in the bundle. The signature of the state is verified when the public
+key of the server is provided, otherwise the state must be compared with a trusted one.`,
+		Example: `  immuverify proof.json --server-signing-pub-key server.pub`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubKeyFile, err := cmd.Flags().GetString("server-signing-pub-key")
+			if err != nil {
+				return err
+			}
+
+			return verify(cmd.OutOrStdout(), args[0], pubKeyFile)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String("server-signing-pub-key", "", "path to the public key used to verify the signature of the state")
+
+	cmd.AddCommand(version.VersionCmd())
+
+	return cmd
+}
+
+func verify(w io.Writer, bundleFile, pubKeyFile string) error {
+	var pubKey crypto.PublicKey
+
+	if pubKeyFile != "" {
+		k, err := signer.ParsePublicKeyFile(pubKeyFile)
+		if err != nil {
+			return err
+		}
+
+		pubKey = k
+	}
+
+	bundle, err := proofbundle.ReadFile(bundleFile)
+	if err != nil {
+		return err
+	}
+
+	err = bundle.Verify(pubKey)
+	if err != nil {
+		return fmt.Errorf("proof bundle verification failed: %w", err)
+	}
+
+	printEntry(w, bundle.Entry)
+
+	fmt.Fprintf(w, "database: %s\n", bundle.State.Db)
+	fmt.Fprintf(w, "state:    tx %d, hash %x\n", bundle.State.TxId, bundle.State.TxHash)
+
+	if pubKey == nil {
+		fmt.Fprintf(w, "signed:   not checked, no server signing public key was provided\n")
+	} else {
+		fmt.Fprintf(w, "signed:   true\n")
+	}
+
+	fmt.Fprintf(w, "verified: true\n")
+
+	return nil
+}
+
+func printEntry(w io.Writer, e *schema.Entry) {
+	if e.ReferencedBy != nil {
+		fmt.Fprintf(w, "tx:       %d\n", e.ReferencedBy.Tx)
+		fmt.Fprintf(w, "key:      %s\n", e.ReferencedBy.Key)
+		fmt.Fprintf(w, "ref:      %s\n", e.Key)
+	} else {
+		fmt.Fprintf(w, "tx:       %d\n", e.Tx)
+		fmt.Fprintf(w, "key:      %s\n", e.Key)
+	}
+
+	if e.Revision != 0 {
+		fmt.Fprintf(w, "rev:      %d\n", e.Revision)
+	}
+
+	fmt.Fprintf(w, "value:    %s\n", e.Value)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package immuverify
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmuVerify(t *testing.T) {
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(t.TempDir()).
+		WithSigningKey("./../../../test/signer/ec1.key"),
+	)
+
+	bs.Start()
+	defer bs.Stop()
+
+	cli, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	defer cli.CloseSession(context.Background())
+
+	_, err = cli.Set(context.Background(), []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	bundle, err := cli.ExportProofBundle(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+
+	bundleFile := filepath.Join(t.TempDir(), "proof.json")
+
+	err = bundle.WriteFile(bundleFile)
+	require.NoError(t, err)
+
+	t.Run("verify without public key", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		cmd := NewCmd()
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{bundleFile})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "value1")
+		require.Contains(t, out.String(), "signed:   not checked")
+		require.Contains(t, out.String(), "verified: true")
+	})
+
+	t.Run("verify with public key", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		cmd := NewCmd()
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{bundleFile, "--server-signing-pub-key", "./../../../test/signer/ec1.pub"})
+
+		err := cmd.Execute()
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "signed:   true")
+		require.Contains(t, out.String(), "verified: true")
+
+		cmd = NewCmd()
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{bundleFile, "--server-signing-pub-key", "./../../../test/signer/ec3.pub"})
+
+		err = cmd.Execute()
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("verify tampered bundle", func(t *testing.T) {
+		bundle.Entry.Value = []byte("tampered")
+
+		tamperedFile := filepath.Join(t.TempDir(), "tampered.json")
+
+		err = bundle.WriteFile(tamperedFile)
+		require.NoError(t, err)
+
+		cmd := NewCmd()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{tamperedFile})
+
+		err := cmd.Execute()
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("verify missing bundle", func(t *testing.T) {
+		cmd := NewCmd()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{filepath.Join(t.TempDir(), "missing.json")})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+	})
+}
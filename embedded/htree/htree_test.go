@@ -85,3 +85,79 @@ func TestHTree(t *testing.T) {
 	_, err = tree.InclusionProof(maxWidth)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 }
+
+func TestMultiInclusionProof(t *testing.T) {
+	const maxWidth = 100
+
+	tree, err := New(maxWidth)
+	require.NoError(t, err)
+
+	for width := 1; width <= maxWidth; width++ {
+		digests := make([][sha256.Size]byte, width)
+
+		for i := 0; i < width; i++ {
+			var b [8]byte
+			binary.BigEndian.PutUint64(b[:], uint64(i))
+			digests[i] = sha256.Sum256(b[:])
+		}
+
+		err = tree.BuildWith(digests)
+		require.NoError(t, err)
+
+		root := tree.Root()
+
+		for step := 1; step <= width; step += 7 {
+			var leaves []int
+			var leafDigests [][sha256.Size]byte
+
+			for i := step / 2; i < width; i += step {
+				leaves = append(leaves, i)
+				leafDigests = append(leafDigests, digests[i])
+			}
+
+			proof, err := tree.MultiInclusionProof(leaves)
+			require.NoError(t, err)
+			require.True(t, VerifyMultiInclusion(proof, leafDigests, root))
+
+			// shared subtrees are included just once
+			termCount := 0
+			for _, l := range leaves {
+				iproof, err := tree.InclusionProof(l)
+				require.NoError(t, err)
+
+				termCount += len(iproof.Terms)
+			}
+			require.LessOrEqual(t, len(proof.Terms), termCount)
+
+			require.False(t, VerifyMultiInclusion(proof, leafDigests, sha256.Sum256(root[:])))
+
+			leafDigests[0] = sha256.Sum256(leafDigests[0][:])
+			require.False(t, VerifyMultiInclusion(proof, leafDigests, root))
+
+			require.False(t, VerifyMultiInclusion(proof, leafDigests[1:], root))
+
+			if len(proof.Terms) > 0 {
+				proof.Terms = proof.Terms[1:]
+				require.False(t, VerifyMultiInclusion(proof, leafDigests, root))
+			}
+		}
+
+		proof, err := tree.MultiInclusionProof([]int{0})
+		require.NoError(t, err)
+
+		proof.Terms = append(proof.Terms, root)
+		require.False(t, VerifyMultiInclusion(proof, digests[:1], root))
+	}
+
+	_, err = tree.MultiInclusionProof(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = tree.MultiInclusionProof([]int{1, 1})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = tree.MultiInclusionProof([]int{maxWidth})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	require.False(t, VerifyMultiInclusion(nil, nil, tree.Root()))
+	require.False(t, VerifyMultiInclusion(&MultiInclusionProof{Leaves: []int{1, 0}, Width: 2}, make([][sha256.Size]byte, 2), tree.Root()))
+}
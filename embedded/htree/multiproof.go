@@ -0,0 +1,142 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package htree
+
+import (
+	"crypto/sha256"
+	"math/bits"
+	"sort"
+)
+
+// MultiInclusionProof proves the inclusion of several leaves at once,
+// subtrees shared by the paths of the leaves are included just once
+type MultiInclusionProof struct {
+	Leaves []int
+	Width  int
+	Terms  [][sha256.Size]byte
+}
+
+// MultiInclusionProof returns the roots of the subtrees not holding any of the leaves which are required
+// to compute the root, listed as found by a depth-first, left-to-right traversal of the tree.
+// Leaves must be provided in strictly ascending order.
+func (t *HTree) MultiInclusionProof(leaves []int) (*MultiInclusionProof, error) {
+	if len(leaves) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	for i, l := range leaves {
+		if l < 0 || l >= t.width || (i > 0 && l <= leaves[i-1]) {
+			return nil, ErrIllegalArguments
+		}
+	}
+
+	proof := &MultiInclusionProof{
+		Leaves: make([]int, len(leaves)),
+		Width:  t.width,
+	}
+
+	copy(proof.Leaves, leaves)
+
+	var appendTerms func(offset, n int, leaves []int)
+
+	appendTerms = func(offset, n int, leaves []int) {
+		if len(leaves) == 0 {
+			layer := bits.Len(uint(n - 1))
+			proof.Terms = append(proof.Terms, t.levels[layer][offset>>layer])
+			return
+		}
+
+		if n == 1 {
+			return
+		}
+
+		k := splitPoint(n)
+		split := sort.SearchInts(leaves, offset+k)
+
+		appendTerms(offset, k, leaves[:split])
+		appendTerms(offset+k, n-k, leaves[split:])
+	}
+
+	appendTerms(0, t.width, proof.Leaves)
+
+	return proof, nil
+}
+
+// VerifyMultiInclusion verifies the digests, listed in the same order as the leaves of the proof, are included in the tree
+func VerifyMultiInclusion(proof *MultiInclusionProof, digests [][sha256.Size]byte, root [sha256.Size]byte) bool {
+	if proof == nil || len(proof.Leaves) == 0 || len(proof.Leaves) != len(digests) {
+		return false
+	}
+
+	for i, l := range proof.Leaves {
+		if l < 0 || l >= proof.Width || (i > 0 && l <= proof.Leaves[i-1]) {
+			return false
+		}
+	}
+
+	nextTerm := 0
+
+	var subtreeRoot func(offset, n, lo, hi int) ([sha256.Size]byte, bool)
+
+	subtreeRoot = func(offset, n, lo, hi int) ([sha256.Size]byte, bool) {
+		if lo == hi {
+			if nextTerm == len(proof.Terms) {
+				return [sha256.Size]byte{}, false
+			}
+
+			nextTerm++
+
+			return proof.Terms[nextTerm-1], true
+		}
+
+		if n == 1 {
+			leaf := [1 + sha256.Size]byte{LeafPrefix}
+			copy(leaf[1:], digests[lo][:])
+
+			return sha256.Sum256(leaf[:]), true
+		}
+
+		k := splitPoint(n)
+		split := lo + sort.SearchInts(proof.Leaves[lo:hi], offset+k)
+
+		left, ok := subtreeRoot(offset, k, lo, split)
+		if !ok {
+			return left, false
+		}
+
+		right, ok := subtreeRoot(offset+k, n-k, split, hi)
+		if !ok {
+			return right, false
+		}
+
+		b := [1 + 2*sha256.Size]byte{NodePrefix}
+		copy(b[1:], left[:])
+		copy(b[1+sha256.Size:], right[:])
+
+		return sha256.Sum256(b[:]), true
+	}
+
+	calcRoot, ok := subtreeRoot(0, proof.Width, 0, len(proof.Leaves))
+
+	return ok && nextTerm == len(proof.Terms) && calcRoot == root
+}
+
+// splitPoint returns the size of the left subtree of a tree holding n > 1 leaves,
+// being the largest power of two lower than n
+func splitPoint(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
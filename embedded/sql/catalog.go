@@ -50,6 +50,8 @@ type Table struct {
 	primaryIndex    *Index
 	autoIncrementPK bool
 	maxPK           int64
+	policies        []*Policy
+	policiesByName  map[string]*Policy
 }
 
 type Index struct {
@@ -60,6 +62,15 @@ type Index struct {
 	colsByID map[uint32]*Column
 }
 
+// Policy is a row-level security policy, rows of the table are only accessible by a command
+// when they satisfy the condition of at least one of the policies applying to it
+type Policy struct {
+	table *Table
+	name  string
+	cmd   PolicyCommand
+	using ValueExp
+}
+
 type Column struct {
 	table         *Table
 	id            uint32
@@ -159,6 +170,18 @@ func (t *Table) GetColumnByID(id uint32) (*Column, error) {
 	return col, nil
 }
 
+func (t *Table) Policies() []*Policy {
+	return t.policies
+}
+
+func (t *Table) GetPolicyByName(name string) (*Policy, error) {
+	p, exists := t.policiesByName[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrPolicyDoesNotExist, name)
+	}
+	return p, nil
+}
+
 func (i *Index) IsPrimary() bool {
 	return i.id == PKIndexID
 }
@@ -250,6 +273,7 @@ func (catlg *Catalog) newTable(name string, colsSpec []*ColSpec) (table *Table,
 		colsByName:     make(map[string]*Column),
 		indexesByName:  make(map[string]*Index),
 		indexesByColID: make(map[uint32][]*Index),
+		policiesByName: make(map[string]*Policy),
 	}
 
 	for i, cs := range colsSpec {
@@ -343,6 +367,47 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 	return index, nil
 }
 
+func (t *Table) newPolicy(name string, cmd PolicyCommand, using ValueExp) (*Policy, error) {
+	if len(name) == 0 || using == nil || cmd < SelectPolicyCommand || cmd > AllPolicyCommand {
+		return nil, ErrIllegalArguments
+	}
+
+	_, exists := t.policiesByName[name]
+	if exists {
+		return nil, fmt.Errorf("%w (%s)", ErrPolicyAlreadyExists, name)
+	}
+
+	policy := &Policy{
+		table: t,
+		name:  name,
+		cmd:   cmd,
+		using: using,
+	}
+
+	t.policies = append(t.policies, policy)
+	t.policiesByName[name] = policy
+
+	return policy, nil
+}
+
+func (t *Table) dropPolicy(name string) (*Policy, error) {
+	policy, err := t.GetPolicyByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range t.policies {
+		if p == policy {
+			t.policies = append(t.policies[:i], t.policies[i+1:]...)
+			break
+		}
+	}
+
+	delete(t.policiesByName, name)
+
+	return policy, nil
+}
+
 func (t *Table) newColumn(spec *ColSpec) (*Column, error) {
 	if spec.autoIncrement {
 		return nil, fmt.Errorf("%w (%s)", ErrLimitedAutoIncrement, spec.colName)
@@ -390,6 +455,11 @@ func (t *Table) renameColumn(oldName, newName string) (*Column, error) {
 		return nil, fmt.Errorf("%w (%s)", ErrColumnDoesNotExist, oldName)
 	}
 
+	// policy conditions refer to columns by name
+	if len(t.policies) > 0 {
+		return nil, fmt.Errorf("%w: columns of table %s can not be renamed as it has row-level security policies", ErrIllegalArguments, t.name)
+	}
+
 	_, exists = t.colsByName[newName]
 	if exists {
 		return nil, fmt.Errorf("%w (%s)", ErrColumnAlreadyExists, newName)
@@ -403,6 +473,14 @@ func (t *Table) renameColumn(oldName, newName string) (*Column, error) {
 	return col, nil
 }
 
+func (p *Policy) Name() string {
+	return p.name
+}
+
+func (p *Policy) Command() PolicyCommand {
+	return p.cmd
+}
+
 func (c *Column) ID() uint32 {
 	return c.id
 }
@@ -506,6 +584,11 @@ func (catlg *Catalog) load(tx *store.OngoingTx) error {
 			return err
 		}
 
+		err = table.loadPolicies(catlg.prefix, tx)
+		if err != nil {
+			return err
+		}
+
 		if table.autoIncrementPK {
 			encMaxPK, err := loadMaxPK(catlg.prefix, tx, table)
 			if errors.Is(err, store.ErrNoMoreEntries) {
@@ -683,6 +766,62 @@ func (table *Table) loadIndexes(sqlPrefix []byte, tx *store.OngoingTx) error {
 	return nil
 }
 
+func (table *Table) loadPolicies(sqlPrefix []byte, tx *store.OngoingTx) error {
+	initialKey := mapKey(sqlPrefix, catalogPolicyPrefix, EncodeID(1), EncodeID(table.id))
+
+	policyReaderSpec := store.KeyReaderSpec{
+		Prefix:  initialKey,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	}
+
+	policyReader, err := tx.NewKeyReader(policyReaderSpec)
+	if err != nil {
+		return err
+	}
+	defer policyReader.Close()
+
+	for {
+		mkey, vref, err := policyReader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		dbID, tableID, name, err := unmapPolicy(sqlPrefix, mkey)
+		if err != nil {
+			return err
+		}
+
+		if table.id != tableID || dbID != 1 {
+			return ErrCorruptedData
+		}
+
+		v, err := vref.Resolve()
+		if err != nil {
+			return err
+		}
+
+		// v={cmd}{usingEXP}
+		if len(v) < 2 {
+			return ErrCorruptedData
+		}
+
+		using, err := parsePolicyCondition(string(v[1:]))
+		if err != nil {
+			return ErrCorruptedData
+		}
+
+		_, err = table.newPolicy(name, PolicyCommand(v[0]), using)
+		if err != nil {
+			return ErrCorruptedData
+		}
+	}
+
+	return nil
+}
+
 func trimPrefix(prefix, mkey []byte, mappingPrefix []byte) ([]byte, error) {
 	if len(prefix)+len(mappingPrefix) > len(mkey) ||
 		!bytes.Equal(prefix, mkey[:len(prefix)]) ||
@@ -761,6 +900,23 @@ func unmapIndex(sqlPrefix, mkey []byte) (dbID, tableID, indexID uint32, err erro
 	return
 }
 
+func unmapPolicy(sqlPrefix, mkey []byte) (dbID, tableID uint32, name string, err error) {
+	enc, err := trimPrefix(sqlPrefix, mkey, []byte(catalogPolicyPrefix))
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	if len(enc) <= EncIDLen*2 {
+		return 0, 0, "", ErrCorruptedData
+	}
+
+	dbID = binary.BigEndian.Uint32(enc)
+	tableID = binary.BigEndian.Uint32(enc[EncIDLen:])
+	name = string(enc[EncIDLen*2:])
+
+	return
+}
+
 func unmapIndexEntry(index *Index, sqlPrefix, mkey []byte) (encPKVals []byte, err error) {
 	if index == nil {
 		return nil, ErrIllegalArguments
@@ -1293,6 +1449,56 @@ func (t *Table) addIndexesToTx(sqlPrefix []byte, tx *store.OngoingTx) error {
 	return nil
 }
 
+// addPoliciesToTx adds the policies of the table to the given transaction.
+func (t *Table) addPoliciesToTx(sqlPrefix []byte, tx *store.OngoingTx) error {
+	initialKey := mapKey(sqlPrefix, catalogPolicyPrefix, EncodeID(1), EncodeID(t.id))
+
+	policyReaderSpec := store.KeyReaderSpec{
+		Prefix:  initialKey,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	}
+
+	policyReader, err := tx.NewKeyReader(policyReaderSpec)
+	if err != nil {
+		return err
+	}
+	defer policyReader.Close()
+
+	for {
+		mkey, vref, err := policyReader.Read()
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		dbID, tableID, _, err := unmapPolicy(sqlPrefix, mkey)
+		if err != nil {
+			return err
+		}
+
+		if t.id != tableID || dbID != 1 {
+			return ErrCorruptedData
+		}
+
+		v, err := vref.Resolve()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tx.Set(mkey, nil, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // addSchemaToTx adds the schema of the catalog to the given transaction.
 func (catlg *Catalog) addSchemaToTx(sqlPrefix []byte, tx *store.OngoingTx) error {
 	dbReaderSpec := store.KeyReaderSpec{
@@ -1358,6 +1564,12 @@ func (catlg *Catalog) addSchemaToTx(sqlPrefix []byte, tx *store.OngoingTx) error
 			return err
 		}
 
+		// read policies into tx
+		err = table.addPoliciesToTx(sqlPrefix, tx)
+		if err != nil {
+			return err
+		}
+
 	}
 
 	return nil
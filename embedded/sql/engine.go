@@ -75,6 +75,8 @@ var ErrTxDoesNotExist = errors.New("tx does not exist")
 var ErrNestedTxNotSupported = errors.New("nested tx are not supported")
 var ErrNoOngoingTx = errors.New("no ongoing transaction")
 var ErrNonTransactionalStmt = errors.New("non transactional statement")
+var ErrSavepointDoesNotExist = errors.New("savepoint does not exist")
+var ErrTxAborted = errors.New("transaction aborted, only rollback is allowed")
 var ErrDivisionByZero = errors.New("division by zero")
 var ErrMissingParameter = errors.New("missing parameter")
 var ErrUnsupportedParameter = errors.New("unsupported parameter")
@@ -85,6 +87,12 @@ var ErrAlreadyClosed = store.ErrAlreadyClosed
 var ErrAmbiguousSelector = errors.New("ambiguous selector")
 var ErrUnsupportedCast = fmt.Errorf("%w: unsupported cast", ErrInvalidValue)
 var ErrColumnMismatchInUnionStmt = errors.New("column mismatch in union statement")
+var ErrNotGrantable = errors.New("statement can not be authorized by table privileges")
+var ErrPolicyAlreadyExists = errors.New("policy already exists")
+var ErrPolicyDoesNotExist = errors.New("policy does not exist")
+var ErrInvalidPolicy = errors.New("invalid policy")
+var ErrRowLevelSecurityViolation = errors.New("row violates row-level security policies")
+var ErrRowLevelSecurityBypassRequired = errors.New("operation requires bypassing row-level security")
 
 var maxKeyLen = 256
 
@@ -108,6 +116,12 @@ type MultiDBHandler interface {
 	CreateDatabase(ctx context.Context, db string, ifNotExists bool) error
 	UseDatabase(ctx context.Context, db string) error
 	ExecPreparedStmts(ctx context.Context, opts *TxOptions, stmts []SQLStmt, params map[string]interface{}) (ntx *SQLTx, committedTxs []*SQLTx, err error)
+	CreateRole(ctx context.Context, role string, ifNotExists bool) error
+	DropRole(ctx context.Context, role string) error
+	GrantPrivileges(ctx context.Context, role string, privileges []string, object *PrivilegeObject) error
+	RevokePrivileges(ctx context.Context, role string, privileges []string, object *PrivilegeObject) error
+	GrantRole(ctx context.Context, role string, username string) error
+	RevokeRole(ctx context.Context, role string, username string) error
 }
 
 func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
@@ -173,6 +187,7 @@ func (e *Engine) NewTx(ctx context.Context, opts *TxOptions) (*SQLTx, error) {
 		opts:             opts,
 		tx:               tx,
 		catalog:          catalog,
+		sessionVars:      SessionVariablesFromContext(ctx),
 		lastInsertedPKs:  make(map[string]int64),
 		firstInsertedPKs: make(map[string]int64),
 	}, nil
@@ -266,8 +281,18 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 			}
 		}
 
+		if currTx.aborted && !isRollbackStmt(stmt) {
+			return currTx, committedTxs, stmts[execStmts:], ErrTxAborted
+		}
+
 		ntx, err := stmt.execAt(ctx, currTx, nparams)
 		if err != nil {
+			if !currTx.Closed() && currTx.hasSavepoints() {
+				// the transaction is kept open so it can be rolled back to a savepoint
+				currTx.aborted = true
+				return currTx, committedTxs, stmts[execStmts:], err
+			}
+
 			currTx.Cancel()
 			return nil, committedTxs, stmts[execStmts:], err
 		}
@@ -308,6 +333,15 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 	return currTx, committedTxs, stmts[execStmts:], nil
 }
 
+func isRollbackStmt(stmt SQLStmt) bool {
+	switch stmt.(type) {
+	case *RollbackStmt, *RollbackToSavepointStmt:
+		return true
+	}
+
+	return false
+}
+
 func (e *Engine) Query(ctx context.Context, tx *SQLTx, sql string, params map[string]interface{}) (RowReader, error) {
 	stmts, err := Parse(strings.NewReader(sql))
 	if err != nil {
@@ -330,6 +364,10 @@ func (e *Engine) QueryPreparedStmt(ctx context.Context, tx *SQLTx, stmt DataSour
 		return nil, ErrIllegalArguments
 	}
 
+	if tx != nil && tx.aborted {
+		return nil, ErrTxAborted
+	}
+
 	qtx := tx
 
 	if qtx == nil {
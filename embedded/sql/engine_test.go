@@ -1767,6 +1767,108 @@ func TestTransactionsEdgeCases(t *testing.T) {
 	})
 }
 
+func TestTransactionSavepoints(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE table1 (
+			id INTEGER AUTO_INCREMENT,
+			title VARCHAR,
+			PRIMARY KEY id
+		)`, nil)
+	require.NoError(t, err)
+
+	t.Run("savepoints require an explicit transaction", func(t *testing.T) {
+		_, _, err = engine.Exec(context.Background(), nil, "SAVEPOINT sp1", nil)
+		require.ErrorIs(t, err, ErrNoOngoingTx)
+
+		_, _, err = engine.Exec(context.Background(), nil, "ROLLBACK TO SAVEPOINT sp1", nil)
+		require.ErrorIs(t, err, ErrNoOngoingTx)
+
+		_, _, err = engine.Exec(context.Background(), nil, "RELEASE SAVEPOINT sp1", nil)
+		require.ErrorIs(t, err, ErrNoOngoingTx)
+	})
+
+	tx, _, err := engine.Exec(context.Background(), nil, `
+		BEGIN TRANSACTION;
+			INSERT INTO table1 (title) VALUES ('title1');
+			SAVEPOINT sp1;
+			INSERT INTO table1 (title) VALUES ('title2');
+			CREATE TABLE table2 (id INTEGER, PRIMARY KEY id);
+			SAVEPOINT sp2;
+			INSERT INTO table2 (id) VALUES (1);
+		`, nil)
+	require.NoError(t, err)
+	require.NotNil(t, tx)
+
+	t.Run("failed stmts abort the transaction until it is rolled back to a savepoint", func(t *testing.T) {
+		ntx, _, err := engine.Exec(context.Background(), tx, "INSERT INTO table1 (id, title) VALUES (1, 'title1')", nil)
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+		require.Equal(t, tx, ntx)
+		require.True(t, tx.Aborted())
+
+		_, _, err = engine.Exec(context.Background(), tx, "INSERT INTO table1 (title) VALUES ('title3')", nil)
+		require.ErrorIs(t, err, ErrTxAborted)
+
+		_, err = engine.Query(context.Background(), tx, "SELECT * FROM table1", nil)
+		require.ErrorIs(t, err, ErrTxAborted)
+
+		_, _, err = engine.Exec(context.Background(), tx, "COMMIT", nil)
+		require.ErrorIs(t, err, ErrTxAborted)
+	})
+
+	_, _, err = engine.Exec(context.Background(), tx, "ROLLBACK TO SAVEPOINT sp3", nil)
+	require.ErrorIs(t, err, ErrSavepointDoesNotExist)
+	require.True(t, tx.Aborted())
+
+	_, _, err = engine.Exec(context.Background(), tx, "ROLLBACK TO sp1", nil)
+	require.NoError(t, err)
+	require.False(t, tx.Aborted())
+
+	_, err = tx.Catalog().GetTableByName("table2")
+	require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+	_, _, err = engine.Exec(context.Background(), tx, "RELEASE SAVEPOINT sp2", nil)
+	require.ErrorIs(t, err, ErrSavepointDoesNotExist)
+	require.True(t, tx.Aborted())
+
+	_, _, err = engine.Exec(context.Background(), tx, "ROLLBACK TO SAVEPOINT sp1", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), tx, `
+		INSERT INTO table1 (title) VALUES ('title3');
+		RELEASE SAVEPOINT sp1;
+	`, nil)
+	require.NoError(t, err)
+	require.False(t, tx.hasSavepoints())
+
+	_, ctxs, err := engine.Exec(context.Background(), tx, "COMMIT", nil)
+	require.NoError(t, err)
+	require.Len(t, ctxs, 1)
+	require.Equal(t, map[string]int64{"table1": 2}, ctxs[0].LastInsertedPKs())
+
+	r, err := engine.Query(context.Background(), nil, "SELECT id, title FROM table1", nil)
+	require.NoError(t, err)
+
+	row, err := r.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "title1", row.ValuesByPosition[1].RawValue())
+
+	row, err = r.Read(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), row.ValuesByPosition[0].RawValue())
+	require.Equal(t, "title3", row.ValuesByPosition[1].RawValue())
+
+	_, err = r.Read(context.Background())
+	require.ErrorIs(t, err, ErrNoMoreRows)
+
+	err = r.Close()
+	require.NoError(t, err)
+
+	_, err = engine.Query(context.Background(), nil, "SELECT * FROM table2", nil)
+	require.ErrorIs(t, err, ErrTableDoesNotExist)
+}
+
 func TestUseSnapshot(t *testing.T) {
 	engine := setupCommonTest(t)
 
@@ -5639,6 +5741,30 @@ func (h *multidbHandlerMock) UseDatabase(ctx context.Context, db string) error {
 	return nil
 }
 
+func (h *multidbHandlerMock) CreateRole(ctx context.Context, role string, ifNotExists bool) error {
+	return ErrNoSupported
+}
+
+func (h *multidbHandlerMock) DropRole(ctx context.Context, role string) error {
+	return ErrNoSupported
+}
+
+func (h *multidbHandlerMock) GrantPrivileges(ctx context.Context, role string, privileges []string, object *PrivilegeObject) error {
+	return ErrNoSupported
+}
+
+func (h *multidbHandlerMock) RevokePrivileges(ctx context.Context, role string, privileges []string, object *PrivilegeObject) error {
+	return ErrNoSupported
+}
+
+func (h *multidbHandlerMock) GrantRole(ctx context.Context, role string, username string) error {
+	return ErrNoSupported
+}
+
+func (h *multidbHandlerMock) RevokeRole(ctx context.Context, role string, username string) error {
+	return ErrNoSupported
+}
+
 func (h *multidbHandlerMock) ExecPreparedStmts(
 	ctx context.Context,
 	opts *TxOptions,
@@ -62,6 +62,8 @@ var reservedWords = map[string]int{
 	"TRANSACTION":    TRANSACTION,
 	"COMMIT":         COMMIT,
 	"ROLLBACK":       ROLLBACK,
+	"SAVEPOINT":      SAVEPOINT,
+	"RELEASE":        RELEASE,
 	"SELECT":         SELECT,
 	"DISTINCT":       DISTINCT,
 	"FROM":           FROM,
@@ -89,6 +91,13 @@ var reservedWords = map[string]int{
 	"IS":             IS,
 	"CAST":           CAST,
 	"::":             SCAST,
+	"ROLE":           ROLE,
+	"DROP":           DROP,
+	"GRANT":          GRANT,
+	"REVOKE":         REVOKE,
+	"POLICY":         POLICY,
+	"FOR":            FOR,
+	"USING":          USING,
 }
 
 var joinTypes = map[string]JoinType{
@@ -49,7 +49,147 @@ func TestCreateDatabaseStmt(t *testing.T) {
 		{
 			input:          "CREATE db1",
 			expectedOutput: nil,
-			expectedError:  errors.New("syntax error: unexpected IDENTIFIER, expecting DATABASE or TABLE or UNIQUE or INDEX at position 10"),
+			expectedError:  errors.New("syntax error: unexpected IDENTIFIER at position 10"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestRoleStmts(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input:          "CREATE ROLE analyst",
+			expectedOutput: []SQLStmt{&CreateRoleStmt{role: "analyst"}},
+		},
+		{
+			input:          "CREATE ROLE IF NOT EXISTS analyst",
+			expectedOutput: []SQLStmt{&CreateRoleStmt{role: "analyst", ifNotExists: true}},
+		},
+		{
+			input:          "DROP ROLE analyst",
+			expectedOutput: []SQLStmt{&DropRoleStmt{role: "analyst"}},
+		},
+		{
+			input: "GRANT SELECT, INSERT ON TABLE mytable TO analyst",
+			expectedOutput: []SQLStmt{&ChangePrivilegesStmt{
+				privileges: []string{PrivilegeSelect, PrivilegeInsert},
+				object:     &PrivilegeObject{Type: TableObject, Name: "mytable"},
+				role:       "analyst",
+			}},
+		},
+		{
+			input: "GRANT ALL ON TABLE mytable TO analyst",
+			expectedOutput: []SQLStmt{&ChangePrivilegesStmt{
+				object: &PrivilegeObject{Type: TableObject, Name: "mytable"},
+				role:   "analyst",
+			}},
+		},
+		{
+			input: "GRANT read, Write ON KEY PREFIX 'user:' TO analyst",
+			expectedOutput: []SQLStmt{&ChangePrivilegesStmt{
+				privileges: []string{"READ", "WRITE"},
+				object:     &PrivilegeObject{Type: KeyPrefixObject, Name: "user:"},
+				role:       "analyst",
+			}},
+		},
+		{
+			input: "REVOKE TRUNCATE ON DATABASE FROM analyst",
+			expectedOutput: []SQLStmt{&ChangePrivilegesStmt{
+				revoke:     true,
+				privileges: []string{"TRUNCATE"},
+				object:     &PrivilegeObject{Type: DatabaseObject},
+				role:       "analyst",
+			}},
+		},
+		{
+			input:          "GRANT ROLE analyst TO 'John'",
+			expectedOutput: []SQLStmt{&ChangeRoleMembershipStmt{role: "analyst", username: "John"}},
+		},
+		{
+			input:          "REVOKE ROLE analyst FROM john",
+			expectedOutput: []SQLStmt{&ChangeRoleMembershipStmt{revoke: true, role: "analyst", username: "john"}},
+		},
+		{
+			input:         "GRANT READ ON KEY 'user:' TO analyst",
+			expectedError: errors.New("syntax error: unexpected VARCHAR, expecting IDENTIFIER at position 25"),
+		},
+		{
+			input:         "GRANT READ ON KEY PREFIXES 'user:' TO analyst",
+			expectedError: errors.New("syntax error: unexpected prefixes, expecting PREFIX at position 34"),
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := ParseString(tc.input)
+		require.Equal(t, tc.expectedError, err, fmt.Sprintf("failed on iteration %d", i))
+
+		if tc.expectedError == nil {
+			require.Equal(t, tc.expectedOutput, res, fmt.Sprintf("failed on iteration %d", i))
+		}
+	}
+}
+
+func TestPolicyStmts(t *testing.T) {
+	testCases := []struct {
+		input          string
+		expectedOutput []SQLStmt
+		expectedError  error
+	}{
+		{
+			input: "CREATE POLICY owner_rows ON docs USING (owner = CURRENT_USER())",
+			expectedOutput: []SQLStmt{&CreatePolicyStmt{
+				policy: "owner_rows",
+				table:  "docs",
+				cmd:    AllPolicyCommand,
+				using: &CmpBoolExp{
+					op:    EQ,
+					left:  &ColSelector{col: "owner"},
+					right: &FnCall{fn: "current_user"},
+				},
+			}},
+		},
+		{
+			input: "CREATE POLICY IF NOT EXISTS public_rows ON docs FOR SELECT USING (public)",
+			expectedOutput: []SQLStmt{&CreatePolicyStmt{
+				policy:      "public_rows",
+				ifNotExists: true,
+				table:       "docs",
+				cmd:         SelectPolicyCommand,
+				using:       &ColSelector{col: "public"},
+			}},
+		},
+		{
+			input: "CREATE POLICY no_deletes ON docs FOR DELETE USING (false)",
+			expectedOutput: []SQLStmt{&CreatePolicyStmt{
+				policy: "no_deletes",
+				table:  "docs",
+				cmd:    DeletePolicyCommand,
+				using:  &Bool{val: false},
+			}},
+		},
+		{
+			input:          "DROP POLICY owner_rows ON docs",
+			expectedOutput: []SQLStmt{&DropPolicyStmt{policy: "owner_rows", table: "docs"}},
+		},
+		{
+			input:          "DROP POLICY IF EXISTS owner_rows ON docs",
+			expectedOutput: []SQLStmt{&DropPolicyStmt{policy: "owner_rows", ifExists: true, table: "docs"}},
+		},
+		{
+			input:         "CREATE POLICY owner_rows ON docs USING owner = CURRENT_USER()",
+			expectedError: errors.New("syntax error: unexpected IDENTIFIER, expecting '(' at position 44"),
 		},
 	}
 
@@ -269,7 +409,7 @@ func TestCreateTableStmt(t *testing.T) {
 		{
 			input:          "CREATE table1",
 			expectedOutput: nil,
-			expectedError:  errors.New("syntax error: unexpected IDENTIFIER, expecting DATABASE or TABLE or UNIQUE or INDEX at position 13"),
+			expectedError:  errors.New("syntax error: unexpected IDENTIFIER at position 13"),
 		},
 		{
 			input:          "CREATE TABLE table1",
@@ -773,6 +913,23 @@ func TestTxStmt(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			input: "BEGIN; SAVEPOINT sp1; DELETE FROM table1; ROLLBACK TO SAVEPOINT sp1; SAVEPOINT sp2; ROLLBACK TO sp2; RELEASE SAVEPOINT sp1; RELEASE sp2; COMMIT;",
+			expectedOutput: []SQLStmt{
+				&BeginTransactionStmt{},
+				&SavepointStmt{name: "sp1"},
+				&DeleteFromStmt{
+					tableRef: &tableRef{table: "table1"},
+				},
+				&RollbackToSavepointStmt{name: "sp1"},
+				&SavepointStmt{name: "sp2"},
+				&RollbackToSavepointStmt{name: "sp2"},
+				&ReleaseSavepointStmt{name: "sp1"},
+				&ReleaseSavepointStmt{name: "sp2"},
+				&CommitStmt{},
+			},
+			expectedError: nil,
+		},
 	}
 
 	for i, tc := range testCases {
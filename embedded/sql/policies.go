@@ -0,0 +1,319 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// policiesCondition returns the condition rows of the table must satisfy to be accessed by the command.
+// Rows are accessible when they satisfy the condition of any of the policies applying to the command,
+// none is accessible when the table has policies but none of them applies to the command.
+// nil is returned when row-level security is not enforced on the table.
+func (tx *SQLTx) policiesCondition(table *Table, cmd PolicyCommand) ValueExp {
+	if len(table.policies) == 0 || tx.bypassesRowLevelSecurity() {
+		return nil
+	}
+
+	var cond ValueExp
+
+	for _, p := range table.policies {
+		if p.cmd != AllPolicyCommand && p.cmd != cmd {
+			continue
+		}
+
+		if cond == nil {
+			cond = p.using
+			continue
+		}
+
+		cond = &BinBoolExp{op: OR, left: cond, right: p.using}
+	}
+
+	if cond == nil {
+		return &Bool{val: false}
+	}
+
+	return cond
+}
+
+func (tx *SQLTx) bypassesRowLevelSecurity() bool {
+	return tx.sessionVars != nil && tx.sessionVars.BypassRowLevelSecurity
+}
+
+// canManagePolicies returns false when the tx was created for a session restricted by row-level security,
+// transactions created without session variables are issued by the owner of the engine
+func (tx *SQLTx) canManagePolicies() bool {
+	return tx.sessionVars == nil || tx.sessionVars.BypassRowLevelSecurity
+}
+
+// checkPolicies checks the row made of the given values is accessible by the command
+func (tx *SQLTx) checkPolicies(table *Table, cmd PolicyCommand, valuesByColID map[uint32]TypedValue) error {
+	cond := tx.policiesCondition(table, cmd)
+	if cond == nil {
+		return nil
+	}
+
+	row := &Row{
+		ValuesByPosition: make([]TypedValue, len(table.cols)),
+		ValuesBySelector: make(map[string]TypedValue, len(table.cols)),
+	}
+
+	for i, col := range table.cols {
+		val, specified := valuesByColID[col.id]
+		if !specified || val == nil {
+			val = &NullValue{t: col.colType}
+		}
+
+		row.ValuesByPosition[i] = val
+		row.ValuesBySelector[EncodeSelector("", table.name, col.colName)] = val
+	}
+
+	r, err := cond.reduce(tx, row, table.name)
+	if err != nil {
+		return err
+	}
+
+	satisfies, isBool := r.(*Bool)
+	if !isBool || !satisfies.val {
+		return fmt.Errorf("%w (%s)", ErrRowLevelSecurityViolation, table.name)
+	}
+
+	return nil
+}
+
+// checkUpsertPolicies checks both the existent row and the one replacing it are accessible for update
+func (tx *SQLTx) checkUpsertPolicies(ctx context.Context, table *Table, valuesByColID map[uint32]TypedValue) error {
+	if tx.policiesCondition(table, UpdatePolicyCommand) == nil {
+		return nil
+	}
+
+	currPKRow, err := tx.fetchPKRow(ctx, table, valuesByColID)
+	if err != nil {
+		return err
+	}
+
+	currValuesByColID := make(map[uint32]TypedValue, len(table.cols))
+
+	for _, col := range table.cols {
+		currValuesByColID[col.id] = currPKRow.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+	}
+
+	err = tx.checkPolicies(table, UpdatePolicyCommand, currValuesByColID)
+	if err != nil {
+		return err
+	}
+
+	return tx.checkPolicies(table, UpdatePolicyCommand, valuesByColID)
+}
+
+// renderPolicyCondition validates the condition of a policy on the table and returns
+// the textual representation it is persisted with
+func renderPolicyCondition(table *Table, cond ValueExp) (string, error) {
+	cols := make(map[string]ColDescriptor, len(table.cols))
+
+	for _, col := range table.cols {
+		des := ColDescriptor{
+			Table:  table.name,
+			Column: col.colName,
+			Type:   col.colType,
+		}
+
+		cols[des.Selector()] = des
+	}
+
+	err := cond.requiresType(BooleanType, cols, make(map[string]SQLValueType), table.name)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	exp, err := renderExp(cond)
+	if err != nil {
+		return "", err
+	}
+
+	// make sure the condition can be loaded back
+	_, err = parsePolicyCondition(exp)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	return exp, nil
+}
+
+func parsePolicyCondition(exp string) (ValueExp, error) {
+	stmts, err := ParseString("SELECT * FROM policy_table WHERE " + exp)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) != 1 {
+		return nil, ErrInvalidPolicy
+	}
+
+	stmt, ok := stmts[0].(*SelectStmt)
+	if !ok || stmt.where == nil {
+		return nil, ErrInvalidPolicy
+	}
+
+	return stmt.where, nil
+}
+
+var numOps = map[NumOperator]string{
+	ADDOP:  "+",
+	SUBSOP: "-",
+	DIVOP:  "/",
+	MULTOP: "*",
+}
+
+var cmpOpsSymbols = map[CmpOperator]string{
+	EQ: "=",
+	NE: "!=",
+	LT: "<",
+	LE: "<=",
+	GT: ">",
+	GE: ">=",
+}
+
+// renderExp returns the SQL representation of the expression,
+// composite expressions are enclosed in parentheses so precedence is preserved
+func renderExp(exp ValueExp) (string, error) {
+	switch e := exp.(type) {
+	case *NullValue:
+		return "NULL", nil
+	case *Integer:
+		if e.val < 0 {
+			return fmt.Sprintf("(0 - %d)", uint64(-e.val)), nil
+		}
+		return strconv.FormatInt(e.val, 10), nil
+	case *Float64:
+		f := strconv.FormatFloat(e.val, 'f', -1, 64)
+		if !strings.Contains(f, ".") {
+			f += ".0"
+		}
+		if e.val < 0 {
+			return fmt.Sprintf("(0 - %s)", f[1:]), nil
+		}
+		return f, nil
+	case *Varchar:
+		return "'" + strings.ReplaceAll(e.val, "'", "''") + "'", nil
+	case *Bool:
+		if e.val {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case *Blob:
+		return fmt.Sprintf("x'%x'", e.val), nil
+	case *Cast:
+		val, err := renderExp(e.val)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("CAST(%s AS %s)", val, e.t), nil
+	case *FnCall:
+		params := make([]string, len(e.params))
+
+		for i, p := range e.params {
+			param, err := renderExp(p)
+			if err != nil {
+				return "", err
+			}
+			params[i] = param
+		}
+
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(e.fn), strings.Join(params, ", ")), nil
+	case *ColSelector:
+		if e.table != "" {
+			return "", fmt.Errorf("%w: columns must not be qualified (%s.%s)", ErrInvalidPolicy, e.table, e.col)
+		}
+		return fmt.Sprintf("\"%s\"", e.col), nil
+	case *NumExp:
+		return renderBinaryExp(e.left, numOps[e.op], e.right)
+	case *NotBoolExp:
+		val, err := renderExp(e.exp)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(NOT %s)", val), nil
+	case *LikeBoolExp:
+		op := "LIKE"
+		if e.notLike {
+			op = "NOT LIKE"
+		}
+		return renderBinaryExp(e.val, op, e.pattern)
+	case *CmpBoolExp:
+		if _, isNull := e.right.(*NullValue); isNull && (e.op == EQ || e.op == NE) {
+			val, err := renderExp(e.left)
+			if err != nil {
+				return "", err
+			}
+
+			if e.op == EQ {
+				return fmt.Sprintf("(%s IS NULL)", val), nil
+			}
+			return fmt.Sprintf("(%s IS NOT NULL)", val), nil
+		}
+
+		return renderBinaryExp(e.left, cmpOpsSymbols[e.op], e.right)
+	case *BinBoolExp:
+		op := "AND"
+		if e.op == OR {
+			op = "OR"
+		}
+		return renderBinaryExp(e.left, op, e.right)
+	case *InListExp:
+		val, err := renderExp(e.val)
+		if err != nil {
+			return "", err
+		}
+
+		values := make([]string, len(e.values))
+
+		for i, v := range e.values {
+			values[i], err = renderExp(v)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		op := "IN"
+		if e.notIn {
+			op = "NOT IN"
+		}
+
+		return fmt.Sprintf("(%s %s (%s))", val, op, strings.Join(values, ", ")), nil
+	}
+
+	return "", fmt.Errorf("%w: unsupported expression %T", ErrInvalidPolicy, exp)
+}
+
+func renderBinaryExp(left ValueExp, op string, right ValueExp) (string, error) {
+	l, err := renderExp(left)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := renderExp(right)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("(%s %s %s)", l, op, r), nil
+}
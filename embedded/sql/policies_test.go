@@ -0,0 +1,267 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowLevelSecurityPolicies(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE docs (
+			id INTEGER AUTO_INCREMENT,
+			owner VARCHAR[64],
+			tenant VARCHAR,
+			title VARCHAR,
+			PRIMARY KEY id
+		);
+
+		INSERT INTO docs (owner, tenant, title) VALUES
+			('alice', 'acme', 'a1'),
+			('alice', 'acme', 'a2'),
+			('bob', 'acme', 'b1'),
+			('carol', 'globex', 'c1');
+	`, nil)
+	require.NoError(t, err)
+
+	aliceCtx := WithSessionVariables(context.Background(), &SessionVariables{User: "alice"})
+	bobCtx := WithSessionVariables(context.Background(), &SessionVariables{User: "bob"})
+	adminCtx := WithSessionVariables(context.Background(), &SessionVariables{User: "admin", BypassRowLevelSecurity: true})
+
+	titles := func(t *testing.T, ctx context.Context, query string) []string {
+		r, err := engine.Query(ctx, nil, query, nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var titles []string
+
+		for {
+			row, err := r.Read(ctx)
+			if errors.Is(err, ErrNoMoreRows) {
+				break
+			}
+			require.NoError(t, err)
+
+			titles = append(titles, row.ValuesByPosition[0].RawValue().(string))
+		}
+
+		return titles
+	}
+
+	t.Run("policies can only be managed bypassing row-level security", func(t *testing.T) {
+		_, _, err := engine.Exec(aliceCtx, nil, "CREATE POLICY owner_rows ON docs USING (owner = CURRENT_USER())", nil)
+		require.ErrorIs(t, err, ErrRowLevelSecurityBypassRequired)
+
+		_, _, err = engine.Exec(aliceCtx, nil, "DROP POLICY owner_rows ON docs", nil)
+		require.ErrorIs(t, err, ErrRowLevelSecurityBypassRequired)
+	})
+
+	t.Run("invalid policies are rejected", func(t *testing.T) {
+		_, _, err := engine.Exec(adminCtx, nil, "CREATE POLICY p1 ON docs1 USING (true)", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		_, _, err = engine.Exec(adminCtx, nil, "CREATE POLICY p1 ON docs USING (id + 1)", nil)
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		_, _, err = engine.Exec(adminCtx, nil, "CREATE POLICY p1 ON docs USING (author = CURRENT_USER())", nil)
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		_, _, err = engine.Exec(adminCtx, nil, "CREATE POLICY p1 ON docs USING (docs.owner = CURRENT_USER())", nil)
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		_, _, err = engine.Exec(adminCtx, nil, "CREATE POLICY p1 ON docs USING (owner = @owner)", map[string]interface{}{"owner": "alice"})
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+
+		_, _, err = engine.Exec(adminCtx, nil, "CREATE POLICY p1 ON docs USING (EXISTS (SELECT id FROM docs))", nil)
+		require.ErrorIs(t, err, ErrInvalidPolicy)
+	})
+
+	_, _, err = engine.Exec(adminCtx, nil, `
+		CREATE POLICY owner_rows ON docs USING (owner = CURRENT_USER());
+		CREATE POLICY IF NOT EXISTS owner_rows ON docs USING (false);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(adminCtx, nil, "CREATE POLICY owner_rows ON docs USING (true)", nil)
+	require.ErrorIs(t, err, ErrPolicyAlreadyExists)
+
+	t.Run("rows are filtered by policies", func(t *testing.T) {
+		require.Equal(t, []string{"a1", "a2"}, titles(t, aliceCtx, "SELECT title FROM docs"))
+		require.Equal(t, []string{"b1"}, titles(t, bobCtx, "SELECT title FROM docs AS d WHERE d.tenant = 'acme'"))
+		require.Equal(t, []string{"a1", "a2", "b1", "c1"}, titles(t, adminCtx, "SELECT title FROM docs"))
+
+		// without session variables policies are still enforced
+		require.Empty(t, titles(t, context.Background(), "SELECT title FROM docs"))
+
+		require.Equal(t, []string{"a2"}, titles(t, aliceCtx, `
+			SELECT d2.title
+			FROM docs AS d1
+			INNER JOIN docs AS d2 ON d1.id + 1 = d2.id
+		`))
+	})
+
+	t.Run("rows can only be written when allowed by policies", func(t *testing.T) {
+		tx, _, err := engine.Exec(aliceCtx, nil, "BEGIN TRANSACTION; UPDATE docs SET title = 'a0'", nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, tx.UpdatedRows())
+
+		_, _, err = engine.Exec(aliceCtx, tx, "UPDATE docs SET owner = 'bob' WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrRowLevelSecurityViolation)
+		require.True(t, tx.Closed())
+
+		_, _, err = engine.Exec(aliceCtx, nil, "UPDATE docs SET title = 'a3' WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(aliceCtx, nil, "INSERT INTO docs (owner, tenant, title) VALUES ('bob', 'acme', 'b2')", nil)
+		require.ErrorIs(t, err, ErrRowLevelSecurityViolation)
+
+		_, _, err = engine.Exec(aliceCtx, nil, "INSERT INTO docs (owner, tenant, title) VALUES ('alice', 'acme', 'a4')", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(aliceCtx, nil, "UPSERT INTO docs (id, owner, tenant, title) VALUES (3, 'alice', 'acme', 'b1')", nil)
+		require.ErrorIs(t, err, ErrRowLevelSecurityViolation)
+
+		_, _, err = engine.Exec(aliceCtx, nil, "UPSERT INTO docs (id, owner, tenant, title) VALUES (2, 'alice', 'acme', 'a2')", nil)
+		require.NoError(t, err)
+
+		_, txs, err := engine.Exec(bobCtx, nil, "DELETE FROM docs", nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, txs[0].UpdatedRows())
+
+		require.Equal(t, []string{"a3", "a2", "c1", "a4"}, titles(t, adminCtx, "SELECT title FROM docs"))
+	})
+
+	t.Run("policies may apply to specific commands", func(t *testing.T) {
+		_, _, err := engine.Exec(adminCtx, nil, `
+			CREATE POLICY tenant_rows ON docs FOR SELECT USING (tenant = 'acme' AND CURRENT_USER() IN ('alice', 'carol'));
+			INSERT INTO docs (owner, tenant, title) VALUES ('dave', 'acme', 'd1');
+		`, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"a3", "a2", "a4", "d1"}, titles(t, aliceCtx, "SELECT title FROM docs"))
+		require.Empty(t, titles(t, bobCtx, "SELECT title FROM docs"))
+
+		_, txs, err := engine.Exec(aliceCtx, nil, "DELETE FROM docs WHERE title = 'd1'", nil)
+		require.NoError(t, err)
+		require.Zero(t, txs[0].UpdatedRows())
+
+		_, txs, err = engine.Exec(aliceCtx, nil, "UPDATE docs SET title = 'a5'", nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, txs[0].UpdatedRows())
+	})
+
+	t.Run("columns of tables with policies can not be renamed", func(t *testing.T) {
+		_, _, err := engine.Exec(adminCtx, nil, "ALTER TABLE docs RENAME COLUMN owner TO author", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("policies can be dropped", func(t *testing.T) {
+		_, _, err := engine.Exec(adminCtx, nil, "DROP POLICY unknown ON docs", nil)
+		require.ErrorIs(t, err, ErrPolicyDoesNotExist)
+
+		_, _, err = engine.Exec(adminCtx, nil, `
+			DROP POLICY IF EXISTS unknown ON docs;
+			DROP POLICY tenant_rows ON docs;
+		`, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"a5", "a5", "a5"}, titles(t, aliceCtx, "SELECT title FROM docs"))
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		table, err := tx.Catalog().GetTableByName("docs")
+		require.NoError(t, err)
+		require.Len(t, table.Policies(), 1)
+
+		policy, err := table.GetPolicyByName("owner_rows")
+		require.NoError(t, err)
+		require.Equal(t, "owner_rows", policy.Name())
+		require.Equal(t, AllPolicyCommand, policy.Command())
+
+		_, err = table.GetPolicyByName("tenant_rows")
+		require.ErrorIs(t, err, ErrPolicyDoesNotExist)
+	})
+}
+
+func TestRenderPolicyCondition(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE docs (
+			id INTEGER,
+			owner VARCHAR,
+			score FLOAT,
+			content BLOB,
+			PRIMARY KEY id
+		)`, nil)
+	require.NoError(t, err)
+
+	tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+	require.NoError(t, err)
+	defer tx.Cancel()
+
+	table, err := tx.Catalog().GetTableByName("docs")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		cond     string
+		rendered string
+	}{
+		{
+			cond:     "owner = CURRENT_USER() OR owner IS NULL",
+			rendered: `(("owner" = CURRENT_USER()) OR ("owner" IS NULL))`,
+		},
+		{
+			cond:     "NOT (id > -10 AND score <= 1.5 * 2) AND owner != 'o''neil'",
+			rendered: `((NOT (("id" > (0 - 10)) AND ("score" <= (1.5 * 2)))) AND ("owner" != 'o''neil'))`,
+		},
+		{
+			cond:     "owner NOT LIKE '^adm' AND id IN (1, 2, 3) AND content IS NOT NULL",
+			rendered: `((("owner" NOT LIKE '^adm') AND ("id" IN (1, 2, 3))) AND ("content" IS NOT NULL))`,
+		},
+		{
+			cond:     "content = x'0aff' OR owner = current_user()",
+			rendered: `(("content" = x'0aff') OR ("owner" = CURRENT_USER()))`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cond, func(t *testing.T) {
+			cond, err := parsePolicyCondition(tc.cond)
+			require.NoError(t, err)
+
+			rendered, err := renderPolicyCondition(table, cond)
+			require.NoError(t, err)
+			require.Equal(t, tc.rendered, rendered)
+
+			reparsed, err := parsePolicyCondition(rendered)
+			require.NoError(t, err)
+
+			rerendered, err := renderPolicyCondition(table, reparsed)
+			require.NoError(t, err)
+			require.Equal(t, rendered, rerendered)
+		})
+	}
+}
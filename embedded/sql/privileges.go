@@ -0,0 +1,130 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import "fmt"
+
+// Privileges on tables, other privilege names used in GRANT and REVOKE statements are passed through
+// to the multi-database handler, which is in charge of validating them
+const (
+	PrivilegeSelect = "SELECT"
+	PrivilegeInsert = "INSERT"
+	PrivilegeUpdate = "UPDATE"
+	PrivilegeDelete = "DELETE"
+)
+
+type PrivilegeObjectType int
+
+const (
+	DatabaseObject PrivilegeObjectType = iota
+	TableObject
+	KeyPrefixObject
+)
+
+// PrivilegeObject is the object privileges are granted on: the database in use,
+// one of its tables or the keys starting with a given prefix
+type PrivilegeObject struct {
+	Type PrivilegeObjectType
+	Name string
+}
+
+// TablePrivilege is a privilege required on a table to execute a statement
+type TablePrivilege struct {
+	Privilege string
+	Table     string
+}
+
+// RequiredTablePrivileges returns the table privileges needed to execute the statements.
+// ErrNotGrantable is returned when a statement requires more than table privileges, e.g. DDL statements.
+// Statements managing roles and privileges do not require table privileges, the multi-database
+// handler is in charge of authorizing them. Statements following a database selection are not
+// considered, as they are authorized by the multi-database handler on the selected database.
+func RequiredTablePrivileges(stmts []SQLStmt) ([]TablePrivilege, error) {
+	var privileges []TablePrivilege
+
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *BeginTransactionStmt, *CommitStmt, *RollbackStmt,
+			*SavepointStmt, *RollbackToSavepointStmt, *ReleaseSavepointStmt:
+		case *UseDatabaseStmt:
+			return privileges, nil
+		case *CreateRoleStmt, *DropRoleStmt, *ChangePrivilegesStmt, *ChangeRoleMembershipStmt:
+		case *UpsertIntoStmt:
+			privileges = append(privileges, TablePrivilege{Privilege: PrivilegeInsert, Table: s.tableRef.table})
+
+			if !s.isInsert {
+				privileges = append(privileges, TablePrivilege{Privilege: PrivilegeUpdate, Table: s.tableRef.table})
+			}
+		case *UpdateStmt:
+			privileges = append(privileges, TablePrivilege{Privilege: PrivilegeUpdate, Table: s.tableRef.table})
+
+			// as filtering rows discloses their content, it requires the privilege to read them
+			if s.where != nil {
+				privileges = append(privileges, TablePrivilege{Privilege: PrivilegeSelect, Table: s.tableRef.table})
+			}
+		case *DeleteFromStmt:
+			privileges = append(privileges, TablePrivilege{Privilege: PrivilegeDelete, Table: s.tableRef.table})
+
+			if s.where != nil {
+				privileges = append(privileges, TablePrivilege{Privilege: PrivilegeSelect, Table: s.tableRef.table})
+			}
+		case DataSource:
+			var err error
+
+			privileges, err = appendSelectPrivileges(privileges, s)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("%w: %T", ErrNotGrantable, stmt)
+		}
+	}
+
+	return privileges, nil
+}
+
+func appendSelectPrivileges(privileges []TablePrivilege, ds DataSource) ([]TablePrivilege, error) {
+	switch s := ds.(type) {
+	case *tableRef:
+		return append(privileges, TablePrivilege{Privilege: PrivilegeSelect, Table: s.table}), nil
+	case *SelectStmt:
+		privileges, err := appendSelectPrivileges(privileges, s.ds)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, join := range s.joins {
+			privileges, err = appendSelectPrivileges(privileges, join.ds)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return privileges, nil
+	case *UnionStmt:
+		privileges, err := appendSelectPrivileges(privileges, s.left)
+		if err != nil {
+			return nil, err
+		}
+
+		return appendSelectPrivileges(privileges, s.right)
+	case *FnDataSourceStmt:
+		return privileges, nil
+	}
+
+	return nil, fmt.Errorf("%w: %T", ErrNotGrantable, ds)
+}
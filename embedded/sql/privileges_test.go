@@ -0,0 +1,113 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredTablePrivileges(t *testing.T) {
+	testCases := []struct {
+		sql        string
+		privileges []TablePrivilege
+		err        error
+	}{
+		{
+			sql: "BEGIN; COMMIT",
+		},
+		{
+			sql: "CREATE ROLE analyst; GRANT SELECT ON TABLE table1 TO analyst",
+		},
+		{
+			sql:        "SELECT * FROM table1",
+			privileges: []TablePrivilege{{Privilege: PrivilegeSelect, Table: "table1"}},
+		},
+		{
+			sql: "SELECT * FROM table1 AS t1 INNER JOIN (SELECT * FROM table2) AS t2 ON t1.id = t2.id",
+			privileges: []TablePrivilege{
+				{Privilege: PrivilegeSelect, Table: "table1"},
+				{Privilege: PrivilegeSelect, Table: "table2"},
+			},
+		},
+		{
+			sql: "SELECT id FROM table1 UNION SELECT id FROM table2",
+			privileges: []TablePrivilege{
+				{Privilege: PrivilegeSelect, Table: "table1"},
+				{Privilege: PrivilegeSelect, Table: "table2"},
+			},
+		},
+		{
+			sql: "SELECT * FROM TABLES()",
+		},
+		{
+			sql:        "INSERT INTO table1(id) VALUES (1)",
+			privileges: []TablePrivilege{{Privilege: PrivilegeInsert, Table: "table1"}},
+		},
+		{
+			sql: "UPSERT INTO table1(id) VALUES (1)",
+			privileges: []TablePrivilege{
+				{Privilege: PrivilegeInsert, Table: "table1"},
+				{Privilege: PrivilegeUpdate, Table: "table1"},
+			},
+		},
+		{
+			sql:        "UPDATE table1 SET title = 'title'",
+			privileges: []TablePrivilege{{Privilege: PrivilegeUpdate, Table: "table1"}},
+		},
+		{
+			sql: "UPDATE table1 SET title = 'title' WHERE id = 1",
+			privileges: []TablePrivilege{
+				{Privilege: PrivilegeUpdate, Table: "table1"},
+				{Privilege: PrivilegeSelect, Table: "table1"},
+			},
+		},
+		{
+			sql: "DELETE FROM table1 WHERE id = 1",
+			privileges: []TablePrivilege{
+				{Privilege: PrivilegeDelete, Table: "table1"},
+				{Privilege: PrivilegeSelect, Table: "table1"},
+			},
+		},
+		{
+			sql: "CREATE TABLE table1(id INTEGER, PRIMARY KEY id)",
+			err: ErrNotGrantable,
+		},
+		{
+			sql: "INSERT INTO table1(id) VALUES (1); USE db1; CREATE TABLE table2(id INTEGER, PRIMARY KEY id)",
+			privileges: []TablePrivilege{
+				{Privilege: PrivilegeInsert, Table: "table1"},
+			},
+		},
+		{
+			sql: "CREATE DATABASE db1",
+			err: ErrNotGrantable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			stmts, err := ParseString(tc.sql)
+			require.NoError(t, err)
+
+			privileges, err := RequiredTablePrivileges(stmts)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.privileges, privileges)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import "context"
+
+// SessionVariables describe the session statements are executed for.
+// The user can be referenced by statements through the CURRENT_USER() function.
+type SessionVariables struct {
+	User string
+
+	// BypassRowLevelSecurity is set for sessions not restricted by row-level security policies,
+	// only such sessions are allowed to create or drop policies
+	BypassRowLevelSecurity bool
+}
+
+type sessionVariablesKey struct{}
+
+// WithSessionVariables returns a copy of ctx carrying the variables of the session,
+// transactions created with the returned context are bound to them
+func WithSessionVariables(ctx context.Context, vars *SessionVariables) context.Context {
+	return context.WithValue(ctx, sessionVariablesKey{}, vars)
+}
+
+// SessionVariablesFromContext returns the session variables carried by ctx, nil if there are none
+func SessionVariablesFromContext(ctx context.Context) *SessionVariables {
+	if ctx == nil {
+		return nil
+	}
+
+	vars, _ := ctx.Value(sessionVariablesKey{}).(*SessionVariables)
+
+	return vars
+}
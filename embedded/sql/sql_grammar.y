@@ -17,7 +17,10 @@ limitations under the License.
 %{
 package sql
 
-import "fmt"
+import (
+    "fmt"
+    "strings"
+)
 
 func setResult(l yyLexer, stmts []SQLStmt) {
     l.(*lexer).result = stmts
@@ -67,14 +70,19 @@ func setResult(l yyLexer, stmts []SQLStmt) {
     update *colUpdate
     updates []*colUpdate
     onConflict *OnConflictDo
+    privileges []string
+    privilegeObject *PrivilegeObject
+    policyCmd PolicyCommand
 }
 
 %token CREATE USE DATABASE SNAPSHOT SINCE AFTER BEFORE UNTIL TX OF TIMESTAMP TABLE UNIQUE INDEX ON ALTER ADD RENAME TO COLUMN PRIMARY KEY
-%token BEGIN TRANSACTION COMMIT ROLLBACK
+%token BEGIN TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
 %token INSERT UPSERT INTO VALUES DELETE UPDATE SET CONFLICT DO NOTHING
 %token SELECT DISTINCT FROM JOIN HAVING WHERE GROUP BY LIMIT OFFSET ORDER ASC DESC AS UNION ALL
 %token NOT LIKE IF EXISTS IN IS
 %token AUTO_INCREMENT NULL CAST SCAST
+%token ROLE DROP GRANT REVOKE
+%token POLICY FOR USING
 %token <id> NPARAM
 %token <pparam> PPARAM
 %token <joinType> JOINTYPE
@@ -135,10 +143,14 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 %type <ordcols> ordcols opt_orderby
 %type <opt_ord> opt_ord
 %type <ids> opt_indexon
-%type <boolean> opt_if_not_exists opt_auto_increment opt_not_null opt_not
+%type <boolean> opt_if_not_exists opt_if_exists opt_auto_increment opt_not_null opt_not
 %type <update> update
 %type <updates> updates
+%type <privileges> privileges privilege_list
+%type <str> privilege username
+%type <privilegeObject> privilege_object
 %type <onConflict> opt_on_conflict
+%type <policyCmd> opt_policy_command
 
 %start sql
 
@@ -163,6 +175,8 @@ sqlstmts:
 
 opt_separator: {} | STMT_SEPARATOR
 
+opt_savepoint: {} | SAVEPOINT
+
 sqlstmt: ddlstmt | dmlstmt | dqlstmt
 
 ddlstmt:
@@ -185,6 +199,21 @@ ddlstmt:
     {
         $$ = &RollbackStmt{}
     }
+|
+    SAVEPOINT IDENTIFIER
+    {
+        $$ = &SavepointStmt{name: $2}
+    }
+|
+    ROLLBACK TO opt_savepoint IDENTIFIER
+    {
+        $$ = &RollbackToSavepointStmt{name: $4}
+    }
+|
+    RELEASE opt_savepoint IDENTIFIER
+    {
+        $$ = &ReleaseSavepointStmt{name: $3}
+    }
 |
     CREATE DATABASE opt_if_not_exists IDENTIFIER
     {
@@ -230,6 +259,153 @@ ddlstmt:
     {
         $$ = &RenameColumnStmt{table: $3, oldName: $6, newName: $8}
     }
+|
+    CREATE ROLE opt_if_not_exists IDENTIFIER
+    {
+        $$ = &CreateRoleStmt{ifNotExists: $3, role: $4}
+    }
+|
+    DROP ROLE IDENTIFIER
+    {
+        $$ = &DropRoleStmt{role: $3}
+    }
+|
+    GRANT privileges ON privilege_object TO IDENTIFIER
+    {
+        $$ = &ChangePrivilegesStmt{privileges: $2, object: $4, role: $6}
+    }
+|
+    REVOKE privileges ON privilege_object FROM IDENTIFIER
+    {
+        $$ = &ChangePrivilegesStmt{revoke: true, privileges: $2, object: $4, role: $6}
+    }
+|
+    GRANT ROLE IDENTIFIER TO username
+    {
+        $$ = &ChangeRoleMembershipStmt{role: $3, username: $5}
+    }
+|
+    REVOKE ROLE IDENTIFIER FROM username
+    {
+        $$ = &ChangeRoleMembershipStmt{revoke: true, role: $3, username: $5}
+    }
+|
+    CREATE POLICY opt_if_not_exists IDENTIFIER ON IDENTIFIER opt_policy_command USING '(' exp ')'
+    {
+        $$ = &CreatePolicyStmt{ifNotExists: $3, policy: $4, table: $6, cmd: $7, using: $10}
+    }
+|
+    DROP POLICY opt_if_exists IDENTIFIER ON IDENTIFIER
+    {
+        $$ = &DropPolicyStmt{ifExists: $3, policy: $4, table: $6}
+    }
+
+opt_policy_command:
+    {
+        $$ = AllPolicyCommand
+    }
+|
+    FOR ALL
+    {
+        $$ = AllPolicyCommand
+    }
+|
+    FOR SELECT
+    {
+        $$ = SelectPolicyCommand
+    }
+|
+    FOR INSERT
+    {
+        $$ = InsertPolicyCommand
+    }
+|
+    FOR UPDATE
+    {
+        $$ = UpdatePolicyCommand
+    }
+|
+    FOR DELETE
+    {
+        $$ = DeletePolicyCommand
+    }
+
+privileges:
+    ALL
+    {
+        $$ = nil
+    }
+|
+    privilege_list
+
+privilege_list:
+    privilege
+    {
+        $$ = []string{$1}
+    }
+|
+    privilege_list ',' privilege
+    {
+        $$ = append($1, $3)
+    }
+
+privilege:
+    SELECT
+    {
+        $$ = PrivilegeSelect
+    }
+|
+    INSERT
+    {
+        $$ = PrivilegeInsert
+    }
+|
+    UPDATE
+    {
+        $$ = PrivilegeUpdate
+    }
+|
+    DELETE
+    {
+        $$ = PrivilegeDelete
+    }
+|
+    IDENTIFIER
+    {
+        $$ = strings.ToUpper($1)
+    }
+
+privilege_object:
+    TABLE IDENTIFIER
+    {
+        $$ = &PrivilegeObject{Type: TableObject, Name: $2}
+    }
+|
+    KEY IDENTIFIER VARCHAR
+    {
+        if $2 != "prefix" {
+            yylex.Error(fmt.Sprintf("syntax error: unexpected %s, expecting PREFIX", $2))
+            return 1
+        }
+
+        $$ = &PrivilegeObject{Type: KeyPrefixObject, Name: $3}
+    }
+|
+    DATABASE
+    {
+        $$ = &PrivilegeObject{Type: DatabaseObject}
+    }
+
+username:
+    IDENTIFIER
+    {
+        $$ = $1
+    }
+|
+    VARCHAR
+    {
+        $$ = $1
+    }
 
 opt_if_not_exists:
     {
@@ -241,6 +417,16 @@ opt_if_not_exists:
         $$ = true
     }
 
+opt_if_exists:
+    {
+        $$ = false
+    }
+|
+    IF EXISTS
+    {
+        $$ = true
+    }
+
 one_or_more_ids:
     IDENTIFIER
     {
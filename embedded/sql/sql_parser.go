@@ -3,56 +3,62 @@ package sql
 
 import __yyfmt__ "fmt"
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func setResult(l yyLexer, stmts []SQLStmt) {
 	l.(*lexer).result = stmts
 }
 
 type yySymType struct {
-	yys           int
-	stmts         []SQLStmt
-	stmt          SQLStmt
-	datasource    DataSource
-	colsSpec      []*ColSpec
-	colSpec       *ColSpec
-	cols          []*ColSelector
-	rows          []*RowSpec
-	row           *RowSpec
-	values        []ValueExp
-	value         ValueExp
-	id            string
-	integer       uint64
-	float         float64
-	str           string
-	boolean       bool
-	blob          []byte
-	sqlType       SQLValueType
-	aggFn         AggregateFn
-	ids           []string
-	col           *ColSelector
-	sel           Selector
-	sels          []Selector
-	distinct      bool
-	ds            DataSource
-	tableRef      *tableRef
-	period        period
-	openPeriod    *openPeriod
-	periodInstant periodInstant
-	joins         []*JoinSpec
-	join          *JoinSpec
-	joinType      JoinType
-	exp           ValueExp
-	binExp        ValueExp
-	err           error
-	ordcols       []*OrdCol
-	opt_ord       bool
-	logicOp       LogicOperator
-	cmpOp         CmpOperator
-	pparam        int
-	update        *colUpdate
-	updates       []*colUpdate
-	onConflict    *OnConflictDo
+	yys             int
+	stmts           []SQLStmt
+	stmt            SQLStmt
+	datasource      DataSource
+	colsSpec        []*ColSpec
+	colSpec         *ColSpec
+	cols            []*ColSelector
+	rows            []*RowSpec
+	row             *RowSpec
+	values          []ValueExp
+	value           ValueExp
+	id              string
+	integer         uint64
+	float           float64
+	str             string
+	boolean         bool
+	blob            []byte
+	sqlType         SQLValueType
+	aggFn           AggregateFn
+	ids             []string
+	col             *ColSelector
+	sel             Selector
+	sels            []Selector
+	distinct        bool
+	ds              DataSource
+	tableRef        *tableRef
+	period          period
+	openPeriod      *openPeriod
+	periodInstant   periodInstant
+	joins           []*JoinSpec
+	join            *JoinSpec
+	joinType        JoinType
+	exp             ValueExp
+	binExp          ValueExp
+	err             error
+	ordcols         []*OrdCol
+	opt_ord         bool
+	logicOp         LogicOperator
+	cmpOp           CmpOperator
+	pparam          int
+	update          *colUpdate
+	updates         []*colUpdate
+	onConflict      *OnConflictDo
+	privileges      []string
+	privilegeObject *PrivilegeObject
+	policyCmd       PolicyCommand
 }
 
 const CREATE = 57346
@@ -81,58 +87,67 @@ const BEGIN = 57368
 const TRANSACTION = 57369
 const COMMIT = 57370
 const ROLLBACK = 57371
-const INSERT = 57372
-const UPSERT = 57373
-const INTO = 57374
-const VALUES = 57375
-const DELETE = 57376
-const UPDATE = 57377
-const SET = 57378
-const CONFLICT = 57379
-const DO = 57380
-const NOTHING = 57381
-const SELECT = 57382
-const DISTINCT = 57383
-const FROM = 57384
-const JOIN = 57385
-const HAVING = 57386
-const WHERE = 57387
-const GROUP = 57388
-const BY = 57389
-const LIMIT = 57390
-const OFFSET = 57391
-const ORDER = 57392
-const ASC = 57393
-const DESC = 57394
-const AS = 57395
-const UNION = 57396
-const ALL = 57397
-const NOT = 57398
-const LIKE = 57399
-const IF = 57400
-const EXISTS = 57401
-const IN = 57402
-const IS = 57403
-const AUTO_INCREMENT = 57404
-const NULL = 57405
-const CAST = 57406
-const SCAST = 57407
-const NPARAM = 57408
-const PPARAM = 57409
-const JOINTYPE = 57410
-const LOP = 57411
-const CMPOP = 57412
-const IDENTIFIER = 57413
-const TYPE = 57414
-const INTEGER = 57415
-const FLOAT = 57416
-const VARCHAR = 57417
-const BOOLEAN = 57418
-const BLOB = 57419
-const AGGREGATE_FUNC = 57420
-const ERROR = 57421
-const DOT = 57422
-const STMT_SEPARATOR = 57423
+const SAVEPOINT = 57372
+const RELEASE = 57373
+const INSERT = 57374
+const UPSERT = 57375
+const INTO = 57376
+const VALUES = 57377
+const DELETE = 57378
+const UPDATE = 57379
+const SET = 57380
+const CONFLICT = 57381
+const DO = 57382
+const NOTHING = 57383
+const SELECT = 57384
+const DISTINCT = 57385
+const FROM = 57386
+const JOIN = 57387
+const HAVING = 57388
+const WHERE = 57389
+const GROUP = 57390
+const BY = 57391
+const LIMIT = 57392
+const OFFSET = 57393
+const ORDER = 57394
+const ASC = 57395
+const DESC = 57396
+const AS = 57397
+const UNION = 57398
+const ALL = 57399
+const NOT = 57400
+const LIKE = 57401
+const IF = 57402
+const EXISTS = 57403
+const IN = 57404
+const IS = 57405
+const AUTO_INCREMENT = 57406
+const NULL = 57407
+const CAST = 57408
+const SCAST = 57409
+const ROLE = 57410
+const DROP = 57411
+const GRANT = 57412
+const REVOKE = 57413
+const POLICY = 57414
+const FOR = 57415
+const USING = 57416
+const NPARAM = 57417
+const PPARAM = 57418
+const JOINTYPE = 57419
+const LOP = 57420
+const CMPOP = 57421
+const IDENTIFIER = 57422
+const TYPE = 57423
+const INTEGER = 57424
+const FLOAT = 57425
+const VARCHAR = 57426
+const BOOLEAN = 57427
+const BLOB = 57428
+const AGGREGATE_FUNC = 57429
+const ERROR = 57430
+const DOT = 57431
+const STMT_SEPARATOR = 57432
 
 var yyToknames = [...]string{
 	"$end",
@@ -164,6 +179,8 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
 	"INSERT",
 	"UPSERT",
 	"INTO",
@@ -200,6 +217,13 @@ var yyToknames = [...]string{
 	"NULL",
 	"CAST",
 	"SCAST",
+	"ROLE",
+	"DROP",
+	"GRANT",
+	"REVOKE",
+	"POLICY",
+	"FOR",
+	"USING",
 	"NPARAM",
 	"PPARAM",
 	"JOINTYPE",
@@ -238,205 +262,248 @@ var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 74,
-	57, 139,
-	60, 139,
-	-2, 127,
-	-1, 189,
-	43, 103,
-	-2, 98,
-	-1, 218,
-	43, 103,
-	-2, 100,
+	-1, 114,
+	59, 174,
+	62, 174,
+	-2, 162,
+	-1, 254,
+	45, 138,
+	-2, 133,
+	-1, 285,
+	45, 138,
+	-2, 135,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 378
+const yyLast = 472
 
 var yyAct = [...]int16{
-	73, 295, 60, 183, 140, 211, 235, 239, 88, 137,
-	146, 175, 217, 106, 234, 98, 176, 157, 45, 6,
-	79, 268, 101, 181, 226, 206, 181, 18, 181, 181,
-	277, 272, 72, 254, 252, 271, 227, 182, 240, 150,
-	255, 253, 222, 76, 205, 203, 78, 195, 194, 180,
-	91, 87, 236, 89, 90, 241, 148, 59, 92, 110,
-	82, 83, 84, 85, 86, 61, 202, 133, 199, 133,
-	77, 159, 132, 117, 103, 81, 76, 128, 129, 78,
-	130, 112, 131, 91, 87, 109, 89, 90, 97, 96,
-	20, 92, 62, 82, 83, 84, 85, 86, 61, 61,
-	142, 99, 110, 77, 62, 57, 124, 139, 81, 294,
-	258, 288, 154, 149, 122, 123, 143, 153, 257, 161,
-	162, 163, 164, 165, 166, 206, 151, 118, 119, 121,
-	120, 196, 124, 174, 177, 124, 181, 144, 105, 251,
-	122, 123, 231, 27, 28, 223, 188, 197, 186, 171,
-	172, 189, 178, 118, 119, 121, 120, 108, 121, 120,
-	173, 62, 138, 192, 204, 193, 190, 187, 191, 198,
-	201, 62, 124, 257, 71, 107, 233, 124, 61, 209,
-	122, 123, 220, 102, 179, 213, 123, 267, 158, 160,
-	215, 155, 158, 118, 119, 121, 120, 152, 118, 119,
-	121, 120, 113, 177, 221, 124, 65, 232, 26, 228,
-	63, 34, 49, 238, 224, 127, 44, 145, 200, 230,
-	266, 242, 229, 93, 126, 237, 118, 119, 121, 120,
-	124, 244, 243, 250, 168, 111, 246, 177, 40, 169,
-	249, 167, 170, 64, 115, 116, 55, 35, 259, 296,
-	297, 260, 280, 212, 149, 264, 263, 184, 287, 275,
-	262, 99, 274, 39, 269, 245, 104, 32, 276, 37,
-	18, 285, 278, 270, 53, 281, 210, 208, 283, 31,
-	30, 21, 247, 286, 135, 289, 134, 41, 42, 207,
-	292, 293, 290, 94, 95, 76, 284, 298, 78, 2,
-	299, 214, 91, 87, 114, 89, 90, 67, 10, 11,
-	92, 66, 82, 83, 84, 85, 86, 61, 185, 147,
-	38, 29, 77, 12, 43, 70, 69, 81, 47, 48,
-	7, 22, 8, 9, 13, 14, 33, 141, 15, 16,
-	23, 25, 24, 19, 18, 256, 100, 125, 248, 265,
-	50, 51, 52, 279, 291, 225, 261, 75, 74, 273,
-	219, 218, 216, 68, 46, 54, 36, 58, 56, 80,
-	282, 136, 156, 17, 5, 4, 3, 1,
+	113, 371, 97, 248, 200, 278, 308, 312, 197, 236,
+	128, 206, 156, 284, 307, 237, 148, 6, 151, 217,
+	119, 74, 191, 111, 23, 342, 246, 273, 246, 246,
+	293, 246, 352, 346, 347, 328, 325, 294, 329, 247,
+	116, 327, 313, 118, 210, 289, 272, 131, 127, 270,
+	260, 259, 245, 309, 326, 175, 269, 129, 130, 314,
+	264, 208, 132, 175, 122, 123, 124, 125, 126, 98,
+	173, 174, 160, 184, 117, 219, 183, 181, 162, 121,
+	184, 159, 96, 169, 170, 172, 171, 147, 146, 25,
+	353, 169, 170, 172, 171, 99, 370, 332, 149, 99,
+	364, 133, 98, 116, 331, 175, 118, 273, 94, 153,
+	131, 127, 213, 168, 261, 246, 155, 179, 180, 84,
+	129, 130, 182, 175, 160, 132, 138, 122, 123, 124,
+	125, 126, 98, 166, 167, 172, 171, 117, 173, 174,
+	47, 204, 121, 192, 324, 243, 304, 193, 262, 232,
+	202, 169, 170, 172, 171, 271, 199, 99, 234, 287,
+	99, 198, 214, 175, 98, 209, 306, 203, 195, 331,
+	222, 223, 224, 225, 226, 227, 211, 276, 173, 174,
+	38, 39, 158, 290, 235, 238, 175, 152, 244, 242,
+	241, 169, 170, 172, 171, 240, 218, 221, 220, 233,
+	215, 173, 174, 205, 175, 239, 253, 157, 251, 212,
+	190, 254, 144, 189, 169, 170, 172, 171, 163, 136,
+	174, 266, 257, 252, 258, 143, 256, 107, 263, 255,
+	106, 268, 169, 170, 172, 171, 49, 103, 49, 218,
+	51, 50, 51, 50, 101, 100, 48, 59, 48, 86,
+	280, 83, 79, 49, 37, 282, 78, 51, 50, 73,
+	65, 45, 28, 48, 296, 41, 178, 341, 288, 42,
+	238, 340, 54, 295, 305, 177, 323, 267, 45, 175,
+	311, 291, 67, 322, 52, 303, 52, 302, 315, 44,
+	229, 161, 310, 92, 137, 81, 31, 228, 66, 317,
+	316, 52, 319, 102, 60, 32, 34, 33, 356, 230,
+	238, 112, 231, 279, 207, 249, 299, 372, 373, 333,
+	301, 300, 363, 334, 350, 336, 298, 343, 338, 209,
+	337, 68, 69, 149, 71, 72, 58, 349, 344, 318,
+	194, 297, 351, 354, 154, 145, 57, 62, 23, 361,
+	357, 345, 90, 359, 277, 275, 56, 116, 35, 362,
+	118, 365, 36, 55, 131, 127, 368, 369, 366, 105,
+	87, 88, 89, 374, 129, 130, 375, 12, 13, 132,
+	30, 122, 123, 124, 125, 126, 98, 26, 29, 141,
+	320, 117, 14, 2, 186, 185, 121, 274, 139, 7,
+	188, 8, 9, 10, 11, 18, 19, 142, 140, 20,
+	21, 134, 135, 360, 27, 23, 64, 281, 187, 63,
+	165, 164, 104, 85, 82, 250, 70, 43, 40, 110,
+	109, 76, 77, 201, 24, 265, 330, 46, 150, 176,
+	321, 339, 15, 16, 17, 53, 80, 355, 367, 292,
+	335, 115, 114, 348, 286, 285, 283, 108, 75, 91,
+	61, 95, 93, 120, 358, 196, 216, 22, 5, 4,
+	3, 1,
 }
 
 var yyPact = [...]int16{
-	304, -1000, -1000, 3, -1000, -1000, -1000, 254, -1000, -1000,
-	325, 137, 306, 248, 247, 225, 140, 193, 228, -1000,
-	304, -1000, 180, 180, 180, 307, -1000, 145, 320, 141,
-	140, 140, 140, 238, -1000, 191, 21, -1000, -1000, 139,
-	187, 135, 293, 180, -1000, -1000, 315, 20, 20, 273,
-	1, 0, 216, 112, 230, -1000, 224, -1000, 57, 104,
-	-1000, -3, 22, -1000, 176, -7, 131, 286, -1000, 20,
-	20, -1000, 239, 45, 159, -1000, 239, 239, -8, -1000,
-	-1000, 239, -1000, -1000, -1000, -1000, -1000, -16, -1000, -1000,
-	-1000, -1000, -21, -1000, 263, 261, 91, 91, 332, 239,
-	56, -1000, 147, -1000, -32, 100, -1000, -1000, 126, 33,
-	120, -1000, 117, -17, 118, -1000, -1000, 45, 239, 239,
-	239, 239, 239, 239, 178, 182, 77, -1000, 116, 74,
-	230, 71, 239, 239, 117, 113, -40, 55, -1000, -52,
-	209, 301, 45, 332, 112, 239, 332, 320, 230, 104,
-	-19, 104, -1000, -41, -42, -1000, 50, -1000, 75, 91,
-	-20, 74, 74, 169, 169, 116, 144, -1000, 155, 239,
-	-22, -1000, -44, -1000, 111, -45, 44, 45, -1000, 267,
-	244, 108, 243, 204, 239, 283, 209, -1000, 45, 114,
-	104, -47, -1000, -1000, -1000, -1000, 121, -66, -53, 91,
-	-1000, 116, -13, -1000, 70, -1000, 239, 105, -36, -1000,
-	-36, -1000, 239, 45, -33, 204, 216, -1000, 114, 222,
-	-1000, -1000, 104, 257, -1000, 177, 66, -1000, -55, -48,
-	-56, -49, 45, -1000, 92, -1000, 239, 37, 45, -1000,
-	-1000, 91, -1000, 214, -1000, -32, -1000, -33, 158, -1000,
-	124, -70, -1000, -1000, -1000, -1000, -1000, -36, 236, -54,
-	-58, 218, 212, 332, -59, -1000, -1000, -1000, -1000, -1000,
-	234, -1000, -1000, 202, 239, 90, 278, -1000, 232, 209,
-	211, 45, 30, -1000, 239, -1000, 204, 90, 90, 45,
-	-1000, 28, 198, -1000, 90, -1000, -1000, -1000, 198, -1000,
+	373, -1000, -1000, -7, -1000, -1000, -1000, 360, -1000, 392,
+	182, 350, 290, 174, 413, 197, 221, 204, 329, 322,
+	302, 167, 248, 304, -1000, 373, -1000, 350, -1000, 180,
+	-1000, 222, 222, 222, 409, 222, 222, -1000, 179, 423,
+	176, 172, 235, 406, 171, -1000, 29, -1000, -1000, -1000,
+	-1000, -1000, -1000, 405, 169, 167, 167, 167, 314, -1000,
+	236, 15, -1000, -1000, 165, -1000, 164, 245, 157, 404,
+	222, 150, 147, -1000, -1000, 419, 299, 299, 391, -1000,
+	139, 233, 383, 385, 206, 383, 301, -9, -10, 286,
+	107, 306, -1000, 300, -1000, 26, 127, -1000, -16, 35,
+	-1000, -1000, 230, -19, 138, 403, -1000, 402, -1000, 299,
+	299, -1000, 45, 123, 208, -1000, 45, 45, -20, -1000,
+	-1000, 45, -1000, -1000, -1000, -1000, -1000, -21, -1000, -1000,
+	-1000, -1000, -17, -1000, 372, 371, 400, -1000, 378, 133,
+	130, -1000, 63, -1000, 296, 63, 81, 81, 428, 45,
+	51, -1000, 124, -1000, -36, 77, -1000, -1000, 129, 19,
+	120, -1000, 116, -22, 118, 117, -1000, -1000, 123, 45,
+	45, 45, 45, 45, 45, 232, 250, 68, -1000, 141,
+	42, 306, 60, 45, 45, 116, 115, 110, 109, -1000,
+	61, -1000, -1000, -1000, 108, -1000, -46, 25, -1000, -59,
+	265, 408, 123, 428, 107, 45, 428, 423, 306, 127,
+	-24, 127, -1000, -47, -48, -1000, 24, -1000, 67, 81,
+	-37, 148, 42, 42, 216, 216, 141, 0, -1000, 212,
+	45, -41, -1000, -49, -1000, 100, -52, 17, 123, -1000,
+	375, -1000, -1000, -1000, -1000, 320, 97, 319, 262, 45,
+	399, 265, -1000, 123, 82, 127, -53, -1000, -1000, -1000,
+	-1000, 159, -69, -61, 81, 190, 284, -1000, 141, -18,
+	-1000, 65, -1000, 45, 86, -44, -1000, -44, -1000, 45,
+	123, -38, 262, 286, -1000, 82, 294, -1000, -1000, 127,
+	365, -1000, 218, 62, -1000, -62, -43, -1000, -1000, -1000,
+	-1000, -1000, -57, -63, -60, 123, -1000, 79, -1000, 45,
+	14, 123, -1000, -1000, 81, -1000, 277, -1000, -36, -1000,
+	-38, 207, -1000, 202, -75, -1000, 45, -1000, -1000, -1000,
+	-1000, -44, 312, -65, -64, 291, 275, 428, -66, -1000,
+	-1000, -1000, -1000, -8, -1000, 303, -1000, -1000, 256, 45,
+	80, 395, -1000, -1000, 308, 265, 273, 123, 10, -1000,
+	45, -1000, 262, 80, 80, 123, -1000, 6, 264, -1000,
+	80, -1000, -1000, -1000, 264, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 377, 299, 376, 375, 374, 19, 373, 372, 17,
-	9, 7, 371, 370, 14, 6, 16, 11, 369, 8,
-	20, 368, 367, 2, 366, 365, 10, 319, 18, 364,
-	363, 174, 362, 12, 361, 360, 0, 15, 359, 358,
-	357, 356, 3, 5, 355, 13, 354, 353, 1, 4,
-	263, 349, 348, 347, 22, 346, 345, 343,
+	0, 471, 393, 470, 469, 468, 17, 467, 466, 19,
+	8, 7, 465, 464, 14, 6, 15, 9, 463, 10,
+	20, 462, 461, 2, 460, 459, 11, 314, 21, 458,
+	457, 23, 456, 13, 455, 454, 0, 16, 453, 452,
+	451, 450, 3, 5, 449, 12, 448, 447, 1, 4,
+	298, 446, 441, 440, 439, 18, 438, 427, 437, 140,
+	22, 126, 436, 435, 434, 388,
 }
 
 var yyR1 = [...]int8{
-	0, 1, 2, 2, 57, 57, 3, 3, 3, 4,
+	0, 1, 2, 2, 64, 64, 65, 65, 3, 3,
+	3, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 50, 50, 11, 11, 5, 5, 5, 5,
-	56, 56, 55, 55, 54, 12, 12, 14, 14, 15,
-	10, 10, 13, 13, 17, 17, 16, 16, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 19, 8,
-	8, 9, 44, 44, 51, 51, 52, 52, 52, 6,
-	6, 7, 25, 25, 24, 24, 21, 21, 22, 22,
-	20, 20, 20, 23, 23, 26, 26, 26, 27, 28,
-	29, 29, 29, 30, 30, 30, 31, 31, 32, 32,
-	33, 33, 34, 35, 35, 37, 37, 41, 41, 38,
-	38, 42, 42, 43, 43, 47, 47, 49, 49, 46,
-	46, 48, 48, 48, 45, 45, 45, 36, 36, 36,
-	36, 36, 36, 36, 36, 39, 39, 39, 39, 53,
-	53, 40, 40, 40, 40, 40, 40, 40, 40,
+	4, 4, 4, 4, 4, 63, 63, 63, 63, 63,
+	63, 57, 57, 58, 58, 59, 59, 59, 59, 59,
+	61, 61, 61, 60, 60, 50, 50, 51, 51, 11,
+	11, 5, 5, 5, 5, 62, 62, 56, 56, 55,
+	12, 12, 14, 14, 15, 10, 10, 13, 13, 17,
+	17, 16, 16, 18, 18, 18, 18, 18, 18, 18,
+	18, 18, 18, 19, 8, 8, 9, 44, 44, 52,
+	52, 53, 53, 53, 6, 6, 7, 25, 25, 24,
+	24, 21, 21, 22, 22, 20, 20, 20, 23, 23,
+	26, 26, 26, 27, 28, 29, 29, 29, 30, 30,
+	30, 31, 31, 32, 32, 33, 33, 34, 35, 35,
+	37, 37, 41, 41, 38, 38, 42, 42, 43, 43,
+	47, 47, 49, 49, 46, 46, 48, 48, 48, 45,
+	45, 45, 36, 36, 36, 36, 36, 36, 36, 36,
+	39, 39, 39, 39, 54, 54, 40, 40, 40, 40,
+	40, 40, 40, 40,
 }
 
 var yyR2 = [...]int8{
-	0, 1, 2, 3, 0, 1, 1, 1, 1, 2,
-	1, 1, 1, 4, 2, 3, 3, 11, 8, 9,
-	6, 8, 0, 3, 1, 3, 9, 8, 7, 8,
-	0, 4, 1, 3, 3, 0, 1, 1, 3, 3,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	1, 1, 1, 6, 1, 1, 1, 1, 4, 1,
-	3, 5, 0, 3, 0, 1, 0, 1, 2, 1,
-	4, 13, 0, 1, 0, 1, 1, 1, 2, 4,
-	1, 4, 4, 1, 3, 3, 4, 2, 1, 2,
-	0, 2, 2, 0, 2, 2, 2, 1, 0, 1,
-	1, 2, 6, 0, 1, 0, 2, 0, 3, 0,
-	2, 0, 2, 0, 2, 0, 3, 0, 4, 2,
-	4, 0, 1, 1, 0, 1, 2, 1, 1, 2,
-	2, 4, 4, 6, 6, 1, 1, 3, 3, 0,
-	1, 3, 3, 3, 3, 3, 3, 3, 4,
+	0, 1, 2, 3, 0, 1, 0, 1, 1, 1,
+	1, 2, 1, 1, 1, 2, 4, 3, 4, 2,
+	3, 3, 11, 8, 9, 6, 8, 4, 3, 6,
+	6, 5, 5, 11, 6, 0, 2, 2, 2, 2,
+	2, 1, 1, 1, 3, 1, 1, 1, 1, 1,
+	2, 3, 1, 1, 1, 0, 3, 0, 2, 1,
+	3, 9, 8, 7, 8, 0, 4, 1, 3, 3,
+	0, 1, 1, 3, 3, 1, 3, 1, 3, 0,
+	1, 1, 3, 1, 1, 1, 1, 1, 6, 1,
+	1, 1, 1, 4, 1, 3, 5, 0, 3, 0,
+	1, 0, 1, 2, 1, 4, 13, 0, 1, 0,
+	1, 1, 1, 2, 4, 1, 4, 4, 1, 3,
+	3, 4, 2, 1, 2, 0, 2, 2, 0, 2,
+	2, 2, 1, 0, 1, 1, 2, 6, 0, 1,
+	0, 2, 0, 3, 0, 2, 0, 2, 0, 2,
+	0, 3, 0, 4, 2, 4, 0, 1, 1, 0,
+	1, 2, 1, 1, 2, 2, 4, 4, 6, 6,
+	1, 1, 3, 3, 0, 1, 3, 3, 3, 3,
+	3, 3, 3, 4,
 }
 
 var yyChk = [...]int16{
 	-1000, -1, -2, -3, -4, -5, -6, 26, 28, 29,
-	4, 5, 19, 30, 31, 34, 35, -7, 40, -57,
-	87, 27, 6, 15, 17, 16, 71, 6, 7, 15,
-	32, 32, 42, -27, 71, 54, -24, 41, -2, -50,
-	58, -50, -50, 17, 71, -28, -29, 8, 9, 71,
-	-27, -27, -27, 36, -25, 55, -21, 84, -22, -20,
-	-23, 78, 71, 71, 56, 71, 18, -50, -30, 11,
-	10, -31, 12, -36, -39, -40, 56, 83, 59, -20,
-	-18, 88, 73, 74, 75, 76, 77, 64, -19, 66,
-	67, 63, 71, -31, 20, 21, 88, 88, -37, 45,
-	-55, -54, 71, -6, 42, 81, -45, 71, 53, 88,
-	80, 59, 88, 71, 18, -31, -31, -36, 82, 83,
-	85, 84, 69, 70, 61, -53, 65, 56, -36, -36,
-	88, -36, 88, 88, 23, 23, -12, -10, 71, -10,
-	-49, 5, -36, -37, 81, 70, -26, -27, 88, -19,
-	71, -20, 71, 84, -23, 71, -8, -9, 71, 88,
-	71, -36, -36, -36, -36, -36, -36, 63, 56, 57,
-	60, 72, -6, 89, -36, -17, -16, -36, -9, 71,
-	89, 81, 89, -42, 48, 17, -49, -54, -36, -49,
-	-28, -6, -45, -45, 89, 89, 81, 72, -10, 88,
-	63, -36, 88, 89, 53, 89, 81, 22, 33, 71,
-	33, -43, 49, -36, 18, -42, -32, -33, -34, -35,
-	68, -45, 89, 24, -9, -44, 90, 89, -10, -6,
-	-16, 72, -36, 71, -14, -15, 88, -14, -36, -11,
-	71, 88, -43, -37, -33, 43, -45, 25, -52, 63,
-	56, 73, 89, 89, 89, 89, -56, 81, 18, -17,
-	-10, -41, 46, -26, -11, -51, 62, 63, 91, -15,
-	37, 89, 89, -38, 44, 47, -49, 89, 38, -47,
-	50, -36, -13, -23, 18, 39, -42, 47, 81, -36,
-	-43, -46, -23, -23, 81, -48, 51, 52, -23, -48,
+	30, 31, 4, 5, 19, 69, 70, 71, 32, 33,
+	36, 37, -7, 42, -64, 96, 27, 22, 80, -65,
+	30, 6, 15, 17, 16, 68, 72, 80, 6, 7,
+	15, 68, 72, -57, 68, 57, -58, -59, 42, 32,
+	37, 36, 80, -57, 68, 34, 34, 44, -27, 80,
+	56, -24, 43, -2, -65, 80, -50, 60, -50, -50,
+	17, -50, -50, 80, -28, -29, 8, 9, 80, 80,
+	-51, 60, 18, 80, 90, 18, 80, -27, -27, -27,
+	38, -25, 57, -21, 93, -22, -20, -23, 87, 80,
+	80, 80, 58, 80, 18, -50, 80, 80, -30, 11,
+	10, -31, 12, -36, -39, -40, 58, 92, 61, -20,
+	-18, 97, 82, 83, 84, 85, 86, 66, -19, 75,
+	76, 65, 80, -31, 20, 21, 80, 61, -61, 15,
+	25, 6, 22, -59, -61, 44, 97, 97, -37, 47,
+	-56, -55, 80, -6, 44, 90, -45, 80, 55, 97,
+	89, 61, 97, 80, 18, 18, -31, -31, -36, 91,
+	92, 94, 93, 78, 79, 63, -54, 67, 58, -36,
+	-36, 97, -36, 97, 97, 23, 23, 18, 22, 80,
+	80, -60, 80, 84, 44, -60, -12, -10, 80, -10,
+	-49, 5, -36, -37, 90, 79, -26, -27, 97, -19,
+	80, -20, 80, 93, -23, 80, -8, -9, 80, 97,
+	80, 80, -36, -36, -36, -36, -36, -36, 65, 58,
+	59, 62, 81, -6, 98, -36, -17, -16, -36, -9,
+	80, 80, 80, 84, 80, 98, 90, 98, -42, 50,
+	17, -49, -55, -36, -49, -28, -6, -45, -45, 98,
+	98, 90, 81, -10, 97, -63, 73, 65, -36, 97,
+	98, 55, 98, 90, 22, 35, 80, 35, -43, 51,
+	-36, 18, -42, -32, -33, -34, -35, 77, -45, 98,
+	24, -9, -44, 99, 98, -10, 74, 57, 42, 32,
+	37, 36, -6, -16, 81, -36, 80, -14, -15, 97,
+	-14, -36, -11, 80, 97, -43, -37, -33, 45, -45,
+	25, -53, 65, 58, 82, 98, 97, 98, 98, 98,
+	-62, 90, 18, -17, -10, -41, 48, -26, -11, -52,
+	64, 65, 100, -36, -15, 39, 98, 98, -38, 46,
+	49, -49, 98, 98, 40, -47, 52, -36, -13, -23,
+	18, 41, -42, 49, 90, -36, -43, -46, -23, -23,
+	90, -48, 53, 54, -23, -48,
 }
 
 var yyDef = [...]int16{
-	0, -2, 1, 4, 6, 7, 8, 10, 11, 12,
-	0, 0, 0, 0, 0, 0, 0, 69, 74, 2,
-	5, 9, 22, 22, 22, 0, 14, 0, 90, 0,
-	0, 0, 0, 0, 88, 72, 0, 75, 3, 0,
-	0, 0, 0, 22, 15, 16, 93, 0, 0, 0,
-	0, 0, 105, 0, 0, 73, 0, 76, 77, 124,
-	80, 0, 83, 13, 0, 0, 0, 0, 89, 0,
-	0, 91, 0, 97, -2, 128, 0, 0, 0, 135,
-	136, 0, 48, 49, 50, 51, 52, 0, 54, 55,
-	56, 57, 83, 92, 0, 0, 35, 0, 117, 0,
-	105, 32, 0, 70, 0, 0, 78, 125, 0, 0,
-	0, 23, 0, 0, 0, 94, 95, 96, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 140, 129, 130,
-	0, 0, 0, 44, 0, 0, 0, 36, 40, 0,
-	111, 0, 106, 117, 0, 0, 117, 90, 0, 124,
-	88, 124, 126, 0, 0, 84, 0, 59, 0, 0,
-	0, 141, 142, 143, 144, 145, 146, 147, 0, 0,
-	0, 138, 0, 137, 0, 0, 45, 46, 20, 0,
-	0, 0, 0, 113, 0, 0, 111, 33, 34, -2,
-	124, 0, 87, 79, 81, 82, 0, 62, 0, 0,
-	148, 131, 0, 132, 0, 58, 0, 0, 0, 41,
-	0, 28, 0, 112, 0, 113, 105, 99, -2, 0,
-	104, 85, 124, 0, 60, 66, 0, 18, 0, 0,
-	0, 0, 47, 21, 30, 37, 44, 27, 114, 118,
-	24, 0, 29, 107, 101, 0, 86, 0, 64, 67,
-	0, 0, 19, 133, 134, 53, 26, 0, 0, 0,
-	0, 109, 0, 117, 0, 61, 65, 68, 63, 38,
-	0, 39, 25, 115, 0, 0, 0, 17, 0, 111,
-	0, 110, 108, 42, 0, 31, 113, 0, 0, 102,
-	71, 116, 121, 43, 0, 119, 122, 123, 121, 120,
+	0, -2, 1, 4, 8, 9, 10, 12, 13, 14,
+	0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 104, 109, 2, 5, 11, 6, 15, 0,
+	7, 55, 55, 55, 0, 55, 55, 19, 0, 125,
+	0, 0, 57, 0, 0, 41, 42, 43, 45, 46,
+	47, 48, 49, 0, 0, 0, 0, 0, 0, 123,
+	107, 0, 110, 3, 0, 17, 0, 0, 0, 0,
+	55, 0, 0, 20, 21, 128, 0, 0, 0, 28,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 0, 108, 0, 111, 112, 159, 115, 0, 118,
+	16, 18, 0, 0, 0, 0, 27, 0, 124, 0,
+	0, 126, 0, 132, -2, 163, 0, 0, 0, 170,
+	171, 0, 83, 84, 85, 86, 87, 0, 89, 90,
+	91, 92, 118, 127, 0, 0, 0, 58, 0, 0,
+	0, 52, 0, 44, 0, 0, 70, 0, 152, 0,
+	140, 67, 0, 105, 0, 0, 113, 160, 0, 0,
+	0, 56, 0, 0, 0, 0, 129, 130, 131, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 175, 164,
+	165, 0, 0, 0, 79, 0, 0, 0, 0, 50,
+	0, 31, 53, 54, 0, 32, 0, 71, 75, 0,
+	146, 0, 141, 152, 0, 0, 152, 125, 0, 159,
+	123, 159, 161, 0, 0, 119, 0, 94, 0, 0,
+	0, 35, 176, 177, 178, 179, 180, 181, 182, 0,
+	0, 0, 173, 0, 172, 0, 0, 80, 81, 25,
+	0, 34, 29, 51, 30, 0, 0, 0, 148, 0,
+	0, 146, 68, 69, -2, 159, 0, 122, 114, 116,
+	117, 0, 97, 0, 0, 0, 0, 183, 166, 0,
+	167, 0, 93, 0, 0, 0, 76, 0, 63, 0,
+	147, 0, 148, 140, 134, -2, 0, 139, 120, 159,
+	0, 95, 101, 0, 23, 0, 0, 36, 37, 38,
+	39, 40, 0, 0, 0, 82, 26, 65, 72, 79,
+	62, 149, 153, 59, 0, 64, 142, 136, 0, 121,
+	0, 99, 102, 0, 0, 24, 0, 168, 169, 88,
+	61, 0, 0, 0, 0, 144, 0, 152, 0, 96,
+	100, 103, 98, 0, 73, 0, 74, 60, 150, 0,
+	0, 0, 22, 33, 0, 146, 0, 145, 143, 77,
+	0, 66, 148, 0, 0, 137, 106, 151, 156, 78,
+	0, 154, 157, 158, 156, 155,
 }
 
 var yyTok1 = [...]int8{
@@ -444,12 +511,12 @@ var yyTok1 = [...]int8{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	88, 89, 84, 82, 81, 83, 86, 85, 3, 3,
+	97, 98, 93, 91, 90, 92, 95, 94, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 90, 3, 91,
+	3, 99, 3, 100,
 }
 
 var yyTok2 = [...]int8{
@@ -460,7 +527,8 @@ var yyTok2 = [...]int8{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78, 79, 80, 87,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89, 96,
 }
 
 var yyTok3 = [...]int8{
@@ -822,312 +890,481 @@ yydefault:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 		}
-	case 9:
+	case 6:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 11:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &BeginTransactionStmt{}
 		}
-	case 10:
+	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = &BeginTransactionStmt{}
 		}
-	case 11:
+	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = &CommitStmt{}
 		}
-	case 12:
+	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = &RollbackStmt{}
 		}
-	case 13:
+	case 15:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.stmt = &SavepointStmt{name: yyDollar[2].id}
+		}
+	case 16:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmt = &RollbackToSavepointStmt{name: yyDollar[4].id}
+		}
+	case 17:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &ReleaseSavepointStmt{name: yyDollar[3].id}
+		}
+	case 18:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: yyDollar[3].boolean, DB: yyDollar[4].id}
 		}
-	case 14:
+	case 19:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[2].id}
 		}
-	case 15:
+	case 20:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[3].id}
 		}
-	case 16:
+	case 21:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &UseSnapshotStmt{period: yyDollar[3].period}
 		}
-	case 17:
+	case 22:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		{
 			yyVAL.stmt = &CreateTableStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[4].id, colsSpec: yyDollar[6].colsSpec, pkColNames: yyDollar[10].ids}
 		}
-	case 18:
+	case 23:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].id, cols: yyDollar[7].ids}
 		}
-	case 19:
+	case 24:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		{
 			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].id, cols: yyDollar[8].ids}
 		}
-	case 20:
+	case 25:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].id, colSpec: yyDollar[6].colSpec}
 		}
-	case 21:
+	case 26:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].id, oldName: yyDollar[6].id, newName: yyDollar[8].id}
 		}
-	case 22:
+	case 27:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmt = &CreateRoleStmt{ifNotExists: yyDollar[3].boolean, role: yyDollar[4].id}
+		}
+	case 28:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &DropRoleStmt{role: yyDollar[3].id}
+		}
+	case 29:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &ChangePrivilegesStmt{privileges: yyDollar[2].privileges, object: yyDollar[4].privilegeObject, role: yyDollar[6].id}
+		}
+	case 30:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &ChangePrivilegesStmt{revoke: true, privileges: yyDollar[2].privileges, object: yyDollar[4].privilegeObject, role: yyDollar[6].id}
+		}
+	case 31:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &ChangeRoleMembershipStmt{role: yyDollar[3].id, username: yyDollar[5].str}
+		}
+	case 32:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &ChangeRoleMembershipStmt{revoke: true, role: yyDollar[3].id, username: yyDollar[5].str}
+		}
+	case 33:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.stmt = &CreatePolicyStmt{ifNotExists: yyDollar[3].boolean, policy: yyDollar[4].id, table: yyDollar[6].id, cmd: yyDollar[7].policyCmd, using: yyDollar[10].exp}
+		}
+	case 34:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &DropPolicyStmt{ifExists: yyDollar[3].boolean, policy: yyDollar[4].id, table: yyDollar[6].id}
+		}
+	case 35:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.policyCmd = AllPolicyCommand
+		}
+	case 36:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.policyCmd = AllPolicyCommand
+		}
+	case 37:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.policyCmd = SelectPolicyCommand
+		}
+	case 38:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.policyCmd = InsertPolicyCommand
+		}
+	case 39:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.policyCmd = UpdatePolicyCommand
+		}
+	case 40:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.policyCmd = DeletePolicyCommand
+		}
+	case 41:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privileges = nil
+		}
+	case 43:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privileges = []string{yyDollar[1].str}
+		}
+	case 44:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.privileges = append(yyDollar[1].privileges, yyDollar[3].str)
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = PrivilegeSelect
+		}
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = PrivilegeInsert
+		}
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = PrivilegeUpdate
+		}
+	case 48:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = PrivilegeDelete
+		}
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = strings.ToUpper(yyDollar[1].id)
+		}
+	case 50:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.privilegeObject = &PrivilegeObject{Type: TableObject, Name: yyDollar[2].id}
+		}
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			if yyDollar[2].id != "prefix" {
+				yylex.Error(fmt.Sprintf("syntax error: unexpected %s, expecting PREFIX", yyDollar[2].id))
+				return 1
+			}
+
+			yyVAL.privilegeObject = &PrivilegeObject{Type: KeyPrefixObject, Name: yyDollar[3].str}
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privilegeObject = &PrivilegeObject{Type: DatabaseObject}
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = yyDollar[1].id
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 23:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 24:
+	case 57:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.boolean = false
+		}
+	case 58:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.boolean = true
+		}
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = []string{yyDollar[1].id}
 		}
-	case 25:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[2].ids
 		}
-	case 26:
+	case 61:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		{
 			yyVAL.stmt = &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, rows: yyDollar[8].rows, onConflict: yyDollar[9].onConflict}
 		}
-	case 27:
+	case 62:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[5].ids, rows: yyDollar[8].rows}
 		}
-	case 28:
+	case 63:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.stmt = &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].ids, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
 		}
-	case 29:
+	case 64:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		{
 			yyVAL.stmt = &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].ids, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
 		}
-	case 30:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.onConflict = nil
 		}
-	case 31:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.onConflict = &OnConflictDo{}
 		}
-	case 32:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.updates = []*colUpdate{yyDollar[1].update}
 		}
-	case 33:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.updates = append(yyDollar[1].updates, yyDollar[3].update)
 		}
-	case 34:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.update = &colUpdate{col: yyDollar[1].id, op: yyDollar[2].cmpOp, val: yyDollar[3].exp}
 		}
-	case 35:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 36:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[1].ids
 		}
-	case 37:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.rows = []*RowSpec{yyDollar[1].row}
 		}
-	case 38:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.rows = append(yyDollar[1].rows, yyDollar[3].row)
 		}
-	case 39:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.row = &RowSpec{Values: yyDollar[2].values}
 		}
-	case 40:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ids = []string{yyDollar[1].id}
 		}
-	case 41:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ids = append(yyDollar[1].ids, yyDollar[3].id)
 		}
-	case 42:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.cols = []*ColSelector{yyDollar[1].col}
 		}
-	case 43:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = append(yyDollar[1].cols, yyDollar[3].col)
 		}
-	case 44:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.values = nil
 		}
-	case 45:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = yyDollar[1].values
 		}
-	case 46:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = []ValueExp{yyDollar[1].exp}
 		}
-	case 47:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].exp)
 		}
-	case 48:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Integer{val: int64(yyDollar[1].integer)}
 		}
-	case 49:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Float64{val: float64(yyDollar[1].float)}
 		}
-	case 50:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Varchar{val: yyDollar[1].str}
 		}
-	case 51:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Bool{val: yyDollar[1].boolean}
 		}
-	case 52:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Blob{val: yyDollar[1].blob}
 		}
-	case 53:
+	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.value = &Cast{val: yyDollar[3].exp, t: yyDollar[5].sqlType}
 		}
-	case 54:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = yyDollar[1].value
 		}
-	case 55:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: yyDollar[1].id}
 		}
-	case 56:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
 		}
-	case 57:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &NullValue{t: AnyType}
 		}
-	case 58:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
 		}
-	case 59:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.colsSpec = []*ColSpec{yyDollar[1].colSpec}
 		}
-	case 60:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.colsSpec = append(yyDollar[1].colsSpec, yyDollar[3].colSpec)
 		}
-	case 61:
+	case 96:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.colSpec = &ColSpec{colName: yyDollar[1].id, colType: yyDollar[2].sqlType, maxLen: int(yyDollar[3].integer), notNull: yyDollar[4].boolean, autoIncrement: yyDollar[5].boolean}
 		}
-	case 62:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.integer = 0
 		}
-	case 63:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.integer = yyDollar[2].integer
 		}
-	case 64:
+	case 99:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 65:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 66:
+	case 101:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 67:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 68:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 69:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 70:
+	case 105:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &UnionStmt{
@@ -1136,7 +1373,7 @@ yydefault:
 				right:    yyDollar[4].stmt.(DataSource),
 			}
 		}
-	case 71:
+	case 106:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -1153,392 +1390,392 @@ yydefault:
 				offset:    yyDollar[13].exp,
 			}
 		}
-	case 72:
+	case 107:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 73:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 74:
+	case 109:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 75:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 76:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sels = nil
 		}
-	case 77:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sels = yyDollar[1].sels
 		}
-	case 78:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyDollar[1].sel.setAlias(yyDollar[2].id)
 			yyVAL.sels = []Selector{yyDollar[1].sel}
 		}
-	case 79:
+	case 114:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyDollar[3].sel.setAlias(yyDollar[4].id)
 			yyVAL.sels = append(yyDollar[1].sels, yyDollar[3].sel)
 		}
-	case 80:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sel = yyDollar[1].col
 		}
-	case 81:
+	case 116:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, col: "*"}
 		}
-	case 82:
+	case 117:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col}
 		}
-	case 83:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{col: yyDollar[1].id}
 		}
-	case 84:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{table: yyDollar[1].id, col: yyDollar[3].id}
 		}
-	case 85:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyDollar[1].tableRef.period = yyDollar[2].period
 			yyDollar[1].tableRef.as = yyDollar[3].id
 			yyVAL.ds = yyDollar[1].tableRef
 		}
-	case 86:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyDollar[2].stmt.(*SelectStmt).as = yyDollar[4].id
 			yyVAL.ds = yyDollar[2].stmt.(DataSource)
 		}
-	case 87:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: yyDollar[1].value.(*FnCall), as: yyDollar[2].id}
 		}
-	case 88:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableRef = &tableRef{table: yyDollar[1].id}
 		}
-	case 89:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.period = period{start: yyDollar[1].openPeriod, end: yyDollar[2].openPeriod}
 		}
-	case 90:
+	case 125:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 91:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 92:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 93:
+	case 128:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 94:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 95:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 96:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: txInstant, exp: yyDollar[2].exp}
 		}
-	case 97:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: timeInstant, exp: yyDollar[1].exp}
 		}
-	case 98:
+	case 133:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joins = nil
 		}
-	case 99:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = yyDollar[1].joins
 		}
-	case 100:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = []*JoinSpec{yyDollar[1].join}
 		}
-	case 101:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.joins = append([]*JoinSpec{yyDollar[1].join}, yyDollar[2].joins...)
 		}
-	case 102:
+	case 137:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[3].ds, indexOn: yyDollar[4].ids, cond: yyDollar[6].exp}
 		}
-	case 103:
+	case 138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joinType = InnerJoin
 		}
-	case 104:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joinType = yyDollar[1].joinType
 		}
-	case 105:
+	case 140:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 106:
+	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 107:
+	case 142:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.cols = nil
 		}
-	case 108:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = yyDollar[3].cols
 		}
-	case 109:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 110:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 111:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 112:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 113:
+	case 148:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 114:
+	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 115:
+	case 150:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ordcols = nil
 		}
-	case 116:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ordcols = yyDollar[3].ordcols
 		}
-	case 117:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ids = nil
 		}
-	case 118:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ids = yyDollar[4].ids
 		}
-	case 119:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ordcols = []*OrdCol{{sel: yyDollar[1].col, descOrder: yyDollar[2].opt_ord}}
 		}
-	case 120:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ordcols = append(yyDollar[1].ordcols, &OrdCol{sel: yyDollar[3].col, descOrder: yyDollar[4].opt_ord})
 		}
-	case 121:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 122:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 123:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = true
 		}
-	case 124:
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.id = ""
 		}
-	case 125:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.id = yyDollar[1].id
 		}
-	case 126:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.id = yyDollar[2].id
 		}
-	case 127:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 128:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].binExp
 		}
-	case 129:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NotBoolExp{exp: yyDollar[2].exp}
 		}
-	case 130:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: &Integer{val: 0}, op: SUBSOP, right: yyDollar[2].exp}
 		}
-	case 131:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: yyDollar[2].boolean, pattern: yyDollar[4].exp}
 		}
-	case 132:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &ExistsBoolExp{q: (yyDollar[3].stmt).(*SelectStmt)}
 		}
-	case 133:
+	case 168:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, q: yyDollar[5].stmt.(*SelectStmt)}
 		}
-	case 134:
+	case 169:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InListExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, values: yyDollar[5].values}
 		}
-	case 135:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].sel
 		}
-	case 136:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].value
 		}
-	case 137:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 138:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &Cast{val: yyDollar[1].exp, t: yyDollar[3].sqlType}
 		}
-	case 139:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 140:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 141:
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: ADDOP, right: yyDollar[3].exp}
 		}
-	case 142:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: SUBSOP, right: yyDollar[3].exp}
 		}
-	case 143:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: DIVOP, right: yyDollar[3].exp}
 		}
-	case 144:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &NumExp{left: yyDollar[1].exp, op: MULTOP, right: yyDollar[3].exp}
 		}
-	case 145:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &BinBoolExp{left: yyDollar[1].exp, op: yyDollar[2].logicOp, right: yyDollar[3].exp}
 		}
-	case 146:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, right: yyDollar[3].exp}
 		}
-	case 147:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: EQ, right: &NullValue{t: AnyType}}
 		}
-	case 148:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.binExp = &CmpBoolExp{left: yyDollar[1].exp, op: NE, right: &NullValue{t: AnyType}}
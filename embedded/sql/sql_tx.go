@@ -19,6 +19,7 @@ package sql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
@@ -34,6 +35,8 @@ type SQLTx struct {
 
 	catalog *Catalog // in-mem catalog
 
+	sessionVars *SessionVariables // variables of the session the tx was created for, if any
+
 	mutatedCatalog bool // set when a DDL stmt was executed within the current tx
 
 	updatedRows      int
@@ -42,10 +45,23 @@ type SQLTx struct {
 
 	txHeader *store.TxHeader // header is set once tx is committed
 
+	savepoints []*savepoint
+
+	aborted   bool // set when a stmt fails within a tx with savepoints, only rollbacks are allowed afterwards
 	committed bool
 	closed    bool
 }
 
+type savepoint struct {
+	name string
+	sp   *store.Savepoint
+
+	mutatedCatalog   bool
+	updatedRows      int
+	lastInsertedPKs  map[string]int64
+	firstInsertedPKs map[string]int64
+}
+
 func (sqlTx *SQLTx) Catalog() *Catalog {
 	return sqlTx.catalog
 }
@@ -113,6 +129,131 @@ func (sqlTx *SQLTx) existKeyWith(prefix, neq []byte) (bool, error) {
 	return err == nil, err
 }
 
+func copyPKs(pks map[string]int64) map[string]int64 {
+	c := make(map[string]int64, len(pks))
+
+	for t, pk := range pks {
+		c[t] = pk
+	}
+
+	return c
+}
+
+func (sqlTx *SQLTx) findSavepoint(name string) (int, error) {
+	// savepoint names may be reused, the most recent one is taken
+	for i := len(sqlTx.savepoints) - 1; i >= 0; i-- {
+		if sqlTx.savepoints[i].name == name {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrSavepointDoesNotExist, name)
+}
+
+// Savepoint creates a named savepoint within the transaction
+func (sqlTx *SQLTx) Savepoint(name string) error {
+	if sqlTx.closed {
+		return ErrAlreadyClosed
+	}
+
+	if sqlTx.aborted {
+		return ErrTxAborted
+	}
+
+	if name == "" {
+		return fmt.Errorf("%w: no savepoint name was provided", ErrIllegalArguments)
+	}
+
+	sp, err := sqlTx.tx.Savepoint()
+	if err != nil {
+		return err
+	}
+
+	sqlTx.savepoints = append(sqlTx.savepoints, &savepoint{
+		name:             name,
+		sp:               sp,
+		mutatedCatalog:   sqlTx.mutatedCatalog,
+		updatedRows:      sqlTx.updatedRows,
+		lastInsertedPKs:  copyPKs(sqlTx.lastInsertedPKs),
+		firstInsertedPKs: copyPKs(sqlTx.firstInsertedPKs),
+	})
+
+	return nil
+}
+
+// RollbackToSavepoint discards all the changes made after the named savepoint was created.
+// The savepoint is kept, while savepoints created after it are destroyed
+func (sqlTx *SQLTx) RollbackToSavepoint(name string) error {
+	if sqlTx.closed {
+		return ErrAlreadyClosed
+	}
+
+	i, err := sqlTx.findSavepoint(name)
+	if err != nil {
+		return err
+	}
+
+	sp := sqlTx.savepoints[i]
+
+	err = sqlTx.tx.RollbackTo(sp.sp)
+	if err != nil {
+		return err
+	}
+
+	// the in-memory catalog may have been changed by either DDL or DML stmts (e.g. auto-incremental PKs)
+	catalog := newCatalog(sqlTx.engine.prefix)
+
+	err = catalog.load(sqlTx.tx)
+	if err != nil {
+		return err
+	}
+
+	sqlTx.catalog = catalog
+	sqlTx.mutatedCatalog = sp.mutatedCatalog
+	sqlTx.updatedRows = sp.updatedRows
+	sqlTx.lastInsertedPKs = copyPKs(sp.lastInsertedPKs)
+	sqlTx.firstInsertedPKs = copyPKs(sp.firstInsertedPKs)
+
+	sqlTx.savepoints = sqlTx.savepoints[:i+1]
+	sqlTx.aborted = false
+
+	return nil
+}
+
+// ReleaseSavepoint destroys the named savepoint and all the savepoints created after it.
+// Changes made after the savepoint was created are kept
+func (sqlTx *SQLTx) ReleaseSavepoint(name string) error {
+	if sqlTx.closed {
+		return ErrAlreadyClosed
+	}
+
+	if sqlTx.aborted {
+		return ErrTxAborted
+	}
+
+	i, err := sqlTx.findSavepoint(name)
+	if err != nil {
+		return err
+	}
+
+	err = sqlTx.tx.ReleaseSavepoint(sqlTx.savepoints[i].sp)
+	if err != nil {
+		return err
+	}
+
+	sqlTx.savepoints = sqlTx.savepoints[:i]
+
+	return nil
+}
+
+func (sqlTx *SQLTx) hasSavepoints() bool {
+	return len(sqlTx.savepoints) > 0
+}
+
+func (sqlTx *SQLTx) Aborted() bool {
+	return sqlTx.aborted
+}
+
 func (sqlTx *SQLTx) Cancel() error {
 	if sqlTx.closed {
 		return ErrAlreadyClosed
@@ -124,6 +265,10 @@ func (sqlTx *SQLTx) Cancel() error {
 }
 
 func (sqlTx *SQLTx) Commit(ctx context.Context) (err error) {
+	if sqlTx.aborted {
+		return ErrTxAborted
+	}
+
 	sqlTx.committed = true
 	sqlTx.closed = true
 
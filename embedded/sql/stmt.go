@@ -35,6 +35,7 @@ const (
 	catalogTablePrefix  = "CTL.TABLE."  // (key=CTL.TABLE.{1}{tableID}, value={tableNAME})
 	catalogColumnPrefix = "CTL.COLUMN." // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
 	catalogIndexPrefix  = "CTL.INDEX."  // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
+	catalogPolicyPrefix = "CTL.POLICY." // (key=CTL.POLICY.{1}{tableID}{policyNAME}, value={cmd}{usingEXP})
 	PIndexPrefix        = "R."          // (key=R.{1}{tableID}{0}({null}({pkVal}{padding}{pkValLen})?)+, value={count (colID valLen val)+})
 	SIndexPrefix        = "E."          // (key=E.{1}{tableID}{indexID}({null}({val}{padding}{valLen})?)+({pkVal}{padding}{pkValLen})+, value={})
 	UIndexPrefix        = "N."          // (key=N.{1}{tableID}{indexID}({null}({val}{padding}{valLen})?)+, value={({pkVal}{padding}{pkValLen})+})
@@ -120,12 +121,24 @@ const (
 	RightJoin
 )
 
+type PolicyCommand = int
+
+// Commands row-level security policies apply to
+const (
+	SelectPolicyCommand PolicyCommand = iota
+	InsertPolicyCommand
+	UpdatePolicyCommand
+	DeletePolicyCommand
+	AllPolicyCommand
+)
+
 const (
-	NowFnCall       string = "NOW"
-	DatabasesFnCall string = "DATABASES"
-	TablesFnCall    string = "TABLES"
-	ColumnsFnCall   string = "COLUMNS"
-	IndexesFnCall   string = "INDEXES"
+	NowFnCall         string = "NOW"
+	CurrentUserFnCall string = "CURRENT_USER"
+	DatabasesFnCall   string = "DATABASES"
+	TablesFnCall      string = "TABLES"
+	ColumnsFnCall     string = "COLUMNS"
+	IndexesFnCall     string = "INDEXES"
 )
 
 type SQLStmt interface {
@@ -191,6 +204,54 @@ func (stmt *RollbackStmt) execAt(ctx context.Context, tx *SQLTx, params map[stri
 	return nil, tx.Cancel()
 }
 
+type SavepointStmt struct {
+	name string
+}
+
+func (stmt *SavepointStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *SavepointStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.IsExplicitCloseRequired() {
+		return nil, ErrNoOngoingTx
+	}
+
+	return tx, tx.Savepoint(stmt.name)
+}
+
+type RollbackToSavepointStmt struct {
+	name string
+}
+
+func (stmt *RollbackToSavepointStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *RollbackToSavepointStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.IsExplicitCloseRequired() {
+		return nil, ErrNoOngoingTx
+	}
+
+	return tx, tx.RollbackToSavepoint(stmt.name)
+}
+
+type ReleaseSavepointStmt struct {
+	name string
+}
+
+func (stmt *ReleaseSavepointStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *ReleaseSavepointStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.IsExplicitCloseRequired() {
+		return nil, ErrNoOngoingTx
+	}
+
+	return tx, tx.ReleaseSavepoint(stmt.name)
+}
+
 type CreateDatabaseStmt struct {
 	DB          string
 	ifNotExists bool
@@ -236,6 +297,202 @@ func (stmt *UseDatabaseStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 	return tx, tx.engine.multidbHandler.UseDatabase(ctx, stmt.DB)
 }
 
+type CreateRoleStmt struct {
+	role        string
+	ifNotExists bool
+}
+
+func (stmt *CreateRoleStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateRoleStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: role creation can not be done within a transaction", ErrNonTransactionalStmt)
+	}
+
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	return nil, tx.engine.multidbHandler.CreateRole(ctx, stmt.role, stmt.ifNotExists)
+}
+
+type DropRoleStmt struct {
+	role string
+}
+
+func (stmt *DropRoleStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropRoleStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: role deletion can not be done within a transaction", ErrNonTransactionalStmt)
+	}
+
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	return nil, tx.engine.multidbHandler.DropRole(ctx, stmt.role)
+}
+
+// ChangePrivilegesStmt grants or revokes privileges on an object of the database in use to a role.
+// An empty list of privileges stands for all the privileges applicable to the object.
+type ChangePrivilegesStmt struct {
+	revoke     bool
+	privileges []string
+	object     *PrivilegeObject
+	role       string
+}
+
+func (stmt *ChangePrivilegesStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *ChangePrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: privileges can not be changed within a transaction", ErrNonTransactionalStmt)
+	}
+
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	if stmt.revoke {
+		return nil, tx.engine.multidbHandler.RevokePrivileges(ctx, stmt.role, stmt.privileges, stmt.object)
+	}
+
+	return nil, tx.engine.multidbHandler.GrantPrivileges(ctx, stmt.role, stmt.privileges, stmt.object)
+}
+
+type ChangeRoleMembershipStmt struct {
+	revoke   bool
+	role     string
+	username string
+}
+
+func (stmt *ChangeRoleMembershipStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *ChangeRoleMembershipStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.IsExplicitCloseRequired() {
+		return nil, fmt.Errorf("%w: roles can not be granted within a transaction", ErrNonTransactionalStmt)
+	}
+
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	if stmt.revoke {
+		return nil, tx.engine.multidbHandler.RevokeRole(ctx, stmt.role, stmt.username)
+	}
+
+	return nil, tx.engine.multidbHandler.GrantRole(ctx, stmt.role, stmt.username)
+}
+
+// CreatePolicyStmt creates a row-level security policy restricting the rows of a table
+// accessible by a command to the ones satisfying the using condition
+type CreatePolicyStmt struct {
+	policy      string
+	ifNotExists bool
+	table       string
+	cmd         PolicyCommand
+	using       ValueExp
+}
+
+func (stmt *CreatePolicyStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreatePolicyStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.canManagePolicies() {
+		return nil, fmt.Errorf("%w: policies can not be created", ErrRowLevelSecurityBypassRequired)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	_, exists := table.policiesByName[stmt.policy]
+	if exists && stmt.ifNotExists {
+		return tx, nil
+	}
+
+	using, err := renderPolicyCondition(table, stmt.using)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := table.newPolicy(stmt.policy, stmt.cmd, stmt.using)
+	if err != nil {
+		return nil, err
+	}
+
+	// v={cmd}{usingEXP}
+	v := make([]byte, 1+len(using))
+	v[0] = byte(policy.cmd)
+	copy(v[1:], using)
+
+	mappedKey := mapKey(tx.sqlPrefix(), catalogPolicyPrefix, EncodeID(1), EncodeID(table.id), []byte(policy.name))
+
+	err = tx.set(mappedKey, nil, v)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+type DropPolicyStmt struct {
+	policy   string
+	ifExists bool
+	table    string
+}
+
+func (stmt *DropPolicyStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropPolicyStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.canManagePolicies() {
+		return nil, fmt.Errorf("%w: policies can not be dropped", ErrRowLevelSecurityBypassRequired)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := table.dropPolicy(stmt.policy)
+	if errors.Is(err, ErrPolicyDoesNotExist) && stmt.ifExists {
+		return tx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	md := store.NewKVMetadata()
+
+	md.AsDeleted(true)
+
+	mappedKey := mapKey(tx.sqlPrefix(), catalogPolicyPrefix, EncodeID(1), EncodeID(table.id), []byte(policy.name))
+
+	err = tx.set(mappedKey, md, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
 type UseSnapshotStmt struct {
 	period period
 }
@@ -646,17 +903,28 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			return nil, fmt.Errorf("%w: specified value must be greater than current one", ErrInvalidValue)
 		}
 
+		rowExists := err == nil
+
 		if stmt.isInsert {
-			if err == nil && stmt.onConflict == nil {
+			if rowExists && stmt.onConflict == nil {
 				return nil, store.ErrKeyAlreadyExists
 			}
 
-			if err == nil && stmt.onConflict != nil {
+			if rowExists && stmt.onConflict != nil {
 				// TODO: conflict resolution may be extended. Currently only supports "ON CONFLICT DO NOTHING"
 				continue
 			}
 		}
 
+		if rowExists {
+			err = tx.checkUpsertPolicies(ctx, table, valuesByColID)
+		} else {
+			err = tx.checkPolicies(table, InsertPolicyCommand, valuesByColID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
 		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !stmt.isInsert)
 		if err != nil {
 			return nil, err
@@ -1011,7 +1279,7 @@ func (stmt *UpdateStmt) validate(table *Table) error {
 
 func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
+		ds:      stmt.tableRef.forCommand(UpdatePolicyCommand),
 		where:   stmt.where,
 		indexOn: stmt.indexOn,
 		limit:   stmt.limit,
@@ -1073,6 +1341,11 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 			valuesByColID[col.id] = rval
 		}
 
+		err = tx.checkPolicies(table, UpdatePolicyCommand, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		pkEncVals, err := encodedPK(table, valuesByColID)
 		if err != nil {
 			return nil, err
@@ -1114,7 +1387,7 @@ func (stmt *DeleteFromStmt) inferParameters(ctx context.Context, tx *SQLTx, para
 
 func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
+		ds:      stmt.tableRef.forCommand(DeletePolicyCommand),
 		where:   stmt.where,
 		indexOn: stmt.indexOn,
 		limit:   stmt.limit,
@@ -1813,23 +2086,27 @@ type FnCall struct {
 }
 
 func (v *FnCall) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
-	if strings.ToUpper(v.fn) == NowFnCall {
+	switch strings.ToUpper(v.fn) {
+	case NowFnCall:
 		return TimestampType, nil
+	case CurrentUserFnCall:
+		return VarcharType, nil
 	}
 
 	return AnyType, fmt.Errorf("%w: unknown function %s", ErrIllegalArguments, v.fn)
 }
 
 func (v *FnCall) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	if strings.ToUpper(v.fn) == NowFnCall {
-		if t != TimestampType {
-			return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, TimestampType, t)
-		}
+	ft, err := v.inferType(cols, params, implicitTable)
+	if err != nil {
+		return err
+	}
 
-		return nil
+	if t != ft {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, ft, t)
 	}
 
-	return fmt.Errorf("%w: unkown function %s", ErrIllegalArguments, v.fn)
+	return nil
 }
 
 func (v *FnCall) substitute(params map[string]interface{}) (val ValueExp, err error) {
@@ -1856,6 +2133,18 @@ func (v *FnCall) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue,
 		return &Timestamp{val: tx.Timestamp().Truncate(time.Microsecond).UTC()}, nil
 	}
 
+	if strings.ToUpper(v.fn) == CurrentUserFnCall {
+		if len(v.params) > 0 {
+			return nil, fmt.Errorf("%w: '%s' function does not expect any argument but %d were provided", ErrIllegalArguments, CurrentUserFnCall, len(v.params))
+		}
+
+		if tx == nil || tx.sessionVars == nil || tx.sessionVars.User == "" {
+			return &NullValue{t: VarcharType}, nil
+		}
+
+		return &Varchar{val: tx.sessionVars.User}, nil
+	}
+
 	return nil, fmt.Errorf("%w: unkown function %s", ErrIllegalArguments, v.fn)
 }
 
@@ -2401,9 +2690,10 @@ func (stmt *UnionStmt) Alias() string {
 }
 
 type tableRef struct {
-	table  string
-	period period
-	as     string
+	table     string
+	period    period
+	as        string
+	policyCmd PolicyCommand // command row-level security policies are enforced for, rows are selected by default
 }
 
 type period struct {
@@ -2537,7 +2827,24 @@ func (stmt *tableRef) Resolve(ctx context.Context, tx *SQLTx, params map[string]
 		return nil, err
 	}
 
-	return newRawRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
+	rowReader, err := newRawRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := tx.policiesCondition(table, stmt.policyCmd)
+	if cond == nil {
+		return rowReader, nil
+	}
+
+	return newConditionalRowReader(rowReader, cond), nil
+}
+
+// forCommand returns a reference to the same table whose rows are accessed by the given command
+func (stmt *tableRef) forCommand(cmd PolicyCommand) *tableRef {
+	ref := *stmt
+	ref.policyCmd = cmd
+	return &ref
 }
 
 func (stmt *tableRef) Alias() string {
@@ -0,0 +1,683 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	indexLeafPrefix  = byte(0)
+	indexInnerPrefix = byte(1)
+)
+
+const authIndexCheckpointFilename = "aindex.checkpoint"
+
+// checkpoint layout: txID | root digest | leaves sorted by key digest (keyDigest | txID | revision) | sha256 of the preceding bytes
+const authIndexCheckpointHeaderSize = txIDSize + sha256.Size
+const authIndexCheckpointLeafSize = sha256.Size + txIDSize + revisionAttrSize
+
+var errInvalidAuthIndexCheckpoint = errors.New("invalid authenticated index checkpoint")
+
+// IndexLeaf holds the latest transaction a key was written at and the number of times it was written,
+// i.e. its latest revision
+type IndexLeaf struct {
+	KeyDigest [sha256.Size]byte
+	TxID      uint64
+	Revision  uint64
+}
+
+// IndexProof proves the latest transaction a key was written at, or that the key was never written,
+// against the root of the authenticated index committed into a transaction header.
+type IndexProof struct {
+	// Digests of the sibling subtrees along the path of the key, from the root downwards
+	Siblings [][sha256.Size]byte
+
+	// Leaf found at the end of the path, it's nil when the path ends in an empty subtree
+	Leaf *IndexLeaf
+}
+
+// authNode is a node of the authenticated index, nodes are never modified once created
+type authNode struct {
+	digest [sha256.Size]byte
+
+	// number of leaves of the subtree
+	size uint64
+
+	// inner nodes
+	left  *authNode
+	right *authNode
+
+	// leaf nodes
+	leaf *IndexLeaf
+}
+
+// authenticatedIndex is a binary Merkle trie mapping the digest of every indexed key to the latest
+// transaction it was written at and its latest revision. Subtrees holding a single key are collapsed into a leaf, thus the shape
+// of the trie only depends on the set of keys and not on the order they were written in.
+//
+// Roots are kept for the last committed transaction and every precommitted one so proofs can be built
+// against committed transactions and precommitted ones can be discarded, older ones are pruned as
+// transactions get committed. The whole trie is kept in memory, its number of keys is bounded by
+// the AuthenticatedIndexMaxKeys option.
+//
+// The trie is periodically checkpointed so only transactions committed after the last checkpoint
+// need to be replayed when the store is opened.
+// The authenticated index must be accessed while holding the commitStateRWMutex.
+type authenticatedIndex struct {
+	root  *authNode
+	roots map[uint64]*authNode
+
+	checkpointTxID uint64
+	checkpointing  bool
+	checkpointWg   sync.WaitGroup
+}
+
+func newAuthenticatedIndex() *authenticatedIndex {
+	return &authenticatedIndex{
+		roots: map[uint64]*authNode{0: nil},
+	}
+}
+
+func indexLeafDigest(keyDigest [sha256.Size]byte, txID, revision uint64) [sha256.Size]byte {
+	var b [1 + sha256.Size + txIDSize + revisionAttrSize]byte
+
+	b[0] = indexLeafPrefix
+	copy(b[1:], keyDigest[:])
+	binary.BigEndian.PutUint64(b[1+sha256.Size:], txID)
+	binary.BigEndian.PutUint64(b[1+sha256.Size+txIDSize:], revision)
+
+	return sha256.Sum256(b[:])
+}
+
+func indexInnerDigest(left, right [sha256.Size]byte) [sha256.Size]byte {
+	var b [1 + 2*sha256.Size]byte
+
+	b[0] = indexInnerPrefix
+	copy(b[1:], left[:])
+	copy(b[1+sha256.Size:], right[:])
+
+	return sha256.Sum256(b[:])
+}
+
+func indexBitAt(keyDigest [sha256.Size]byte, depth int) byte {
+	return (keyDigest[depth/8] >> (7 - depth%8)) & 1
+}
+
+// digestOf returns the digest of the subtree, empty subtrees are represented by the zero digest
+func (n *authNode) digestOf() [sha256.Size]byte {
+	if n == nil {
+		return [sha256.Size]byte{}
+	}
+	return n.digest
+}
+
+// sizeOf returns the number of leaves of the subtree
+func (n *authNode) sizeOf() uint64 {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func newAuthLeaf(keyDigest [sha256.Size]byte, txID, revision uint64) *authNode {
+	return &authNode{
+		digest: indexLeafDigest(keyDigest, txID, revision),
+		size:   1,
+		leaf:   &IndexLeaf{KeyDigest: keyDigest, TxID: txID, Revision: revision},
+	}
+}
+
+// leafOf returns the leaf of the key within the subtree, if any
+func leafOf(n *authNode, keyDigest [sha256.Size]byte) *IndexLeaf {
+	for depth := 0; n != nil && n.leaf == nil; depth++ {
+		if indexBitAt(keyDigest, depth) == 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	if n == nil || n.leaf.KeyDigest != keyDigest {
+		return nil
+	}
+
+	return n.leaf
+}
+
+func newAuthInner(left, right *authNode) *authNode {
+	return &authNode{
+		digest: indexInnerDigest(left.digestOf(), right.digestOf()),
+		size:   left.sizeOf() + right.sizeOf(),
+		left:   left,
+		right:  right,
+	}
+}
+
+// insertInto returns the root of a new subtree including the leaf, nodes of the original subtree are reused
+func insertInto(n *authNode, depth int, leaf *authNode) *authNode {
+	if n == nil {
+		return leaf
+	}
+
+	if n.leaf != nil {
+		if n.leaf.KeyDigest == leaf.leaf.KeyDigest {
+			return leaf
+		}
+
+		return splitLeaves(depth, n, leaf)
+	}
+
+	if indexBitAt(leaf.leaf.KeyDigest, depth) == 0 {
+		return newAuthInner(insertInto(n.left, depth+1, leaf), n.right)
+	}
+
+	return newAuthInner(n.left, insertInto(n.right, depth+1, leaf))
+}
+
+// splitLeaves returns the subtree holding two leaves with different key digests
+func splitLeaves(depth int, l1, l2 *authNode) *authNode {
+	b1 := indexBitAt(l1.leaf.KeyDigest, depth)
+	b2 := indexBitAt(l2.leaf.KeyDigest, depth)
+
+	if b1 == b2 {
+		child := splitLeaves(depth+1, l1, l2)
+
+		if b1 == 0 {
+			return newAuthInner(child, nil)
+		}
+		return newAuthInner(nil, child)
+	}
+
+	if b1 == 0 {
+		return newAuthInner(l1, l2)
+	}
+	return newAuthInner(l2, l1)
+}
+
+// rootAfter returns the root of the authenticated index once the entries of the transaction are included,
+// together with the revision every entry is written as. The current root is left untouched.
+// Non-indexable entries are skipped as they are not indexed either, their revision is zero.
+func (ai *authenticatedIndex) rootAfter(tx *Tx) (*authNode, []uint64) {
+	root := ai.root
+
+	revisions := make([]uint64, tx.header.NEntries)
+
+	for i, e := range tx.Entries() {
+		if e.md != nil && e.md.NonIndexable() {
+			continue
+		}
+
+		keyDigest := sha256.Sum256(e.Key())
+
+		revisions[i] = 1
+
+		if leaf := leafOf(ai.root, keyDigest); leaf != nil {
+			revisions[i] = leaf.Revision + 1
+		}
+
+		root = insertInto(root, 0, newAuthLeaf(keyDigest, tx.header.ID, revisions[i]))
+	}
+
+	return root, revisions
+}
+
+// advance sets the root as of the given transaction, roots of transactions older than
+// the last committed one are no longer needed
+func (ai *authenticatedIndex) advance(txID uint64, root *authNode, committedTxID uint64) {
+	ai.root = root
+	ai.roots[txID] = root
+
+	ai.pruneBefore(committedTxID)
+}
+
+// pruneBefore removes the roots of the transactions older than the given one
+func (ai *authenticatedIndex) pruneBefore(txID uint64) {
+	for id := range ai.roots {
+		if id < txID {
+			delete(ai.roots, id)
+		}
+	}
+}
+
+// discardSince restores the root as of the transaction preceding txID
+func (ai *authenticatedIndex) discardSince(txID uint64) error {
+	root, ok := ai.roots[txID-1]
+	if !ok {
+		return fmt.Errorf("%w: authenticated index root not found for transaction %d", ErrUnexpectedError, txID-1)
+	}
+
+	for id := range ai.roots {
+		if id >= txID {
+			delete(ai.roots, id)
+		}
+	}
+
+	ai.root = root
+
+	return nil
+}
+
+func (ai *authenticatedIndex) rootAt(txID uint64) (*authNode, bool) {
+	root, ok := ai.roots[txID]
+	return root, ok
+}
+
+// proofFor returns the proof for the key against the subtree, nodes are immutable
+// so it's safe to build proofs without holding any lock once the root was obtained
+func proofFor(root *authNode, key []byte) *IndexProof {
+	keyDigest := sha256.Sum256(key)
+
+	proof := &IndexProof{}
+
+	n := root
+
+	for depth := 0; n != nil && n.leaf == nil; depth++ {
+		if indexBitAt(keyDigest, depth) == 0 {
+			proof.Siblings = append(proof.Siblings, n.right.digestOf())
+			n = n.left
+		} else {
+			proof.Siblings = append(proof.Siblings, n.left.digestOf())
+			n = n.right
+		}
+	}
+
+	if n != nil {
+		leaf := *n.leaf
+		proof.Leaf = &leaf
+	}
+
+	return proof
+}
+
+// leavesOf appends the leaves of the subtree sorted by key digest
+func leavesOf(n *authNode, leaves []*IndexLeaf) []*IndexLeaf {
+	if n == nil {
+		return leaves
+	}
+
+	if n.leaf != nil {
+		return append(leaves, n.leaf)
+	}
+
+	return leavesOf(n.right, leavesOf(n.left, leaves))
+}
+
+// buildFrom returns the subtree holding the leaves, which must be sorted by key digest.
+// The resulting shape is the same as if leaves were inserted one by one.
+func buildFrom(leaves []*IndexLeaf, depth int) *authNode {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	if len(leaves) == 1 {
+		return newAuthLeaf(leaves[0].KeyDigest, leaves[0].TxID, leaves[0].Revision)
+	}
+
+	i := 0
+	for i < len(leaves) && indexBitAt(leaves[i].KeyDigest, depth) == 0 {
+		i++
+	}
+
+	return newAuthInner(buildFrom(leaves[:i], depth+1), buildFrom(leaves[i:], depth+1))
+}
+
+func writeAuthIndexCheckpoint(path string, txID uint64, root *authNode) error {
+	tmpPath := path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	err = func() error {
+		defer f.Close()
+
+		h := sha256.New()
+		w := bufio.NewWriter(io.MultiWriter(f, h))
+
+		var b [authIndexCheckpointLeafSize]byte
+
+		binary.BigEndian.PutUint64(b[:], txID)
+		rootDigest := root.digestOf()
+		copy(b[txIDSize:], rootDigest[:])
+
+		_, err := w.Write(b[:authIndexCheckpointHeaderSize])
+		if err != nil {
+			return err
+		}
+
+		for _, leaf := range leavesOf(root, nil) {
+			copy(b[:], leaf.KeyDigest[:])
+			binary.BigEndian.PutUint64(b[sha256.Size:], leaf.TxID)
+			binary.BigEndian.PutUint64(b[sha256.Size+txIDSize:], leaf.Revision)
+
+			_, err = w.Write(b[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		err = w.Flush()
+		if err != nil {
+			return err
+		}
+
+		_, err = f.Write(h.Sum(nil))
+		if err != nil {
+			return err
+		}
+
+		return f.Sync()
+	}()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// readAuthIndexCheckpoint returns the transaction the checkpoint was taken at together with the trie as of it
+func readAuthIndexCheckpoint(path string) (uint64, *authNode, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	leavesSize := stat.Size() - authIndexCheckpointHeaderSize - sha256.Size
+	if leavesSize < 0 || leavesSize%authIndexCheckpointLeafSize != 0 {
+		return 0, nil, errInvalidAuthIndexCheckpoint
+	}
+
+	h := sha256.New()
+	r := io.TeeReader(bufio.NewReader(f), h)
+
+	var b [authIndexCheckpointLeafSize]byte
+
+	_, err = io.ReadFull(r, b[:authIndexCheckpointHeaderSize])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	txID := binary.BigEndian.Uint64(b[:])
+
+	var rootDigest [sha256.Size]byte
+	copy(rootDigest[:], b[txIDSize:])
+
+	leaves := make([]*IndexLeaf, leavesSize/authIndexCheckpointLeafSize)
+
+	for i := range leaves {
+		_, err = io.ReadFull(r, b[:])
+		if err != nil {
+			return 0, nil, err
+		}
+
+		leaf := &IndexLeaf{
+			TxID:     binary.BigEndian.Uint64(b[sha256.Size:]),
+			Revision: binary.BigEndian.Uint64(b[sha256.Size+txIDSize:]),
+		}
+		copy(leaf.KeyDigest[:], b[:])
+
+		if i > 0 && bytes.Compare(leaves[i-1].KeyDigest[:], leaf.KeyDigest[:]) >= 0 {
+			return 0, nil, errInvalidAuthIndexCheckpoint
+		}
+
+		leaves[i] = leaf
+	}
+
+	checksum := h.Sum(nil)
+
+	_, err = io.ReadFull(r, b[:sha256.Size])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if !bytes.Equal(checksum, b[:sha256.Size]) {
+		return 0, nil, errInvalidAuthIndexCheckpoint
+	}
+
+	root := buildFrom(leaves, 0)
+
+	if root.digestOf() != rootDigest {
+		return 0, nil, errInvalidAuthIndexCheckpoint
+	}
+
+	return txID, root, nil
+}
+
+// mayCheckpointAuthenticatedIndex prunes the roots no longer needed once transactions are committed and
+// writes a checkpoint of the authenticated index in the background once enough transactions were committed
+// since the last one.
+// It requires the caller to have already acquired the commitStateRWMutex lock
+func (s *ImmuStore) mayCheckpointAuthenticatedIndex() {
+	ai := s.authIndex
+
+	if ai == nil {
+		return
+	}
+
+	ai.pruneBefore(s.committedTxID)
+
+	if s.readOnly || ai.checkpointing || s.committedTxID < ai.checkpointTxID+uint64(s.authIndexCheckpointThld) {
+		return
+	}
+
+	txID := s.committedTxID
+	root, ok := ai.rootAt(txID)
+	if !ok {
+		return
+	}
+
+	ai.checkpointing = true
+	ai.checkpointWg.Add(1)
+
+	go func() {
+		defer ai.checkpointWg.Done()
+
+		err := writeAuthIndexCheckpoint(filepath.Join(s.path, authIndexCheckpointFilename), txID, root)
+		if err != nil {
+			s.logger.Warningf("Could not checkpoint authenticated index at '%s': %v", s.path, err)
+		}
+
+		s.commitStateRWMutex.Lock()
+		defer s.commitStateRWMutex.Unlock()
+
+		if err == nil {
+			ai.checkpointTxID = txID
+		}
+		ai.checkpointing = false
+	}()
+}
+
+// checkpointAuthenticatedIndex waits for any ongoing checkpoint and writes a final one if new
+// transactions were committed since then
+func (s *ImmuStore) checkpointAuthenticatedIndex() error {
+	ai := s.authIndex
+
+	if ai == nil || s.readOnly {
+		return nil
+	}
+
+	ai.checkpointWg.Wait()
+
+	s.commitStateRWMutex.RLock()
+	txID := s.committedTxID
+	root, ok := ai.rootAt(txID)
+	checkpointTxID := ai.checkpointTxID
+	s.commitStateRWMutex.RUnlock()
+
+	if !ok || txID <= checkpointTxID {
+		return nil
+	}
+
+	err := writeAuthIndexCheckpoint(filepath.Join(s.path, authIndexCheckpointFilename), txID, root)
+	if err != nil {
+		return fmt.Errorf("could not checkpoint authenticated index: %w", err)
+	}
+
+	s.commitStateRWMutex.Lock()
+	ai.checkpointTxID = txID
+	s.commitStateRWMutex.Unlock()
+
+	return nil
+}
+
+// loadAuthenticatedIndexCheckpoint returns the transaction the checkpoint was taken at, zero when
+// there is no usable checkpoint
+func (s *ImmuStore) loadAuthenticatedIndexCheckpoint() (uint64, error) {
+	txID, root, err := readAuthIndexCheckpoint(filepath.Join(s.path, authIndexCheckpointFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		s.logger.Warningf("Discarding authenticated index checkpoint at '%s': %v", s.path, err)
+		return 0, nil
+	}
+
+	if txID == 0 || txID > s.committedTxID {
+		s.logger.Warningf("Discarding authenticated index checkpoint at '%s': taken at transaction %d", s.path, txID)
+		return 0, nil
+	}
+
+	hdr, err := s.ReadTxHeader(txID, false, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if hdr.Metadata != nil && hdr.Metadata.HasIndexRoot() {
+		committedRoot, err := hdr.Metadata.GetIndexRoot()
+		if err != nil {
+			return 0, err
+		}
+
+		if committedRoot != root.digestOf() {
+			s.logger.Warningf("Discarding authenticated index checkpoint at '%s': root differs from the one committed at transaction %d", s.path, txID)
+			return 0, nil
+		}
+	}
+
+	s.authIndex.root = root
+	s.authIndex.roots = map[uint64]*authNode{txID: root}
+	s.authIndex.checkpointTxID = txID
+
+	return txID, nil
+}
+
+func (s *ImmuStore) loadAuthenticatedIndex() error {
+	s.logger.Infof("Loading authenticated index at '%s'...", s.path)
+
+	s.authIndex = newAuthenticatedIndex()
+
+	if s.inmemPrecommittedTxID == 0 {
+		return nil
+	}
+
+	checkpointTxID, err := s.loadAuthenticatedIndexCheckpoint()
+	if err != nil {
+		return err
+	}
+
+	if checkpointTxID == s.inmemPrecommittedTxID {
+		s.logger.Infof("Authenticated index loaded at '%s'", s.path)
+		return nil
+	}
+
+	tx, err := s.fetchAllocTx()
+	if err != nil {
+		return err
+	}
+	defer s.releaseAllocTx(tx)
+
+	txReader, err := s.newTxReader(checkpointTxID+1, false, true, false, tx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		tx, err := txReader.Read()
+		if err == ErrNoMoreEntries {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		root, _ := s.authIndex.rootAfter(tx)
+
+		s.authIndex.advance(tx.header.ID, root, s.committedTxID)
+	}
+
+	s.logger.Infof("Authenticated index loaded at '%s'", s.path)
+
+	return nil
+}
+
+// IndexProof returns the header of the last committed transaction together with a proof of the latest
+// transaction the key was written at as of it. When the key was never written, the proof shows its absence.
+func (s *ImmuStore) IndexProof(key []byte) (*TxHeader, *IndexProof, error) {
+	if s.authIndex == nil {
+		return nil, nil, ErrAuthenticatedIndexDisabled
+	}
+
+	s.commitStateRWMutex.RLock()
+	txID := s.committedTxID
+	root, ok := s.authIndex.rootAt(txID)
+	s.commitStateRWMutex.RUnlock()
+
+	if txID == 0 {
+		return nil, nil, ErrTxNotFound
+	}
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: authenticated index root not found for transaction %d", ErrUnexpectedError, txID)
+	}
+
+	hdr, err := s.ReadTxHeader(txID, false, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if hdr.Metadata == nil || !hdr.Metadata.HasIndexRoot() {
+		return nil, nil, ErrIndexRootNotPresentInMetadata
+	}
+
+	committedRoot, err := hdr.Metadata.GetIndexRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if committedRoot != root.digestOf() {
+		return nil, nil, fmt.Errorf("%w: authenticated index root differs from the one committed at transaction %d", ErrCorruptedIndex, txID)
+	}
+
+	return hdr, proofFor(root, key), nil
+}
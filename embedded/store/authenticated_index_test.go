@@ -0,0 +1,480 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticatedIndexTrie(t *testing.T) {
+	const keyCount = 1000
+
+	keys := make([][]byte, keyCount)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%d", i))
+	}
+
+	var root *authNode
+
+	for i, k := range keys {
+		root = insertInto(root, 0, newAuthLeaf(sha256.Sum256(k), uint64(i+1), 1))
+	}
+
+	require.Equal(t, uint64(keyCount), root.sizeOf())
+
+	t.Run("the root should not depend on the insertion order", func(t *testing.T) {
+		var shuffledRoot *authNode
+
+		for _, i := range rand.Perm(keyCount) {
+			shuffledRoot = insertInto(shuffledRoot, 0, newAuthLeaf(sha256.Sum256(keys[i]), uint64(i+1), 1))
+		}
+
+		require.Equal(t, root.digestOf(), shuffledRoot.digestOf())
+	})
+
+	t.Run("proofs of the latest transaction should be verifiable", func(t *testing.T) {
+		for i, k := range keys {
+			proof := proofFor(root, k)
+			require.True(t, VerifyIndexProof(proof, k, uint64(i+1), root.digestOf()))
+			require.False(t, VerifyIndexProof(proof, k, uint64(i+2), root.digestOf()))
+			require.False(t, VerifyIndexProof(proof, k, 0, root.digestOf()))
+		}
+	})
+
+	t.Run("proofs of absence should be verifiable", func(t *testing.T) {
+		for i := 0; i < keyCount; i++ {
+			k := []byte(fmt.Sprintf("missing%d", i))
+
+			proof := proofFor(root, k)
+			require.True(t, VerifyIndexProof(proof, k, 0, root.digestOf()))
+			require.False(t, VerifyIndexProof(proof, k, 1, root.digestOf()))
+
+			if proof.Leaf != nil {
+				// the leaf of an existing key can not be used to prove the absence of that key
+				require.False(t, VerifyIndexProof(proof, keys[proof.Leaf.TxID-1], 0, root.digestOf()))
+			}
+		}
+	})
+
+	t.Run("tampered proofs should not be verifiable", func(t *testing.T) {
+		proof := proofFor(root, keys[0])
+		require.NotEmpty(t, proof.Siblings)
+
+		proof.Siblings[0][0] ^= 1
+		require.False(t, VerifyIndexProof(proof, keys[0], 1, root.digestOf()))
+
+		proof = proofFor(root, keys[0])
+		proof.Siblings = proof.Siblings[1:]
+		require.False(t, VerifyIndexProof(proof, keys[0], 1, root.digestOf()))
+
+		require.False(t, VerifyIndexProof(nil, keys[0], 1, root.digestOf()))
+	})
+
+	t.Run("the trie rebuilt from its sorted leaves should have the same root", func(t *testing.T) {
+		leaves := leavesOf(root, nil)
+		require.Len(t, leaves, keyCount)
+
+		require.Equal(t, root.digestOf(), buildFrom(leaves, 0).digestOf())
+		require.Nil(t, buildFrom(nil, 0))
+	})
+
+	t.Run("absence should be verifiable on an empty index", func(t *testing.T) {
+		proof := proofFor(nil, keys[0])
+		require.Empty(t, proof.Siblings)
+		require.Nil(t, proof.Leaf)
+		require.True(t, VerifyIndexProof(proof, keys[0], 0, [sha256.Size]byte{}))
+	})
+}
+
+func TestAuthenticatedIndex(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithAuthenticatedIndex(true)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	_, _, err = immuStore.IndexProof([]byte("key0"))
+	require.ErrorIs(t, err, ErrTxNotFound)
+
+	set := func(immuStore *ImmuStore, key string, md *KVMetadata) *TxHeader {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), md, []byte("value"))
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		return hdr
+	}
+
+	const txCount = 20
+
+	for i := 0; i < txCount; i++ {
+		hdr := set(immuStore, fmt.Sprintf("key%d", i%10), nil)
+		require.True(t, hdr.Metadata.HasIndexRoot())
+	}
+
+	nonIndexableMD := NewKVMetadata()
+	err = nonIndexableMD.AsNonIndexable(true)
+	require.NoError(t, err)
+
+	set(immuStore, "key0", nonIndexableMD)
+
+	checkProofs := func(t *testing.T, immuStore *ImmuStore) {
+		for i := 0; i < 10; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+
+			hdr, proof, err := immuStore.IndexProof(key)
+			require.NoError(t, err)
+			require.Equal(t, uint64(txCount+1), hdr.ID)
+
+			root, err := hdr.Metadata.GetIndexRoot()
+			require.NoError(t, err)
+
+			require.True(t, VerifyIndexProof(proof, key, uint64(i+11), root))
+			require.False(t, VerifyIndexProof(proof, key, uint64(i+1), root))
+			require.Equal(t, uint64(2), proof.Leaf.Revision)
+		}
+
+		hdr, proof, err := immuStore.IndexProof([]byte("missing"))
+		require.NoError(t, err)
+
+		root, err := hdr.Metadata.GetIndexRoot()
+		require.NoError(t, err)
+
+		require.True(t, VerifyIndexProof(proof, []byte("missing"), 0, root))
+	}
+
+	t.Run("proofs should be built against the last committed transaction", func(t *testing.T) {
+		checkProofs(t, immuStore)
+	})
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	t.Run("the authenticated index should be loaded when reopening the store", func(t *testing.T) {
+		immuStore, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		checkProofs(t, immuStore)
+	})
+
+	t.Run("the authenticated index should be restored when discarding precommitted transactions", func(t *testing.T) {
+		immuStore.SetExternalCommitAllowance(true)
+
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key0"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		go tx.Commit(context.Background())
+
+		err = immuStore.WaitForTx(context.Background(), txCount+2, true)
+		require.NoError(t, err)
+
+		checkProofs(t, immuStore)
+
+		n, err := immuStore.DiscardPrecommittedTxsSince(txCount + 2)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		immuStore.SetExternalCommitAllowance(false)
+
+		hdr := set(immuStore, "key1", nil)
+
+		_, proof, err := immuStore.IndexProof([]byte("key0"))
+		require.NoError(t, err)
+
+		root, err := hdr.Metadata.GetIndexRoot()
+		require.NoError(t, err)
+
+		require.True(t, VerifyIndexProof(proof, []byte("key0"), 11, root))
+	})
+
+	t.Run("key revisions should be committed into the entry metadata", func(t *testing.T) {
+		txs, hCount, err := immuStore.History([]byte("key1"), 0, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), hCount)
+
+		tx := tempTxHolder(t, immuStore)
+
+		for i, txID := range txs {
+			err = immuStore.ReadTx(txID, false, tx)
+			require.NoError(t, err)
+
+			e, err := tx.EntryOf([]byte("key1"))
+			require.NoError(t, err)
+
+			rev, err := e.Metadata().Revision()
+			require.NoError(t, err)
+			require.Equal(t, uint64(i+1), rev)
+		}
+
+		err = immuStore.ReadTx(txCount+1, false, tx)
+		require.NoError(t, err)
+
+		e, err := tx.EntryOf([]byte("key0"))
+		require.NoError(t, err)
+		require.False(t, e.Metadata().HasRevision())
+
+		md := NewKVMetadata()
+		err = md.WithRevision(1)
+		require.NoError(t, err)
+
+		otx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+		defer otx.Cancel()
+
+		err = otx.Set([]byte("key1"), md, []byte("value"))
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("replicated transactions should include the same index root", func(t *testing.T) {
+		replicaStore, err := Open(t.TempDir(), opts)
+		require.NoError(t, err)
+
+		defer immustoreClose(t, replicaStore)
+
+		txholder := tempTxHolder(t, immuStore)
+
+		for txID := uint64(1); txID <= immuStore.LastCommittedTxID(); txID++ {
+			etx, err := immuStore.ExportTx(txID, false, false, txholder)
+			require.NoError(t, err)
+
+			hdr, err := replicaStore.ReplicateTx(context.Background(), etx, false, false)
+			require.NoError(t, err)
+			require.True(t, hdr.Metadata.HasIndexRoot())
+		}
+
+		_, proof, err := replicaStore.IndexProof([]byte("key1"))
+		require.NoError(t, err)
+
+		hdr, err := replicaStore.ReadTxHeader(replicaStore.LastCommittedTxID(), false, false)
+		require.NoError(t, err)
+
+		root, err := hdr.Metadata.GetIndexRoot()
+		require.NoError(t, err)
+
+		require.True(t, VerifyIndexProof(proof, []byte("key1"), hdr.ID, root))
+	})
+
+	t.Run("replicated transactions with a different index root should be rejected", func(t *testing.T) {
+		primaryStore, err := Open(t.TempDir(), DefaultOptions())
+		require.NoError(t, err)
+
+		defer immustoreClose(t, primaryStore)
+
+		replicaStore, err := Open(t.TempDir(), opts)
+		require.NoError(t, err)
+
+		defer immustoreClose(t, replicaStore)
+
+		tx, err := primaryStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		tx.WithMetadata(NewTxMetadata().WithIndexRoot(sha256.Sum256([]byte("root"))))
+
+		err = tx.Set([]byte("key0"), nil, []byte("value"))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+
+		etx, err := primaryStore.ExportTx(1, false, false, tempTxHolder(t, primaryStore))
+		require.NoError(t, err)
+
+		_, err = replicaStore.ReplicateTx(context.Background(), etx, false, false)
+		require.ErrorIs(t, err, ErrIndexRootMismatch)
+	})
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	t.Run("proofs should not be available if the authenticated index is disabled", func(t *testing.T) {
+		immuStore, err := Open(dir, DefaultOptions())
+		require.NoError(t, err)
+
+		defer immustoreClose(t, immuStore)
+
+		_, _, err = immuStore.IndexProof([]byte("key0"))
+		require.ErrorIs(t, err, ErrAuthenticatedIndexDisabled)
+	})
+}
+
+func TestAuthenticatedIndexMaxKeys(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithAuthenticatedIndex(true).WithAuthenticatedIndexMaxKeys(3))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	set := func(key string) error {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), nil, []byte("value"))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		err = set(fmt.Sprintf("key%d", i))
+		require.NoError(t, err)
+	}
+
+	err = set("key3")
+	require.ErrorIs(t, err, ErrAuthenticatedIndexFull)
+
+	// keys already in the index can still be written
+	err = set("key0")
+	require.NoError(t, err)
+
+	t.Run("roots of committed transactions should be pruned", func(t *testing.T) {
+		immuStore.commitStateRWMutex.RLock()
+		defer immuStore.commitStateRWMutex.RUnlock()
+
+		require.Len(t, immuStore.authIndex.roots, 1)
+		require.Equal(t, uint64(3), immuStore.authIndex.root.sizeOf())
+	})
+}
+
+func TestAuthenticatedIndexCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithAuthenticatedIndex(true).
+		WithAuthenticatedIndexCheckpointThld(5)
+
+	checkpointPath := filepath.Join(dir, authIndexCheckpointFilename)
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	set := func(immuStore *ImmuStore, key string) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(key), nil, []byte("value"))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	checkProofs := func(t *testing.T, immuStore *ImmuStore, txCount int) {
+		for i := 0; i < 10; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+
+			hdr, proof, err := immuStore.IndexProof(key)
+			require.NoError(t, err)
+			require.Equal(t, uint64(txCount), hdr.ID)
+
+			root, err := hdr.Metadata.GetIndexRoot()
+			require.NoError(t, err)
+
+			require.True(t, VerifyIndexProof(proof, key, proof.Leaf.TxID, root))
+			require.Equal(t, uint64((txCount-i-1)/10+1), proof.Leaf.Revision)
+		}
+	}
+
+	for i := 0; i < 7; i++ {
+		set(immuStore, fmt.Sprintf("key%d", i%10))
+	}
+
+	t.Run("the authenticated index should be checkpointed in the background", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(checkpointPath)
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	for i := 7; i < 23; i++ {
+		set(immuStore, fmt.Sprintf("key%d", i%10))
+	}
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	t.Run("the authenticated index should be checkpointed when closing the store", func(t *testing.T) {
+		txID, root, err := readAuthIndexCheckpoint(checkpointPath)
+		require.NoError(t, err)
+		require.Equal(t, uint64(23), txID)
+		require.Len(t, leavesOf(root, nil), 10)
+	})
+
+	t.Run("only transactions after the checkpoint should be replayed", func(t *testing.T) {
+		immuStore, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		require.Equal(t, uint64(23), immuStore.authIndex.checkpointTxID)
+		checkProofs(t, immuStore, 23)
+
+		set(immuStore, "key3")
+
+		err = immuStore.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("a corrupted checkpoint should be discarded", func(t *testing.T) {
+		b, err := os.ReadFile(checkpointPath)
+		require.NoError(t, err)
+
+		b[authIndexCheckpointHeaderSize] ^= 1
+
+		err = os.WriteFile(checkpointPath, b, 0644)
+		require.NoError(t, err)
+
+		_, _, err = readAuthIndexCheckpoint(checkpointPath)
+		require.ErrorIs(t, err, errInvalidAuthIndexCheckpoint)
+
+		immuStore, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		require.Zero(t, immuStore.authIndex.checkpointTxID)
+		checkProofs(t, immuStore, 24)
+
+		err = immuStore.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("a checkpoint ahead of the committed transactions should be discarded", func(t *testing.T) {
+		err = writeAuthIndexCheckpoint(checkpointPath, 100, nil)
+		require.NoError(t, err)
+
+		immuStore, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		defer immustoreClose(t, immuStore)
+
+		require.Zero(t, immuStore.authIndex.checkpointTxID)
+		checkProofs(t, immuStore, 24)
+	})
+}
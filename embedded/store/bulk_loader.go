@@ -0,0 +1,209 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrBulkLoadInProgress = errors.New("bulk load already in progress")
+var ErrBulkLoadKeysNotSorted = fmt.Errorf("%w: keys must be provided in strictly ascending order", ErrIllegalArguments)
+
+// BulkLoader writes pre-sorted entries into the store using transactions as large as possible.
+//
+// Transactions are committed as usual, thus linear and AHT proofs are generated for each one of them,
+// but indexing is done by the loader itself, inserting many transactions at once into the index.
+// Index flushing is deferred until the loader is closed, reducing the amount of data written into the index.
+//
+// Note: loading is not atomic, entries may be partially loaded if an error occurs
+type BulkLoader struct {
+	st *ImmuStore
+
+	tx      *OngoingTx
+	lastKey []byte
+
+	firstTxID    uint64
+	lastTxID     uint64
+	lastIndexed  uint64
+	entriesCount int
+
+	closed bool
+}
+
+// NewBulkLoader starts a bulk load, only one bulk load can be in progress at a time.
+// The loader must be closed in order to commit and index the remaining entries.
+func (s *ImmuStore) NewBulkLoader() (*BulkLoader, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	if s.readOnly {
+		return nil, ErrReadOnly
+	}
+
+	if s.bulkLoading {
+		return nil, ErrBulkLoadInProgress
+	}
+
+	if s.indexRebuilding {
+		return nil, ErrIndexRebuildInProgress
+	}
+
+	// the loader takes care of indexing loaded transactions
+	s.indexer.Pause()
+
+	err := s.indexer.deferFlush()
+	if err != nil {
+		s.indexer.Resume()
+		return nil, err
+	}
+
+	s.bulkLoading = true
+
+	return &BulkLoader{st: s}, nil
+}
+
+// Add adds an entry to the current transaction, which is committed once it's full.
+// Keys must be provided in strictly ascending order.
+func (l *BulkLoader) Add(ctx context.Context, key []byte, md *KVMetadata, value []byte) error {
+	if l.closed {
+		return ErrAlreadyClosed
+	}
+
+	if l.lastKey != nil && bytes.Compare(key, l.lastKey) <= 0 {
+		return ErrBulkLoadKeysNotSorted
+	}
+
+	if l.tx == nil {
+		tx, err := l.st.NewWriteOnlyTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		l.tx = tx
+	}
+
+	err := l.tx.Set(key, md, value)
+	if err != nil {
+		return err
+	}
+
+	l.lastKey = make([]byte, len(key))
+	copy(l.lastKey, key)
+
+	l.entriesCount++
+
+	if len(l.tx.entries) < l.st.maxTxEntries {
+		return nil
+	}
+
+	return l.commit(ctx)
+}
+
+func (l *BulkLoader) commit(ctx context.Context) error {
+	tx := l.tx
+	l.tx = nil
+
+	hdr, err := tx.AsyncCommit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if l.firstTxID == 0 {
+		l.firstTxID = hdr.ID
+		l.lastIndexed = hdr.ID - 1
+	}
+	l.lastTxID = hdr.ID
+
+	if l.lastTxID-l.lastIndexed < uint64(l.st.indexer.maxBulkSize) {
+		return nil
+	}
+
+	return l.index()
+}
+
+func (l *BulkLoader) index() error {
+	err := l.st.indexer.indexUpto(l.lastTxID)
+	if err != nil {
+		return err
+	}
+
+	l.lastIndexed = l.lastTxID
+
+	return nil
+}
+
+// Close commits the remaining entries, indexes all the loaded transactions and resumes index flushing
+func (l *BulkLoader) Close(ctx context.Context) (err error) {
+	if l.closed {
+		return ErrAlreadyClosed
+	}
+
+	l.closed = true
+
+	defer func() {
+		flushErr := l.st.indexer.resumeFlush()
+		if err == nil {
+			err = flushErr
+		}
+
+		l.st.indexer.Resume()
+
+		l.st.mutex.Lock()
+		l.st.bulkLoading = false
+		l.st.mutex.Unlock()
+	}()
+
+	if l.tx != nil && len(l.tx.entries) == 0 {
+		l.tx.Cancel()
+		l.tx = nil
+	}
+
+	if l.tx != nil {
+		err = l.commit(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if l.lastTxID > l.lastIndexed {
+		return l.index()
+	}
+
+	return nil
+}
+
+// FirstTxID returns the id of the first transaction committed by the loader, zero if none was committed
+func (l *BulkLoader) FirstTxID() uint64 {
+	return l.firstTxID
+}
+
+// LastTxID returns the id of the last transaction committed by the loader, zero if none was committed
+func (l *BulkLoader) LastTxID() uint64 {
+	return l.lastTxID
+}
+
+// EntriesCount returns the number of entries added to the loader
+func (l *BulkLoader) EntriesCount() int {
+	return l.entriesCount
+}
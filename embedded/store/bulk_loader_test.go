@@ -0,0 +1,209 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkLoader(t *testing.T) {
+	opts := DefaultOptions().
+		WithMaxConcurrency(1).
+		WithMaxTxEntries(10)
+
+	opts.WithIndexOptions(opts.IndexOpts.WithMaxBulkSize(3).WithFlushThld(20))
+
+	immuStore, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	// a regular transaction before bulk loading
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key0050"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	loader, err := immuStore.NewBulkLoader()
+	require.NoError(t, err)
+
+	_, err = immuStore.NewBulkLoader()
+	require.ErrorIs(t, err, ErrBulkLoadInProgress)
+
+	const entriesCount = 95
+
+	for i := 0; i < entriesCount; i++ {
+		key := []byte(fmt.Sprintf("key%04d", i))
+
+		err = loader.Add(context.Background(), key, nil, []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		err = loader.Add(context.Background(), key, nil, []byte("value"))
+		require.ErrorIs(t, err, ErrBulkLoadKeysNotSorted)
+	}
+
+	err = loader.Add(context.Background(), []byte("key"), nil, []byte("value"))
+	require.ErrorIs(t, err, ErrBulkLoadKeysNotSorted)
+
+	err = loader.Close(context.Background())
+	require.NoError(t, err)
+
+	err = loader.Close(context.Background())
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	err = loader.Add(context.Background(), []byte("key1000"), nil, []byte("value"))
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	require.Equal(t, entriesCount, loader.EntriesCount())
+	require.Equal(t, uint64(2), loader.FirstTxID())
+	require.Equal(t, uint64(11), loader.LastTxID())
+
+	// all the loaded transactions were indexed
+	require.Equal(t, uint64(11), immuStore.IndexInfo())
+
+	for i := 0; i < entriesCount; i++ {
+		valRef, err := immuStore.Get([]byte(fmt.Sprintf("key%04d", i)))
+		require.NoError(t, err)
+		require.Equal(t, uint64(2+i/10), valRef.Tx())
+
+		val, err := valRef.Resolve()
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+	}
+
+	valRef, err := immuStore.Get([]byte("key0050"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), valRef.HC())
+
+	t.Run("loaded transactions should be verifiable", func(t *testing.T) {
+		sourceTx := tempTxHolder(t, immuStore)
+		targetTx := tempTxHolder(t, immuStore)
+
+		for sourceTxID := uint64(1); sourceTxID <= loader.LastTxID(); sourceTxID++ {
+			err := immuStore.ReadTx(sourceTxID, false, sourceTx)
+			require.NoError(t, err)
+
+			for targetTxID := sourceTxID; targetTxID <= loader.LastTxID(); targetTxID++ {
+				err := immuStore.ReadTx(targetTxID, false, targetTx)
+				require.NoError(t, err)
+
+				dproof, err := immuStore.DualProof(sourceTx.Header(), targetTx.Header())
+				require.NoError(t, err)
+
+				verifies := VerifyDualProof(dproof, sourceTxID, targetTxID, sourceTx.header.Alh(), targetTx.header.Alh())
+				require.True(t, verifies)
+			}
+		}
+	})
+
+	t.Run("regular transactions should be indexed after bulk loading", func(t *testing.T) {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte("key0000"), nil, []byte("newValue"))
+		require.NoError(t, err)
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(12), hdr.ID)
+
+		valRef, err := immuStore.Get([]byte("key0000"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(12), valRef.Tx())
+	})
+
+	t.Run("a new bulk load can be started once the previous one was closed", func(t *testing.T) {
+		loader, err := immuStore.NewBulkLoader()
+		require.NoError(t, err)
+
+		err = loader.Add(context.Background(), nil, nil, []byte("value"))
+		require.ErrorIs(t, err, ErrNullKey)
+
+		err = loader.Close(context.Background())
+		require.NoError(t, err)
+
+		require.Zero(t, loader.FirstTxID())
+		require.Zero(t, loader.LastTxID())
+	})
+}
+
+func TestBulkLoaderOnClosedStore(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	_, err = immuStore.NewBulkLoader()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
+
+func TestBulkLoaderWithConcurrentPreconditions(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithMaxConcurrency(1))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	loader, err := immuStore.NewBulkLoader()
+	require.NoError(t, err)
+
+	err = loader.Add(context.Background(), []byte("key1"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	// a transaction with preconditions is checked against an up-to-date index,
+	// without resuming the indexer while loading
+	tx, err := immuStore.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	err = tx.AddPrecondition(&PreconditionKeyMustNotExist{Key: []byte("key2")})
+	require.NoError(t, err)
+
+	_, err = tx.AsyncCommit(context.Background())
+	require.NoError(t, err)
+
+	immuStore.indexer.stateCond.L.Lock()
+	require.Equal(t, paused, immuStore.indexer.state)
+	immuStore.indexer.stateCond.L.Unlock()
+
+	err = loader.Close(context.Background())
+	require.NoError(t, err)
+
+	immuStore.indexer.stateCond.L.Lock()
+	require.Equal(t, running, immuStore.indexer.state)
+	require.Zero(t, immuStore.indexer.pauses)
+	immuStore.indexer.stateCond.L.Unlock()
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), 2)
+	require.NoError(t, err)
+
+	_, err = immuStore.Get([]byte("key1"))
+	require.NoError(t, err)
+
+	_, err = immuStore.Get([]byte("key2"))
+	require.NoError(t, err)
+}
@@ -86,6 +86,7 @@ var ErrUnexpectedError = errors.New("unexpected error")
 var ErrUnsupportedTxVersion = errors.New("unsupported tx version")
 var ErrNewerVersionOrCorruptedData = errors.New("tx created with a newer version or data is corrupted")
 var ErrTxPoolExhausted = errors.New("transaction pool exhausted")
+var ErrSavepointNotFound = errors.New("savepoint not found")
 
 var ErrInvalidPrecondition = errors.New("invalid precondition")
 var ErrInvalidPreconditionTooMany = fmt.Errorf("%w: too many preconditions", ErrInvalidPrecondition)
@@ -93,10 +94,13 @@ var ErrInvalidPreconditionNull = fmt.Errorf("%w: null", ErrInvalidPrecondition)
 var ErrInvalidPreconditionNullKey = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrNullKey)
 var ErrInvalidPreconditionMaxKeyLenExceeded = fmt.Errorf("%w: %v", ErrInvalidPrecondition, ErrMaxKeyLenExceeded)
 var ErrInvalidPreconditionInvalidTxID = fmt.Errorf("%w: invalid transaction ID", ErrInvalidPrecondition)
+var ErrInvalidPreconditionInvalidValueHash = fmt.Errorf("%w: invalid value hash", ErrInvalidPrecondition)
 
 var ErrSourceTxNewerThanTargetTx = errors.New("source tx is newer than target tx")
 
 var ErrCompactionUnsupported = errors.New("compaction is unsupported when remote storage is used")
+var ErrIndexRebuildUnsupported = errors.New("index rebuild is unsupported when remote storage is used")
+var ErrIndexRebuildInProgress = errors.New("index rebuild already in progress")
 
 var ErrMetadataUnsupported = errors.New(
 	"metadata is unsupported when in 1.1 compatibility mode, " +
@@ -106,6 +110,12 @@ var ErrMetadataUnsupported = errors.New(
 var ErrUnsupportedTxHeaderVersion = errors.New("missing tx header serialization method")
 var ErrIllegalTruncationArgument = fmt.Errorf("%w: invalid truncation info", ErrIllegalArguments)
 var ErrTxNotPresentInMetadata = errors.New("tx not present in metadata")
+var ErrIndexRootNotPresentInMetadata = errors.New("index root not present in metadata")
+var ErrTimestampAnchorNotPresentInMetadata = errors.New("timestamp anchor not present in metadata")
+var ErrAuthenticatedIndexDisabled = errors.New("authenticated index is not enabled")
+var ErrAuthenticatedIndexFull = errors.New("authenticated index reached the maximum number of keys")
+var ErrIndexRootMismatch = fmt.Errorf("%w: index root differs", ErrIllegalArguments)
+var ErrKeyRevisionMismatch = fmt.Errorf("%w: key revision differs", ErrIllegalArguments)
 
 const MaxKeyLen = 1024 // assumed to be not lower than hash size
 const MaxParallelIO = 127
@@ -130,6 +140,7 @@ const (
 )
 
 const indexDirname = "index"
+const indexRebuildDirname = "index_rebuild"
 const ahtDirname = "aht"
 
 type ImmuStore struct {
@@ -170,11 +181,15 @@ type ImmuStore struct {
 	maxActiveTransactions int
 	mvccReadSetLimit      int
 	maxWaitees            int
-	maxConcurrency        int
-	maxIOConcurrency      int
-	maxTxEntries          int
-	maxKeyLen             int
-	maxValueLen           int
+
+	authIndexCheckpointThld int
+	authIndexMaxKeys        int
+
+	maxConcurrency   int
+	maxIOConcurrency int
+	maxTxEntries     int
+	maxKeyLen        int
+	maxValueLen      int
 
 	writeTxHeaderVersion int
 
@@ -199,11 +214,16 @@ type ImmuStore struct {
 
 	indexer *indexer
 
+	authIndex *authenticatedIndex // nil when the authenticated index is disabled
+
 	closed bool
 
 	mutex sync.Mutex
 
 	compactionDisabled bool
+
+	bulkLoading     bool
+	indexRebuilding bool
 }
 
 type refVLog struct {
@@ -509,11 +529,15 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		maxActiveTransactions: opts.MaxActiveTransactions,
 		mvccReadSetLimit:      opts.MVCCReadSetLimit,
 		maxWaitees:            opts.MaxWaitees,
-		maxConcurrency:        opts.MaxConcurrency,
-		maxIOConcurrency:      opts.MaxIOConcurrency,
-		maxTxEntries:          maxTxEntries,
-		maxKeyLen:             maxKeyLen,
-		maxValueLen:           maxInt(maxValueLen, opts.MaxValueLen),
+
+		authIndexCheckpointThld: opts.AuthenticatedIndexCheckpointThld,
+		authIndexMaxKeys:        opts.AuthenticatedIndexMaxKeys,
+
+		maxConcurrency:   opts.MaxConcurrency,
+		maxIOConcurrency: opts.MaxIOConcurrency,
+		maxTxEntries:     maxTxEntries,
+		maxKeyLen:        maxKeyLen,
+		maxValueLen:      maxInt(maxValueLen, opts.MaxValueLen),
 
 		writeTxHeaderVersion: opts.WriteTxHeaderVersion,
 
@@ -553,6 +577,14 @@ func OpenWith(path string, vLogs []appendable.Appendable, txLog, cLog appendable
 		}
 	}
 
+	if opts.AuthenticatedIndex {
+		err = store.loadAuthenticatedIndex()
+		if err != nil {
+			store.Close()
+			return nil, fmt.Errorf("could not load authenticated index: %w", err)
+		}
+	}
+
 	err = store.inmemPrecommitWHub.DoneUpto(precommittedTxID)
 	if err != nil {
 		return nil, err
@@ -933,6 +965,16 @@ func (s *ImmuStore) WaitForIndexingUpto(ctx context.Context, txID uint64) error
 	return s.indexer.WaitForIndexingUpto(ctx, txID)
 }
 
+// waitForIndexingUpto waits for the indexer to index the transactions up to the specified one,
+// while indexing is paused (e.g. during bulk loading) they are indexed right away instead
+func (s *ImmuStore) waitForIndexingUpto(ctx context.Context, txID uint64) error {
+	if s.indexer.isPaused() {
+		return s.indexer.indexUptoCommitted(ctx, txID)
+	}
+
+	return s.WaitForIndexingUpto(ctx, txID)
+}
+
 func (s *ImmuStore) CompactIndex() error {
 	if s.compactionDisabled {
 		return ErrCompactionUnsupported
@@ -944,6 +986,49 @@ func (s *ImmuStore) FlushIndex(cleanupPercentage float32, synced bool) error {
 	return s.indexer.FlushIndex(cleanupPercentage, synced)
 }
 
+// RebuildIndex builds a fresh index from the transaction log without blocking reads or writes,
+// the current index is replaced once the new one caught up with committed transactions.
+// The progress function, if provided, is called with the last indexed and the last committed transaction ids.
+func (s *ImmuStore) RebuildIndex(ctx context.Context, progress func(indexedTxID, committedTxID uint64)) error {
+	if s.compactionDisabled {
+		return ErrIndexRebuildUnsupported
+	}
+
+	s.mutex.Lock()
+
+	if s.closed {
+		s.mutex.Unlock()
+		return ErrAlreadyClosed
+	}
+
+	if s.readOnly {
+		s.mutex.Unlock()
+		return ErrReadOnly
+	}
+
+	if s.bulkLoading {
+		s.mutex.Unlock()
+		return ErrBulkLoadInProgress
+	}
+
+	if s.indexRebuilding {
+		s.mutex.Unlock()
+		return ErrIndexRebuildInProgress
+	}
+
+	s.indexRebuilding = true
+
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		s.indexRebuilding = false
+		s.mutex.Unlock()
+	}()
+
+	return s.indexer.RebuildIndex(ctx, progress)
+}
+
 func maxTxSize(maxTxEntries, maxKeyLen, maxTxMetadataLen, maxKVMetadataLen int) int {
 	return txIDSize /*txID*/ +
 		tsSize /*ts*/ +
@@ -1284,7 +1369,7 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 			waitForIndexingUpto = currPrecomittedTxID
 		}
 
-		err = s.WaitForIndexingUpto(ctx, waitForIndexingUpto)
+		err = s.waitForIndexingUpto(ctx, waitForIndexingUpto)
 		if err != nil {
 			return nil, err
 		}
@@ -1299,7 +1384,7 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 		tx.entries[i].vOff = r.offsets[i]
 	}
 
-	err = s.performPrecommit(tx, ts, blTxID)
+	err = s.performPrecommit(tx, ts, blTxID, hdr != nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1311,6 +1396,42 @@ func (s *ImmuStore) precommit(ctx context.Context, otx *OngoingTx, hdr *TxHeader
 	return tx.Header(), err
 }
 
+// assignRevisions commits the revision of every indexed entry into its metadata,
+// replicated transactions must already hold the very same revisions unless they were
+// committed without an authenticated index
+func (s *ImmuStore) assignRevisions(tx *Tx, revisions []uint64, replicated bool) error {
+	if replicated && (tx.header.Metadata == nil || !tx.header.Metadata.HasIndexRoot()) {
+		return nil
+	}
+
+	updated := false
+
+	for i, e := range tx.Entries() {
+		var rev uint64
+
+		if e.md != nil && e.md.HasRevision() {
+			rev, _ = e.md.Revision()
+		}
+
+		if rev == revisions[i] {
+			continue
+		}
+
+		if replicated {
+			return ErrKeyRevisionMismatch
+		}
+
+		e.md = e.md.withRevision(revisions[i])
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	return tx.BuildHashTree()
+}
+
 func (s *ImmuStore) LastCommittedTxID() uint64 {
 	s.commitStateRWMutex.RLock()
 	defer s.commitStateRWMutex.RUnlock()
@@ -1325,7 +1446,9 @@ func (s *ImmuStore) lastPrecommittedTxID() uint64 {
 	return s.inmemPrecommittedTxID
 }
 
-func (s *ImmuStore) performPrecommit(tx *Tx, ts int64, blTxID uint64) error {
+// performPrecommit appends the transaction into the transaction log,
+// replicated transactions must be appended without changes into their headers
+func (s *ImmuStore) performPrecommit(tx *Tx, ts int64, blTxID uint64, replicated bool) error {
 	s.commitStateRWMutex.Lock()
 	defer s.commitStateRWMutex.Unlock()
 
@@ -1359,6 +1482,48 @@ func (s *ImmuStore) performPrecommit(tx *Tx, ts int64, blTxID uint64) error {
 
 	tx.header.PrevAlh = s.inmemPrecommittedAlh
 
+	var indexRoot *authNode
+
+	if s.authIndex != nil {
+		var revisions []uint64
+
+		indexRoot, revisions = s.authIndex.rootAfter(tx)
+
+		if indexRoot.sizeOf() > uint64(s.authIndexMaxKeys) {
+			return ErrAuthenticatedIndexFull
+		}
+
+		if replicated && tx.header.Metadata != nil && tx.header.Metadata.HasIndexRoot() {
+			root, err := tx.header.Metadata.GetIndexRoot()
+			if err != nil {
+				return err
+			}
+
+			if root != indexRoot.digestOf() {
+				return ErrIndexRootMismatch
+			}
+		}
+
+		err = s.assignRevisions(tx, revisions, replicated)
+		if err != nil {
+			return err
+		}
+
+		if !replicated && tx.header.Version > 0 {
+			// metadata may be shared with the ongoing transaction
+			md := NewTxMetadata()
+
+			if tx.header.Metadata != nil {
+				err = md.ReadFrom(tx.header.Metadata.Bytes())
+				if err != nil {
+					return err
+				}
+			}
+
+			tx.header.Metadata = md.WithIndexRoot(indexRoot.digestOf())
+		}
+	}
+
 	txSize := 0
 
 	// tx serialization into pre-allocated buffer
@@ -1464,6 +1629,10 @@ func (s *ImmuStore) performPrecommit(tx *Tx, ts int64, blTxID uint64) error {
 	s.inmemPrecommittedAlh = alh
 	s.precommittedTxLogSize += int64(txSize)
 
+	if s.authIndex != nil {
+		s.authIndex.advance(s.inmemPrecommittedTxID, indexRoot, s.committedTxID)
+	}
+
 	s.inmemPrecommitWHub.DoneUpto(s.inmemPrecommittedTxID)
 
 	err = s.cLogBuf.put(s.inmemPrecommittedTxID, alh, txOff, txSize)
@@ -1520,6 +1689,13 @@ func (s *ImmuStore) DiscardPrecommittedTxsSince(txID uint64) (int, error) {
 
 	txsToDiscard := int(s.inmemPrecommittedTxID + 1 - txID)
 
+	if s.authIndex != nil {
+		err := s.authIndex.discardSince(txID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	err := s.aht.ResetSize(s.aht.Size() - uint64(txsToDiscard))
 	if err != nil {
 		return 0, err
@@ -1541,6 +1717,10 @@ func (s *ImmuStore) DiscardPrecommittedTxsSince(txID uint64) (int, error) {
 	if err != nil || tx != txID-1 {
 		s.inmemPrecommittedTxID = s.committedTxID
 		s.inmemPrecommittedAlh = s.committedAlh
+
+		if s.authIndex != nil {
+			s.authIndex.discardSince(s.committedTxID + 1)
+		}
 		s.logger.Warningf("precommitted transactions has been discarded due to unexpected error in cLogBuf")
 		return 0, err
 	}
@@ -1652,6 +1832,8 @@ func (s *ImmuStore) mayCommit() error {
 
 	s.commitWHub.DoneUpto(commitUpToTxID)
 
+	s.mayCheckpointAuthenticatedIndex()
+
 	return nil
 }
 
@@ -1689,7 +1871,27 @@ type KeyIndex interface {
 }
 
 type unsafeIndex struct {
-	st *ImmuStore
+	st  *ImmuStore
+	ctx context.Context
+
+	// index must include all the precommitted transactions before being read
+	upToTxID uint64
+	upToDate bool
+}
+
+func (index *unsafeIndex) waitForIndexing() error {
+	if index.upToDate {
+		return nil
+	}
+
+	err := index.st.waitForIndexingUpto(index.ctx, index.upToTxID)
+	if err != nil {
+		return err
+	}
+
+	index.upToDate = true
+
+	return nil
 }
 
 func (index *unsafeIndex) Get(key []byte) (ValueRef, error) {
@@ -1697,14 +1899,24 @@ func (index *unsafeIndex) Get(key []byte) (ValueRef, error) {
 }
 
 func (index *unsafeIndex) GetWithFilters(key []byte, filters ...FilterFn) (ValueRef, error) {
+	err := index.waitForIndexing()
+	if err != nil {
+		return nil, err
+	}
+
 	return index.st.GetWithFilters(key, filters...)
 }
 
 func (index *unsafeIndex) GetWithPrefix(prefix []byte, neq []byte) (key []byte, valRef ValueRef, err error) {
-	return index.st.GetWithPrefixAndFilters(prefix, neq, IgnoreDeleted, IgnoreExpired)
+	return index.GetWithPrefixAndFilters(prefix, neq, IgnoreDeleted, IgnoreExpired)
 }
 
 func (index *unsafeIndex) GetWithPrefixAndFilters(prefix []byte, neq []byte, filters ...FilterFn) (key []byte, valRef ValueRef, err error) {
+	err = index.waitForIndexing()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return index.st.GetWithPrefixAndFilters(prefix, neq, filters...)
 }
 
@@ -1731,7 +1943,13 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 
 	lastPreCommittedTxID := s.lastPrecommittedTxID()
 
-	otx.entries, otx.preconditions, err = callback(lastPreCommittedTxID+1, &unsafeIndex{st: s})
+	// reads made by the callback are done on an index including all the precommitted transactions,
+	// so entries can be safely computed from the latest values (e.g. counters)
+	otx.entries, otx.preconditions, err = callback(lastPreCommittedTxID+1, &unsafeIndex{
+		st:       s,
+		ctx:      ctx,
+		upToTxID: lastPreCommittedTxID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1751,10 +1969,8 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 	}
 
 	if otx.hasPreconditions() {
-		s.indexer.Resume()
-
 		// Preconditions must be executed with up-to-date tree
-		err = s.WaitForIndexingUpto(ctx, lastPreCommittedTxID)
+		err = s.waitForIndexingUpto(ctx, lastPreCommittedTxID)
 		if err != nil {
 			return nil, err
 		}
@@ -1763,8 +1979,6 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		if err != nil {
 			return nil, err
 		}
-
-		s.indexer.Pause()
 	}
 
 	tx, err := s.fetchAllocTx()
@@ -1803,7 +2017,7 @@ func (s *ImmuStore) preCommitWith(ctx context.Context, callback func(txID uint64
 		tx.entries[i].vOff = r.offsets[i]
 	}
 
-	err = s.performPrecommit(tx, s.timeFunc().Unix(), s.aht.Size())
+	err = s.performPrecommit(tx, s.timeFunc().Unix(), s.aht.Size(), false)
 	if err != nil {
 		return nil, err
 	}
@@ -1898,6 +2112,52 @@ func (s *ImmuStore) DualProof(sourceTxHdr, targetTxHdr *TxHeader) (proof *DualPr
 	return
 }
 
+// TxLinkProof proves the header of a transaction is linked into a more recent (target) transaction
+type TxLinkProof struct {
+	TxHeader       *TxHeader
+	InclusionProof [][sha256.Size]byte
+	LinearProof    *LinearProof
+}
+
+// TxLinkProof generates the proof linking the header of a transaction into the target one.
+// Transactions preceding the last one included in the binary linking tree of the target transaction
+// are proven with an inclusion proof against its root, otherwise with a linear proof.
+// Once the target transaction is verified, a single proof per transaction is enough to verify
+// multiple transactions without the need of a dual proof for each one of them.
+func (s *ImmuStore) TxLinkProof(txHdr, targetTxHdr *TxHeader) (*TxLinkProof, error) {
+	if txHdr == nil || targetTxHdr == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if txHdr.ID > targetTxHdr.ID {
+		return nil, ErrSourceTxNewerThanTargetTx
+	}
+
+	proof := &TxLinkProof{
+		TxHeader: txHdr,
+	}
+
+	if txHdr.ID < targetTxHdr.BlTxID {
+		binInclusionProof, err := s.aht.InclusionProof(txHdr.ID, targetTxHdr.BlTxID) // must match targetTx.BlRoot
+		if err != nil {
+			return nil, err
+		}
+
+		proof.InclusionProof = binInclusionProof
+
+		return proof, nil
+	}
+
+	lproof, err := s.LinearProof(txHdr.ID, targetTxHdr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.LinearProof = lproof
+
+	return proof, nil
+}
+
 type LinearProof struct {
 	SourceTxID uint64
 	TargetTxID uint64
@@ -2830,6 +3090,8 @@ func (s *ImmuStore) sync() error {
 
 	s.commitWHub.DoneUpto(commitUpToTxID)
 
+	s.mayCheckpointAuthenticatedIndex()
+
 	return nil
 }
 
@@ -2852,6 +3114,9 @@ func (s *ImmuStore) Close() error {
 
 	merr := multierr.NewMultiErr()
 
+	err := s.checkpointAuthenticatedIndex()
+	merr.Append(err)
+
 	for i := range s.vLogs {
 		vLog := s.fetchVLog(i + 1)
 
@@ -2861,7 +3126,7 @@ func (s *ImmuStore) Close() error {
 		s.releaseVLog(i + 1)
 	}
 
-	err := s.inmemPrecommitWHub.Close()
+	err = s.inmemPrecommitWHub.Close()
 	merr.Append(err)
 
 	err = s.durablePrecommitWHub.Close()
@@ -1626,6 +1626,50 @@ func TestImmudbStoreCommitWith(t *testing.T) {
 	require.Equal(t, []byte("value"), val)
 }
 
+func TestImmudbStoreCommitWithReadModifyWrite(t *testing.T) {
+	immuStore, err := Open(t.TempDir(), DefaultOptions().WithMaxConcurrency(1))
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	increment := func(txID uint64, index KeyIndex) ([]*EntrySpec, []Precondition, error) {
+		var counter uint64
+
+		valRef, err := index.Get([]byte("counter"))
+		if err == nil {
+			val, err := valRef.Resolve()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			counter = binary.BigEndian.Uint64(val)
+		} else if !errors.Is(err, ErrKeyNotFound) {
+			return nil, nil, err
+		}
+
+		val := make([]byte, 8)
+		binary.BigEndian.PutUint64(val, counter+1)
+
+		return []*EntrySpec{{Key: []byte("counter"), Value: val}}, nil, nil
+	}
+
+	// no waiting for indexing between commits, the callback must still read the latest value
+	for i := 0; i < 10; i++ {
+		_, err = immuStore.CommitWith(context.Background(), increment, false)
+		require.NoError(t, err)
+	}
+
+	err = immuStore.WaitForIndexingUpto(context.Background(), 10)
+	require.NoError(t, err)
+
+	valRef, err := immuStore.Get([]byte("counter"))
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), binary.BigEndian.Uint64(val))
+}
+
 func TestImmudbStoreHistoricalValues(t *testing.T) {
 	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
 	opts.WithIndexOptions(opts.IndexOpts.WithFlushThld(10))
@@ -2684,6 +2728,44 @@ func TestImmudbStoreCommitWithPreconditions(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("value must equal constraint should pass only when the current value has the specified hash", func(t *testing.T) {
+		otx, err := immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key5"), nil, []byte("value5"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionValueMustEqual{Key: []byte("key2"), ValueHash: sha256.Sum256([]byte("value3"))})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key5"), nil, []byte("value5"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionValueMustEqual{Key: []byte("key1"), ValueHash: sha256.Sum256([]byte("value1"))})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.ErrorIs(t, err, ErrPreconditionFailed)
+
+		otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
+		require.NoError(t, err)
+
+		err = otx.Set([]byte("key5"), nil, []byte("value5"))
+		require.NoError(t, err)
+
+		err = otx.AddPrecondition(&PreconditionValueMustEqual{Key: []byte("key2"), ValueHash: sha256.Sum256([]byte("value2"))})
+		require.NoError(t, err)
+
+		_, err = otx.Commit(context.Background())
+		require.NoError(t, err)
+	})
+
 	// insert an expirable entry
 	otx, err = immuStore.NewTx(context.Background(), DefaultTxOptions())
 	require.NoError(t, err)
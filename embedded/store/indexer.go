@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"sync"
 	"time"
@@ -47,17 +48,20 @@ type indexer struct {
 
 	index *tbtree.TBtree
 
-	ctx        context.Context
-	cancelFunc context.CancelFunc
-	wHub       *watchers.WatchersHub
+	ctx          context.Context
+	cancelFunc   context.CancelFunc
+	indexingDone chan struct{} // closed once the indexing goroutine exits
+	wHub         *watchers.WatchersHub
 
 	state     int
+	pauses    int // indexing is paused until every Pause call is followed by a Resume call
 	stateCond *sync.Cond
 
 	closed bool
 
 	compactionMutex sync.Mutex
 	mutex           sync.Mutex
+	indexingMutex   sync.Mutex // transactions may be indexed either by the indexer or during bulk loading
 
 	metricsLastCommittedTrx prometheus.Gauge
 	metricsLastIndexedTrx   prometheus.Gauge
@@ -110,7 +114,9 @@ func newIndexer(path string, store *ImmuStore, opts *Options) (*indexer, error)
 		WithCommitLogMaxOpenedFiles(opts.IndexOpts.CommitLogMaxOpenedFiles).
 		WithRenewSnapRootAfter(opts.IndexOpts.RenewSnapRootAfter).
 		WithCompactionThld(opts.IndexOpts.CompactionThld).
-		WithDelayDuringCompaction(opts.IndexOpts.DelayDuringCompaction)
+		WithDelayDuringCompaction(opts.IndexOpts.DelayDuringCompaction).
+		WithBloomFilterBitsPerKey(opts.IndexOpts.BloomFilterBitsPerKey).
+		WithBloomFilterPrefixLengths(opts.IndexOpts.BloomFilterPrefixLengths...)
 
 	if opts.appFactory != nil {
 		indexOpts.WithAppFactory(func(rootPath, subPath string, appOpts *multiapp.Options) (appendable.Appendable, error) {
@@ -309,21 +315,217 @@ func (idx *indexer) FlushIndex(cleanupPercentage float32, synced bool) (err erro
 	return nil
 }
 
+// RebuildIndex builds a fresh index from the transaction log into a side directory while the current index
+// keeps serving reads and indexing new transactions. Once the new index catches up with committed transactions,
+// it replaces the current one. Progress is reported after every bulk of indexed transactions.
+//
+// Note: if the process is interrupted while swapping directories, the index is rebuilt from scratch on next opening
+func (idx *indexer) RebuildIndex(ctx context.Context, progress func(indexedTxID, committedTxID uint64)) (err error) {
+	idx.compactionMutex.Lock()
+	defer idx.compactionMutex.Unlock()
+
+	idx.store.logger.Infof("Rebuilding index '%s'...", idx.store.path)
+
+	defer func() {
+		if err == nil {
+			idx.store.logger.Infof("Index '%s' sucessfully rebuilt", idx.store.path)
+		} else {
+			idx.store.logger.Warningf("%v: while rebuilding index '%s'", err, idx.store.path)
+		}
+	}()
+
+	idx.mutex.Lock()
+	if idx.closed {
+		idx.mutex.Unlock()
+		return ErrAlreadyClosed
+	}
+	opts := idx.index.GetOptions()
+	idx.mutex.Unlock()
+
+	rebuildPath := filepath.Join(idx.store.path, indexRebuildDirname)
+
+	// leftovers from an interrupted rebuild are discarded
+	err = os.RemoveAll(rebuildPath)
+	if err != nil {
+		return err
+	}
+
+	index, err := tbtree.Open(rebuildPath, opts)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if index != nil {
+			index.Close()
+			os.RemoveAll(rebuildPath)
+		}
+	}()
+
+	// the new index is flushed only once completed
+	err = index.DeferFlush()
+	if err != nil {
+		return err
+	}
+
+	// a dedicated holder is used so as not to consume concurrency slots while rebuilding
+	tx := NewTx(idx.store.maxTxEntries, idx.store.maxKeyLen)
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		committedTxID := idx.store.LastCommittedTxID()
+		if index.Ts() >= committedTxID {
+			break
+		}
+
+		txID := index.Ts() + uint64(idx.maxBulkSize)
+		if txID > committedTxID {
+			txID = committedTxID
+		}
+
+		err = idx.indexInto(index, tx, txID)
+		if err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(txID, committedTxID)
+		}
+	}
+
+	// the new index is closed while replacing the current one
+	newIndex := index
+	index = nil
+
+	txID, err := idx.replaceIndex(newIndex, rebuildPath, tx, opts)
+	if err != nil {
+		return err
+	}
+
+	if progress != nil {
+		progress(txID, txID)
+	}
+
+	return nil
+}
+
+// replaceIndex indexes the remaining transactions into the new index and swaps it in place of the current one
+func (idx *indexer) replaceIndex(index *tbtree.TBtree, rebuildPath string, tx *Tx, opts *tbtree.Options) (txID uint64, err error) {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	defer func() {
+		if err != nil {
+			os.RemoveAll(rebuildPath)
+		}
+	}()
+
+	if idx.closed {
+		index.Close()
+		return 0, ErrAlreadyClosed
+	}
+
+	// the indexing goroutine is terminated before swapping and a new one is started on the swapped index
+	idx.stop()
+	defer idx.resume()
+
+	// no more transactions are indexed into the current index while swapping
+	idx.indexingMutex.Lock()
+	defer idx.indexingMutex.Unlock()
+
+	txID = idx.store.LastCommittedTxID()
+	if index.Ts() < txID {
+		err = idx.indexInto(index, tx, txID)
+		if err != nil {
+			index.Close()
+			return 0, err
+		}
+	}
+
+	err = index.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	err = idx.index.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	err = swapDirs(idx.path, rebuildPath)
+
+	// the index is reopened even if swapping failed
+	reopenedIndex, openErr := tbtree.Open(idx.path, opts)
+	if openErr != nil {
+		return 0, openErr
+	}
+
+	idx.index = reopenedIndex
+
+	if err != nil {
+		return 0, err
+	}
+
+	idx.metricsLastIndexedTrx.Set(float64(txID))
+
+	if idx.wHub != nil {
+		idx.wHub.DoneUpto(txID)
+	}
+
+	return txID, nil
+}
+
+// swapDirs replaces the content of the destination directory with the one of the source directory
+func swapDirs(dstPath, srcPath string) error {
+	discardedPath := dstPath + "_discarded"
+
+	err := os.RemoveAll(discardedPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(dstPath, discardedPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(srcPath, dstPath)
+	if err != nil {
+		// restore original directory
+		os.Rename(discardedPath, dstPath)
+		return err
+	}
+
+	return os.RemoveAll(discardedPath)
+}
+
 func (idx *indexer) stop() {
 	idx.stateCond.L.Lock()
 	idx.state = stopped
 	idx.cancelFunc()
+	done := idx.indexingDone
 	idx.stateCond.L.Unlock()
 	idx.stateCond.Signal()
 
+	// wait for the indexing goroutine to exit
+	<-done
+
 	idx.store.notify(Info, true, "Indexing gracefully stopped at '%s'", idx.store.path)
 }
 
 func (idx *indexer) resume() {
 	idx.stateCond.L.Lock()
-	idx.state = running
+	if idx.pauses > 0 {
+		idx.state = paused
+	} else {
+		idx.state = running
+	}
 	idx.ctx, idx.cancelFunc = context.WithCancel(context.Background())
-	go idx.doIndexing()
+	idx.indexingDone = make(chan struct{})
+	go idx.doIndexing(idx.ctx, idx.indexingDone)
 	idx.stateCond.L.Unlock()
 
 	idx.store.notify(Info, true, "Indexing in progress at '%s'", idx.store.path)
@@ -359,18 +561,35 @@ func (idx *indexer) restartIndex() error {
 
 func (idx *indexer) Resume() {
 	idx.stateCond.L.Lock()
-	idx.state = running
+	if idx.pauses > 0 {
+		idx.pauses--
+	}
+	if idx.pauses == 0 && idx.state == paused {
+		idx.state = running
+	}
 	idx.stateCond.L.Unlock()
 	idx.stateCond.Signal()
 }
 
+func (idx *indexer) isPaused() bool {
+	idx.stateCond.L.Lock()
+	defer idx.stateCond.L.Unlock()
+
+	return idx.pauses > 0
+}
+
 func (idx *indexer) Pause() {
 	idx.stateCond.L.Lock()
-	idx.state = paused
+	idx.pauses++
+	if idx.state == running {
+		idx.state = paused
+	}
 	idx.stateCond.L.Unlock()
 }
 
-func (idx *indexer) doIndexing() {
+func (idx *indexer) doIndexing(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
 	committedTxID := idx.store.LastCommittedTxID()
 	idx.metricsLastCommittedTrx.Set(float64(committedTxID))
 
@@ -382,13 +601,15 @@ func (idx *indexer) doIndexing() {
 			idx.wHub.DoneUpto(lastIndexedTx)
 		}
 
-		err := idx.store.commitWHub.WaitFor(idx.ctx, lastIndexedTx+1)
-		if idx.ctx.Err() != nil || errors.Is(err, watchers.ErrAlreadyClosed) {
+		err := idx.store.commitWHub.WaitFor(ctx, lastIndexedTx+1)
+		if ctx.Err() != nil || errors.Is(err, watchers.ErrAlreadyClosed) {
 			return
 		}
 		if err != nil {
 			idx.store.logger.Errorf("Indexing failed at '%s' due to error: %v", idx.store.path, err)
-			time.Sleep(60 * time.Second)
+			if !sleepCtx(ctx, 60*time.Second) {
+				return
+			}
 		}
 
 		committedTxID := idx.store.LastCommittedTxID()
@@ -410,17 +631,39 @@ func (idx *indexer) doIndexing() {
 		}
 		idx.stateCond.L.Unlock()
 
+		idx.indexingMutex.Lock()
+		if idx.index.Ts() != lastIndexedTx {
+			// transactions were already indexed during bulk loading
+			idx.indexingMutex.Unlock()
+			continue
+		}
 		err = idx.indexSince(lastIndexedTx + 1)
+		idx.indexingMutex.Unlock()
 		if err == ErrAlreadyClosed || err == tbtree.ErrAlreadyClosed {
 			return
 		}
 		if err != nil {
 			idx.store.logger.Errorf("Indexing failed at '%s' due to error: %v", idx.store.path, err)
-			time.Sleep(60 * time.Second)
+			if !sleepCtx(ctx, 60*time.Second) {
+				return
+			}
 		}
 	}
 }
 
+// sleepCtx waits for the given duration, it returns false if the context is done before
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
 func (idx *indexer) indexSince(txID uint64) error {
 	ctx, cancel := context.WithTimeout(context.Background(), idx.bulkPreparationTimeout)
 	defer cancel()
@@ -442,48 +685,13 @@ func (idx *indexer) indexSince(txID uint64) error {
 			txmd = idx.tx.header.Metadata.Bytes()
 		}
 
-		txmdLen := len(txmd)
-
 		for _, e := range txEntries {
 			if e.md != nil && e.md.NonIndexable() {
 				continue
 			}
 
-			// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmd
-			var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
-			o := 0
-
-			binary.BigEndian.PutUint32(b[o:], uint32(e.vLen))
-			o += lszSize
-
-			binary.BigEndian.PutUint64(b[o:], uint64(e.vOff))
-			o += offsetSize
-
-			copy(b[o:], e.hVal[:])
-			o += sha256.Size
-
-			binary.BigEndian.PutUint16(b[o:], uint16(txmdLen))
-			o += sszSize
-
-			copy(b[o:], txmd)
-			o += txmdLen
-
-			var kvmd []byte
-
-			if e.md != nil {
-				kvmd = e.md.Bytes()
-			}
-
-			kvmdLen := len(kvmd)
-
-			binary.BigEndian.PutUint16(b[o:], uint16(kvmdLen))
-			o += sszSize
-
-			copy(b[o:], kvmd)
-			o += kvmdLen
-
 			idx._kvs[indexableEntries].K = e.key()
-			idx._kvs[indexableEntries].V = b[:o]
+			idx._kvs[indexableEntries].V = indexedValueOf(e, txmd)
 			idx._kvs[indexableEntries].T = txID + uint64(i)
 
 			indexableEntries++
@@ -521,3 +729,125 @@ func (idx *indexer) indexSince(txID uint64) error {
 
 	return nil
 }
+
+// indexUpto indexes all the transactions up to the specified one with a single insertion into the index.
+// Unlike the indexer, it doesn't wait for transactions to be committed, they are expected to be already committed.
+func (idx *indexer) indexUpto(txID uint64) error {
+	idx.indexingMutex.Lock()
+	defer idx.indexingMutex.Unlock()
+
+	if idx.index.Ts() >= txID {
+		return nil
+	}
+
+	err := idx.indexInto(idx.index, idx.tx, txID)
+	if err != nil {
+		return err
+	}
+
+	idx.metricsLastIndexedTrx.Set(float64(txID))
+
+	if idx.wHub != nil {
+		idx.wHub.DoneUpto(txID)
+	}
+
+	return nil
+}
+
+// indexUptoCommitted waits for the transactions up to the specified one to be committed and indexes them,
+// it doesn't rely on the indexer as indexing may be paused (e.g. during bulk loading)
+func (idx *indexer) indexUptoCommitted(ctx context.Context, txID uint64) error {
+	err := idx.store.commitWHub.WaitFor(ctx, txID)
+	if errors.Is(err, watchers.ErrAlreadyClosed) {
+		return ErrAlreadyClosed
+	}
+	if err != nil {
+		return err
+	}
+
+	return idx.indexUpto(txID)
+}
+
+// indexInto inserts into the given index all the transactions following its current timestamp up to the specified one
+func (idx *indexer) indexInto(index *tbtree.TBtree, tx *Tx, txID uint64) error {
+	var kvts []*tbtree.KVT
+
+	for id := index.Ts() + 1; id <= txID; id++ {
+		err := idx.store.readTx(id, false, false, tx)
+		if err != nil {
+			return err
+		}
+
+		var txmd []byte
+
+		if tx.header.Metadata != nil {
+			txmd = tx.header.Metadata.Bytes()
+		}
+
+		for _, e := range tx.Entries() {
+			if e.md != nil && e.md.NonIndexable() {
+				continue
+			}
+
+			kvts = append(kvts, &tbtree.KVT{K: e.Key(), V: indexedValueOf(e, txmd), T: id})
+		}
+	}
+
+	if len(kvts) == 0 {
+		return index.IncreaseTs(txID)
+	}
+
+	return index.BulkInsert(kvts)
+}
+
+func (idx *indexer) deferFlush() error {
+	err := idx.index.DeferFlush()
+	if err == tbtree.ErrAlreadyClosed {
+		return ErrAlreadyClosed
+	}
+	return err
+}
+
+func (idx *indexer) resumeFlush() error {
+	err := idx.index.ResumeFlush()
+	if err == tbtree.ErrAlreadyClosed {
+		return ErrAlreadyClosed
+	}
+	return err
+}
+
+// indexedValueOf returns the value stored in the index for the given entry
+func indexedValueOf(e *TxEntry, txmd []byte) []byte {
+	// vLen + vOff + vHash + txmdLen + txmd + kvmdLen + kvmd
+	var b [lszSize + offsetSize + sha256.Size + sszSize + maxTxMetadataLen + sszSize + maxKVMetadataLen]byte
+	o := 0
+
+	binary.BigEndian.PutUint32(b[o:], uint32(e.vLen))
+	o += lszSize
+
+	binary.BigEndian.PutUint64(b[o:], uint64(e.vOff))
+	o += offsetSize
+
+	copy(b[o:], e.hVal[:])
+	o += sha256.Size
+
+	binary.BigEndian.PutUint16(b[o:], uint16(len(txmd)))
+	o += sszSize
+
+	copy(b[o:], txmd)
+	o += len(txmd)
+
+	var kvmd []byte
+
+	if e.md != nil {
+		kvmd = e.md.Bytes()
+	}
+
+	binary.BigEndian.PutUint16(b[o:], uint16(len(kvmd)))
+	o += sszSize
+
+	copy(b[o:], kvmd)
+	o += len(kvmd)
+
+	return b[:o]
+}
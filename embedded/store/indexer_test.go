@@ -18,6 +18,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -200,3 +201,226 @@ func TestClosedIndexer(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrAlreadyClosed)
 }
+
+func TestRebuildIndex(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().WithMaxConcurrency(1)
+	opts.WithIndexOptions(opts.IndexOpts.WithMaxBulkSize(5))
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	set := func(key, value string) error {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		if err != nil {
+			return err
+		}
+
+		err = tx.Set([]byte(key), nil, []byte(value))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Commit(context.Background())
+		return err
+	}
+
+	const txCount = 30
+
+	for i := 0; i < txCount; i++ {
+		err = set(fmt.Sprintf("key%d", i%10), fmt.Sprintf("value%d", i))
+		require.NoError(t, err)
+	}
+
+	t.Run("rebuilding should fail if the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := immuStore.RebuildIndex(ctx, nil)
+		require.ErrorIs(t, err, context.Canceled)
+
+		require.NoDirExists(t, filepath.Join(dir, indexRebuildDirname))
+
+		valRef, err := immuStore.Get([]byte("key0"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), valRef.HC())
+	})
+
+	t.Run("rebuilding should not be possible while bulk loading", func(t *testing.T) {
+		loader, err := immuStore.NewBulkLoader()
+		require.NoError(t, err)
+
+		err = immuStore.RebuildIndex(context.Background(), nil)
+		require.ErrorIs(t, err, ErrBulkLoadInProgress)
+
+		err = loader.Close(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("index should be rebuilt while writing", func(t *testing.T) {
+		var lastIndexedTxID uint64
+		var progressCount int
+
+		writesDone := make(chan error)
+
+		go func() {
+			for i := txCount; i < 2*txCount; i++ {
+				err := set(fmt.Sprintf("key%d", i%10), fmt.Sprintf("value%d", i))
+				if err != nil {
+					writesDone <- err
+					return
+				}
+			}
+
+			writesDone <- nil
+		}()
+
+		immuStore.indexer.stateCond.L.Lock()
+		indexingDone := immuStore.indexer.indexingDone
+		immuStore.indexer.stateCond.L.Unlock()
+
+		err := immuStore.RebuildIndex(context.Background(), func(indexedTxID, committedTxID uint64) {
+			require.GreaterOrEqual(t, indexedTxID, lastIndexedTxID)
+			require.LessOrEqual(t, indexedTxID, committedTxID)
+
+			lastIndexedTxID = indexedTxID
+			progressCount++
+		})
+		require.NoError(t, err)
+		require.Greater(t, progressCount, 1)
+
+		// the indexing goroutine of the replaced index must have exited
+		select {
+		case <-indexingDone:
+		default:
+			require.Fail(t, "indexing goroutine still running after swapping the index")
+		}
+
+		err = <-writesDone
+		require.NoError(t, err)
+
+		require.NoDirExists(t, filepath.Join(dir, indexRebuildDirname))
+		require.NoDirExists(t, filepath.Join(dir, indexDirname+"_discarded"))
+
+		err = immuStore.WaitForIndexingUpto(context.Background(), 2*txCount)
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			valRef, err := immuStore.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+			require.Equal(t, uint64(6), valRef.HC())
+			require.Equal(t, uint64(2*txCount-10+i+1), valRef.Tx())
+
+			val, err := valRef.Resolve()
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d", 2*txCount-10+i)), val)
+		}
+	})
+
+	t.Run("rebuilt index should be used after reopening the store", func(t *testing.T) {
+		err := immuStore.Close()
+		require.NoError(t, err)
+
+		immuStore, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		require.Equal(t, uint64(2*txCount), immuStore.IndexInfo())
+
+		valRef, err := immuStore.Get([]byte("key9"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(6), valRef.HC())
+	})
+}
+
+func TestRebuildIndexFailures(t *testing.T) {
+	t.Run("rebuilding should not be supported with remote storage", func(t *testing.T) {
+		immuStore, err := Open(t.TempDir(), DefaultOptions().WithCompactionDisabled(true))
+		require.NoError(t, err)
+
+		defer immustoreClose(t, immuStore)
+
+		err = immuStore.RebuildIndex(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIndexRebuildUnsupported)
+	})
+
+	t.Run("rebuilding should fail on a closed store", func(t *testing.T) {
+		immuStore, err := Open(t.TempDir(), DefaultOptions())
+		require.NoError(t, err)
+
+		err = immuStore.Close()
+		require.NoError(t, err)
+
+		err = immuStore.RebuildIndex(context.Background(), nil)
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+	})
+}
+
+func TestIndexWithBloomFilters(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions()
+	opts.WithIndexOptions(opts.IndexOpts.WithBloomFilterBitsPerKey(10))
+
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	set := func(key string, preconditions ...Precondition) error {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		if err != nil {
+			return err
+		}
+
+		for _, c := range preconditions {
+			err = tx.AddPrecondition(c)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Set([]byte(key), nil, []byte("value"))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Commit(context.Background())
+		return err
+	}
+
+	for i := 0; i < 10; i++ {
+		err = set(fmt.Sprintf("key%d", i), &PreconditionKeyMustNotExist{Key: []byte(fmt.Sprintf("key%d", i))})
+		require.NoError(t, err)
+	}
+
+	err = set("key0", &PreconditionKeyMustNotExist{Key: []byte("key0")})
+	require.ErrorIs(t, err, ErrPreconditionFailed)
+
+	checkLookups := func(t *testing.T, immuStore *ImmuStore) {
+		for i := 0; i < 10; i++ {
+			_, err := immuStore.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.NoError(t, err)
+		}
+
+		_, err := immuStore.Get([]byte("missing"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	}
+
+	checkLookups(t, immuStore)
+
+	err = immuStore.RebuildIndex(context.Background(), nil)
+	require.NoError(t, err)
+
+	checkLookups(t, immuStore)
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	immuStore, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer immustoreClose(t, immuStore)
+
+	checkLookups(t, immuStore)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+	"github.com/codenotary/immudb/embedded/appendable/multiapp"
+	"github.com/codenotary/immudb/embedded/appendable/singleapp"
+)
+
+// TxIntegrity holds the outcome of checking the integrity of a single transaction
+type TxIntegrity struct {
+	// Alh is the accumulative linear hash recomputed from the transaction data
+	Alh [sha256.Size]byte
+	// VerifiedValues is the number of values read back from the value logs and matching their hashes
+	VerifiedValues int
+	// TruncatedValues is the number of values no longer available because the value logs were truncated
+	TruncatedValues int
+}
+
+// CheckTxIntegrity reads the committed transaction txID directly from the commit log,
+// bypassing caches, and recomputes its entry hashes and Alh.
+// The transaction must be linked to prevAlh, which for the first transaction is the
+// hash of an empty input as set when the store is created. Its Alh must be the one stored in the
+// AHT leaf txID and its binary linking root must match the AHT root at BlTxID.
+// Every value is read back from the value logs and validated against its hash,
+// values removed by truncation are reported but not considered a corruption.
+func (s *ImmuStore) CheckTxIntegrity(txID uint64, prevAlh [sha256.Size]byte, tx *Tx) (*TxIntegrity, error) {
+	if tx == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := s.readCommittedTxFromLog(txID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := tx.header
+
+	if hdr.ID != txID {
+		return nil, fmt.Errorf("%w: tx %d found when reading tx %d", ErrCorruptedTxData, hdr.ID, txID)
+	}
+
+	if hdr.PrevAlh != prevAlh {
+		return nil, fmt.Errorf("%w: alh chain is broken at tx %d", ErrCorruptedCLog, txID)
+	}
+
+	alh := hdr.Alh()
+
+	leaf, err := s.aht.DataAt(txID)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(leaf, alh[:]) {
+		return nil, fmt.Errorf("%w: leaf %d does not match the alh of tx %d", ErrCorruptedAHtree, txID, txID)
+	}
+
+	if hdr.BlTxID > 0 {
+		blRoot, err := s.aht.RootAt(hdr.BlTxID)
+		if err != nil {
+			return nil, err
+		}
+		if blRoot != hdr.BlRoot {
+			return nil, fmt.Errorf("%w: root at %d does not match the one linked by tx %d", ErrCorruptedAHtree, hdr.BlTxID, txID)
+		}
+	}
+
+	integrity := &TxIntegrity{Alh: alh}
+
+	var b []byte
+
+	for i, e := range tx.entries[:hdr.NEntries] {
+		if cap(b) < e.vLen {
+			b = make([]byte, e.vLen)
+		}
+
+		truncated, err := s.checkValueIntegrity(b[:e.vLen], e.vOff, e.hVal)
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %d at tx %d", err, i, txID)
+		}
+
+		if truncated {
+			integrity.TruncatedValues++
+		} else {
+			integrity.VerifiedValues++
+		}
+	}
+
+	return integrity, nil
+}
+
+func (s *ImmuStore) readCommittedTxFromLog(txID uint64, tx *Tx) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return ErrAlreadyClosed
+	}
+
+	s.commitStateRWMutex.RLock()
+	committedTxID := s.committedTxID
+	s.commitStateRWMutex.RUnlock()
+
+	if txID == 0 {
+		return ErrIllegalArguments
+	}
+
+	if txID > committedTxID {
+		return ErrTxNotFound
+	}
+
+	txOff, txSize, err := s.txOffsetAndSize(txID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.readFrom(appendable.NewReaderFrom(s.txLog, txOff, txSize), false)
+	if err == io.EOF {
+		return fmt.Errorf("%w: unexpected EOF while reading tx %d", ErrCorruptedTxData, txID)
+	}
+
+	return err
+}
+
+// checkValueIntegrity reads a value from its value log, skipping the value cache
+func (s *ImmuStore) checkValueIntegrity(b []byte, off int64, hvalue [sha256.Size]byte) (truncated bool, err error) {
+	vLogID, offset := decodeOffset(off)
+
+	if vLogID == 0 {
+		// value was not stored on any vlog i.e. a truncated transaction was replicated
+		return len(b) > 0, nil
+	}
+
+	vLog := s.fetchVLog(vLogID)
+	n, err := vLog.ReadAt(b, offset)
+	s.releaseVLog(vLogID)
+
+	if errors.Is(err, io.EOF) {
+		return true, nil
+	}
+	if err == multiapp.ErrAlreadyClosed || err == singleapp.ErrAlreadyClosed {
+		return false, ErrAlreadyClosed
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if n != len(b) || hvalue != sha256.Sum256(b) {
+		return false, fmt.Errorf("%w: value does not match its hash", ErrCorruptedData)
+	}
+
+	return false, nil
+}
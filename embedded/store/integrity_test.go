@@ -0,0 +1,130 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTxIntegrity(t *testing.T) {
+	dir := t.TempDir()
+
+	immuStore, err := Open(dir, DefaultOptions().WithMaxConcurrency(1))
+	require.NoError(t, err)
+
+	txCount := 10
+
+	for i := 0; i < txCount; i++ {
+		tx, err := immuStore.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key%d", i)), nil, []byte(fmt.Sprintf("integrity-value-%03d", i)))
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("empty%d", i)), nil, nil)
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	checkAll := func(st *ImmuStore) error {
+		tx := tempTxHolder(t, st)
+
+		alh := sha256.Sum256(nil)
+
+		for txID := uint64(1); txID <= uint64(txCount); txID++ {
+			integrity, err := st.CheckTxIntegrity(txID, alh, tx)
+			if err != nil {
+				return err
+			}
+
+			require.Equal(t, 2, integrity.VerifiedValues)
+			require.Zero(t, integrity.TruncatedValues)
+
+			alh = integrity.Alh
+		}
+
+		_, committedAlh := st.CommittedAlh()
+		require.Equal(t, committedAlh, alh)
+
+		return nil
+	}
+
+	t.Run("untampered store should pass all checks", func(t *testing.T) {
+		require.NoError(t, checkAll(immuStore))
+	})
+
+	t.Run("invalid arguments should be rejected", func(t *testing.T) {
+		tx := tempTxHolder(t, immuStore)
+
+		_, err := immuStore.CheckTxIntegrity(1, [sha256.Size]byte{}, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = immuStore.CheckTxIntegrity(0, [sha256.Size]byte{}, tx)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = immuStore.CheckTxIntegrity(uint64(txCount+1), [sha256.Size]byte{}, tx)
+		require.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("broken alh chain should be detected", func(t *testing.T) {
+		tx := tempTxHolder(t, immuStore)
+
+		_, err := immuStore.CheckTxIntegrity(2, [sha256.Size]byte{1}, tx)
+		require.ErrorIs(t, err, ErrCorruptedCLog)
+	})
+
+	err = immuStore.Close()
+	require.NoError(t, err)
+
+	t.Run("closed store should fail", func(t *testing.T) {
+		_, err := immuStore.CheckTxIntegrity(1, [sha256.Size]byte{}, tempTxHolder(t, immuStore))
+		require.ErrorIs(t, err, ErrAlreadyClosed)
+	})
+
+	t.Run("tampered value should be detected", func(t *testing.T) {
+		vLogPath := filepath.Join(dir, "val_0", "00000000.val")
+
+		content, err := os.ReadFile(vLogPath)
+		require.NoError(t, err)
+
+		pos := bytes.Index(content, []byte("integrity-value-005"))
+		require.Greater(t, pos, 0)
+
+		content[pos] ^= 0xff
+
+		err = os.WriteFile(vLogPath, content, 0644)
+		require.NoError(t, err)
+
+		immuStore, err := Open(dir, DefaultOptions().WithMaxConcurrency(1))
+		require.NoError(t, err)
+		defer immuStore.Close()
+
+		err = checkAll(immuStore)
+		require.ErrorIs(t, err, ErrCorruptedData)
+		require.Contains(t, err.Error(), "at tx 6")
+	})
+}
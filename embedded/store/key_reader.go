@@ -77,6 +77,14 @@ func (s *Snapshot) set(key, value []byte) error {
 	return s.snap.Set(key, value)
 }
 
+func (s *Snapshot) saveState() (*tbtree.SnapshotState, error) {
+	return s.snap.SaveState()
+}
+
+func (s *Snapshot) restoreState(state *tbtree.SnapshotState) error {
+	return s.snap.RestoreState(state)
+}
+
 func (s *Snapshot) Get(key []byte) (valRef ValueRef, err error) {
 	return s.GetWithFilters(key, IgnoreExpired, IgnoreDeleted)
 }
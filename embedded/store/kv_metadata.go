@@ -26,18 +26,24 @@ import (
 
 var ErrNonExpirable = errors.New("non expirable")
 var ErrReadOnly = errors.New("read-only")
+var ErrRevisionNotPresentInMetadata = errors.New("revision not present in metadata")
 
 const (
 	deletedAttrCode      attributeCode = 0
 	expiresAtAttrCode    attributeCode = 1
 	nonIndexableAttrCode attributeCode = 2
+	revisionAttrCode     attributeCode = 3
 )
 
 const deletedAttrSize = 0
 const expiresAtAttrSize = tsSize
 const nonIndexableAttrSize = 0
+const revisionAttrSize = 8
 
-const maxKVMetadataLen = (attrCodeSize + deletedAttrSize) + (attrCodeSize + expiresAtAttrSize) + (attrCodeSize + nonIndexableAttrSize)
+const maxKVMetadataLen = (attrCodeSize + deletedAttrSize) +
+	(attrCodeSize + expiresAtAttrSize) +
+	(attrCodeSize + nonIndexableAttrSize) +
+	(attrCodeSize + revisionAttrSize)
 
 type KVMetadata struct {
 	attributes map[attributeCode]attribute
@@ -98,6 +104,32 @@ func (a *nonIndexableAttribute) deserialize(b []byte) (int, error) {
 	return 0, nil
 }
 
+// revisionAttribute holds the revision of the key the entry was written as,
+// it's assigned by the store when the authenticated index is enabled
+type revisionAttribute struct {
+	revision uint64
+}
+
+func (a *revisionAttribute) code() attributeCode {
+	return revisionAttrCode
+}
+
+func (a *revisionAttribute) serialize() []byte {
+	var b [revisionAttrSize]byte
+	binary.BigEndian.PutUint64(b[:], a.revision)
+	return b[:]
+}
+
+func (a *revisionAttribute) deserialize(b []byte) (int, error) {
+	if len(b) < revisionAttrSize {
+		return 0, ErrCorruptedData
+	}
+
+	a.revision = binary.BigEndian.Uint64(b)
+
+	return revisionAttrSize, nil
+}
+
 func NewKVMetadata() *KVMetadata {
 	return &KVMetadata{
 		attributes: make(map[attributeCode]attribute),
@@ -201,10 +233,57 @@ func (md *KVMetadata) NonIndexable() bool {
 	return ok
 }
 
+// WithRevision sets the revision of the key the entry is written as.
+// Revisions are assigned by the store, thus entries being written must not set them.
+func (md *KVMetadata) WithRevision(revision uint64) error {
+	if md.readonly {
+		return ErrReadOnly
+	}
+
+	md.attributes[revisionAttrCode] = &revisionAttribute{revision: revision}
+
+	return nil
+}
+
+func (md *KVMetadata) HasRevision() bool {
+	_, ok := md.attributes[revisionAttrCode]
+	return ok
+}
+
+// Revision returns the revision of the key the entry was written as
+func (md *KVMetadata) Revision() (uint64, error) {
+	attr, ok := md.attributes[revisionAttrCode]
+	if !ok {
+		return 0, ErrRevisionNotPresentInMetadata
+	}
+
+	return attr.(*revisionAttribute).revision, nil
+}
+
+// withRevision returns a read-only copy of the metadata holding the given revision,
+// the revision is removed when zero
+func (md *KVMetadata) withRevision(revision uint64) *KVMetadata {
+	rmd := newReadOnlyKVMetadata()
+
+	if md != nil {
+		for code, attr := range md.attributes {
+			rmd.attributes[code] = attr
+		}
+	}
+
+	if revision == 0 {
+		delete(rmd.attributes, revisionAttrCode)
+	} else {
+		rmd.attributes[revisionAttrCode] = &revisionAttribute{revision: revision}
+	}
+
+	return rmd
+}
+
 func (md *KVMetadata) Bytes() []byte {
 	var b bytes.Buffer
 
-	for _, attrCode := range []attributeCode{deletedAttrCode, expiresAtAttrCode, nonIndexableAttrCode} {
+	for _, attrCode := range []attributeCode{deletedAttrCode, expiresAtAttrCode, nonIndexableAttrCode, revisionAttrCode} {
 		attr, ok := md.attributes[attrCode]
 		if ok {
 			b.WriteByte(byte(attr.code()))
@@ -266,6 +345,10 @@ func newAttribute(attrCode attributeCode) (attribute, error) {
 		{
 			return &nonIndexableAttribute{}, nil
 		}
+	case revisionAttrCode:
+		{
+			return &revisionAttribute{}, nil
+		}
 	default:
 		{
 			return nil, fmt.Errorf("error reading metadata attributes: %w", ErrCorruptedData)
@@ -61,6 +61,9 @@ func TestKVMetadata(t *testing.T) {
 
 		err = desmd.AsNonIndexable(true)
 		require.ErrorIs(t, err, ErrReadOnly)
+
+		err = desmd.WithRevision(1)
+		require.ErrorIs(t, err, ErrReadOnly)
 	})
 
 	desmd := NewKVMetadata()
@@ -95,6 +98,15 @@ func TestKVMetadata(t *testing.T) {
 	desmd.AsNonIndexable(true)
 	require.True(t, desmd.NonIndexable())
 
+	require.False(t, desmd.HasRevision())
+
+	_, err = desmd.Revision()
+	require.ErrorIs(t, err, ErrRevisionNotPresentInMetadata)
+
+	err = desmd.WithRevision(3)
+	require.NoError(t, err)
+	require.True(t, desmd.HasRevision())
+
 	bs = desmd.Bytes()
 	require.NotNil(t, bs)
 	require.Len(t, bs, maxKVMetadataLen)
@@ -105,4 +117,29 @@ func TestKVMetadata(t *testing.T) {
 	require.True(t, desmd.IsExpirable())
 	require.True(t, desmd.ExpiredAt(now))
 	require.True(t, desmd.NonIndexable())
+
+	revision, err := desmd.Revision()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), revision)
+
+	t.Run("revisions should be replaced into read-only copies", func(t *testing.T) {
+		rmd := desmd.withRevision(4)
+		require.True(t, rmd.readonly)
+		require.True(t, rmd.Deleted())
+
+		revision, err := rmd.Revision()
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), revision)
+
+		revision, err = desmd.Revision()
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), revision)
+
+		rmd = desmd.withRevision(0)
+		require.False(t, rmd.HasRevision())
+		require.True(t, rmd.NonIndexable())
+
+		rmd = (*KVMetadata)(nil).withRevision(1)
+		require.True(t, rmd.HasRevision())
+	})
 }
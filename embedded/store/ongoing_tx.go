@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/codenotary/immudb/embedded/tbtree"
 )
 
 // OngoingTx (no-thread safe) represents an interactive or incremental transaction with support of RYOW.
@@ -49,11 +51,27 @@ type OngoingTx struct {
 
 	metadata *TxMetadata
 
+	savepoints []*Savepoint
+
 	ts time.Time
 
 	closed bool
 }
 
+// Savepoint holds the state of an ongoing transaction at a given point in time.
+// Rolling back to a savepoint discards entries, preconditions and reads made after it was created
+type Savepoint struct {
+	entries   []*EntrySpec
+	snapState *tbtree.SnapshotState
+
+	preconditions          int
+	expectedGets           int
+	expectedGetsWithPrefix int
+	expectedReaders        int
+
+	metadata *TxMetadata
+}
+
 type expectedGet struct {
 	key        []byte
 	filters    []FilterFn
@@ -252,6 +270,10 @@ func (tx *OngoingTx) set(key []byte, md *KVMetadata, value []byte, hashValue [sh
 }
 
 func (tx *OngoingTx) Set(key []byte, md *KVMetadata, value []byte) error {
+	if md != nil && !md.readonly && md.HasRevision() {
+		return fmt.Errorf("%w: key revisions are assigned by the store", ErrIllegalArguments)
+	}
+
 	var hashValue [sha256.Size]byte
 	return tx.set(key, md, value, hashValue, false)
 }
@@ -422,6 +444,119 @@ func (tx *OngoingTx) NewKeyReader(spec KeyReaderSpec) (KeyReader, error) {
 	return newOngoingTxKeyReader(tx, spec)
 }
 
+// Savepoint creates a new savepoint capturing the current state of the transaction
+func (tx *OngoingTx) Savepoint() (*Savepoint, error) {
+	if tx.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	sp := &Savepoint{
+		entries:                make([]*EntrySpec, len(tx.entries)),
+		preconditions:          len(tx.preconditions),
+		expectedGets:           len(tx.expectedGets),
+		expectedGetsWithPrefix: len(tx.expectedGetsWithPrefix),
+		expectedReaders:        len(tx.expectedReaders),
+		metadata:               tx.metadata,
+	}
+
+	// entries are replaced but never modified in place, so a shallow copy is enough
+	copy(sp.entries, tx.entries)
+
+	if !tx.IsWriteOnly() {
+		snapState, err := tx.snap.saveState()
+		if err != nil {
+			return nil, err
+		}
+
+		sp.snapState = snapState
+	}
+
+	tx.savepoints = append(tx.savepoints, sp)
+
+	return sp, nil
+}
+
+func (tx *OngoingTx) savepointIndex(sp *Savepoint) (int, error) {
+	if sp == nil {
+		return 0, ErrIllegalArguments
+	}
+
+	for i, s := range tx.savepoints {
+		if s == sp {
+			return i, nil
+		}
+	}
+
+	return 0, ErrSavepointNotFound
+}
+
+// RollbackTo discards all the changes made since the savepoint was created.
+// The savepoint remains valid while any savepoint created after it is destroyed
+func (tx *OngoingTx) RollbackTo(sp *Savepoint) error {
+	if tx.closed {
+		return ErrAlreadyClosed
+	}
+
+	i, err := tx.savepointIndex(sp)
+	if err != nil {
+		return err
+	}
+
+	if !tx.IsWriteOnly() {
+		err = tx.snap.restoreState(sp.snapState)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, e := range tx.entries[len(sp.entries):] {
+		delete(tx.entriesByKey, sha256.Sum256(e.Key))
+	}
+
+	tx.entries = make([]*EntrySpec, len(sp.entries))
+	copy(tx.entries, sp.entries)
+
+	tx.preconditions = tx.preconditions[:sp.preconditions]
+
+	// reads done by readers created before the savepoint are kept as such readers may still be in use
+	tx.expectedGets = tx.expectedGets[:sp.expectedGets]
+	tx.expectedGetsWithPrefix = tx.expectedGetsWithPrefix[:sp.expectedGetsWithPrefix]
+	tx.expectedReaders = tx.expectedReaders[:sp.expectedReaders]
+
+	tx.readsetSize = len(tx.expectedGets) + len(tx.expectedGetsWithPrefix)
+
+	for _, eReader := range tx.expectedReaders {
+		tx.readsetSize += len(eReader.expectedReads)
+
+		for _, eReads := range eReader.expectedReads {
+			tx.readsetSize += len(eReads)
+		}
+	}
+
+	tx.metadata = sp.metadata
+
+	tx.savepoints = tx.savepoints[:i+1]
+
+	return nil
+}
+
+// ReleaseSavepoint destroys the savepoint and any savepoint created after it.
+// Changes made since the savepoint was created are kept
+func (tx *OngoingTx) ReleaseSavepoint(sp *Savepoint) error {
+	if tx.closed {
+		return ErrAlreadyClosed
+	}
+
+	i, err := tx.savepointIndex(sp)
+	if err != nil {
+		return err
+	}
+
+	tx.savepoints = tx.savepoints[:i]
+
+	return nil
+}
+
 func (tx *OngoingTx) RequireMVCCOnFollowingTxs(requireMVCCOnFollowingTxs bool) {
 	tx.requireMVCCOnFollowingTxs = requireMVCCOnFollowingTxs
 }
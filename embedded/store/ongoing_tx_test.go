@@ -17,6 +17,7 @@ limitations under the License.
 package store
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -91,3 +92,115 @@ func TestOngoingTxOptions(t *testing.T) {
 
 	require.Equal(t, 1*time.Hour, opts.WithSnapshotRenewalPeriod(1*time.Hour).SnapshotRenewalPeriod)
 }
+
+func TestOngoingTxSavepoints(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer immustoreClose(t, st)
+
+	tx, err := st.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = st.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte("value2"))
+	require.NoError(t, err)
+
+	sp1, err := tx.Savepoint()
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key1"), nil, []byte("value1_updated"))
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key2"), nil, []byte("value2_updated"))
+	require.NoError(t, err)
+
+	err = tx.Set([]byte("key3"), nil, []byte("value3"))
+	require.NoError(t, err)
+
+	err = tx.AddPrecondition(&PreconditionKeyMustNotExist{Key: []byte("key1")})
+	require.NoError(t, err)
+
+	_, err = tx.Get([]byte("key4"))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	sp2, err := tx.Savepoint()
+	require.NoError(t, err)
+
+	err = tx.RollbackTo(sp1)
+	require.NoError(t, err)
+
+	require.Len(t, tx.entries, 1)
+	require.Empty(t, tx.preconditions)
+	require.Empty(t, tx.expectedGets)
+	require.Zero(t, tx.readsetSize)
+
+	t.Run("savepoints created after the restored one should be destroyed", func(t *testing.T) {
+		err = tx.RollbackTo(sp2)
+		require.ErrorIs(t, err, ErrSavepointNotFound)
+
+		err = tx.ReleaseSavepoint(sp2)
+		require.ErrorIs(t, err, ErrSavepointNotFound)
+	})
+
+	valRef, err := tx.Get([]byte("key1"))
+	require.NoError(t, err)
+
+	val, err := valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), val)
+
+	valRef, err = tx.Get([]byte("key2"))
+	require.NoError(t, err)
+
+	val, err = valRef.Resolve()
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), val)
+
+	_, err = tx.Get([]byte("key3"))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	err = tx.Set([]byte("key4"), nil, []byte("value4"))
+	require.NoError(t, err)
+
+	err = tx.ReleaseSavepoint(sp1)
+	require.NoError(t, err)
+
+	err = tx.RollbackTo(sp1)
+	require.ErrorIs(t, err, ErrSavepointNotFound)
+
+	err = tx.RollbackTo(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	hdr, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, hdr.NEntries)
+
+	_, err = tx.Savepoint()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	err = tx.RollbackTo(sp1)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	err = tx.ReleaseSavepoint(sp1)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	valRef, err = st.Get([]byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), valRef.Tx())
+
+	_, err = st.Get([]byte("key3"))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	valRef, err = st.Get([]byte("key4"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), valRef.Tx())
+}
@@ -43,6 +43,8 @@ const DefaultCompressionLevel = appendable.DefaultCompressionLevel
 const DefaultTxLogCacheSize = 1000
 const DefaultVLogCacheSize = 0
 const DefaultMaxWaitees = 1000
+const DefaultAuthenticatedIndexCheckpointThld = 10_000
+const DefaultAuthenticatedIndexMaxKeys = 10_000_000
 const DefaultVLogMaxOpenedFiles = 10
 const DefaultTxLogMaxOpenedFiles = 10
 const DefaultCommitLogMaxOpenedFiles = 10
@@ -114,6 +116,16 @@ type Options struct {
 	// Version of transaction header to use (limits available features)
 	WriteTxHeaderVersion int
 
+	// If set to true, the root of the authenticated index is committed into every transaction header
+	AuthenticatedIndex bool
+
+	// Number of committed transactions after which the authenticated index is checkpointed
+	AuthenticatedIndexCheckpointThld int
+
+	// Maximum number of distinct keys in the authenticated index, transactions writing new keys beyond it are rejected.
+	// The whole authenticated index is kept in memory, taking roughly 250 bytes per key
+	AuthenticatedIndexMaxKeys int
+
 	// Maximum number of go-routines waiting for specific transactions to be in a committed or indexed state
 	MaxWaitees int
 
@@ -181,6 +193,12 @@ type IndexOptions struct {
 
 	// Maximum time waiting for more transactions to be committed and included into the same bulk
 	BulkPreparationTimeout time.Duration
+
+	// Number of bits per key of the bloom filters used to skip lookups of missing keys (0 disables them)
+	BloomFilterBitsPerKey int
+
+	// Lengths of the key prefixes added into the bloom filters, so as to skip lookups of missing prefixes as well
+	BloomFilterPrefixLengths []int
 }
 
 type AHTOptions struct {
@@ -215,6 +233,9 @@ func DefaultOptions() *Options {
 
 		MaxWaitees: DefaultMaxWaitees,
 
+		AuthenticatedIndexCheckpointThld: DefaultAuthenticatedIndexCheckpointThld,
+		AuthenticatedIndexMaxKeys:        DefaultAuthenticatedIndexMaxKeys,
+
 		TimeFunc: func() time.Time {
 			return time.Now()
 		},
@@ -253,6 +274,8 @@ func DefaultIndexOptions() *IndexOptions {
 
 		MaxBulkSize:            DefaultIndexingMaxBulkSize,
 		BulkPreparationTimeout: DefaultBulkPreparationTimeout,
+
+		BloomFilterBitsPerKey: tbtree.DefaultBloomFilterBitsPerKey,
 	}
 }
 
@@ -323,6 +346,15 @@ func (opts *Options) Validate() error {
 	if opts.WriteTxHeaderVersion > MaxTxHeaderVersion {
 		return fmt.Errorf("%w: invalid WriteTxHeaderVersion", ErrInvalidOptions)
 	}
+	if opts.AuthenticatedIndex && opts.WriteTxHeaderVersion < 1 {
+		return fmt.Errorf("%w: authenticated index requires WriteTxHeaderVersion 1 or higher", ErrInvalidOptions)
+	}
+	if opts.AuthenticatedIndexCheckpointThld <= 0 {
+		return fmt.Errorf("%w: invalid AuthenticatedIndexCheckpointThld", ErrInvalidOptions)
+	}
+	if opts.AuthenticatedIndexMaxKeys <= 0 {
+		return fmt.Errorf("%w: invalid AuthenticatedIndexMaxKeys", ErrInvalidOptions)
+	}
 
 	// options below are only set during initialization and stored as metadata
 	if opts.MaxTxEntries <= 0 {
@@ -389,6 +421,14 @@ func (opts *IndexOptions) Validate() error {
 	if opts.BulkPreparationTimeout < 0 {
 		return fmt.Errorf("%w: invalid BulkPreparationTimeout", ErrInvalidOptions)
 	}
+	if opts.BloomFilterBitsPerKey < 0 {
+		return fmt.Errorf("%w: invalid index option BloomFilterBitsPerKey", ErrInvalidOptions)
+	}
+	for _, l := range opts.BloomFilterPrefixLengths {
+		if l <= 0 {
+			return fmt.Errorf("%w: invalid index option BloomFilterPrefixLengths", ErrInvalidOptions)
+		}
+	}
 	if opts.NodesLogMaxOpenedFiles <= 0 {
 		return fmt.Errorf("%w: invalid index option NodesLogMaxOpenedFiles", ErrInvalidOptions)
 	}
@@ -541,6 +581,21 @@ func (opts *Options) WithWriteTxHeaderVersion(version int) *Options {
 	return opts
 }
 
+func (opts *Options) WithAuthenticatedIndex(enabled bool) *Options {
+	opts.AuthenticatedIndex = enabled
+	return opts
+}
+
+func (opts *Options) WithAuthenticatedIndexCheckpointThld(checkpointThld int) *Options {
+	opts.AuthenticatedIndexCheckpointThld = checkpointThld
+	return opts
+}
+
+func (opts *Options) WithAuthenticatedIndexMaxKeys(maxKeys int) *Options {
+	opts.AuthenticatedIndexMaxKeys = maxKeys
+	return opts
+}
+
 func (opts *Options) WithCompressionFormat(compressionFormat int) *Options {
 	opts.CompressionFormat = compressionFormat
 	return opts
@@ -613,6 +668,16 @@ func (opts *IndexOptions) WithBulkPreparationTimeout(bulkPreparationTimeout time
 	return opts
 }
 
+func (opts *IndexOptions) WithBloomFilterBitsPerKey(bloomFilterBitsPerKey int) *IndexOptions {
+	opts.BloomFilterBitsPerKey = bloomFilterBitsPerKey
+	return opts
+}
+
+func (opts *IndexOptions) WithBloomFilterPrefixLengths(bloomFilterPrefixLengths ...int) *IndexOptions {
+	opts.BloomFilterPrefixLengths = bloomFilterPrefixLengths
+	return opts
+}
+
 func (opts *IndexOptions) WithCompactionThld(compactionThld int) *IndexOptions {
 	opts.CompactionThld = compactionThld
 	return opts
@@ -47,6 +47,9 @@ func TestInvalidOptions(t *testing.T) {
 		{"CommitLogMaxOpenedFiles", DefaultOptions().WithCommitLogMaxOpenedFiles(0)},
 		{"WriteTxHeaderVersion", DefaultOptions().WithWriteTxHeaderVersion(-1)},
 		{"WriteTxHeaderVersion-max", DefaultOptions().WithWriteTxHeaderVersion(MaxTxHeaderVersion + 1)},
+		{"AuthenticatedIndex", DefaultOptions().WithWriteTxHeaderVersion(0).WithAuthenticatedIndex(true)},
+		{"AuthenticatedIndexCheckpointThld", DefaultOptions().WithAuthenticatedIndexCheckpointThld(0)},
+		{"AuthenticatedIndexMaxKeys", DefaultOptions().WithAuthenticatedIndexMaxKeys(0)},
 		{"MaxWaitees", DefaultOptions().WithMaxWaitees(-1)},
 		{"TimeFunc", DefaultOptions().WithTimeFunc(nil)},
 		{"MaxTxEntries", DefaultOptions().WithMaxTxEntries(0)},
@@ -80,6 +83,8 @@ func TestInvalidIndexOptions(t *testing.T) {
 		{"RenewSnapRootAfter", DefaultIndexOptions().WithRenewSnapRootAfter(-1)},
 		{"MaxBulkSize", DefaultIndexOptions().WithMaxBulkSize(0)},
 		{"BulkPreparationTimeout", DefaultIndexOptions().WithBulkPreparationTimeout(-1)},
+		{"BloomFilterBitsPerKey", DefaultIndexOptions().WithBloomFilterBitsPerKey(-1)},
+		{"BloomFilterPrefixLengths", DefaultIndexOptions().WithBloomFilterPrefixLengths(0)},
 		{"CompactionThld", DefaultIndexOptions().WithCompactionThld(0)},
 		{"DelayDuringCompaction", DefaultIndexOptions().WithDelayDuringCompaction(-1)},
 		{"NodesLogMaxOpenedFiles", DefaultIndexOptions().WithNodesLogMaxOpenedFiles(0)},
@@ -142,6 +147,10 @@ func TestValidOptions(t *testing.T) {
 
 	require.True(t, opts.WithSynced(true).Synced)
 
+	require.False(t, opts.WithAuthenticatedIndex(false).AuthenticatedIndex)
+	require.Equal(t, 100, opts.WithAuthenticatedIndexCheckpointThld(100).AuthenticatedIndexCheckpointThld)
+	require.Equal(t, 1000, opts.WithAuthenticatedIndexMaxKeys(1000).AuthenticatedIndexMaxKeys)
+
 	require.NotNil(t, opts.WithIndexOptions(DefaultIndexOptions()).IndexOpts)
 
 	require.NotNil(t, opts.WithAHTOptions(DefaultAHTOptions()).AHTOpts)
@@ -187,6 +196,8 @@ func TestValidOptions(t *testing.T) {
 	require.Equal(t, 1_000, indexOpts.WithMaxBulkSize(1_000).MaxBulkSize)
 	require.Equal(t, time.Duration(500)*time.Millisecond,
 		indexOpts.WithBulkPreparationTimeout(time.Duration(500)*time.Millisecond).BulkPreparationTimeout)
+	require.Equal(t, 10, indexOpts.WithBloomFilterBitsPerKey(10).BloomFilterBitsPerKey)
+	require.Equal(t, []int{4}, indexOpts.WithBloomFilterPrefixLengths(4).BloomFilterPrefixLengths)
 	require.Equal(t, 10, indexOpts.WithNodesLogMaxOpenedFiles(10).NodesLogMaxOpenedFiles)
 	require.Equal(t, 11, indexOpts.WithHistoryLogMaxOpenedFiles(11).HistoryLogMaxOpenedFiles)
 	require.Equal(t, 12, indexOpts.WithCommitLogMaxOpenedFiles(12).CommitLogMaxOpenedFiles)
@@ -17,6 +17,7 @@ limitations under the License.
 package store
 
 import (
+	"crypto/sha256"
 	"errors"
 
 	"github.com/codenotary/immudb/embedded/tbtree"
@@ -122,3 +123,35 @@ func (cs *PreconditionKeyNotModifiedAfterTx) Check(idx KeyIndex) (bool, error) {
 
 	return valRef.Tx() <= cs.TxID, nil
 }
+
+type PreconditionValueMustEqual struct {
+	Key       []byte
+	ValueHash [sha256.Size]byte
+}
+
+func (cs *PreconditionValueMustEqual) String() string { return "ValueMustEqual" }
+
+func (cs *PreconditionValueMustEqual) Validate(st *ImmuStore) error {
+	if len(cs.Key) == 0 {
+		return ErrInvalidPreconditionNullKey
+	}
+
+	if len(cs.Key) > st.maxKeyLen {
+		return ErrInvalidPreconditionMaxKeyLenExceeded
+	}
+
+	return nil
+}
+
+func (cs *PreconditionValueMustEqual) Check(idx KeyIndex) (bool, error) {
+	// values are compared by hash, thus there is no need to read them
+	valRef, err := idx.Get(cs.Key)
+	if err != nil && errors.Is(err, ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return valRef.HVal() == cs.ValueHash, nil
+}
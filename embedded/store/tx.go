@@ -384,6 +384,11 @@ func (tx *Tx) Proof(key []byte) (*htree.InclusionProof, error) {
 	return tx.htree.InclusionProof(kindex)
 }
 
+// MultiProof returns a single proof of inclusion of the entries at the given positions, in ascending order
+func (tx *Tx) MultiProof(indexes []int) (*htree.MultiInclusionProof, error) {
+	return tx.htree.MultiInclusionProof(indexes)
+}
+
 func (tx *Tx) readFrom(r *appendable.Reader, skipIntegrityCheck bool) error {
 	tdr := &txDataReader{r: r, skipIntegrityCheck: skipIntegrityCheck}
 
@@ -18,6 +18,7 @@ package store
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 )
@@ -25,14 +26,20 @@ import (
 // attributeCode is used to identify the attribute.
 const (
 	truncatedUptoTxAttrCode attributeCode = 0
+	indexRootAttrCode       attributeCode = 1
+	timestampAnchorAttrCode attributeCode = 2
 )
 
 // attribute size is the size of the attribute in bytes.
 const (
 	truncatedUptoTxAttrSize = txIDSize
+	indexRootAttrSize       = sha256.Size
+	timestampAnchorAttrSize = txIDSize
 )
 
-const maxTxMetadataLen = (attrCodeSize + truncatedUptoTxAttrSize)
+const maxTxMetadataLen = (attrCodeSize + truncatedUptoTxAttrSize) +
+	(attrCodeSize + indexRootAttrSize) +
+	(attrCodeSize + timestampAnchorAttrSize)
 
 // truncatedUptoTxAttribute is used to identify that the transaction
 // stores the information up to which given transaction ID the
@@ -63,12 +70,75 @@ func (a *truncatedUptoTxAttribute) deserialize(b []byte) (int, error) {
 	return txIDSize, nil
 }
 
+// indexRootAttribute is used to store the root of the authenticated
+// index as of the transaction, i.e. after including its entries.
+type indexRootAttribute struct {
+	root [sha256.Size]byte
+}
+
+// code returns the attribute code.
+func (a *indexRootAttribute) code() attributeCode {
+	return indexRootAttrCode
+}
+
+// serialize returns the serialized attribute.
+func (a *indexRootAttribute) serialize() []byte {
+	return a.root[:]
+}
+
+// deserialize deserializes the attribute.
+func (a *indexRootAttribute) deserialize(b []byte) (int, error) {
+	if len(b) < sha256.Size {
+		return 0, ErrCorruptedData
+	}
+
+	copy(a.root[:], b)
+	return sha256.Size, nil
+}
+
+// timestampAnchorAttribute is used to identify that the transaction
+// stores a time-stamp token attesting the state of the database
+// as of the given transaction ID.
+type timestampAnchorAttribute struct {
+	txID uint64
+}
+
+// code returns the attribute code.
+func (a *timestampAnchorAttribute) code() attributeCode {
+	return timestampAnchorAttrCode
+}
+
+// serialize returns the serialized attribute.
+func (a *timestampAnchorAttribute) serialize() []byte {
+	var b [txIDSize]byte
+	binary.BigEndian.PutUint64(b[:], a.txID)
+	return b[:]
+}
+
+// deserialize deserializes the attribute.
+func (a *timestampAnchorAttribute) deserialize(b []byte) (int, error) {
+	if len(b) < txIDSize {
+		return 0, ErrCorruptedData
+	}
+
+	a.txID = binary.BigEndian.Uint64(b)
+	return txIDSize, nil
+}
+
 func getAttributeFrom(attrCode attributeCode) (attribute, error) {
 	switch attrCode {
 	case truncatedUptoTxAttrCode:
 		{
 			return &truncatedUptoTxAttribute{}, nil
 		}
+	case indexRootAttrCode:
+		{
+			return &indexRootAttribute{}, nil
+		}
+	case timestampAnchorAttrCode:
+		{
+			return &timestampAnchorAttribute{}, nil
+		}
 	default:
 		{
 			return nil, fmt.Errorf("error reading tx metadata attributes: %w", ErrCorruptedData)
@@ -102,7 +172,7 @@ func (md *TxMetadata) Equal(amd *TxMetadata) bool {
 func (md *TxMetadata) Bytes() []byte {
 	var b bytes.Buffer
 
-	for _, attrCode := range []attributeCode{truncatedUptoTxAttrCode} {
+	for _, attrCode := range []attributeCode{truncatedUptoTxAttrCode, indexRootAttrCode, timestampAnchorAttrCode} {
 		attr, ok := md.attributes[attrCode]
 		if ok {
 			b.WriteByte(byte(attr.code()))
@@ -114,7 +184,7 @@ func (md *TxMetadata) Bytes() []byte {
 }
 
 func (md *TxMetadata) ReadFrom(b []byte) error {
-	if len(b) > maxKVMetadataLen {
+	if len(b) > maxTxMetadataLen {
 		return ErrCorruptedData
 	}
 
@@ -182,3 +252,63 @@ func (md *TxMetadata) WithTruncatedTxID(txID uint64) *TxMetadata {
 	attr.(*truncatedUptoTxAttribute).txID = txID
 	return md
 }
+
+// HasIndexRoot returns true if the transaction stores the root
+// of the authenticated index.
+func (md *TxMetadata) HasIndexRoot() bool {
+	_, ok := md.attributes[indexRootAttrCode]
+	return ok
+}
+
+// GetIndexRoot returns the root of the authenticated index as of the transaction.
+func (md *TxMetadata) GetIndexRoot() ([sha256.Size]byte, error) {
+	attr, ok := md.attributes[indexRootAttrCode]
+	if !ok {
+		return [sha256.Size]byte{}, ErrIndexRootNotPresentInMetadata
+	}
+
+	return attr.(*indexRootAttribute).root, nil
+}
+
+// WithIndexRoot sets the root of the authenticated index as of the transaction.
+func (md *TxMetadata) WithIndexRoot(root [sha256.Size]byte) *TxMetadata {
+	attr, ok := md.attributes[indexRootAttrCode]
+	if !ok {
+		md.attributes[indexRootAttrCode] = &indexRootAttribute{root: root}
+		return md
+	}
+
+	attr.(*indexRootAttribute).root = root
+	return md
+}
+
+// HasTimestampAnchoredTxID returns true if the transaction stores a time-stamp token
+// attesting the state of the database as of a given transaction.
+func (md *TxMetadata) HasTimestampAnchoredTxID() bool {
+	_, ok := md.attributes[timestampAnchorAttrCode]
+	return ok
+}
+
+// GetTimestampAnchoredTxID returns the ID of the transaction whose state is attested
+// by the time-stamp token stored in the transaction.
+func (md *TxMetadata) GetTimestampAnchoredTxID() (uint64, error) {
+	attr, ok := md.attributes[timestampAnchorAttrCode]
+	if !ok {
+		return 0, ErrTimestampAnchorNotPresentInMetadata
+	}
+
+	return attr.(*timestampAnchorAttribute).txID, nil
+}
+
+// WithTimestampAnchoredTxID sets the ID of the transaction whose state is attested
+// by the time-stamp token stored in the transaction.
+func (md *TxMetadata) WithTimestampAnchoredTxID(txID uint64) *TxMetadata {
+	attr, ok := md.attributes[timestampAnchorAttrCode]
+	if !ok {
+		md.attributes[timestampAnchorAttrCode] = &timestampAnchorAttribute{txID: txID}
+		return md
+	}
+
+	attr.(*timestampAnchorAttribute).txID = txID
+	return md
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package store
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -75,9 +76,47 @@ func TestTxMetadataWithAttributes(t *testing.T) {
 
 	bs = desmd.Bytes()
 	require.NotNil(t, bs)
+	require.Len(t, bs, attrCodeSize+truncatedUptoTxAttrSize)
+
+	err = desmd.ReadFrom(bs)
+	require.NoError(t, err)
+	require.True(t, desmd.HasTruncatedTxID())
+
+	require.False(t, desmd.HasIndexRoot())
+
+	_, err = desmd.GetIndexRoot()
+	require.ErrorIs(t, err, ErrIndexRootNotPresentInMetadata)
+
+	desmd.WithIndexRoot(sha256.Sum256([]byte("root1")))
+	desmd.WithIndexRoot(sha256.Sum256([]byte("root2")))
+	require.True(t, desmd.HasIndexRoot())
+
+	require.False(t, desmd.HasTimestampAnchoredTxID())
+
+	_, err = desmd.GetTimestampAnchoredTxID()
+	require.ErrorIs(t, err, ErrTimestampAnchorNotPresentInMetadata)
+
+	desmd.WithTimestampAnchoredTxID(5)
+	desmd.WithTimestampAnchoredTxID(7)
+	require.True(t, desmd.HasTimestampAnchoredTxID())
+
+	bs = desmd.Bytes()
 	require.Len(t, bs, maxTxMetadataLen)
 
+	desmd = NewTxMetadata()
+
 	err = desmd.ReadFrom(bs)
 	require.NoError(t, err)
 	require.True(t, desmd.HasTruncatedTxID())
+
+	root, err := desmd.GetIndexRoot()
+	require.NoError(t, err)
+	require.Equal(t, sha256.Sum256([]byte("root2")), root)
+
+	anchoredTxID, err := desmd.GetTimestampAnchoredTxID()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), anchoredTxID)
+
+	err = desmd.ReadFrom(bs[:len(bs)-1])
+	require.ErrorIs(t, err, ErrCorruptedData)
 }
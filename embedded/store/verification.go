@@ -28,6 +28,10 @@ func VerifyInclusion(proof *htree.InclusionProof, entryDigest, root [sha256.Size
 	return htree.VerifyInclusion(proof, entryDigest, root)
 }
 
+func VerifyMultiInclusion(proof *htree.MultiInclusionProof, entryDigests [][sha256.Size]byte, root [sha256.Size]byte) bool {
+	return htree.VerifyMultiInclusion(proof, entryDigests, root)
+}
+
 func advanceLinearHash(alh [sha256.Size]byte, txID uint64, term [sha256.Size]byte) [sha256.Size]byte {
 	var bs [txIDSize + 2*sha256.Size]byte
 	binary.BigEndian.PutUint64(bs[:], txID)
@@ -234,6 +238,81 @@ func VerifyDualProof(proof *DualProof, sourceTxID, targetTxID uint64, sourceAlh,
 	return true
 }
 
+// VerifyIndexProof verifies the key was last written at txID as of the transaction the index root was committed into.
+// A txID equal to zero verifies the key was never written.
+func VerifyIndexProof(proof *IndexProof, key []byte, txID uint64, root [sha256.Size]byte) bool {
+	if proof == nil || len(proof.Siblings) > 8*sha256.Size {
+		return false
+	}
+
+	keyDigest := sha256.Sum256(key)
+
+	var digest [sha256.Size]byte
+
+	if txID == 0 {
+		// the path either ends in an empty subtree or in the leaf of another key sharing the same path
+		if proof.Leaf != nil {
+			if proof.Leaf.KeyDigest == keyDigest {
+				return false
+			}
+
+			for i := range proof.Siblings {
+				if indexBitAt(proof.Leaf.KeyDigest, i) != indexBitAt(keyDigest, i) {
+					return false
+				}
+			}
+
+			digest = indexLeafDigest(proof.Leaf.KeyDigest, proof.Leaf.TxID, proof.Leaf.Revision)
+		}
+	} else {
+		if proof.Leaf == nil || proof.Leaf.KeyDigest != keyDigest || proof.Leaf.TxID != txID {
+			return false
+		}
+
+		digest = indexLeafDigest(keyDigest, txID, proof.Leaf.Revision)
+	}
+
+	for i := len(proof.Siblings) - 1; i >= 0; i-- {
+		if indexBitAt(keyDigest, i) == 0 {
+			digest = indexInnerDigest(digest, proof.Siblings[i])
+		} else {
+			digest = indexInnerDigest(proof.Siblings[i], digest)
+		}
+	}
+
+	return digest == root
+}
+
+// VerifyTxLinkProof verifies the header included in the proof is linked into the target transaction,
+// the target transaction must have been already verified
+func VerifyTxLinkProof(proof *TxLinkProof, targetTxHdr *TxHeader) bool {
+	if proof == nil || proof.TxHeader == nil || targetTxHdr == nil {
+		return false
+	}
+
+	if proof.TxHeader.ID == 0 || proof.TxHeader.ID > targetTxHdr.ID {
+		return false
+	}
+
+	if proof.TxHeader.ID < targetTxHdr.BlTxID {
+		return ahtree.VerifyInclusion(
+			proof.InclusionProof,
+			proof.TxHeader.ID,
+			targetTxHdr.BlTxID,
+			leafFor(proof.TxHeader.Alh()),
+			targetTxHdr.BlRoot,
+		)
+	}
+
+	return VerifyLinearProof(
+		proof.LinearProof,
+		proof.TxHeader.ID,
+		targetTxHdr.ID,
+		proof.TxHeader.Alh(),
+		targetTxHdr.Alh(),
+	)
+}
+
 func leafFor(d [sha256.Size]byte) [sha256.Size]byte {
 	var b [1 + sha256.Size]byte
 	b[0] = ahtree.LeafPrefix
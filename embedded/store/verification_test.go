@@ -191,3 +191,53 @@ func TestVerifyDualProofWithAdditionalLinearInclusionProof(t *testing.T) {
 	})
 
 }
+
+func TestVerifyTxLinkProof(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "data")
+	copier := fs.NewStandardCopier()
+	require.NoError(t, copier.CopyDir("../../test/data_long_linear_proof", dir))
+
+	opts := DefaultOptions().WithSynced(false).WithMaxConcurrency(1)
+	immuStore, err := Open(dir, opts)
+	require.NoError(t, err)
+	defer immustoreClose(t, immuStore)
+
+	maxTxID := immuStore.TxCount()
+
+	t.Run("edge cases", func(t *testing.T) {
+		hdr, err := immuStore.ReadTxHeader(1, false, false)
+		require.NoError(t, err)
+
+		_, err = immuStore.TxLinkProof(nil, hdr)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		targetHdr, err := immuStore.ReadTxHeader(2, false, false)
+		require.NoError(t, err)
+
+		_, err = immuStore.TxLinkProof(targetHdr, hdr)
+		require.ErrorIs(t, err, ErrSourceTxNewerThanTargetTx)
+
+		require.False(t, VerifyTxLinkProof(nil, targetHdr))
+		require.False(t, VerifyTxLinkProof(&TxLinkProof{}, targetHdr))
+		require.False(t, VerifyTxLinkProof(&TxLinkProof{TxHeader: targetHdr}, hdr))
+	})
+
+	t.Run("exhaustive link proof check", func(t *testing.T) {
+		for targetTxID := uint64(1); targetTxID <= maxTxID; targetTxID++ {
+			targetHdr, err := immuStore.ReadTxHeader(targetTxID, false, false)
+			require.NoError(t, err)
+
+			for txID := uint64(1); txID <= targetTxID; txID++ {
+				hdr, err := immuStore.ReadTxHeader(txID, false, false)
+				require.NoError(t, err)
+
+				proof, err := immuStore.TxLinkProof(hdr, targetHdr)
+				require.NoError(t, err)
+				require.True(t, VerifyTxLinkProof(proof, targetHdr))
+
+				proof.TxHeader.Ts++
+				require.False(t, VerifyTxLinkProof(proof, targetHdr))
+			}
+		}
+	})
+}
@@ -0,0 +1,558 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/codenotary/immudb/embedded/appendable"
+)
+
+const bloomFolder = "bloom" // bloom filters are snapshot-agnostic / compaction-agnostic as keys are never removed from the tree
+
+const maxBloomHashCount = 30
+
+// bloomFilter is a probabilistic set of keys, lookups may return false positives but never false negatives
+type bloomFilter struct {
+	bits      []byte
+	hashCount int
+	keyCount  int
+}
+
+func newBloomFilter(capacity, bitsPerKey int) *bloomFilter {
+	bitCount := capacity * bitsPerKey
+	if bitCount < 64 {
+		bitCount = 64
+	}
+
+	hashCount := int(math.Round(float64(bitsPerKey) * math.Ln2))
+	if hashCount < 1 {
+		hashCount = 1
+	}
+	if hashCount > maxBloomHashCount {
+		hashCount = maxBloomHashCount
+	}
+
+	return &bloomFilter{
+		bits:      make([]byte, (bitCount+7)/8),
+		hashCount: hashCount,
+	}
+}
+
+func bloomHash(key []byte) (h1, h2 uint32) {
+	h := fnv.New64a()
+	h.Write(key)
+	sum := h.Sum64()
+
+	return uint32(sum), uint32(sum>>32) | 1
+}
+
+func (f *bloomFilter) add(h1, h2 uint32) {
+	bitCount := uint32(len(f.bits) * 8)
+
+	for i := 0; i < f.hashCount; i++ {
+		b := (h1 + uint32(i)*h2) % bitCount
+		f.bits[b/8] |= 1 << (b % 8)
+	}
+
+	f.keyCount++
+}
+
+func (f *bloomFilter) mayContain(h1, h2 uint32) bool {
+	bitCount := uint32(len(f.bits) * 8)
+
+	for i := 0; i < f.hashCount; i++ {
+		b := (h1 + uint32(i)*h2) % bitCount
+		if f.bits[b/8]&(1<<(b%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bloomFilters holds the keys inserted into the tree in a sequence of fixed-capacity filters,
+// a new filter is added once the current one is full so as to keep the false positive rate bounded.
+// Prefixes of the configured lengths are added alongside each key so as to resolve prefix lookups as well.
+//
+// Filters modified since the last flush are appended to the bloom log alongside the nodes log,
+// each record holds the timestamp of the flushed tree followed by the modified filters and a checksum:
+// ts(8) | prefixCount(4) | [prefixLen(4)]* | filterCount(4) | [filterIndex(4) | hashCount(4) | keyCount(4) | bitsLen(4) | bits]* | sha256(32)
+//
+// The log is rewritten from the beginning with all the filters once it grows beyond bloomLogCompactionFactor times their size,
+// leftovers of previous records are discarded when loading the log.
+type bloomFilters struct {
+	capacity      int
+	bitsPerKey    int
+	prefixLengths []int
+
+	filters []*bloomFilter
+	dirty   map[int]struct{}
+
+	bLog              appendable.Appendable
+	committedBLogSize int64
+	ts                uint64 // timestamp of the last persisted record
+
+	mutex sync.RWMutex
+}
+
+const bloomLogCompactionFactor = 2
+
+const maxBloomPrefixLengths = 64
+
+func newBloomFilters(capacity, bitsPerKey int, prefixLengths []int) *bloomFilters {
+	return &bloomFilters{
+		capacity:      capacity,
+		bitsPerKey:    bitsPerKey,
+		prefixLengths: prefixLengths,
+		dirty:         make(map[int]struct{}),
+	}
+}
+
+// entriesOf returns the key followed by its prefixes of the configured lengths
+func (bf *bloomFilters) entriesOf(key []byte) [][]byte {
+	entries := [][]byte{key}
+
+	for _, l := range bf.prefixLengths {
+		if l < len(key) {
+			entries = append(entries, key[:l])
+		}
+	}
+
+	return entries
+}
+
+// tracksPrefixLength returns true if prefixes of the given length are added into the filters
+func (bf *bloomFilters) tracksPrefixLength(l int) bool {
+	for _, pl := range bf.prefixLengths {
+		if pl == l {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bf *bloomFilters) add(key []byte) {
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	for _, e := range bf.entriesOf(key) {
+		h1, h2 := bloomHash(e)
+
+		if len(bf.filters) == 0 || bf.filters[len(bf.filters)-1].keyCount >= bf.capacity {
+			bf.filters = append(bf.filters, newBloomFilter(bf.capacity, bf.bitsPerKey))
+		}
+
+		i := len(bf.filters) - 1
+
+		bf.filters[i].add(h1, h2)
+		bf.dirty[i] = struct{}{}
+	}
+}
+
+func (bf *bloomFilters) mayContain(key []byte) bool {
+	h1, h2 := bloomHash(key)
+
+	bf.mutex.RLock()
+	defer bf.mutex.RUnlock()
+
+	for _, f := range bf.filters {
+		if f.mayContain(h1, h2) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mayContain checks the filters, if enabled, before looking up a key in the tree
+func (t *TBtree) mayContain(key []byte) bool {
+	if t.bloom == nil {
+		return true
+	}
+
+	metricsBloomFilterChecks.WithLabelValues(t.path).Inc()
+
+	if t.bloom.mayContain(key) {
+		return true
+	}
+
+	metricsBloomFilterHits.WithLabelValues(t.path).Inc()
+
+	return false
+}
+
+// mayContainPrefix checks the filters, if enabled and tracking prefixes of such length, before looking up a prefix in the tree
+func (t *TBtree) mayContainPrefix(prefix []byte) bool {
+	if t.bloom == nil || !t.bloom.tracksPrefixLength(len(prefix)) {
+		return true
+	}
+
+	return t.mayContain(prefix)
+}
+
+// load replays the bloom log, partially written, corrupted or outdated records are discarded
+func (bf *bloomFilters) load(bLog appendable.Appendable) error {
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	bLogSize, err := bLog.Size()
+	if err != nil {
+		return err
+	}
+
+	r := appendable.NewReaderFrom(bLog, 0, 4096)
+
+	var off int64
+
+	for off < bLogSize {
+		rec, err := readBloomRecord(r, bLogSize-off)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if rec.ts < bf.ts || !equalLengths(rec.prefixLengths, bf.prefixLengths) {
+			// leftovers from before the log was rewritten or filters of a different setup
+			break
+		}
+
+		for i, f := range rec.filters {
+			for len(bf.filters) <= i {
+				bf.filters = append(bf.filters, nil)
+			}
+			bf.filters[i] = f
+		}
+
+		bf.ts = rec.ts
+		off = r.ReadCount()
+	}
+
+	for _, f := range bf.filters {
+		if f == nil {
+			// filters are always appended in order
+			return ErrCorruptedFile
+		}
+	}
+
+	bf.bLog = bLog
+	bf.committedBLogSize = off
+
+	return bLog.SetOffset(off)
+}
+
+func equalLengths(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+type bloomRecord struct {
+	ts            uint64
+	prefixLengths []int
+	filters       map[int]*bloomFilter
+}
+
+// bloomRecordReader reads the fields of a record while computing its checksum
+type bloomRecordReader struct {
+	r *appendable.Reader
+	h hash.Hash
+}
+
+func (br *bloomRecordReader) read(bs []byte) error {
+	_, err := br.r.Read(bs)
+	if err != nil {
+		return err
+	}
+
+	br.h.Write(bs)
+
+	return nil
+}
+
+func (br *bloomRecordReader) readUint32() (uint32, error) {
+	var b [4]byte
+
+	err := br.read(b[:])
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+// readBloomRecord reads the next record, io.EOF is returned if it's not entirely valid
+func readBloomRecord(r *appendable.Reader, maxSize int64) (*bloomRecord, error) {
+	br := &bloomRecordReader{r: r, h: sha256.New()}
+
+	var tsBs [8]byte
+
+	err := br.read(tsBs[:])
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &bloomRecord{
+		ts:      binary.BigEndian.Uint64(tsBs[:]),
+		filters: make(map[int]*bloomFilter),
+	}
+
+	prefixCount, err := br.readUint32()
+	if err != nil {
+		return nil, err
+	}
+
+	if prefixCount > maxBloomPrefixLengths {
+		return nil, io.EOF
+	}
+
+	for i := 0; i < int(prefixCount); i++ {
+		l, err := br.readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		rec.prefixLengths = append(rec.prefixLengths, int(l))
+	}
+
+	filterCount, err := br.readUint32()
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(filterCount)*16 > maxSize {
+		return nil, io.EOF
+	}
+
+	for i := 0; i < int(filterCount); i++ {
+		filterIndex, err := br.readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		hashCount, err := br.readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		keyCount, err := br.readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		bitsLen, err := br.readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		if int64(bitsLen) > maxSize || hashCount == 0 || hashCount > maxBloomHashCount || bitsLen == 0 {
+			return nil, io.EOF
+		}
+
+		bits := make([]byte, bitsLen)
+
+		err = br.read(bits)
+		if err != nil {
+			return nil, err
+		}
+
+		rec.filters[int(filterIndex)] = &bloomFilter{
+			bits:      bits,
+			hashCount: int(hashCount),
+			keyCount:  int(keyCount),
+		}
+	}
+
+	var checksum [sha256.Size]byte
+
+	_, err = r.Read(checksum[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(checksum[:], br.h.Sum(nil)) {
+		return nil, io.EOF
+	}
+
+	return rec, nil
+}
+
+// recordSize returns the size of the record holding the specified filters
+func (bf *bloomFilters) recordSize(filters map[int]struct{}) int64 {
+	size := 8 + 4 + 4*len(bf.prefixLengths) + 4 + sha256.Size
+
+	for i := range filters {
+		size += 4 + 4 + 4 + 4 + len(bf.filters[i].bits)
+	}
+
+	return int64(size)
+}
+
+func (bf *bloomFilters) record(ts uint64, filters map[int]struct{}) []byte {
+	b := make([]byte, bf.recordSize(filters))
+	o := 0
+
+	binary.BigEndian.PutUint64(b[o:], ts)
+	o += 8
+
+	binary.BigEndian.PutUint32(b[o:], uint32(len(bf.prefixLengths)))
+	o += 4
+
+	for _, l := range bf.prefixLengths {
+		binary.BigEndian.PutUint32(b[o:], uint32(l))
+		o += 4
+	}
+
+	binary.BigEndian.PutUint32(b[o:], uint32(len(filters)))
+	o += 4
+
+	for i := range filters {
+		f := bf.filters[i]
+
+		binary.BigEndian.PutUint32(b[o:], uint32(i))
+		o += 4
+
+		binary.BigEndian.PutUint32(b[o:], uint32(f.hashCount))
+		o += 4
+
+		binary.BigEndian.PutUint32(b[o:], uint32(f.keyCount))
+		o += 4
+
+		binary.BigEndian.PutUint32(b[o:], uint32(len(f.bits)))
+		o += 4
+
+		copy(b[o:], f.bits)
+		o += len(f.bits)
+	}
+
+	checksum := sha256.Sum256(b[:o])
+	copy(b[o:], checksum[:])
+
+	return b
+}
+
+// flush appends the filters modified since the last flush into the bloom log,
+// or rewrites the log with all the filters once it has grown too much.
+// It must be called before committing the tree so as to never load filters missing committed keys.
+func (bf *bloomFilters) flush(ts uint64, sync bool) error {
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	if bf.bLog == nil {
+		return nil
+	}
+
+	off := bf.committedBLogSize
+	filters := bf.dirty
+
+	all := make(map[int]struct{}, len(bf.filters))
+	for i := range bf.filters {
+		all[i] = struct{}{}
+	}
+
+	if off+bf.recordSize(filters) > bloomLogCompactionFactor*bf.recordSize(all) {
+		// a partially rewritten log gets discarded when loading, filters are then rebuilt from the tree
+		off = 0
+		filters = all
+	}
+
+	// will overwrite partially written and uncommitted data
+	err := bf.bLog.SetOffset(off)
+	if err != nil {
+		return err
+	}
+
+	b := bf.record(ts, filters)
+
+	_, _, err = bf.bLog.Append(b)
+	if err != nil {
+		return err
+	}
+
+	err = bf.bLog.Flush()
+	if err != nil {
+		return err
+	}
+
+	if sync {
+		err = bf.bLog.Sync()
+		if err != nil {
+			return err
+		}
+	}
+
+	bf.committedBLogSize = off + int64(len(b))
+	bf.ts = ts
+	bf.dirty = make(map[int]struct{})
+
+	return nil
+}
+
+func (bf *bloomFilters) close() error {
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	if bf.bLog == nil {
+		return nil
+	}
+
+	return bf.bLog.Close()
+}
+
+// addKeysFrom adds all the keys stored in the subtree into the filters
+func (bf *bloomFilters) addKeysFrom(n node) error {
+	switch n := n.(type) {
+	case *leafNode:
+		for _, v := range n.values {
+			bf.add(v.key)
+		}
+	case *innerNode:
+		for _, c := range n.nodes {
+			err := bf.addKeysFrom(c)
+			if err != nil {
+				return err
+			}
+		}
+	case *nodeRef:
+		c, err := n.t.nodeAt(n.off, false)
+		if err != nil {
+			return err
+		}
+
+		return bf.addKeysFrom(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tbtree
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilters(t *testing.T) {
+	bf := newBloomFilters(100, 10, nil)
+
+	for i := 0; i < 1000; i++ {
+		bf.add([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	require.Len(t, bf.filters, 10)
+
+	for i := 0; i < 1000; i++ {
+		require.True(t, bf.mayContain([]byte(fmt.Sprintf("key%d", i))))
+	}
+
+	falsePositives := 0
+
+	for i := 0; i < 1000; i++ {
+		if bf.mayContain([]byte(fmt.Sprintf("missing%d", i))) {
+			falsePositives++
+		}
+	}
+
+	// ~1% per filter with 10 bits per key
+	require.Less(t, falsePositives, 250)
+}
+
+func TestTBTreeWithBloomFilters(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithBloomFilterBitsPerKey(10).
+		WithBloomFilterCapacity(100).
+		WithFlushThld(50)
+
+	tbtree, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	const keyCount = 500
+
+	for i := 0; i < keyCount; i++ {
+		err = tbtree.Insert([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	require.NotNil(t, tbtree.bloom)
+	require.Len(t, tbtree.bloom.filters, keyCount/100)
+
+	checkLookups := func(t *testing.T, tbtree *TBtree) {
+		for i := 0; i < keyCount; i++ {
+			v, _, _, err := tbtree.Get([]byte(fmt.Sprintf("key%04d", i)))
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), v)
+		}
+
+		for i := 0; i < keyCount; i++ {
+			_, _, _, err := tbtree.Get([]byte(fmt.Sprintf("missing%04d", i)))
+			require.ErrorIs(t, err, ErrKeyNotFound)
+		}
+
+		snap, err := tbtree.Snapshot()
+		require.NoError(t, err)
+
+		defer snap.Close()
+
+		_, _, _, err = snap.Get([]byte("missing"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		err = snap.Set([]byte("snapKey"), []byte("snapValue"))
+		require.NoError(t, err)
+
+		v, _, _, err := snap.Get([]byte("snapKey"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("snapValue"), v)
+	}
+
+	t.Run("keys set into snapshots should not be added into the filters of the tree", func(t *testing.T) {
+		keyCount := func() int {
+			tbtree.bloom.mutex.RLock()
+			defer tbtree.bloom.mutex.RUnlock()
+
+			count := 0
+			for _, f := range tbtree.bloom.filters {
+				count += f.keyCount
+			}
+			return count
+		}
+
+		keysBefore := keyCount()
+
+		snap, err := tbtree.Snapshot()
+		require.NoError(t, err)
+
+		err = snap.Set([]byte("uncommittedKey"), []byte("value"))
+		require.NoError(t, err)
+
+		_, _, _, err = snap.Get([]byte("uncommittedKey"))
+		require.NoError(t, err)
+
+		err = snap.Close()
+		require.NoError(t, err)
+
+		require.Equal(t, keysBefore, keyCount())
+	})
+
+	t.Run("lookups should be resolved with bloom filters", func(t *testing.T) {
+		checkLookups(t, tbtree)
+	})
+
+	err = tbtree.Close()
+	require.NoError(t, err)
+
+	require.DirExists(t, filepath.Join(dir, bloomFolder))
+
+	t.Run("persisted bloom filters should be loaded when reopening the tree", func(t *testing.T) {
+		tbtree, err := Open(dir, opts)
+		require.NoError(t, err)
+
+		defer tbtree.Close()
+
+		require.Equal(t, tbtree.Ts(), tbtree.bloom.ts)
+		require.Empty(t, tbtree.bloom.dirty)
+
+		checkLookups(t, tbtree)
+	})
+
+	t.Run("bloom filters should be rebuilt if they are missing", func(t *testing.T) {
+		err := os.RemoveAll(filepath.Join(dir, bloomFolder))
+		require.NoError(t, err)
+
+		tbtree, err := Open(dir, opts)
+		require.NoError(t, err)
+
+		require.Equal(t, tbtree.Ts(), tbtree.bloom.ts)
+
+		checkLookups(t, tbtree)
+
+		err = tbtree.Close()
+		require.NoError(t, err)
+
+		tbtree, err = Open(dir, opts)
+		require.NoError(t, err)
+
+		defer tbtree.Close()
+
+		require.Empty(t, tbtree.bloom.dirty)
+
+		checkLookups(t, tbtree)
+	})
+
+	t.Run("partially written bloom filters should be discarded", func(t *testing.T) {
+		bLogPath := filepath.Join(dir, bloomFolder, "00000000.bf")
+
+		fi, err := os.Stat(bLogPath)
+		require.NoError(t, err)
+
+		err = os.Truncate(bLogPath, fi.Size()-1)
+		require.NoError(t, err)
+
+		tbtree, err := Open(dir, opts)
+		require.NoError(t, err)
+
+		defer tbtree.Close()
+
+		checkLookups(t, tbtree)
+	})
+
+	t.Run("bloom filters should not be used if disabled", func(t *testing.T) {
+		tbtree, err := Open(dir, opts.WithBloomFilterBitsPerKey(0))
+		require.NoError(t, err)
+
+		defer tbtree.Close()
+
+		require.Nil(t, tbtree.bloom)
+
+		checkLookups(t, tbtree)
+	})
+}
+
+func TestTBTreeWithPrefixBloomFilters(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithBloomFilterBitsPerKey(10).
+		WithBloomFilterPrefixLengths(4)
+
+	tbtree, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		err = tbtree.Insert([]byte(fmt.Sprintf("tb%02d/key%d", i%10, i)), []byte("value"))
+		require.NoError(t, err)
+	}
+
+	checkLookups := func(t *testing.T, tbtree *TBtree) {
+		for i := 0; i < 10; i++ {
+			prefix := []byte(fmt.Sprintf("tb%02d", i))
+
+			require.True(t, tbtree.mayContainPrefix(prefix))
+
+			k, _, _, _, err := tbtree.GetWithPrefix(prefix, nil)
+			require.NoError(t, err)
+			require.Equal(t, prefix, k[:len(prefix)])
+		}
+
+		// prefixes of tracked length are resolved by the filters
+		require.False(t, tbtree.mayContainPrefix([]byte("zz00")))
+
+		_, _, _, _, err := tbtree.GetWithPrefix([]byte("zz00"), nil)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, _, _, _, err = tbtree.GetWithPrefix([]byte("tb"), nil)
+		require.NoError(t, err)
+
+		snap, err := tbtree.Snapshot()
+		require.NoError(t, err)
+
+		defer snap.Close()
+
+		err = snap.Set([]byte("sn00/key"), []byte("value"))
+		require.NoError(t, err)
+
+		k, _, _, _, err := snap.GetWithPrefix([]byte("sn00"), nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("sn00/key"), k)
+	}
+
+	checkLookups(t, tbtree)
+
+	// prefixes of other lengths are looked up in the tree
+	require.True(t, tbtree.mayContainPrefix([]byte("zz")))
+
+	err = tbtree.Close()
+	require.NoError(t, err)
+
+	t.Run("filters should be rebuilt if tracked prefix lengths change", func(t *testing.T) {
+		tbtree, err := Open(dir, opts.WithBloomFilterPrefixLengths(2, 4))
+		require.NoError(t, err)
+
+		defer tbtree.Close()
+
+		require.Equal(t, []int{2, 4}, tbtree.bloom.prefixLengths)
+		require.Equal(t, tbtree.Ts(), tbtree.bloom.ts)
+
+		checkLookups(t, tbtree)
+
+		require.False(t, tbtree.mayContainPrefix([]byte("zz")))
+	})
+}
+
+func TestBloomLogCompaction(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := DefaultOptions().
+		WithBloomFilterBitsPerKey(10).
+		WithBloomFilterCapacity(1000).
+		WithFlushThld(1)
+
+	tbtree, err := Open(dir, opts)
+	require.NoError(t, err)
+
+	all := func() map[int]struct{} {
+		all := make(map[int]struct{})
+		for i := range tbtree.bloom.filters {
+			all[i] = struct{}{}
+		}
+		return all
+	}
+
+	// each insertion flushes the tree and thus the dirty filter
+	for i := 0; i < 100; i++ {
+		err = tbtree.Insert([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		require.NoError(t, err)
+
+		require.LessOrEqual(t, tbtree.bloom.committedBLogSize, bloomLogCompactionFactor*tbtree.bloom.recordSize(all()))
+	}
+
+	err = tbtree.Close()
+	require.NoError(t, err)
+
+	// leftovers of records written before rewriting the log are discarded
+	tbtree, err = Open(dir, opts)
+	require.NoError(t, err)
+
+	defer tbtree.Close()
+
+	require.Equal(t, tbtree.Ts(), tbtree.bloom.ts)
+
+	for i := 0; i < 100; i++ {
+		_, _, _, err := tbtree.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.NoError(t, err)
+	}
+}
@@ -117,3 +117,13 @@ var metricsBtreeNodesDataEndOffset = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Name: "immudb_btree_nodes_data_end",
 	Help: "End offset for btree nodes data appendable",
 }, []string{"id"})
+
+var metricsBloomFilterChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_btree_bloom_filter_checks",
+	Help: "Number of key lookups checked against btree bloom filters",
+}, []string{"id"})
+
+var metricsBloomFilterHits = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "immudb_btree_bloom_filter_hits",
+	Help: "Number of key lookups resolved by btree bloom filters without descending the btree, hit rate is given by hits over checks",
+}, []string{"id"})
@@ -40,6 +40,8 @@ const DefaultMaxKeySize = 1024
 const DefaultMaxValueSize = 512
 const DefaultCompactionThld = 2
 const DefaultDelayDuringCompaction = time.Duration(10) * time.Millisecond
+const DefaultBloomFilterBitsPerKey = 0 // bloom filters are disabled by default
+const DefaultBloomFilterCapacity = 100_000
 
 const DefaultNodesLogMaxOpenedFiles = 10
 const DefaultHistoryLogMaxOpenedFiles = 1
@@ -73,6 +75,10 @@ type Options struct {
 	compactionThld        int
 	delayDuringCompaction time.Duration
 
+	bloomFilterBitsPerKey    int
+	bloomFilterCapacity      int
+	bloomFilterPrefixLengths []int
+
 	// options below are only set during initialization and stored as metadata
 	maxNodeSize  int
 	maxKeySize   int
@@ -96,6 +102,8 @@ func DefaultOptions() *Options {
 		fileMode:              DefaultFileMode,
 		compactionThld:        DefaultCompactionThld,
 		delayDuringCompaction: DefaultDelayDuringCompaction,
+		bloomFilterBitsPerKey: DefaultBloomFilterBitsPerKey,
+		bloomFilterCapacity:   DefaultBloomFilterCapacity,
 
 		nodesLogMaxOpenedFiles:   DefaultNodesLogMaxOpenedFiles,
 		historyLogMaxOpenedFiles: DefaultHistoryLogMaxOpenedFiles,
@@ -178,6 +186,24 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid CompactionThld", ErrInvalidOptions)
 	}
 
+	if opts.bloomFilterBitsPerKey < 0 {
+		return fmt.Errorf("%w: invalid BloomFilterBitsPerKey", ErrInvalidOptions)
+	}
+
+	if opts.bloomFilterCapacity <= 0 {
+		return fmt.Errorf("%w: invalid BloomFilterCapacity", ErrInvalidOptions)
+	}
+
+	if len(opts.bloomFilterPrefixLengths) > maxBloomPrefixLengths {
+		return fmt.Errorf("%w: invalid BloomFilterPrefixLengths", ErrInvalidOptions)
+	}
+
+	for _, l := range opts.bloomFilterPrefixLengths {
+		if l <= 0 || l > opts.maxKeySize {
+			return fmt.Errorf("%w: invalid BloomFilterPrefixLengths", ErrInvalidOptions)
+		}
+	}
+
 	if opts.logger == nil {
 		return fmt.Errorf("%w: invalid Logger", ErrInvalidOptions)
 	}
@@ -284,3 +310,24 @@ func (opts *Options) WithDelayDuringCompaction(delay time.Duration) *Options {
 	opts.delayDuringCompaction = delay
 	return opts
 }
+
+// WithBloomFilterBitsPerKey enables bloom filters when a positive number of bits per key is specified.
+// Filters are used to skip lookups of missing keys, prefix lookups are only resolved by them
+// when prefixes of such length are tracked.
+func (opts *Options) WithBloomFilterBitsPerKey(bitsPerKey int) *Options {
+	opts.bloomFilterBitsPerKey = bitsPerKey
+	return opts
+}
+
+// WithBloomFilterCapacity sets the number of keys held by each bloom filter
+func (opts *Options) WithBloomFilterCapacity(capacity int) *Options {
+	opts.bloomFilterCapacity = capacity
+	return opts
+}
+
+// WithBloomFilterPrefixLengths sets the lengths of the key prefixes added into the bloom filters,
+// lookups of prefixes of such lengths are then resolved by the filters as well
+func (opts *Options) WithBloomFilterPrefixLengths(lengths ...int) *Options {
+	opts.bloomFilterPrefixLengths = lengths
+	return opts
+}
@@ -44,6 +44,10 @@ func TestInvalidOptions(t *testing.T) {
 		{"RenewSnapRootAfter", DefaultOptions().WithRenewSnapRootAfter(-1)},
 		{"CacheSize", DefaultOptions().WithCacheSize(0)},
 		{"CompactionThld", DefaultOptions().WithCompactionThld(-1)},
+		{"BloomFilterBitsPerKey", DefaultOptions().WithBloomFilterBitsPerKey(-1)},
+		{"BloomFilterCapacity", DefaultOptions().WithBloomFilterCapacity(0)},
+		{"BloomFilterPrefixLengths", DefaultOptions().WithBloomFilterPrefixLengths(0)},
+		{"BloomFilterPrefixLengths>MaxKeySize", DefaultOptions().WithBloomFilterPrefixLengths(DefaultMaxKeySize + 1)},
 		{"MaxKeySize", DefaultOptions().WithMaxKeySize(0)},
 		{"MaxValueSize", DefaultOptions().WithMaxValueSize(0)},
 		{"MaxNodeSize", DefaultOptions().WithMaxNodeSize(requiredNodeSize(DefaultMaxKeySize, DefaultMaxValueSize) - 1)},
@@ -81,6 +85,9 @@ func TestValidOptions(t *testing.T) {
 
 	require.Equal(t, 1, opts.WithCompactionThld(1).compactionThld)
 	require.Equal(t, time.Duration(1)*time.Millisecond, opts.WithDelayDuringCompaction(time.Duration(1)*time.Millisecond).delayDuringCompaction)
+	require.Equal(t, 10, opts.WithBloomFilterBitsPerKey(10).bloomFilterBitsPerKey)
+	require.Equal(t, 1000, opts.WithBloomFilterCapacity(1000).bloomFilterCapacity)
+	require.Equal(t, []int{4}, opts.WithBloomFilterPrefixLengths(4).bloomFilterPrefixLengths)
 	require.False(t, opts.WithReadOnly(false).readOnly)
 	require.NotNil(t, opts.WithLogger(DefaultOptions().logger))
 
@@ -38,6 +38,9 @@ type Snapshot struct {
 	maxReaderID int
 	closed      bool
 
+	// keys set into the snapshot, and their prefixes, are not added into the bloom filters of the tree
+	localKeys map[string]struct{}
+
 	_buf []byte
 
 	mutex sync.RWMutex
@@ -58,6 +61,17 @@ func (s *Snapshot) Set(key, value []byte) error {
 		return err
 	}
 
+	if s.t.bloom != nil {
+		// keys set into the snapshot must not be filtered out by snapshot lookups
+		if s.localKeys == nil {
+			s.localKeys = make(map[string]struct{})
+		}
+
+		for _, e := range s.t.bloom.entriesOf(k) {
+			s.localKeys[string(e)] = struct{}{}
+		}
+	}
+
 	for len(nodes) > 1 {
 		newRoot := &innerNode{
 			t:     s.t,
@@ -81,6 +95,95 @@ func (s *Snapshot) Set(key, value []byte) error {
 	return nil
 }
 
+// SnapshotState holds a copy of the content of a snapshot, including local changes made to it
+type SnapshotState struct {
+	root node
+}
+
+// SaveState returns a copy of the current content of the snapshot.
+// Only locally modified nodes are copied, nodes shared with the tree are immutable.
+func (s *Snapshot) SaveState() (*SnapshotState, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.closed {
+		return nil, ErrAlreadyClosed
+	}
+
+	return &SnapshotState{root: cloneMutated(s.root)}, nil
+}
+
+// RestoreState discards all the changes made to the snapshot since the state was saved.
+// The same state may be restored multiple times.
+func (s *Snapshot) RestoreState(state *SnapshotState) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return ErrAlreadyClosed
+	}
+
+	if state == nil {
+		return ErrIllegalArguments
+	}
+
+	s.root = cloneMutated(state.root)
+
+	return nil
+}
+
+func cloneMutated(n node) node {
+	switch n := n.(type) {
+	case *innerNode:
+		if !n.mut {
+			return n
+		}
+
+		newNode := &innerNode{
+			t:       n.t,
+			nodes:   make([]node, len(n.nodes)),
+			_ts:     n._ts,
+			_minOff: n._minOff,
+			mut:     true,
+		}
+
+		for i, c := range n.nodes {
+			newNode.nodes[i] = cloneMutated(c)
+		}
+
+		return newNode
+	case *leafNode:
+		if !n.mut {
+			return n
+		}
+
+		newLeaf := &leafNode{
+			t:      n.t,
+			values: make([]*leafValue, len(n.values)),
+			_ts:    n._ts,
+			mut:    true,
+		}
+
+		for i, lv := range n.values {
+			tss := make([]uint64, len(lv.tss))
+			copy(tss, lv.tss)
+
+			newLeaf.values[i] = &leafValue{
+				key:    lv.key,
+				value:  lv.value,
+				ts:     lv.ts,
+				tss:    tss,
+				hOff:   lv.hOff,
+				hCount: lv.hCount,
+			}
+		}
+
+		return newLeaf
+	}
+
+	return n
+}
+
 func (s *Snapshot) Get(key []byte) (value []byte, ts uint64, hc uint64, err error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
@@ -93,10 +196,32 @@ func (s *Snapshot) Get(key []byte) (value []byte, ts uint64, hc uint64, err erro
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !s.mayContain(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	v, ts, hc, err := s.root.get(key)
 	return cp(v), ts, hc, err
 }
 
+// mayContain checks the keys set into the snapshot and the bloom filters of the tree
+func (s *Snapshot) mayContain(key []byte) bool {
+	if _, ok := s.localKeys[string(key)]; ok {
+		return true
+	}
+
+	return s.t.mayContain(key)
+}
+
+// mayContainPrefix checks the prefixes of the keys set into the snapshot and the bloom filters of the tree
+func (s *Snapshot) mayContainPrefix(prefix []byte) bool {
+	if _, ok := s.localKeys[string(prefix)]; ok {
+		return true
+	}
+
+	return s.t.mayContainPrefix(prefix)
+}
+
 func (s *Snapshot) History(key []byte, offset uint64, descOrder bool, limit int) (tss []uint64, hCount uint64, err error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
@@ -131,6 +256,10 @@ func (s *Snapshot) GetWithPrefix(prefix []byte, neq []byte) (key []byte, value [
 		return nil, nil, 0, 0, ErrAlreadyClosed
 	}
 
+	if !s.mayContainPrefix(prefix) {
+		return nil, nil, 0, 0, ErrKeyNotFound
+	}
+
 	_, leaf, off, err := s.root.findLeafNode(prefix, nil, 0, neq, false)
 	if err != nil {
 		return nil, nil, 0, 0, err
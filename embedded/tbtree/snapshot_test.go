@@ -18,6 +18,7 @@ package tbtree
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -228,3 +229,67 @@ func TestSnapshotIsolation(t *testing.T) {
 	err = tbtree.Close()
 	require.NoError(t, err)
 }
+
+func TestSnapshotSaveAndRestoreState(t *testing.T) {
+	tbtree, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	defer tbtree.Close()
+
+	err = tbtree.Insert([]byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	snap, err := tbtree.Snapshot()
+	require.NoError(t, err)
+
+	err = snap.RestoreState(nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	err = snap.Set([]byte("key2"), []byte("value2"))
+	require.NoError(t, err)
+
+	state, err := snap.SaveState()
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		err = snap.Set([]byte("key1"), []byte("value1_updated"))
+		require.NoError(t, err)
+
+		for j := 0; j < 100; j++ {
+			err = snap.Set([]byte(fmt.Sprintf("key3_%d", j)), []byte("value3"))
+			require.NoError(t, err)
+		}
+
+		v, _, hc, err := snap.Get([]byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1_updated"), v)
+		require.Equal(t, uint64(2), hc)
+
+		err = snap.RestoreState(state)
+		require.NoError(t, err)
+
+		v, _, hc, err = snap.Get([]byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), v)
+		require.Equal(t, uint64(1), hc)
+
+		v, _, _, err = snap.Get([]byte("key2"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), v)
+
+		_, _, _, err = snap.Get([]byte("key3_0"))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	}
+
+	_, _, _, err = tbtree.Get([]byte("key2"))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	err = snap.Close()
+	require.NoError(t, err)
+
+	_, err = snap.SaveState()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	err = snap.RestoreState(state)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
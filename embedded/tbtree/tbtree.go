@@ -212,6 +212,13 @@ type TBtree struct {
 
 	compacting bool
 
+	flushDeferred bool // automatic flushing is disabled while loading data in bulk
+
+	bloom                    *bloomFilters // nil when bloom filters are disabled
+	bloomFilterBitsPerKey    int
+	bloomFilterCapacity      int
+	bloomFilterPrefixLengths []int
+
 	closed  bool
 	rwmutex sync.RWMutex
 }
@@ -397,6 +404,12 @@ func Open(path string, opts *Options) (*TBtree, error) {
 
 		opts.logger.Infof("Successfully read snapshots at '%s'", snapPath)
 
+		err = t.openBloomFilters(appFactory, appendableOpts)
+		if err != nil {
+			t.Close()
+			return nil, err
+		}
+
 		// Discard older snapshots upon successful validation
 		err = discardSnapshots(path, snapIDs[:i-1], opts.logger)
 		if err != nil {
@@ -427,7 +440,65 @@ func Open(path string, opts *Options) (*TBtree, error) {
 		return nil, err
 	}
 
-	return OpenWith(path, nLog, hLog, cLog, opts)
+	t, err := OpenWith(path, nLog, hLog, cLog, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.openBloomFilters(appFactory, appendableOpts)
+	if err != nil {
+		t.Close()
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// openBloomFilters loads the bloom filters persisted alongside the nodes log.
+// Filters are rebuilt from the tree if they do not include all the keys of the tree e.g. if they were just enabled.
+func (t *TBtree) openBloomFilters(appFactory AppFactoryFunc, appendableOpts *multiapp.Options) error {
+	if t.bloomFilterBitsPerKey == 0 || t.readOnly {
+		return nil
+	}
+
+	appendableOpts.WithFileExt("bf")
+	appendableOpts.WithMaxOpenedFiles(1)
+	bLog, err := appFactory(t.path, bloomFolder, appendableOpts)
+	if err != nil {
+		return err
+	}
+
+	bloom := newBloomFilters(t.bloomFilterCapacity, t.bloomFilterBitsPerKey, t.bloomFilterPrefixLengths)
+
+	err = bloom.load(bLog)
+	if err != nil && !errors.Is(err, ErrCorruptedFile) {
+		bLog.Close()
+		return err
+	}
+
+	if err != nil || bloom.ts < t.root.ts() {
+		t.logger.Infof("Rebuilding bloom filters of index '%s' {ts=%d}...", t.path, t.root.ts())
+
+		bloom = newBloomFilters(t.bloomFilterCapacity, t.bloomFilterBitsPerKey, t.bloomFilterPrefixLengths)
+		bloom.bLog = bLog
+
+		err = bloom.addKeysFrom(t.root)
+		if err != nil {
+			bLog.Close()
+			return err
+		}
+
+		// filters are persisted from scratch
+		err = bloom.flush(t.root.ts(), true)
+		if err != nil {
+			bLog.Close()
+			return err
+		}
+	}
+
+	t.bloom = bloom
+
+	return nil
 }
 
 func snapFolder(folder string, snapID uint64) string {
@@ -572,6 +643,9 @@ func OpenWith(path string, nLog, hLog, cLog appendable.Appendable, opts *Options
 		nodesLogMaxOpenedFiles:   opts.nodesLogMaxOpenedFiles,
 		historyLogMaxOpenedFiles: opts.historyLogMaxOpenedFiles,
 		commitLogMaxOpenedFiles:  opts.commitLogMaxOpenedFiles,
+		bloomFilterBitsPerKey:    opts.bloomFilterBitsPerKey,
+		bloomFilterCapacity:      opts.bloomFilterCapacity,
+		bloomFilterPrefixLengths: opts.bloomFilterPrefixLengths,
 		readOnly:                 opts.readOnly,
 		snapshots:                make(map[uint64]*Snapshot),
 	}
@@ -707,7 +781,10 @@ func (t *TBtree) GetOptions() *Options {
 		WithDelayDuringCompaction(t.delayDuringCompaction).
 		WithNodesLogMaxOpenedFiles(t.nodesLogMaxOpenedFiles).
 		WithHistoryLogMaxOpenedFiles(t.historyLogMaxOpenedFiles).
-		WithCommitLogMaxOpenedFiles(t.commitLogMaxOpenedFiles)
+		WithCommitLogMaxOpenedFiles(t.commitLogMaxOpenedFiles).
+		WithBloomFilterBitsPerKey(t.bloomFilterBitsPerKey).
+		WithBloomFilterCapacity(t.bloomFilterCapacity).
+		WithBloomFilterPrefixLengths(t.bloomFilterPrefixLengths...)
 }
 
 func (t *TBtree) cachePut(n node) {
@@ -935,6 +1012,10 @@ func (t *TBtree) Get(key []byte) (value []byte, ts uint64, hc uint64, err error)
 		return nil, 0, 0, ErrIllegalArguments
 	}
 
+	if !t.mayContain(key) {
+		return nil, 0, 0, ErrKeyNotFound
+	}
+
 	v, ts, hc, err := t.root.get(key)
 	return cp(v), ts, hc, err
 }
@@ -966,6 +1047,10 @@ func (t *TBtree) GetWithPrefix(prefix []byte, neq []byte) (key []byte, value []b
 		return nil, nil, 0, 0, ErrAlreadyClosed
 	}
 
+	if !t.mayContainPrefix(prefix) {
+		return nil, nil, 0, 0, ErrKeyNotFound
+	}
+
 	path, leaf, off, err := t.root.findLeafNode(prefix, nil, 0, neq, false)
 	if err != nil {
 		return nil, nil, 0, 0, err
@@ -1117,6 +1202,13 @@ func (t *TBtree) flushTree(cleanupPercentageHint float32, forceSync bool, forceC
 		}
 	}
 
+	if t.bloom != nil {
+		err = t.bloom.flush(t.root.ts(), sync)
+		if err != nil {
+			return 0, 0, t.wrapNwarn("Flushing bloom filters of index '%s' {ts=%d} returned: %v", t.path, t.root.ts(), err)
+		}
+	}
+
 	// will overwrite partially written and uncommitted data
 	err = t.cLog.SetOffset(t.committedLogSize)
 	if err != nil {
@@ -1517,6 +1609,11 @@ func (t *TBtree) Close() error {
 	err = t.cLog.Close()
 	merrors.Append(err)
 
+	if t.bloom != nil {
+		err = t.bloom.close()
+		merrors.Append(err)
+	}
+
 	err = merrors.Reduce()
 	if err != nil {
 		return t.wrapNwarn("Closing index '%s' {ts=%d} returned: %v", t.path, t.root.ts(), err)
@@ -1545,7 +1642,7 @@ func (t *TBtree) IncreaseTs(ts uint64) error {
 	t.insertionCountSinceSync++
 	t.insertionCountSinceCleanup++
 
-	if t.insertionCountSinceFlush >= t.flushThld {
+	if !t.flushDeferred && t.insertionCountSinceFlush >= t.flushThld {
 		_, _, err := t.flushTree(t.cleanupPercentage, false, false, "IncreaseTs")
 		return err
 	}
@@ -1553,6 +1650,41 @@ func (t *TBtree) IncreaseTs(ts uint64) error {
 	return nil
 }
 
+// DeferFlush disables automatic flushing of the tree until ResumeFlush is called.
+// It's meant to be used when inserting large amounts of data, mutated nodes are kept in memory meanwhile.
+func (t *TBtree) DeferFlush() error {
+	t.rwmutex.Lock()
+	defer t.rwmutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	t.flushDeferred = true
+
+	return nil
+}
+
+// ResumeFlush enables automatic flushing again, the tree is flushed
+// if the flush threshold was reached while flushing was deferred.
+func (t *TBtree) ResumeFlush() error {
+	t.rwmutex.Lock()
+	defer t.rwmutex.Unlock()
+
+	if t.closed {
+		return ErrAlreadyClosed
+	}
+
+	t.flushDeferred = false
+
+	if t.insertionCountSinceFlush >= t.flushThld {
+		_, _, err := t.flushTree(t.cleanupPercentage, false, false, "ResumeFlush")
+		return err
+	}
+
+	return nil
+}
+
 type KVT struct {
 	K []byte
 	V []byte
@@ -1684,13 +1816,19 @@ func (t *TBtree) bulkInsert(kvts []*KVT) error {
 
 	t.root = nodes[0]
 
+	if t.bloom != nil {
+		for _, kvt := range immutableKVTs {
+			t.bloom.add(kvt.K)
+		}
+	}
+
 	metricsBtreeDepth.WithLabelValues(t.path).Set(float64(depth))
 
 	t.insertionCountSinceFlush += len(immutableKVTs)
 	t.insertionCountSinceSync += len(immutableKVTs)
 	t.insertionCountSinceCleanup += len(immutableKVTs)
 
-	if t.insertionCountSinceFlush >= t.flushThld {
+	if !t.flushDeferred && t.insertionCountSinceFlush >= t.flushThld {
 		_, _, err := t.flushTree(t.cleanupPercentage, false, false, "BulkInsert")
 		return err
 	}
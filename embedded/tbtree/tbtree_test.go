@@ -1237,6 +1237,45 @@ func TestTBTreeIncreaseTs(t *testing.T) {
 	require.ErrorIs(t, err, ErrAlreadyClosed)
 }
 
+func TestTBTreeDeferFlush(t *testing.T) {
+	tbtree, err := Open(t.TempDir(), DefaultOptions().WithFlushThld(2))
+	require.NoError(t, err)
+
+	err = tbtree.DeferFlush()
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		err = tbtree.BulkInsert([]*KVT{{K: []byte(fmt.Sprintf("key%d", i)), V: []byte("value")}})
+		require.NoError(t, err)
+	}
+
+	err = tbtree.IncreaseTs(tbtree.Ts() + 1)
+	require.NoError(t, err)
+
+	// flush threshold was exceeded but nothing was written yet
+	require.True(t, tbtree.root.mutated())
+	require.Zero(t, tbtree.committedLogSize)
+
+	err = tbtree.ResumeFlush()
+	require.NoError(t, err)
+
+	require.False(t, tbtree.root.mutated())
+	require.NotZero(t, tbtree.committedLogSize)
+
+	_, ts, _, err := tbtree.Get([]byte("key9"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), ts)
+
+	err = tbtree.Close()
+	require.NoError(t, err)
+
+	err = tbtree.DeferFlush()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+
+	err = tbtree.ResumeFlush()
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
+
 func BenchmarkRandomInsertion(b *testing.B) {
 	seed := rand.NewSource(time.Now().UnixNano())
 	rnd := rand.New(seed)
@@ -63,6 +63,10 @@ func KVMetadataToProto(md *store.KVMetadata) *KVMetadata {
 		kvmd.Expiration = &Expiration{ExpiresAt: expTime.Unix()}
 	}
 
+	if md.HasRevision() {
+		kvmd.Revision, _ = md.Revision()
+	}
+
 	return kvmd
 }
 
@@ -109,6 +113,10 @@ func KVMetadataFromProto(md *KVMetadata) *store.KVMetadata {
 
 	kvmd.AsNonIndexable(md.NonIndexable)
 
+	if md.Revision > 0 {
+		kvmd.WithRevision(md.Revision)
+	}
+
 	return kvmd
 }
 
@@ -128,6 +136,32 @@ func InclusionProofFromProto(iproof *InclusionProof) *htree.InclusionProof {
 	}
 }
 
+func MultiInclusionProofToProto(proof *htree.MultiInclusionProof) *MultiInclusionProof {
+	leaves := make([]int32, len(proof.Leaves))
+	for i, l := range proof.Leaves {
+		leaves[i] = int32(l)
+	}
+
+	return &MultiInclusionProof{
+		Leaves: leaves,
+		Width:  int32(proof.Width),
+		Terms:  DigestsToProto(proof.Terms),
+	}
+}
+
+func MultiInclusionProofFromProto(proof *MultiInclusionProof) *htree.MultiInclusionProof {
+	leaves := make([]int, len(proof.Leaves))
+	for i, l := range proof.Leaves {
+		leaves[i] = int(l)
+	}
+
+	return &htree.MultiInclusionProof{
+		Leaves: leaves,
+		Width:  int(proof.Width),
+		Terms:  DigestsFromProto(proof.Terms),
+	}
+}
+
 func DualProofToProto(dualProof *store.DualProof) *DualProof {
 	return &DualProof{
 		SourceTxHeader:     TxHeaderToProto(dualProof.SourceTxHeader),
@@ -169,6 +203,14 @@ func TxMetadataToProto(md *store.TxMetadata) *TxMetadata {
 		txID, _ := md.GetTruncatedTxID()
 		txmd.TruncatedTxID = txID
 	}
+	if md.HasIndexRoot() {
+		root, _ := md.GetIndexRoot()
+		txmd.IndexRoot = root[:]
+	}
+	if md.HasTimestampAnchoredTxID() {
+		txID, _ := md.GetTimestampAnchoredTxID()
+		txmd.TimestampAnchoredTxID = txID
+	}
 
 	return txmd
 }
@@ -235,10 +277,75 @@ func TxMetadataFromProto(md *TxMetadata) *store.TxMetadata {
 	if md.TruncatedTxID > 0 {
 		txmd.WithTruncatedTxID(md.TruncatedTxID)
 	}
+	if len(md.IndexRoot) > 0 {
+		txmd.WithIndexRoot(DigestFromProto(md.IndexRoot))
+	}
+	if md.TimestampAnchoredTxID > 0 {
+		txmd.WithTimestampAnchoredTxID(md.TimestampAnchoredTxID)
+	}
 
 	return txmd
 }
 
+func IndexProofToProto(proof *store.IndexProof, dualProof *store.DualProof) *VerifiableIndexProof {
+	indexProof := &VerifiableIndexProof{
+		DualProof: DualProofToProto(dualProof),
+		Siblings:  DigestsToProto(proof.Siblings),
+	}
+
+	if proof.Leaf != nil {
+		indexProof.Leaf = &IndexLeaf{
+			KeyDigest: proof.Leaf.KeyDigest[:],
+			TxId:      proof.Leaf.TxID,
+			Revision:  proof.Leaf.Revision,
+		}
+	}
+
+	return indexProof
+}
+
+func IndexProofFromProto(proof *VerifiableIndexProof) *store.IndexProof {
+	indexProof := &store.IndexProof{
+		Siblings: DigestsFromProto(proof.Siblings),
+	}
+
+	if proof.Leaf != nil {
+		indexProof.Leaf = &store.IndexLeaf{
+			KeyDigest: DigestFromProto(proof.Leaf.KeyDigest),
+			TxID:      proof.Leaf.TxId,
+			Revision:  proof.Leaf.Revision,
+		}
+	}
+
+	return indexProof
+}
+
+func TxLinkProofToProto(proof *store.TxLinkProof) *TxLinkProof {
+	txLinkProof := &TxLinkProof{
+		TxHeader:       TxHeaderToProto(proof.TxHeader),
+		InclusionProof: DigestsToProto(proof.InclusionProof),
+	}
+
+	if proof.LinearProof != nil {
+		txLinkProof.LinearProof = LinearProofToProto(proof.LinearProof)
+	}
+
+	return txLinkProof
+}
+
+func TxLinkProofFromProto(proof *TxLinkProof) *store.TxLinkProof {
+	txLinkProof := &store.TxLinkProof{
+		TxHeader:       TxHeaderFromProto(proof.TxHeader),
+		InclusionProof: DigestsFromProto(proof.InclusionProof),
+	}
+
+	if proof.LinearProof != nil {
+		txLinkProof.LinearProof = LinearProofFromProto(proof.LinearProof)
+	}
+
+	return txLinkProof
+}
+
 func LinearProofFromProto(lproof *LinearProof) *store.LinearProof {
 	return &store.LinearProof{
 		SourceTxID: lproof.SourceTxId,
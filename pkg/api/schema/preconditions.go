@@ -46,3 +46,14 @@ func PreconditionKeyNotModifiedAfterTX(key []byte, txID uint64) *Precondition {
 		},
 	}
 }
+
+func PreconditionValueMustEqual(key []byte, valueHash []byte) *Precondition {
+	return &Precondition{
+		Precondition: &Precondition_ValueMustEqual{
+			ValueMustEqual: &Precondition_ValueMustEqualPrecondition{
+				Key:       key,
+				ValueHash: valueHash,
+			},
+		},
+	}
+}
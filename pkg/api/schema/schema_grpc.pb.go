@@ -24,6 +24,17 @@ type ImmuServiceClient interface {
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ChangePermission(ctx context.Context, in *ChangePermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetActiveUser(ctx context.Context, in *SetActiveUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*APIKeyList, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListRoles(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoleList, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ChangeRolePrivilege grants or revokes a privilege to a role
+	ChangeRolePrivilege(ctx context.Context, in *ChangeRolePrivilegeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ChangeRoleMembership grants or revokes a role to a user
+	ChangeRoleMembership(ctx context.Context, in *ChangeRoleMembershipRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Deprecated: Do not use.
 	UpdateAuthConfig(ctx context.Context, in *AuthConfig, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Deprecated: Do not use.
@@ -34,6 +45,9 @@ type ImmuServiceClient interface {
 	NewTx(ctx context.Context, in *NewTxRequest, opts ...grpc.CallOption) (*NewTxResponse, error)
 	Commit(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CommittedSQLTx, error)
 	Rollback(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	TxSavepoint(ctx context.Context, in *TxSavepointRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	TxRollbackToSavepoint(ctx context.Context, in *TxSavepointRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	TxReleaseSavepoint(ctx context.Context, in *TxSavepointRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	TxSQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	TxSQLQuery(ctx context.Context, in *SQLQueryRequest, opts ...grpc.CallOption) (*SQLQueryResult, error)
 	// Deprecated: Do not use.
@@ -44,10 +58,12 @@ type ImmuServiceClient interface {
 	VerifiableSet(ctx context.Context, in *VerifiableSetRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	Get(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Entry, error)
 	VerifiableGet(ctx context.Context, in *VerifiableGetRequest, opts ...grpc.CallOption) (*VerifiableEntry, error)
+	VerifiableGetAbsent(ctx context.Context, in *VerifiableGetAbsentRequest, opts ...grpc.CallOption) (*VerifiableIndexProof, error)
 	Delete(ctx context.Context, in *DeleteKeysRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	GetAll(ctx context.Context, in *KeyListRequest, opts ...grpc.CallOption) (*Entries, error)
 	ExecAll(ctx context.Context, in *ExecAllRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*Entries, error)
+	VerifiableScan(ctx context.Context, in *VerifiableScanRequest, opts ...grpc.CallOption) (*VerifiableEntries, error)
 	// NOT YET SUPPORTED
 	Count(ctx context.Context, in *KeyPrefix, opts ...grpc.CallOption) (*EntryCount, error)
 	// NOT YET SUPPORTED
@@ -56,18 +72,35 @@ type ImmuServiceClient interface {
 	VerifiableTxById(ctx context.Context, in *VerifiableTxRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	TxScan(ctx context.Context, in *TxScanRequest, opts ...grpc.CallOption) (*TxList, error)
 	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*Entries, error)
+	VerifiableHistory(ctx context.Context, in *VerifiableHistoryRequest, opts ...grpc.CallOption) (*VerifiableEntries, error)
 	// ServerInfo returns information about the server instance.
 	// ServerInfoRequest is defined for future extensions.
 	ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error)
+	// SigningKeyChain returns the chain of signing key rotations, so that states signed with a rotated
+	// key can be verified starting from a trusted root key.
+	SigningKeyChain(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SigningKeyChainResponse, error)
 	// DEPRECATED: Use ServerInfo
 	Health(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 	DatabaseHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DatabaseHealthResponse, error)
 	CurrentState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ImmutableState, error)
+	// CosignState publishes the cosignature of a state made by a witness that checked its consistency,
+	// witness cosignatures are attached to the states returned by CurrentState
+	CosignState(ctx context.Context, in *CosignStateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CosignedState returns the most recent state cosigned by at least the requested number of witnesses,
+	// it may be older than the current state as new transactions are committed before witnesses cosign them
+	CosignedState(ctx context.Context, in *CosignedStateRequest, opts ...grpc.CallOption) (*ImmutableState, error)
+	// GetTimestampAnchor returns the earliest timestamp anchor covering the given transaction,
+	// i.e. the first RFC 3161 time-stamp token attesting the state as of such transaction or a later one
+	GetTimestampAnchor(ctx context.Context, in *TimestampAnchorRequest, opts ...grpc.CallOption) (*TimestampAnchor, error)
+	// PrefixDigest computes a Merkle root over the entries of the index snapshot whose keys share the given prefix,
+	// ordered by key, so two databases can compare their content with a single hash and bisect differences
+	PrefixDigest(ctx context.Context, in *PrefixDigestRequest, opts ...grpc.CallOption) (*ContentDigest, error)
 	SetReference(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	VerifiableSetReference(ctx context.Context, in *VerifiableReferenceRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*TxHeader, error)
 	VerifiableZAdd(ctx context.Context, in *VerifiableZAddRequest, opts ...grpc.CallOption) (*VerifiableTx, error)
 	ZScan(ctx context.Context, in *ZScanRequest, opts ...grpc.CallOption) (*ZEntries, error)
+	VerifiableZScan(ctx context.Context, in *VerifiableZScanRequest, opts ...grpc.CallOption) (*VerifiableZEntries, error)
 	// Deprecated: Do not use.
 	// DEPRECATED: Use CreateDatabaseV2
 	CreateDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -93,6 +126,8 @@ type ImmuServiceClient interface {
 	GetDatabaseSettingsV2(ctx context.Context, in *DatabaseSettingsRequest, opts ...grpc.CallOption) (*DatabaseSettingsResponse, error)
 	FlushIndex(ctx context.Context, in *FlushIndexRequest, opts ...grpc.CallOption) (*FlushIndexResponse, error)
 	CompactIndex(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadResponse, error)
+	RebuildIndex(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ImmuService_RebuildIndexClient, error)
 	// Streams
 	StreamGet(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (ImmuService_StreamGetClient, error)
 	StreamSet(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamSetClient, error)
@@ -111,6 +146,9 @@ type ImmuServiceClient interface {
 	ListTables(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SQLQueryResult, error)
 	DescribeTable(ctx context.Context, in *Table, opts ...grpc.CallOption) (*SQLQueryResult, error)
 	VerifiableSQLGet(ctx context.Context, in *VerifiableSQLGetRequest, opts ...grpc.CallOption) (*VerifiableSQLEntry, error)
+	// TableDigest computes a Merkle root over the rows of a table, ordered by primary key
+	TableDigest(ctx context.Context, in *TableDigestRequest, opts ...grpc.CallOption) (*ContentDigest, error)
+	VerifiableSQLQuery(ctx context.Context, in *VerifiableSQLQueryRequest, opts ...grpc.CallOption) (*VerifiableSQLQueryResult, error)
 	TruncateDatabase(ctx context.Context, in *TruncateDatabaseRequest, opts ...grpc.CallOption) (*TruncateDatabaseResponse, error)
 }
 
@@ -167,6 +205,87 @@ func (c *immuServiceClient) SetActiveUser(ctx context.Context, in *SetActiveUser
 	return out, nil
 }
 
+func (c *immuServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/UnlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*APIKeyList, error) {
+	out := new(APIKeyList)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ListRoles(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoleList, error) {
+	out := new(RoleList)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ChangeRolePrivilege(ctx context.Context, in *ChangeRolePrivilegeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ChangeRolePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) ChangeRoleMembership(ctx context.Context, in *ChangeRoleMembershipRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ChangeRoleMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *immuServiceClient) UpdateAuthConfig(ctx context.Context, in *AuthConfig, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
@@ -241,6 +360,33 @@ func (c *immuServiceClient) Rollback(ctx context.Context, in *empty.Empty, opts
 	return out, nil
 }
 
+func (c *immuServiceClient) TxSavepoint(ctx context.Context, in *TxSavepointRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TxSavepoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) TxRollbackToSavepoint(ctx context.Context, in *TxSavepointRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TxRollbackToSavepoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) TxReleaseSavepoint(ctx context.Context, in *TxSavepointRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TxReleaseSavepoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) TxSQLExec(ctx context.Context, in *SQLExecRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TxSQLExec", in, out, opts...)
@@ -315,6 +461,15 @@ func (c *immuServiceClient) VerifiableGet(ctx context.Context, in *VerifiableGet
 	return out, nil
 }
 
+func (c *immuServiceClient) VerifiableGetAbsent(ctx context.Context, in *VerifiableGetAbsentRequest, opts ...grpc.CallOption) (*VerifiableIndexProof, error) {
+	out := new(VerifiableIndexProof)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableGetAbsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Delete(ctx context.Context, in *DeleteKeysRequest, opts ...grpc.CallOption) (*TxHeader, error) {
 	out := new(TxHeader)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Delete", in, out, opts...)
@@ -351,6 +506,15 @@ func (c *immuServiceClient) Scan(ctx context.Context, in *ScanRequest, opts ...g
 	return out, nil
 }
 
+func (c *immuServiceClient) VerifiableScan(ctx context.Context, in *VerifiableScanRequest, opts ...grpc.CallOption) (*VerifiableEntries, error) {
+	out := new(VerifiableEntries)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Count(ctx context.Context, in *KeyPrefix, opts ...grpc.CallOption) (*EntryCount, error) {
 	out := new(EntryCount)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Count", in, out, opts...)
@@ -405,6 +569,15 @@ func (c *immuServiceClient) History(ctx context.Context, in *HistoryRequest, opt
 	return out, nil
 }
 
+func (c *immuServiceClient) VerifiableHistory(ctx context.Context, in *VerifiableHistoryRequest, opts ...grpc.CallOption) (*VerifiableEntries, error) {
+	out := new(VerifiableEntries)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error) {
 	out := new(ServerInfoResponse)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/ServerInfo", in, out, opts...)
@@ -414,6 +587,15 @@ func (c *immuServiceClient) ServerInfo(ctx context.Context, in *ServerInfoReques
 	return out, nil
 }
 
+func (c *immuServiceClient) SigningKeyChain(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SigningKeyChainResponse, error) {
+	out := new(SigningKeyChainResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/SigningKeyChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) Health(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
 	out := new(HealthResponse)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/Health", in, out, opts...)
@@ -441,6 +623,42 @@ func (c *immuServiceClient) CurrentState(ctx context.Context, in *empty.Empty, o
 	return out, nil
 }
 
+func (c *immuServiceClient) CosignState(ctx context.Context, in *CosignStateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CosignState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) CosignedState(ctx context.Context, in *CosignedStateRequest, opts ...grpc.CallOption) (*ImmutableState, error) {
+	out := new(ImmutableState)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/CosignedState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) GetTimestampAnchor(ctx context.Context, in *TimestampAnchorRequest, opts ...grpc.CallOption) (*TimestampAnchor, error) {
+	out := new(TimestampAnchor)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/GetTimestampAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) PrefixDigest(ctx context.Context, in *PrefixDigestRequest, opts ...grpc.CallOption) (*ContentDigest, error) {
+	out := new(ContentDigest)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/PrefixDigest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) SetReference(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*TxHeader, error) {
 	out := new(TxHeader)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/SetReference", in, out, opts...)
@@ -486,6 +704,15 @@ func (c *immuServiceClient) ZScan(ctx context.Context, in *ZScanRequest, opts ..
 	return out, nil
 }
 
+func (c *immuServiceClient) VerifiableZScan(ctx context.Context, in *VerifiableZScanRequest, opts ...grpc.CallOption) (*VerifiableZEntries, error) {
+	out := new(VerifiableZEntries)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableZScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *immuServiceClient) CreateDatabase(ctx context.Context, in *Database, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
@@ -626,8 +853,49 @@ func (c *immuServiceClient) CompactIndex(ctx context.Context, in *empty.Empty, o
 	return out, nil
 }
 
+func (c *immuServiceClient) BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadResponse, error) {
+	out := new(BulkLoadResponse)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/BulkLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) RebuildIndex(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (ImmuService_RebuildIndexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[0], "/immudb.schema.ImmuService/RebuildIndex", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &immuServiceRebuildIndexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ImmuService_RebuildIndexClient interface {
+	Recv() (*RebuildIndexProgress, error)
+	grpc.ClientStream
+}
+
+type immuServiceRebuildIndexClient struct {
+	grpc.ClientStream
+}
+
+func (x *immuServiceRebuildIndexClient) Recv() (*RebuildIndexProgress, error) {
+	m := new(RebuildIndexProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *immuServiceClient) StreamGet(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (ImmuService_StreamGetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[0], "/immudb.schema.ImmuService/streamGet", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[1], "/immudb.schema.ImmuService/streamGet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -659,7 +927,7 @@ func (x *immuServiceStreamGetClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamSet(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[1], "/immudb.schema.ImmuService/streamSet", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[2], "/immudb.schema.ImmuService/streamSet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -693,7 +961,7 @@ func (x *immuServiceStreamSetClient) CloseAndRecv() (*TxHeader, error) {
 }
 
 func (c *immuServiceClient) StreamVerifiableGet(ctx context.Context, in *VerifiableGetRequest, opts ...grpc.CallOption) (ImmuService_StreamVerifiableGetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[2], "/immudb.schema.ImmuService/streamVerifiableGet", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[3], "/immudb.schema.ImmuService/streamVerifiableGet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -725,7 +993,7 @@ func (x *immuServiceStreamVerifiableGetClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamVerifiableSet(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamVerifiableSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[3], "/immudb.schema.ImmuService/streamVerifiableSet", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[4], "/immudb.schema.ImmuService/streamVerifiableSet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -759,7 +1027,7 @@ func (x *immuServiceStreamVerifiableSetClient) CloseAndRecv() (*VerifiableTx, er
 }
 
 func (c *immuServiceClient) StreamScan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (ImmuService_StreamScanClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[4], "/immudb.schema.ImmuService/streamScan", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[5], "/immudb.schema.ImmuService/streamScan", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -791,7 +1059,7 @@ func (x *immuServiceStreamScanClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamZScan(ctx context.Context, in *ZScanRequest, opts ...grpc.CallOption) (ImmuService_StreamZScanClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[5], "/immudb.schema.ImmuService/streamZScan", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[6], "/immudb.schema.ImmuService/streamZScan", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -823,7 +1091,7 @@ func (x *immuServiceStreamZScanClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (ImmuService_StreamHistoryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[6], "/immudb.schema.ImmuService/streamHistory", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[7], "/immudb.schema.ImmuService/streamHistory", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -855,7 +1123,7 @@ func (x *immuServiceStreamHistoryClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) StreamExecAll(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamExecAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[7], "/immudb.schema.ImmuService/streamExecAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[8], "/immudb.schema.ImmuService/streamExecAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -889,7 +1157,7 @@ func (x *immuServiceStreamExecAllClient) CloseAndRecv() (*TxHeader, error) {
 }
 
 func (c *immuServiceClient) ExportTx(ctx context.Context, in *ExportTxRequest, opts ...grpc.CallOption) (ImmuService_ExportTxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[8], "/immudb.schema.ImmuService/exportTx", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[9], "/immudb.schema.ImmuService/exportTx", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -921,7 +1189,7 @@ func (x *immuServiceExportTxClient) Recv() (*Chunk, error) {
 }
 
 func (c *immuServiceClient) ReplicateTx(ctx context.Context, opts ...grpc.CallOption) (ImmuService_ReplicateTxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[9], "/immudb.schema.ImmuService/replicateTx", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[10], "/immudb.schema.ImmuService/replicateTx", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -955,7 +1223,7 @@ func (x *immuServiceReplicateTxClient) CloseAndRecv() (*TxHeader, error) {
 }
 
 func (c *immuServiceClient) StreamExportTx(ctx context.Context, opts ...grpc.CallOption) (ImmuService_StreamExportTxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[10], "/immudb.schema.ImmuService/streamExportTx", opts...)
+	stream, err := c.cc.NewStream(ctx, &ImmuService_ServiceDesc.Streams[11], "/immudb.schema.ImmuService/streamExportTx", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1030,6 +1298,24 @@ func (c *immuServiceClient) VerifiableSQLGet(ctx context.Context, in *Verifiable
 	return out, nil
 }
 
+func (c *immuServiceClient) TableDigest(ctx context.Context, in *TableDigestRequest, opts ...grpc.CallOption) (*ContentDigest, error) {
+	out := new(ContentDigest)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TableDigest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *immuServiceClient) VerifiableSQLQuery(ctx context.Context, in *VerifiableSQLQueryRequest, opts ...grpc.CallOption) (*VerifiableSQLQueryResult, error) {
+	out := new(VerifiableSQLQueryResult)
+	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/VerifiableSQLQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *immuServiceClient) TruncateDatabase(ctx context.Context, in *TruncateDatabaseRequest, opts ...grpc.CallOption) (*TruncateDatabaseResponse, error) {
 	out := new(TruncateDatabaseResponse)
 	err := c.cc.Invoke(ctx, "/immudb.schema.ImmuService/TruncateDatabase", in, out, opts...)
@@ -1048,6 +1334,17 @@ type ImmuServiceServer interface {
 	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
 	ChangePermission(context.Context, *ChangePermissionRequest) (*empty.Empty, error)
 	SetActiveUser(context.Context, *SetActiveUserRequest) (*empty.Empty, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*empty.Empty, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*APIKeyList, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error)
+	ListRoles(context.Context, *empty.Empty) (*RoleList, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*empty.Empty, error)
+	DropRole(context.Context, *DropRoleRequest) (*empty.Empty, error)
+	// ChangeRolePrivilege grants or revokes a privilege to a role
+	ChangeRolePrivilege(context.Context, *ChangeRolePrivilegeRequest) (*empty.Empty, error)
+	// ChangeRoleMembership grants or revokes a role to a user
+	ChangeRoleMembership(context.Context, *ChangeRoleMembershipRequest) (*empty.Empty, error)
 	// Deprecated: Do not use.
 	UpdateAuthConfig(context.Context, *AuthConfig) (*empty.Empty, error)
 	// Deprecated: Do not use.
@@ -1058,6 +1355,9 @@ type ImmuServiceServer interface {
 	NewTx(context.Context, *NewTxRequest) (*NewTxResponse, error)
 	Commit(context.Context, *empty.Empty) (*CommittedSQLTx, error)
 	Rollback(context.Context, *empty.Empty) (*empty.Empty, error)
+	TxSavepoint(context.Context, *TxSavepointRequest) (*empty.Empty, error)
+	TxRollbackToSavepoint(context.Context, *TxSavepointRequest) (*empty.Empty, error)
+	TxReleaseSavepoint(context.Context, *TxSavepointRequest) (*empty.Empty, error)
 	TxSQLExec(context.Context, *SQLExecRequest) (*empty.Empty, error)
 	TxSQLQuery(context.Context, *SQLQueryRequest) (*SQLQueryResult, error)
 	// Deprecated: Do not use.
@@ -1068,10 +1368,12 @@ type ImmuServiceServer interface {
 	VerifiableSet(context.Context, *VerifiableSetRequest) (*VerifiableTx, error)
 	Get(context.Context, *KeyRequest) (*Entry, error)
 	VerifiableGet(context.Context, *VerifiableGetRequest) (*VerifiableEntry, error)
+	VerifiableGetAbsent(context.Context, *VerifiableGetAbsentRequest) (*VerifiableIndexProof, error)
 	Delete(context.Context, *DeleteKeysRequest) (*TxHeader, error)
 	GetAll(context.Context, *KeyListRequest) (*Entries, error)
 	ExecAll(context.Context, *ExecAllRequest) (*TxHeader, error)
 	Scan(context.Context, *ScanRequest) (*Entries, error)
+	VerifiableScan(context.Context, *VerifiableScanRequest) (*VerifiableEntries, error)
 	// NOT YET SUPPORTED
 	Count(context.Context, *KeyPrefix) (*EntryCount, error)
 	// NOT YET SUPPORTED
@@ -1080,18 +1382,35 @@ type ImmuServiceServer interface {
 	VerifiableTxById(context.Context, *VerifiableTxRequest) (*VerifiableTx, error)
 	TxScan(context.Context, *TxScanRequest) (*TxList, error)
 	History(context.Context, *HistoryRequest) (*Entries, error)
+	VerifiableHistory(context.Context, *VerifiableHistoryRequest) (*VerifiableEntries, error)
 	// ServerInfo returns information about the server instance.
 	// ServerInfoRequest is defined for future extensions.
 	ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error)
+	// SigningKeyChain returns the chain of signing key rotations, so that states signed with a rotated
+	// key can be verified starting from a trusted root key.
+	SigningKeyChain(context.Context, *empty.Empty) (*SigningKeyChainResponse, error)
 	// DEPRECATED: Use ServerInfo
 	Health(context.Context, *empty.Empty) (*HealthResponse, error)
 	DatabaseHealth(context.Context, *empty.Empty) (*DatabaseHealthResponse, error)
 	CurrentState(context.Context, *empty.Empty) (*ImmutableState, error)
+	// CosignState publishes the cosignature of a state made by a witness that checked its consistency,
+	// witness cosignatures are attached to the states returned by CurrentState
+	CosignState(context.Context, *CosignStateRequest) (*empty.Empty, error)
+	// CosignedState returns the most recent state cosigned by at least the requested number of witnesses,
+	// it may be older than the current state as new transactions are committed before witnesses cosign them
+	CosignedState(context.Context, *CosignedStateRequest) (*ImmutableState, error)
+	// GetTimestampAnchor returns the earliest timestamp anchor covering the given transaction,
+	// i.e. the first RFC 3161 time-stamp token attesting the state as of such transaction or a later one
+	GetTimestampAnchor(context.Context, *TimestampAnchorRequest) (*TimestampAnchor, error)
+	// PrefixDigest computes a Merkle root over the entries of the index snapshot whose keys share the given prefix,
+	// ordered by key, so two databases can compare their content with a single hash and bisect differences
+	PrefixDigest(context.Context, *PrefixDigestRequest) (*ContentDigest, error)
 	SetReference(context.Context, *ReferenceRequest) (*TxHeader, error)
 	VerifiableSetReference(context.Context, *VerifiableReferenceRequest) (*VerifiableTx, error)
 	ZAdd(context.Context, *ZAddRequest) (*TxHeader, error)
 	VerifiableZAdd(context.Context, *VerifiableZAddRequest) (*VerifiableTx, error)
 	ZScan(context.Context, *ZScanRequest) (*ZEntries, error)
+	VerifiableZScan(context.Context, *VerifiableZScanRequest) (*VerifiableZEntries, error)
 	// Deprecated: Do not use.
 	// DEPRECATED: Use CreateDatabaseV2
 	CreateDatabase(context.Context, *Database) (*empty.Empty, error)
@@ -1117,6 +1436,8 @@ type ImmuServiceServer interface {
 	GetDatabaseSettingsV2(context.Context, *DatabaseSettingsRequest) (*DatabaseSettingsResponse, error)
 	FlushIndex(context.Context, *FlushIndexRequest) (*FlushIndexResponse, error)
 	CompactIndex(context.Context, *empty.Empty) (*empty.Empty, error)
+	BulkLoad(context.Context, *BulkLoadRequest) (*BulkLoadResponse, error)
+	RebuildIndex(*empty.Empty, ImmuService_RebuildIndexServer) error
 	// Streams
 	StreamGet(*KeyRequest, ImmuService_StreamGetServer) error
 	StreamSet(ImmuService_StreamSetServer) error
@@ -1135,6 +1456,9 @@ type ImmuServiceServer interface {
 	ListTables(context.Context, *empty.Empty) (*SQLQueryResult, error)
 	DescribeTable(context.Context, *Table) (*SQLQueryResult, error)
 	VerifiableSQLGet(context.Context, *VerifiableSQLGetRequest) (*VerifiableSQLEntry, error)
+	// TableDigest computes a Merkle root over the rows of a table, ordered by primary key
+	TableDigest(context.Context, *TableDigestRequest) (*ContentDigest, error)
+	VerifiableSQLQuery(context.Context, *VerifiableSQLQueryRequest) (*VerifiableSQLQueryResult, error)
 	TruncateDatabase(context.Context, *TruncateDatabaseRequest) (*TruncateDatabaseResponse, error)
 }
 
@@ -1157,6 +1481,33 @@ func (UnimplementedImmuServiceServer) ChangePermission(context.Context, *ChangeP
 func (UnimplementedImmuServiceServer) SetActiveUser(context.Context, *SetActiveUserRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetActiveUser not implemented")
 }
+func (UnimplementedImmuServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedImmuServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedImmuServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*APIKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedImmuServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedImmuServiceServer) ListRoles(context.Context, *empty.Empty) (*RoleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedImmuServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedImmuServiceServer) DropRole(context.Context, *DropRoleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (UnimplementedImmuServiceServer) ChangeRolePrivilege(context.Context, *ChangeRolePrivilegeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRolePrivilege not implemented")
+}
+func (UnimplementedImmuServiceServer) ChangeRoleMembership(context.Context, *ChangeRoleMembershipRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoleMembership not implemented")
+}
 func (UnimplementedImmuServiceServer) UpdateAuthConfig(context.Context, *AuthConfig) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuthConfig not implemented")
 }
@@ -1181,6 +1532,15 @@ func (UnimplementedImmuServiceServer) Commit(context.Context, *empty.Empty) (*Co
 func (UnimplementedImmuServiceServer) Rollback(context.Context, *empty.Empty) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
 }
+func (UnimplementedImmuServiceServer) TxSavepoint(context.Context, *TxSavepointRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxSavepoint not implemented")
+}
+func (UnimplementedImmuServiceServer) TxRollbackToSavepoint(context.Context, *TxSavepointRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxRollbackToSavepoint not implemented")
+}
+func (UnimplementedImmuServiceServer) TxReleaseSavepoint(context.Context, *TxSavepointRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxReleaseSavepoint not implemented")
+}
 func (UnimplementedImmuServiceServer) TxSQLExec(context.Context, *SQLExecRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxSQLExec not implemented")
 }
@@ -1205,6 +1565,9 @@ func (UnimplementedImmuServiceServer) Get(context.Context, *KeyRequest) (*Entry,
 func (UnimplementedImmuServiceServer) VerifiableGet(context.Context, *VerifiableGetRequest) (*VerifiableEntry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifiableGet not implemented")
 }
+func (UnimplementedImmuServiceServer) VerifiableGetAbsent(context.Context, *VerifiableGetAbsentRequest) (*VerifiableIndexProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableGetAbsent not implemented")
+}
 func (UnimplementedImmuServiceServer) Delete(context.Context, *DeleteKeysRequest) (*TxHeader, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -1217,6 +1580,9 @@ func (UnimplementedImmuServiceServer) ExecAll(context.Context, *ExecAllRequest)
 func (UnimplementedImmuServiceServer) Scan(context.Context, *ScanRequest) (*Entries, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (UnimplementedImmuServiceServer) VerifiableScan(context.Context, *VerifiableScanRequest) (*VerifiableEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableScan not implemented")
+}
 func (UnimplementedImmuServiceServer) Count(context.Context, *KeyPrefix) (*EntryCount, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
 }
@@ -1235,9 +1601,15 @@ func (UnimplementedImmuServiceServer) TxScan(context.Context, *TxScanRequest) (*
 func (UnimplementedImmuServiceServer) History(context.Context, *HistoryRequest) (*Entries, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
 }
+func (UnimplementedImmuServiceServer) VerifiableHistory(context.Context, *VerifiableHistoryRequest) (*VerifiableEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableHistory not implemented")
+}
 func (UnimplementedImmuServiceServer) ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
 }
+func (UnimplementedImmuServiceServer) SigningKeyChain(context.Context, *empty.Empty) (*SigningKeyChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningKeyChain not implemented")
+}
 func (UnimplementedImmuServiceServer) Health(context.Context, *empty.Empty) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
@@ -1247,6 +1619,18 @@ func (UnimplementedImmuServiceServer) DatabaseHealth(context.Context, *empty.Emp
 func (UnimplementedImmuServiceServer) CurrentState(context.Context, *empty.Empty) (*ImmutableState, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentState not implemented")
 }
+func (UnimplementedImmuServiceServer) CosignState(context.Context, *CosignStateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosignState not implemented")
+}
+func (UnimplementedImmuServiceServer) CosignedState(context.Context, *CosignedStateRequest) (*ImmutableState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CosignedState not implemented")
+}
+func (UnimplementedImmuServiceServer) GetTimestampAnchor(context.Context, *TimestampAnchorRequest) (*TimestampAnchor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimestampAnchor not implemented")
+}
+func (UnimplementedImmuServiceServer) PrefixDigest(context.Context, *PrefixDigestRequest) (*ContentDigest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrefixDigest not implemented")
+}
 func (UnimplementedImmuServiceServer) SetReference(context.Context, *ReferenceRequest) (*TxHeader, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetReference not implemented")
 }
@@ -1262,6 +1646,9 @@ func (UnimplementedImmuServiceServer) VerifiableZAdd(context.Context, *Verifiabl
 func (UnimplementedImmuServiceServer) ZScan(context.Context, *ZScanRequest) (*ZEntries, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ZScan not implemented")
 }
+func (UnimplementedImmuServiceServer) VerifiableZScan(context.Context, *VerifiableZScanRequest) (*VerifiableZEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableZScan not implemented")
+}
 func (UnimplementedImmuServiceServer) CreateDatabase(context.Context, *Database) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
 }
@@ -1307,6 +1694,12 @@ func (UnimplementedImmuServiceServer) FlushIndex(context.Context, *FlushIndexReq
 func (UnimplementedImmuServiceServer) CompactIndex(context.Context, *empty.Empty) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompactIndex not implemented")
 }
+func (UnimplementedImmuServiceServer) BulkLoad(context.Context, *BulkLoadRequest) (*BulkLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkLoad not implemented")
+}
+func (UnimplementedImmuServiceServer) RebuildIndex(*empty.Empty, ImmuService_RebuildIndexServer) error {
+	return status.Errorf(codes.Unimplemented, "method RebuildIndex not implemented")
+}
 func (UnimplementedImmuServiceServer) StreamGet(*KeyRequest, ImmuService_StreamGetServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamGet not implemented")
 }
@@ -1355,6 +1748,12 @@ func (UnimplementedImmuServiceServer) DescribeTable(context.Context, *Table) (*S
 func (UnimplementedImmuServiceServer) VerifiableSQLGet(context.Context, *VerifiableSQLGetRequest) (*VerifiableSQLEntry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifiableSQLGet not implemented")
 }
+func (UnimplementedImmuServiceServer) TableDigest(context.Context, *TableDigestRequest) (*ContentDigest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TableDigest not implemented")
+}
+func (UnimplementedImmuServiceServer) VerifiableSQLQuery(context.Context, *VerifiableSQLQueryRequest) (*VerifiableSQLQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiableSQLQuery not implemented")
+}
 func (UnimplementedImmuServiceServer) TruncateDatabase(context.Context, *TruncateDatabaseRequest) (*TruncateDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TruncateDatabase not implemented")
 }
@@ -1460,6 +1859,168 @@ func _ImmuService_SetActiveUser_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/UnlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ListRoles(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).DropRole(ctx, req.(*DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ChangeRolePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRolePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ChangeRolePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ChangeRolePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ChangeRolePrivilege(ctx, req.(*ChangeRolePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_ChangeRoleMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeRoleMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).ChangeRoleMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/ChangeRoleMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).ChangeRoleMembership(ctx, req.(*ChangeRoleMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_UpdateAuthConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthConfig)
 	if err := dec(in); err != nil {
@@ -1509,97 +2070,151 @@ func _ImmuService_OpenSession_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: "/immudb.schema.ImmuService/OpenSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).OpenSession(ctx, req.(*OpenSessionRequest))
+		return srv.(ImmuServiceServer).OpenSession(ctx, req.(*OpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CloseSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CloseSession(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_KeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).KeepAlive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/KeepAlive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).KeepAlive(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_NewTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).NewTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/NewTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).NewTx(ctx, req.(*NewTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ImmuService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).CloseSession(ctx, in)
+		return srv.(ImmuServiceServer).Commit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/CloseSession",
+		FullMethod: "/immudb.schema.ImmuService/Commit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).CloseSession(ctx, req.(*empty.Empty))
+		return srv.(ImmuServiceServer).Commit(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_KeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ImmuService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).KeepAlive(ctx, in)
+		return srv.(ImmuServiceServer).Rollback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/KeepAlive",
+		FullMethod: "/immudb.schema.ImmuService/Rollback",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).KeepAlive(ctx, req.(*empty.Empty))
+		return srv.(ImmuServiceServer).Rollback(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_NewTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewTxRequest)
+func _ImmuService_TxSavepoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSavepointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).NewTx(ctx, in)
+		return srv.(ImmuServiceServer).TxSavepoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/NewTx",
+		FullMethod: "/immudb.schema.ImmuService/TxSavepoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).NewTx(ctx, req.(*NewTxRequest))
+		return srv.(ImmuServiceServer).TxSavepoint(ctx, req.(*TxSavepointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _ImmuService_TxRollbackToSavepoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSavepointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).Commit(ctx, in)
+		return srv.(ImmuServiceServer).TxRollbackToSavepoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/Commit",
+		FullMethod: "/immudb.schema.ImmuService/TxRollbackToSavepoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).Commit(ctx, req.(*empty.Empty))
+		return srv.(ImmuServiceServer).TxRollbackToSavepoint(ctx, req.(*TxSavepointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ImmuService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _ImmuService_TxReleaseSavepoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSavepointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ImmuServiceServer).Rollback(ctx, in)
+		return srv.(ImmuServiceServer).TxReleaseSavepoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/immudb.schema.ImmuService/Rollback",
+		FullMethod: "/immudb.schema.ImmuService/TxReleaseSavepoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ImmuServiceServer).Rollback(ctx, req.(*empty.Empty))
+		return srv.(ImmuServiceServer).TxReleaseSavepoint(ctx, req.(*TxSavepointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1748,6 +2363,24 @@ func _ImmuService_VerifiableGet_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_VerifiableGetAbsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableGetAbsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableGetAbsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableGetAbsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableGetAbsent(ctx, req.(*VerifiableGetAbsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKeysRequest)
 	if err := dec(in); err != nil {
@@ -1820,6 +2453,24 @@ func _ImmuService_Scan_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_VerifiableScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableScan(ctx, req.(*VerifiableScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyPrefix)
 	if err := dec(in); err != nil {
@@ -1928,6 +2579,24 @@ func _ImmuService_History_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_VerifiableHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableHistory(ctx, req.(*VerifiableHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ServerInfoRequest)
 	if err := dec(in); err != nil {
@@ -1946,6 +2615,24 @@ func _ImmuService_ServerInfo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_SigningKeyChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).SigningKeyChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/SigningKeyChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).SigningKeyChain(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -2000,6 +2687,78 @@ func _ImmuService_CurrentState_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_CosignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CosignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CosignState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CosignState(ctx, req.(*CosignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_CosignedState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignedStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).CosignedState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/CosignedState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).CosignedState(ctx, req.(*CosignedStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_GetTimestampAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimestampAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).GetTimestampAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/GetTimestampAnchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).GetTimestampAnchor(ctx, req.(*TimestampAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_PrefixDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrefixDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).PrefixDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/PrefixDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).PrefixDigest(ctx, req.(*PrefixDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_SetReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReferenceRequest)
 	if err := dec(in); err != nil {
@@ -2090,6 +2849,24 @@ func _ImmuService_ZScan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_VerifiableZScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableZScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableZScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableZScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableZScan(ctx, req.(*VerifiableZScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Database)
 	if err := dec(in); err != nil {
@@ -2360,6 +3137,45 @@ func _ImmuService_CompactIndex_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_BulkLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).BulkLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/BulkLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).BulkLoad(ctx, req.(*BulkLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_RebuildIndex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ImmuServiceServer).RebuildIndex(m, &immuServiceRebuildIndexServer{stream})
+}
+
+type ImmuService_RebuildIndexServer interface {
+	Send(*RebuildIndexProgress) error
+	grpc.ServerStream
+}
+
+type immuServiceRebuildIndexServer struct {
+	grpc.ServerStream
+}
+
+func (x *immuServiceRebuildIndexServer) Send(m *RebuildIndexProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ImmuService_StreamGet_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(KeyRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2706,6 +3522,42 @@ func _ImmuService_VerifiableSQLGet_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImmuService_TableDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TableDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).TableDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/TableDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).TableDigest(ctx, req.(*TableDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImmuService_VerifiableSQLQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifiableSQLQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImmuServiceServer).VerifiableSQLQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/immudb.schema.ImmuService/VerifiableSQLQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImmuServiceServer).VerifiableSQLQuery(ctx, req.(*VerifiableSQLQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImmuService_TruncateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TruncateDatabaseRequest)
 	if err := dec(in); err != nil {
@@ -2751,6 +3603,42 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetActiveUser",
 			Handler:    _ImmuService_SetActiveUser_Handler,
 		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _ImmuService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _ImmuService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _ImmuService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _ImmuService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _ImmuService_ListRoles_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _ImmuService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _ImmuService_DropRole_Handler,
+		},
+		{
+			MethodName: "ChangeRolePrivilege",
+			Handler:    _ImmuService_ChangeRolePrivilege_Handler,
+		},
+		{
+			MethodName: "ChangeRoleMembership",
+			Handler:    _ImmuService_ChangeRoleMembership_Handler,
+		},
 		{
 			MethodName: "UpdateAuthConfig",
 			Handler:    _ImmuService_UpdateAuthConfig_Handler,
@@ -2783,6 +3671,18 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Rollback",
 			Handler:    _ImmuService_Rollback_Handler,
 		},
+		{
+			MethodName: "TxSavepoint",
+			Handler:    _ImmuService_TxSavepoint_Handler,
+		},
+		{
+			MethodName: "TxRollbackToSavepoint",
+			Handler:    _ImmuService_TxRollbackToSavepoint_Handler,
+		},
+		{
+			MethodName: "TxReleaseSavepoint",
+			Handler:    _ImmuService_TxReleaseSavepoint_Handler,
+		},
 		{
 			MethodName: "TxSQLExec",
 			Handler:    _ImmuService_TxSQLExec_Handler,
@@ -2815,6 +3715,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifiableGet",
 			Handler:    _ImmuService_VerifiableGet_Handler,
 		},
+		{
+			MethodName: "VerifiableGetAbsent",
+			Handler:    _ImmuService_VerifiableGetAbsent_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ImmuService_Delete_Handler,
@@ -2831,6 +3735,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _ImmuService_Scan_Handler,
 		},
+		{
+			MethodName: "VerifiableScan",
+			Handler:    _ImmuService_VerifiableScan_Handler,
+		},
 		{
 			MethodName: "Count",
 			Handler:    _ImmuService_Count_Handler,
@@ -2855,10 +3763,18 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "History",
 			Handler:    _ImmuService_History_Handler,
 		},
+		{
+			MethodName: "VerifiableHistory",
+			Handler:    _ImmuService_VerifiableHistory_Handler,
+		},
 		{
 			MethodName: "ServerInfo",
 			Handler:    _ImmuService_ServerInfo_Handler,
 		},
+		{
+			MethodName: "SigningKeyChain",
+			Handler:    _ImmuService_SigningKeyChain_Handler,
+		},
 		{
 			MethodName: "Health",
 			Handler:    _ImmuService_Health_Handler,
@@ -2871,6 +3787,22 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentState",
 			Handler:    _ImmuService_CurrentState_Handler,
 		},
+		{
+			MethodName: "CosignState",
+			Handler:    _ImmuService_CosignState_Handler,
+		},
+		{
+			MethodName: "CosignedState",
+			Handler:    _ImmuService_CosignedState_Handler,
+		},
+		{
+			MethodName: "GetTimestampAnchor",
+			Handler:    _ImmuService_GetTimestampAnchor_Handler,
+		},
+		{
+			MethodName: "PrefixDigest",
+			Handler:    _ImmuService_PrefixDigest_Handler,
+		},
 		{
 			MethodName: "SetReference",
 			Handler:    _ImmuService_SetReference_Handler,
@@ -2891,6 +3823,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ZScan",
 			Handler:    _ImmuService_ZScan_Handler,
 		},
+		{
+			MethodName: "VerifiableZScan",
+			Handler:    _ImmuService_VerifiableZScan_Handler,
+		},
 		{
 			MethodName: "CreateDatabase",
 			Handler:    _ImmuService_CreateDatabase_Handler,
@@ -2951,6 +3887,10 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompactIndex",
 			Handler:    _ImmuService_CompactIndex_Handler,
 		},
+		{
+			MethodName: "BulkLoad",
+			Handler:    _ImmuService_BulkLoad_Handler,
+		},
 		{
 			MethodName: "SQLExec",
 			Handler:    _ImmuService_SQLExec_Handler,
@@ -2971,12 +3911,25 @@ var ImmuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifiableSQLGet",
 			Handler:    _ImmuService_VerifiableSQLGet_Handler,
 		},
+		{
+			MethodName: "TableDigest",
+			Handler:    _ImmuService_TableDigest_Handler,
+		},
+		{
+			MethodName: "VerifiableSQLQuery",
+			Handler:    _ImmuService_VerifiableSQLQuery_Handler,
+		},
 		{
 			MethodName: "TruncateDatabase",
 			Handler:    _ImmuService_TruncateDatabase_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RebuildIndex",
+			Handler:       _ImmuService_RebuildIndex_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "streamGet",
 			Handler:       _ImmuService_StreamGet_Handler,
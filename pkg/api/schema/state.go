@@ -17,7 +17,8 @@ limitations under the License.
 package schema
 
 import (
-	"crypto/ecdsa"
+	"bytes"
+	"crypto"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
@@ -44,9 +45,63 @@ func (state *ImmutableState) ToBytes() []byte {
 }
 
 // CheckSignature
-func (state *ImmutableState) CheckSignature(key *ecdsa.PublicKey) (ok bool, err error) {
+func (state *ImmutableState) CheckSignature(key crypto.PublicKey) (ok bool, err error) {
 	if state.Signature == nil {
 		return false, errors.New("no signature found")
 	}
 	return signer.Verify(state.ToBytes(), state.Signature.Signature, key)
 }
+
+// CountWitnessSignatures returns how many of the provided witness keys made a valid signature of the state
+func (state *ImmutableState) CountWitnessSignatures(witnessKeys []crypto.PublicKey) (int, error) {
+	count := 0
+
+	for _, key := range witnessKeys {
+		publicKey, err := signer.MarshalKey(key)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, sig := range state.WitnessSignatures {
+			if !bytes.Equal(sig.GetPublicKey(), publicKey) {
+				continue
+			}
+
+			ok, err := signer.Verify(state.ToBytes(), sig.GetSignature(), key)
+			if err == nil && ok {
+				count++
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func SigningKeyRotationsToProto(rotations []*signer.KeyRotation) []*SigningKeyRotation {
+	res := make([]*SigningKeyRotation, len(rotations))
+
+	for i, r := range rotations {
+		res[i] = &SigningKeyRotation{
+			PublicKey:       r.PublicKey,
+			SignerPublicKey: r.SignerPublicKey,
+			Signature:       r.Signature,
+		}
+	}
+
+	return res
+}
+
+func SigningKeyRotationsFromProto(rotations []*SigningKeyRotation) []*signer.KeyRotation {
+	res := make([]*signer.KeyRotation, len(rotations))
+
+	for i, r := range rotations {
+		res[i] = &signer.KeyRotation{
+			PublicKey:       r.GetPublicKey(),
+			SignerPublicKey: r.GetSignerPublicKey(),
+			Signature:       r.GetSignature(),
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// APIKeyHeader is the gRPC metadata key, and the HTTP header on the REST gateway, carrying the API key
+const APIKeyHeader = "x-api-key"
+
+const apiKeyPrefix = "immudb_"
+const apiKeySecretLen = 32
+
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// APIKey is a long-lived credential bound to a user, restricted to a single permission on a database.
+// Only the hash of the secret is stored, the key itself is shown once when it's created
+type APIKey struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Username   string    `json:"username"`   //user the key is bound to
+	Database   string    `json:"database"`   //database the key grants access to
+	Permission uint32    `json:"permission"` //permission granted on the database, never more than the one of the user
+	SecretHash []byte    `json:"secrethash"`
+	CreatedBy  string    `json:"createdBy"`
+	CreatedAt  time.Time `json:"createdat"`
+	ExpiresAt  time.Time `json:"expiresat"` //zero if the key never expires
+	LastUsedAt time.Time `json:"lastusedat"`
+	Revoked    bool      `json:"revoked"`
+}
+
+// GenerateAPIKey creates a new API key, returning the key to be handed to the client
+func GenerateAPIKey() (*APIKey, string, error) {
+	secret := make([]byte, apiKeySecretLen)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	encodedSecret := hex.EncodeToString(secret)
+
+	apiKey := &APIKey{
+		ID:         NewStringUUID(),
+		SecretHash: hashAPIKeySecret(encodedSecret),
+		CreatedAt:  time.Now(),
+	}
+
+	return apiKey, apiKeyPrefix + apiKey.ID + "_" + encodedSecret, nil
+}
+
+// ParseAPIKey splits an API key in the id of the key and its secret
+func ParseAPIKey(key string) (id string, secret string, err error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return "", "", ErrInvalidAPIKey
+	}
+
+	key = key[len(apiKeyPrefix):]
+
+	i := strings.Index(key, "_")
+	if i <= 0 || i == len(key)-1 {
+		return "", "", ErrInvalidAPIKey
+	}
+
+	return key[:i], key[i+1:], nil
+}
+
+func hashAPIKeySecret(secret string) []byte {
+	// secrets are random, there is no need for a slow password hashing function
+	// which would be paid on every request
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
+}
+
+// VerifySecret checks the secret matches the one of the key
+func (k *APIKey) VerifySecret(secret string) bool {
+	return subtle.ConstantTimeCompare(k.SecretHash, hashAPIKeySecret(secret)) == 1
+}
+
+// IsExpired returns true if the key has an expiration time and it has passed
+func (k *APIKey) IsExpired() bool {
+	return !k.ExpiresAt.IsZero() && time.Now().After(k.ExpiresAt)
+}
+
+// Validate checks the key is valid for the user it's bound to
+func (k *APIKey) Validate() error {
+	if k.Revoked {
+		return fmt.Errorf("%w: key has been revoked", ErrInvalidAPIKey)
+	}
+
+	if k.IsExpired() {
+		return fmt.Errorf("%w: key has expired", ErrInvalidAPIKey)
+	}
+
+	return nil
+}
+
+// IsValidAPIKeyPermission returns true if the permission can be granted to API keys
+func IsValidAPIKeyPermission(permission uint32) bool {
+	return permission == PermissionR || permission == PermissionRW || permission == PermissionAdmin
+}
+
+// GetAPIKeyFromContext returns the API key provided in the incoming metadata, if any
+func GetAPIKeyFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	keys := md.Get(APIKeyHeader)
+	if len(keys) == 0 || keys[0] == "" {
+		return "", false
+	}
+
+	return keys[0], true
+}
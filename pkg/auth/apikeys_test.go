@@ -0,0 +1,65 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAPIKeys(t *testing.T) {
+	apiKey, key, err := GenerateAPIKey()
+	require.NoError(t, err)
+	require.NotEmpty(t, apiKey.ID)
+	require.NoError(t, apiKey.Validate())
+
+	id, secret, err := ParseAPIKey(key)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.ID, id)
+	require.True(t, apiKey.VerifySecret(secret))
+	require.False(t, apiKey.VerifySecret(secret[1:]))
+
+	for _, invalid := range []string{"", "immudb_", "immudb__secret", "immudb_id_", "other_id_secret"} {
+		_, _, err := ParseAPIKey(invalid)
+		require.ErrorIs(t, err, ErrInvalidAPIKey, invalid)
+	}
+
+	apiKey.ExpiresAt = time.Now().Add(-time.Second)
+	require.True(t, apiKey.IsExpired())
+	require.ErrorIs(t, apiKey.Validate(), ErrInvalidAPIKey)
+
+	apiKey.ExpiresAt = time.Time{}
+	apiKey.Revoked = true
+	require.ErrorIs(t, apiKey.Validate(), ErrInvalidAPIKey)
+
+	require.True(t, IsValidAPIKeyPermission(PermissionR))
+	require.True(t, IsValidAPIKeyPermission(PermissionAdmin))
+	require.False(t, IsValidAPIKeyPermission(PermissionSysAdmin))
+	require.False(t, IsValidAPIKeyPermission(PermissionNone))
+
+	_, ok := GetAPIKeyFromContext(context.Background())
+	require.False(t, ok)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyHeader, key))
+	k, ok := GetAPIKeyFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, key, k)
+}
@@ -0,0 +1,549 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrInvalidJWT = errors.New("invalid jwt")
+var ErrInvalidJWKS = errors.New("invalid jwks")
+var ErrInvalidJWTOptions = errors.New("invalid jwt options")
+
+const (
+	DefaultJWTUsernameClaim = "sub"
+	DefaultJWTRolesClaim    = "roles"
+
+	// jwtLeeway is the clock skew tolerated when checking the validity period of tokens
+	jwtLeeway = time.Minute
+	// jwksMinRefreshInterval limits how often the key set is reloaded when tokens are signed by unknown keys,
+	// failed reloads included
+	jwksMinRefreshInterval = time.Minute
+)
+
+// JWTOptions configures the validation of externally issued JWT bearer tokens
+type JWTOptions struct {
+	JWKS          string // path or http(s) URL of the JSON Web Key Set used to verify signatures
+	Issuer        string // the iss claim must match it
+	Audience      string // the aud claim must contain it
+	UsernameClaim string // claim holding the immudb username, sub by default
+	RolesClaim    string // claim holding the immudb roles granted to the user, roles by default
+}
+
+// JWTClaims are the claims of a validated token mapped to immudb users and roles
+type JWTClaims struct {
+	Username  string
+	Roles     []string
+	ExpiresAt time.Time
+}
+
+// JWTValidator validates JWT bearer tokens against a JSON Web Key Set
+type JWTValidator struct {
+	opts JWTOptions
+
+	mutex       sync.Mutex
+	keys        []*jsonWebKey
+	lastRefresh time.Time // time of the last reload attempt
+
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+type jsonWebKey struct {
+	kid string
+	alg string
+	key crypto.PublicKey
+}
+
+// NewJWTValidator creates a validator loading the key set from opts.JWKS
+func NewJWTValidator(opts JWTOptions) (*JWTValidator, error) {
+	if opts.JWKS == "" {
+		return nil, fmt.Errorf("%w: no key set was provided", ErrInvalidJWKS)
+	}
+
+	// a key set may be shared by other applications, tokens issued to them must not be accepted
+	if opts.Issuer == "" {
+		return nil, fmt.Errorf("%w: no issuer was provided", ErrInvalidJWTOptions)
+	}
+
+	if opts.Audience == "" {
+		return nil, fmt.Errorf("%w: no audience was provided", ErrInvalidJWTOptions)
+	}
+
+	if opts.UsernameClaim == "" {
+		opts.UsernameClaim = DefaultJWTUsernameClaim
+	}
+
+	if opts.RolesClaim == "" {
+		opts.RolesClaim = DefaultJWTRolesClaim
+	}
+
+	v := &JWTValidator{
+		opts:       opts,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+	}
+
+	v.lastRefresh = v.now()
+
+	err := v.refreshKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// refreshKeys reloads the key set, it must be called without holding the mutex
+// as fetching the key set may take as long as the http client timeout
+func (v *JWTValidator) refreshKeys() error {
+	var data []byte
+	var err error
+
+	if strings.HasPrefix(v.opts.JWKS, "http://") || strings.HasPrefix(v.opts.JWKS, "https://") {
+		data, err = v.fetchKeys(v.opts.JWKS)
+	} else {
+		data, err = ioutil.ReadFile(v.opts.JWKS)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJWKS, err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.keys = keys
+
+	return nil
+}
+
+// mayRefreshKeys returns true if the key set can be reloaded, the attempt is recorded
+// so that concurrent or failing reloads are rate limited as well
+func (v *JWTValidator) mayRefreshKeys() bool {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	now := v.now()
+
+	if now.Sub(v.lastRefresh) < jwksMinRefreshInterval {
+		return false
+	}
+
+	v.lastRefresh = now
+
+	return true
+}
+
+func (v *JWTValidator) currentKeys() []*jsonWebKey {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	return v.keys
+}
+
+func (v *JWTValidator) fetchKeys(url string) ([]byte, error) {
+	res, err := v.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	return ioutil.ReadAll(res.Body)
+}
+
+// parseJWKS parses the signature keys of a JSON Web Key Set (RFC 7517),
+// RSA, EC (P-256, P-384, P-521) and OKP (Ed25519) keys are supported
+func parseJWKS(data []byte) ([]*jsonWebKey, error) {
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			Alg string `json:"alg"`
+			Crv string `json:"crv"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+
+	err := json.Unmarshal(data, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJWKS, err)
+	}
+
+	var keys []*jsonWebKey
+
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+
+		switch k.Kty {
+		case "RSA":
+			n, errN := decodeBigInt(k.N)
+			e, errE := decodeBigInt(k.E)
+			if errN != nil || errE != nil || !e.IsInt64() {
+				return nil, fmt.Errorf("%w: malformed RSA key '%s'", ErrInvalidJWKS, k.Kid)
+			}
+
+			key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			var curve elliptic.Curve
+
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				continue
+			}
+
+			x, errX := decodeBigInt(k.X)
+			y, errY := decodeBigInt(k.Y)
+			if errX != nil || errY != nil || !curve.IsOnCurve(x, y) {
+				return nil, fmt.Errorf("%w: malformed EC key '%s'", ErrInvalidJWKS, k.Kid)
+			}
+
+			key = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		case "OKP":
+			if k.Crv != "Ed25519" {
+				continue
+			}
+
+			x, err := base64.RawURLEncoding.DecodeString(k.X)
+			if err != nil || len(x) != ed25519.PublicKeySize {
+				return nil, fmt.Errorf("%w: malformed Ed25519 key '%s'", ErrInvalidJWKS, k.Kid)
+			}
+
+			key = ed25519.PublicKey(x)
+		default:
+			continue
+		}
+
+		keys = append(keys, &jsonWebKey{kid: k.Kid, alg: k.Alg, key: key})
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no signature keys found", ErrInvalidJWKS)
+	}
+
+	return keys, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// IsJWT returns true if token has the compact serialization of a signed JWT,
+// it is used to tell bearer tokens apart from passwords
+func IsJWT(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	_, err := parseJWTHeader(parts[0])
+	return err == nil
+}
+
+func parseJWTHeader(encoded string) (*jwtHeader, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var header jwtHeader
+
+	err = json.Unmarshal(data, &header)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Alg == "" {
+		return nil, errors.New("missing alg")
+	}
+
+	return &header, nil
+}
+
+// Validate checks the signature and the registered claims of token,
+// then maps its claims to the immudb username and roles
+func (v *JWTValidator) Validate(token string) (*JWTClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidJWT)
+	}
+
+	header, err := parseJWTHeader(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidJWT)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidJWT)
+	}
+
+	err = v.verifySignature(header, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", ErrInvalidJWT)
+	}
+
+	var claims map[string]interface{}
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", ErrInvalidJWT)
+	}
+
+	return v.validateClaims(claims)
+}
+
+func (v *JWTValidator) verifySignature(header *jwtHeader, signed, signature []byte) error {
+	verify, err := signatureVerifier(header.Alg)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		found := false
+
+		for _, k := range v.currentKeys() {
+			if header.Kid != "" && k.kid != header.Kid {
+				continue
+			}
+			if k.alg != "" && k.alg != header.Alg {
+				continue
+			}
+
+			found = true
+
+			if verify(k.key, signed, signature) {
+				return nil
+			}
+		}
+
+		// keys are reloaded when the token is signed by an unknown key, as issuers rotate them
+		if found || attempt > 0 || !v.mayRefreshKeys() {
+			break
+		}
+
+		err = v.refreshKeys()
+		if err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w: signature verification failed", ErrInvalidJWT)
+}
+
+type signatureVerifierFn func(key crypto.PublicKey, signed, signature []byte) bool
+
+func signatureVerifier(alg string) (signatureVerifierFn, error) {
+	switch alg {
+	case "RS256":
+		return rsaVerifier(crypto.SHA256, false), nil
+	case "RS384":
+		return rsaVerifier(crypto.SHA384, false), nil
+	case "RS512":
+		return rsaVerifier(crypto.SHA512, false), nil
+	case "PS256":
+		return rsaVerifier(crypto.SHA256, true), nil
+	case "PS384":
+		return rsaVerifier(crypto.SHA384, true), nil
+	case "PS512":
+		return rsaVerifier(crypto.SHA512, true), nil
+	case "ES256":
+		return ecdsaVerifier(crypto.SHA256, elliptic.P256()), nil
+	case "ES384":
+		return ecdsaVerifier(crypto.SHA384, elliptic.P384()), nil
+	case "ES512":
+		return ecdsaVerifier(crypto.SHA512, elliptic.P521()), nil
+	case "EdDSA":
+		return func(key crypto.PublicKey, signed, signature []byte) bool {
+			pk, ok := key.(ed25519.PublicKey)
+			return ok && ed25519.Verify(pk, signed, signature)
+		}, nil
+	}
+
+	// symmetric algorithms and unsigned tokens are not accepted
+	return nil, fmt.Errorf("%w: unsupported algorithm '%s'", ErrInvalidJWT, alg)
+}
+
+func digest(hash crypto.Hash, data []byte) []byte {
+	switch hash {
+	case crypto.SHA384:
+		h := sha512.Sum384(data)
+		return h[:]
+	case crypto.SHA512:
+		h := sha512.Sum512(data)
+		return h[:]
+	}
+
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+func rsaVerifier(hash crypto.Hash, pss bool) signatureVerifierFn {
+	return func(key crypto.PublicKey, signed, signature []byte) bool {
+		pk, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return false
+		}
+
+		if pss {
+			return rsa.VerifyPSS(pk, hash, digest(hash, signed), signature, nil) == nil
+		}
+
+		return rsa.VerifyPKCS1v15(pk, hash, digest(hash, signed), signature) == nil
+	}
+}
+
+func ecdsaVerifier(hash crypto.Hash, curve elliptic.Curve) signatureVerifierFn {
+	return func(key crypto.PublicKey, signed, signature []byte) bool {
+		pk, ok := key.(*ecdsa.PublicKey)
+		if !ok || pk.Curve != curve {
+			return false
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return false
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		return ecdsa.Verify(pk, digest(hash, signed), r, s)
+	}
+}
+
+func (v *JWTValidator) validateClaims(claims map[string]interface{}) (*JWTClaims, error) {
+	now := v.now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing expiration time", ErrInvalidJWT)
+	}
+
+	expiresAt := time.Unix(int64(exp), 0)
+	if now.After(expiresAt.Add(jwtLeeway)) {
+		return nil, fmt.Errorf("%w: token has expired", ErrInvalidJWT)
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(jwtLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return nil, fmt.Errorf("%w: token is not valid yet", ErrInvalidJWT)
+	}
+
+	if claims["iss"] != v.opts.Issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidJWT)
+	}
+
+	if !containsClaimValue(claims["aud"], v.opts.Audience) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidJWT)
+	}
+
+	username, ok := claims[v.opts.UsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("%w: missing %s claim", ErrInvalidJWT, v.opts.UsernameClaim)
+	}
+
+	var roles []string
+
+	switch r := claims[v.opts.RolesClaim].(type) {
+	case string:
+		// space separated values, as in the scope claim
+		roles = strings.Fields(r)
+	case []interface{}:
+		for _, role := range r {
+			if s, ok := role.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+	}
+
+	return &JWTClaims{
+		Username:  username,
+		Roles:     roles,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// containsClaimValue checks if claim, which may be a single string or an array of strings, contains value
+func containsClaimValue(claim interface{}, value string) bool {
+	switch c := claim.(type) {
+	case string:
+		return c == value
+	case []interface{}:
+		for _, v := range c {
+			if v == value {
+				return true
+			}
+		}
+	}
+	return false
+}
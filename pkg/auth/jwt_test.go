@@ -0,0 +1,400 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testJWTIssuer struct {
+	rsaKey     *rsa.PrivateKey
+	ecKey      *ecdsa.PrivateKey
+	ed25519Key ed25519.PrivateKey
+}
+
+func newTestJWTIssuer(t *testing.T) *testJWTIssuer {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return &testJWTIssuer{rsaKey: rsaKey, ecKey: ecKey, ed25519Key: ed25519Key}
+}
+
+func (i *testJWTIssuer) jwks(t *testing.T) []byte {
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa1",
+				"use": "sig",
+				"n":   b64(i.rsaKey.N.Bytes()),
+				"e":   b64(big.NewInt(int64(i.rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec1",
+				"crv": "P-256",
+				"x":   b64(i.ecKey.X.FillBytes(make([]byte, 32))),
+				"y":   b64(i.ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+			{
+				"kty": "OKP",
+				"kid": "ed1",
+				"crv": "Ed25519",
+				"x":   b64(i.ed25519Key.Public().(ed25519.PublicKey)),
+			},
+		},
+	}
+
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	return data
+}
+
+func (i *testJWTIssuer) token(t *testing.T, alg, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+
+	switch alg {
+	case "RS256":
+		signature, err = rsa.SignPKCS1v15(rand.Reader, i.rsaKey, crypto.SHA256, digest(crypto.SHA256, []byte(signed)))
+		require.NoError(t, err)
+	case "PS256":
+		signature, err = rsa.SignPSS(rand.Reader, i.rsaKey, crypto.SHA256, digest(crypto.SHA256, []byte(signed)), nil)
+		require.NoError(t, err)
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, i.ecKey, digest(crypto.SHA256, []byte(signed)))
+		require.NoError(t, err)
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case "EdDSA":
+		signature = ed25519.Sign(i.ed25519Key, []byte(signed))
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestJWTValidator(t *testing.T) {
+	issuer := newTestJWTIssuer(t)
+
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	err := os.WriteFile(jwksPath, issuer.jwks(t), 0644)
+	require.NoError(t, err)
+
+	v, err := NewJWTValidator(JWTOptions{
+		JWKS:     jwksPath,
+		Issuer:   "https://sso.example.com",
+		Audience: "immudb",
+	})
+	require.NoError(t, err)
+
+	claims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   "https://sso.example.com",
+			"aud":   []string{"immudb", "other"},
+			"sub":   "user1",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"roles": []string{"analyst", "auditor"},
+		}
+	}
+
+	for _, tc := range []struct{ alg, kid string }{
+		{"RS256", "rsa1"},
+		{"PS256", "rsa1"},
+		{"ES256", "ec1"},
+		{"EdDSA", "ed1"},
+		{"EdDSA", ""},
+	} {
+		t.Run(tc.alg+" signed tokens should be accepted", func(t *testing.T) {
+			token := issuer.token(t, tc.alg, tc.kid, claims())
+			require.True(t, IsJWT(token))
+
+			c, err := v.Validate(token)
+			require.NoError(t, err)
+			require.Equal(t, "user1", c.Username)
+			require.Equal(t, []string{"analyst", "auditor"}, c.Roles)
+		})
+	}
+
+	t.Run("passwords should not be taken as tokens", func(t *testing.T) {
+		require.False(t, IsJWT("immudb"))
+		require.False(t, IsJWT("a.b.c"))
+	})
+
+	t.Run("tampered tokens should be rejected", func(t *testing.T) {
+		token := issuer.token(t, "RS256", "rsa1", claims())
+
+		tamperedClaims := claims()
+		tamperedClaims["sub"] = "immudb"
+		tampered := issuer.token(t, "RS256", "rsa1", tamperedClaims)
+
+		parts := strings.Split(token, ".")
+		tamperedParts := strings.Split(tampered, ".")
+
+		_, err := v.Validate(parts[0] + "." + tamperedParts[1] + "." + parts[2])
+		require.ErrorIs(t, err, ErrInvalidJWT)
+	})
+
+	t.Run("tokens signed with a different key should be rejected", func(t *testing.T) {
+		token := issuer.token(t, "ES256", "rsa1", claims())
+
+		_, err := v.Validate(token)
+		require.ErrorIs(t, err, ErrInvalidJWT)
+	})
+
+	t.Run("unsigned and symmetric tokens should be rejected", func(t *testing.T) {
+		token := issuer.token(t, "RS256", "rsa1", claims())
+		parts := strings.Split(token, ".")
+
+		for _, alg := range []string{"none", "HS256"} {
+			header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"` + alg + `"}`))
+
+			_, err := v.Validate(header + "." + parts[1] + ".")
+			require.ErrorIs(t, err, ErrInvalidJWT)
+		}
+	})
+
+	t.Run("registered claims should be checked", func(t *testing.T) {
+		for name, change := range map[string]func(c map[string]interface{}){
+			"expired":       func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+			"no expiration": func(c map[string]interface{}) { delete(c, "exp") },
+			"not yet valid": func(c map[string]interface{}) { c["nbf"] = time.Now().Add(time.Hour).Unix() },
+			"issuer":        func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+			"audience":      func(c map[string]interface{}) { c["aud"] = "other" },
+			"username":      func(c map[string]interface{}) { delete(c, "sub") },
+		} {
+			c := claims()
+			change(c)
+
+			_, err := v.Validate(issuer.token(t, "RS256", "rsa1", c))
+			require.ErrorIs(t, err, ErrInvalidJWT, name)
+		}
+	})
+
+	t.Run("custom claims should be mapped", func(t *testing.T) {
+		v, err := NewJWTValidator(JWTOptions{
+			JWKS:          jwksPath,
+			Issuer:        "https://sso.example.com",
+			Audience:      "immudb",
+			UsernameClaim: "preferred_username",
+			RolesClaim:    "scope",
+		})
+		require.NoError(t, err)
+
+		c := claims()
+		c["preferred_username"] = "user2"
+		c["scope"] = "analyst auditor"
+
+		mapped, err := v.Validate(issuer.token(t, "RS256", "rsa1", c))
+		require.NoError(t, err)
+		require.Equal(t, "user2", mapped.Username)
+		require.Equal(t, []string{"analyst", "auditor"}, mapped.Roles)
+	})
+
+	t.Run("key set should be reloaded when keys are rotated", func(t *testing.T) {
+		rotated := newTestJWTIssuer(t)
+
+		err := os.WriteFile(jwksPath, rotated.jwks(t), 0644)
+		require.NoError(t, err)
+
+		token := rotated.token(t, "RS256", "rsa2", claims())
+
+		_, err = v.Validate(token)
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		v.lastRefresh = time.Now().Add(-jwksMinRefreshInterval)
+
+		// the key id is unknown, keys are reloaded but the new key has another id
+		_, err = v.Validate(token)
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		_, err = v.Validate(rotated.token(t, "RS256", "rsa1", claims()))
+		require.NoError(t, err)
+	})
+}
+
+func TestJWTValidatorWithJWKSURL(t *testing.T) {
+	issuer := newTestJWTIssuer(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jwks.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(issuer.jwks(t))
+	}))
+	defer srv.Close()
+
+	opts := JWTOptions{
+		JWKS:     srv.URL + "/jwks.json",
+		Issuer:   srv.URL,
+		Audience: "immudb",
+	}
+
+	v, err := NewJWTValidator(opts)
+	require.NoError(t, err)
+
+	c, err := v.Validate(issuer.token(t, "ES256", "ec1", map[string]interface{}{
+		"iss": srv.URL,
+		"aud": "immudb",
+		"sub": "user1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "user1", c.Username)
+	require.Empty(t, c.Roles)
+
+	_, err = NewJWTValidator(JWTOptions{JWKS: srv.URL + "/missing", Issuer: srv.URL, Audience: "immudb"})
+	require.ErrorIs(t, err, ErrInvalidJWKS)
+
+	_, err = NewJWTValidator(JWTOptions{JWKS: filepath.Join(t.TempDir(), "missing.json"), Issuer: srv.URL, Audience: "immudb"})
+	require.ErrorIs(t, err, ErrInvalidJWKS)
+
+	_, err = NewJWTValidator(JWTOptions{})
+	require.ErrorIs(t, err, ErrInvalidJWKS)
+
+	_, err = NewJWTValidator(JWTOptions{JWKS: opts.JWKS, Audience: opts.Audience})
+	require.ErrorIs(t, err, ErrInvalidJWTOptions)
+
+	_, err = NewJWTValidator(JWTOptions{JWKS: opts.JWKS, Issuer: opts.Issuer})
+	require.ErrorIs(t, err, ErrInvalidJWTOptions)
+}
+
+func TestJWTValidatorKeyRefresh(t *testing.T) {
+	issuer := newTestJWTIssuer(t)
+
+	var requests int32
+	var failing int32
+
+	block := make(chan struct{})
+	blocked := make(chan struct{}, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		if atomic.LoadInt32(&failing) == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		if n == 2 {
+			blocked <- struct{}{}
+			<-block
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(issuer.jwks(t))
+	}))
+	defer srv.Close()
+
+	v, err := NewJWTValidator(JWTOptions{
+		JWKS:     srv.URL,
+		Issuer:   srv.URL,
+		Audience: "immudb",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, atomic.LoadInt32(&requests))
+
+	claims := map[string]interface{}{
+		"iss": srv.URL,
+		"aud": "immudb",
+		"sub": "user1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+
+	unknownKeyToken := issuer.token(t, "RS256", "rsa2", claims)
+
+	v.mutex.Lock()
+	v.lastRefresh = time.Now().Add(-jwksMinRefreshInterval)
+	v.mutex.Unlock()
+
+	t.Run("tokens should be validated while keys are being fetched", func(t *testing.T) {
+		done := make(chan error)
+
+		go func() {
+			_, err := v.Validate(unknownKeyToken)
+			done <- err
+		}()
+
+		<-blocked
+
+		_, err := v.Validate(issuer.token(t, "ES256", "ec1", claims))
+		require.NoError(t, err)
+
+		// the ongoing reload rate limits other reloads
+		_, err = v.Validate(unknownKeyToken)
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		close(block)
+
+		require.ErrorIs(t, <-done, ErrInvalidJWT)
+		require.EqualValues(t, 2, atomic.LoadInt32(&requests))
+	})
+
+	t.Run("failed reloads should be rate limited", func(t *testing.T) {
+		atomic.StoreInt32(&failing, 1)
+
+		v.mutex.Lock()
+		v.lastRefresh = time.Now().Add(-jwksMinRefreshInterval)
+		v.mutex.Unlock()
+
+		_, err := v.Validate(unknownKeyToken)
+		require.ErrorIs(t, err, ErrInvalidJWKS)
+
+		_, err = v.Validate(unknownKeyToken)
+		require.ErrorIs(t, err, ErrInvalidJWT)
+
+		require.EqualValues(t, 3, atomic.LoadInt32(&requests))
+
+		// previously loaded keys are kept
+		_, err = v.Validate(issuer.token(t, "ES256", "ec1", claims))
+		require.NoError(t, err)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 	"unicode"
 
 	"golang.org/x/crypto/bcrypt"
@@ -43,21 +44,89 @@ func ComparePasswords(hashedPassword []byte, plainPassword []byte) error {
 const minPasswordLen = 8
 const maxPasswordLen = 32
 
-// PasswordRequirementsMsg message used to inform the user about password strength requirements
-var PasswordRequirementsMsg = fmt.Sprintf(
-	"password must have between %d and %d letters, digits and special characters "+
-		"of which at least 1 uppercase letter, 1 digit and 1 special character",
-	minPasswordLen,
-	maxPasswordLen,
-)
+// bcrypt ignores the bytes beyond this length
+const maxHashedPasswordLen = 72
 
-// IsStrongPassword checks if the provided password meets the strength requirements
-func IsStrongPassword(password string) error {
-	err := errors.New(PasswordRequirementsMsg)
-	if len(password) < minPasswordLen || len(password) > maxPasswordLen {
+// ErrInvalidPasswordPolicy is returned when the requirements of a password policy are inconsistent
+var ErrInvalidPasswordPolicy = errors.New("invalid password policy")
+
+// PasswordPolicy defines the requirements passwords must meet and for how long they are valid
+type PasswordPolicy struct {
+	MinLength      int
+	MaxLength      int
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSpecial bool
+
+	// History is the number of previous passwords of a user which can not be reused
+	History int
+
+	// MaxAge is the time after which passwords expire and must be changed, zero disables the expiration
+	MaxAge time.Duration
+}
+
+// DefaultPasswordPolicy returns the policy enforced when no other is configured
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:      minPasswordLen,
+		MaxLength:      maxPasswordLen,
+		RequireUpper:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+	}
+}
+
+// Validate checks the requirements of the policy are consistent
+func (p *PasswordPolicy) Validate() error {
+	if p.MinLength < 1 || p.MaxLength < p.MinLength || p.MaxLength > maxHashedPasswordLen {
+		return fmt.Errorf("%w: password length must be between 1 and %d", ErrInvalidPasswordPolicy, maxHashedPasswordLen)
+	}
+	if p.History < 0 {
+		return fmt.Errorf("%w: password history can not be negative", ErrInvalidPasswordPolicy)
+	}
+	if p.MaxAge < 0 {
+		return fmt.Errorf("%w: password max age can not be negative", ErrInvalidPasswordPolicy)
+	}
+	return nil
+}
+
+// RequirementsMsg describes the requirements passwords must meet
+func (p *PasswordPolicy) RequirementsMsg() string {
+	msg := fmt.Sprintf("password must have between %d and %d letters, digits and special characters", p.MinLength, p.MaxLength)
+
+	var required []string
+	if p.RequireUpper {
+		required = append(required, "1 uppercase letter")
+	}
+	if p.RequireLower {
+		required = append(required, "1 lowercase letter")
+	}
+	if p.RequireDigit {
+		required = append(required, "1 digit")
+	}
+	if p.RequireSpecial {
+		required = append(required, "1 special character")
+	}
+
+	switch len(required) {
+	case 0:
+		return msg
+	case 1:
+		return msg + " of which at least " + required[0]
+	default:
+		return msg + " of which at least " + strings.Join(required[:len(required)-1], ", ") + " and " + required[len(required)-1]
+	}
+}
+
+// Check returns an error if the password does not meet the requirements of the policy
+func (p *PasswordPolicy) Check(password string) error {
+	err := errors.New(p.RequirementsMsg())
+	if len(password) < p.MinLength || len(password) > p.MaxLength {
 		return err
 	}
 	var hasUpper bool
+	var hasLower bool
 	var hasDigit bool
 	var hasSpecial bool
 	for _, ch := range password {
@@ -65,6 +134,7 @@ func IsStrongPassword(password string) error {
 		case unicode.IsUpper(ch):
 			hasUpper = true
 		case unicode.IsLower(ch):
+			hasLower = true
 		case unicode.IsDigit(ch):
 			hasDigit = true
 		case unicode.IsPunct(ch) || unicode.IsSymbol(ch):
@@ -73,12 +143,28 @@ func IsStrongPassword(password string) error {
 			return err
 		}
 	}
-	if !hasUpper || !hasDigit || !hasSpecial {
+	if (p.RequireUpper && !hasUpper) ||
+		(p.RequireLower && !hasLower) ||
+		(p.RequireDigit && !hasDigit) ||
+		(p.RequireSpecial && !hasSpecial) {
 		return err
 	}
 	return nil
 }
 
+// IsExpired returns true if a password set at changedAt is no longer valid
+func (p *PasswordPolicy) IsExpired(changedAt time.Time) bool {
+	return p.MaxAge > 0 && time.Since(changedAt) > p.MaxAge
+}
+
+// PasswordRequirementsMsg message used to inform the user about password strength requirements
+var PasswordRequirementsMsg = DefaultPasswordPolicy().RequirementsMsg()
+
+// IsStrongPassword checks if the provided password meets the strength requirements
+func IsStrongPassword(password string) error {
+	return DefaultPasswordPolicy().Check(password)
+}
+
 // DecodeBase64Password decodes the provided base64-encoded password if it has the
 // "enc:" prefix or returns it with leading and trailing space trimmed otherwise
 func DecodeBase64Password(passwordBase64 string) (string, error) {
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -43,6 +44,42 @@ func TestIsStrongPassword(t *testing.T) {
 	}
 }
 
+func TestPasswordPolicy(t *testing.T) {
+	require.NoError(t, DefaultPasswordPolicy().Validate())
+	require.Equal(t, PasswordRequirementsMsg, DefaultPasswordPolicy().RequirementsMsg())
+	require.Contains(t, PasswordRequirementsMsg, "1 uppercase letter, 1 digit and 1 special character")
+
+	for _, p := range []*PasswordPolicy{
+		{MinLength: 0, MaxLength: 10},
+		{MinLength: 10, MaxLength: 8},
+		{MinLength: 8, MaxLength: 100},
+		{MinLength: 8, MaxLength: 32, History: -1},
+		{MinLength: 8, MaxLength: 32, MaxAge: -time.Hour},
+	} {
+		require.ErrorIs(t, p.Validate(), ErrInvalidPasswordPolicy)
+	}
+
+	policy := &PasswordPolicy{
+		MinLength:    12,
+		MaxLength:    64,
+		RequireLower: true,
+		RequireDigit: true,
+		MaxAge:       time.Hour,
+	}
+	require.NoError(t, policy.Validate())
+	require.Equal(t, "password must have between 12 and 64 letters, digits and special characters "+
+		"of which at least 1 lowercase letter and 1 digit", policy.RequirementsMsg())
+
+	require.NoError(t, policy.Check("correcthorse1"))
+	require.EqualError(t, policy.Check("CORRECTHORSE1"), policy.RequirementsMsg())
+	require.Error(t, policy.Check("correcthorse"))
+	require.Error(t, policy.Check("short1"))
+
+	require.False(t, policy.IsExpired(time.Now().Add(-time.Minute)))
+	require.True(t, policy.IsExpired(time.Now().Add(-2*time.Hour)))
+	require.False(t, DefaultPasswordPolicy().IsExpired(time.Time{}))
+}
+
 func TestDecodeBase64Password(t *testing.T) {
 	pass := "pass"
 	_, err := DecodeBase64Password(pass)
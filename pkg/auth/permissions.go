@@ -19,16 +19,20 @@ package auth
 var maintenanceMethods = map[string]struct{}{
 	"Get":                 {},
 	"VerifiableGet":       {},
+	"VerifiableGetAbsent": {},
 	"StreamGet":           {},
 	"StreamVerifiableGet": {},
 	"GetAll":              {},
 	"ZScan":               {},
+	"VerifiableZScan":     {},
 	"StreamZScan":         {},
 	"VerifiableTxByID":    {},
 	"IScan":               {},
 	"Scan":                {},
+	"VerifiableScan":      {},
 	"StreamScan":          {},
 	"History":             {},
+	"VerifiableHistory":   {},
 	"StreamHistory":       {},
 	"TxByID":              {},
 	"TxScan":              {},
@@ -38,17 +42,24 @@ var maintenanceMethods = map[string]struct{}{
 	"CountAll":            {},
 	"DatabaseList":        {},
 	"CurrentState":        {},
+	"CosignState":         {},
+	"CosignedState":       {},
+	"GetTimestampAnchor":  {},
+	"PrefixDigest":        {},
+	"TableDigest":         {},
 	"UseSnapshot":         {},
 	"SQLQuery":            {},
 	"ListTables":          {},
 	"DescribeTable":       {},
 	"VerifiableSQLGet":    {},
+	"VerifiableSQLQuery":  {},
 
 	// admin methods
 	"ListUsers":    {},
 	"Dump":         {},
 	"FlushIndex":   {},
 	"CompactIndex": {},
+	"RebuildIndex": {},
 }
 
 // PermissionSysAdmin the admin permission byte
@@ -73,6 +84,7 @@ var methodsPermissions = map[string][]uint32{
 	"StreamVerifiableSet":    {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"Get":                    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"VerifiableGet":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableGetAbsent":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamGet":              {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamVerifiableGet":    {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"GetAll":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW},
@@ -83,12 +95,15 @@ var methodsPermissions = map[string][]uint32{
 	"ZAdd":                   {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"VerifiableZAdd":         {PermissionSysAdmin, PermissionAdmin, PermissionRW},
 	"ZScan":                  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableZScan":        {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamZScan":            {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"VerifiableTxByID":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"IScan":                  {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"Scan":                   {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableScan":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamScan":             {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"History":                {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableHistory":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"StreamHistory":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"TxByID":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"TxScan":                 {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
@@ -96,6 +111,11 @@ var methodsPermissions = map[string][]uint32{
 	"CountAll":               {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"DatabaseList":           {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"CurrentState":           {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CosignState":            {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CosignedState":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"GetTimestampAnchor":     {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"PrefixDigest":           {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"TableDigest":            {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"DatabaseHealth":         {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"DatabaseSettings":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"SQLExec":                {PermissionSysAdmin, PermissionAdmin, PermissionRW},
@@ -104,6 +124,7 @@ var methodsPermissions = map[string][]uint32{
 	"ListTables":             {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"DescribeTable":          {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"VerifiableSQLGet":       {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"VerifiableSQLQuery":     {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 
 	// admin methods
 	"ListUsers":        {PermissionSysAdmin, PermissionAdmin},
@@ -112,6 +133,10 @@ var methodsPermissions = map[string][]uint32{
 	"SetPermission":    {PermissionSysAdmin, PermissionAdmin},
 	"DeactivateUser":   {PermissionSysAdmin, PermissionAdmin},
 	"SetActiveUser":    {PermissionSysAdmin, PermissionAdmin},
+	"UnlockUser":       {PermissionSysAdmin, PermissionAdmin},
+	"CreateAPIKey":     {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"ListAPIKeys":      {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"RevokeAPIKey":     {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
 	"UpdateAuthConfig": {PermissionSysAdmin},
 	"UpdateMTLSConfig": {PermissionSysAdmin},
 	"CreateDatabase":   {PermissionSysAdmin},
@@ -121,11 +146,20 @@ var methodsPermissions = map[string][]uint32{
 	"Dump":             {PermissionSysAdmin, PermissionAdmin},
 	"FlushIndex":       {PermissionSysAdmin, PermissionAdmin},
 	"CompactIndex":     {PermissionSysAdmin, PermissionAdmin},
+	"BulkLoad":         {PermissionSysAdmin, PermissionAdmin},
+	"RebuildIndex":     {PermissionSysAdmin, PermissionAdmin},
 	"ExportTx":         {PermissionSysAdmin, PermissionAdmin},
 	"ReplicateTx":      {PermissionSysAdmin, PermissionAdmin},
+
+	// role methods
+	"ListRoles":            {PermissionSysAdmin, PermissionAdmin, PermissionRW, PermissionR},
+	"CreateRole":           {PermissionSysAdmin, PermissionAdmin},
+	"DropRole":             {PermissionSysAdmin, PermissionAdmin},
+	"ChangeRolePrivilege":  {PermissionSysAdmin, PermissionAdmin},
+	"ChangeRoleMembership": {PermissionSysAdmin, PermissionAdmin},
 }
 
-//HasPermissionForMethod checks if userPermission can access method name
+// HasPermissionForMethod checks if userPermission can access method name
 func HasPermissionForMethod(userPermission uint32, method string) bool {
 	methodPermissions, ok := methodsPermissions[method]
 	if !ok {
@@ -0,0 +1,268 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ErrInvalidPrivilege = errors.New("invalid privilege")
+
+// Privilege grantable to roles
+type Privilege string
+
+const (
+	// SQL privileges, granted on a table or on all the tables of a database
+	PrivilegeSelect Privilege = "SELECT"
+	PrivilegeInsert Privilege = "INSERT"
+	PrivilegeUpdate Privilege = "UPDATE"
+	PrivilegeDelete Privilege = "DELETE"
+
+	// Key-value privileges, granted on the keys starting with a given prefix
+	PrivilegeRead  Privilege = "READ"
+	PrivilegeWrite Privilege = "WRITE"
+
+	// Database privileges
+	PrivilegeTruncate  Privilege = "TRUNCATE"
+	PrivilegeReplicate Privilege = "REPLICATE"
+
+	// privilegeAny is satisfied by any privilege granted on the database
+	privilegeAny Privilege = ""
+)
+
+var TablePrivileges = []Privilege{PrivilegeSelect, PrivilegeInsert, PrivilegeUpdate, PrivilegeDelete}
+var KeyPrivileges = []Privilege{PrivilegeRead, PrivilegeWrite}
+var DatabasePrivileges = []Privilege{PrivilegeTruncate, PrivilegeReplicate}
+
+// ParsePrivilege returns the privilege with the given name, names are case insensitive
+func ParsePrivilege(name string) (Privilege, error) {
+	p := Privilege(strings.ToUpper(name))
+
+	if !p.IsTablePrivilege() && !p.IsKeyPrivilege() && !p.IsDatabasePrivilege() {
+		return "", fmt.Errorf("%w: unknown privilege '%s'", ErrInvalidPrivilege, name)
+	}
+
+	return p, nil
+}
+
+func (p Privilege) IsTablePrivilege() bool {
+	return ContainsPrivilege(TablePrivileges, p)
+}
+
+func (p Privilege) IsKeyPrivilege() bool {
+	return ContainsPrivilege(KeyPrivileges, p)
+}
+
+func (p Privilege) IsDatabasePrivilege() bool {
+	return ContainsPrivilege(DatabasePrivileges, p)
+}
+
+// ContainsPrivilege returns true if p is one of the privileges
+func ContainsPrivilege(privileges []Privilege, p Privilege) bool {
+	for _, privilege := range privileges {
+		if privilege == p {
+			return true
+		}
+	}
+	return false
+}
+
+// RolePrivilege is a privilege granted to a role on a database
+type RolePrivilege struct {
+	Privilege Privilege `json:"privilege"`
+	Database  string    `json:"database"`
+	Object    string    `json:"object"` //table name or key prefix, empty when it applies to all of them
+}
+
+// Validate checks the privilege is applicable to its object
+func (p RolePrivilege) Validate() error {
+	if p.Database == "" {
+		return fmt.Errorf("%w: database can not be empty", ErrInvalidPrivilege)
+	}
+
+	if p.Privilege.IsDatabasePrivilege() && p.Object != "" {
+		return fmt.Errorf("%w: %s privilege applies to the whole database", ErrInvalidPrivilege, p.Privilege)
+	}
+
+	if !p.Privilege.IsTablePrivilege() && !p.Privilege.IsKeyPrivilege() && !p.Privilege.IsDatabasePrivilege() {
+		return fmt.Errorf("%w: unknown privilege '%s'", ErrInvalidPrivilege, p.Privilege)
+	}
+
+	return nil
+}
+
+// covers returns true if the privilege grants privilege on object, where object is a table name
+// for SQL privileges and a key for key-value ones. An empty object stands for all of them.
+func (p RolePrivilege) covers(database string, privilege Privilege, object string) bool {
+	if p.Database != database {
+		return false
+	}
+
+	if privilege == privilegeAny {
+		return true
+	}
+
+	if p.Privilege != privilege {
+		return false
+	}
+
+	switch {
+	case privilege.IsTablePrivilege():
+		return p.Object == "" || p.Object == object
+	case privilege.IsKeyPrivilege():
+		return strings.HasPrefix(object, p.Object)
+	}
+
+	return true
+}
+
+// Role is a named set of privileges that can be granted to users
+type Role struct {
+	Name       string          `json:"name"`
+	Privileges []RolePrivilege `json:"privileges"`
+	CreatedBy  string          `json:"createdBy"` //user which created this role
+	CreatedAt  time.Time       `json:"createdat"` //time in which this role is created
+}
+
+// IsValidRoleName is a regexp function used to check role names, which are lowercase
+// as they are referenced from SQL statements as identifiers
+var IsValidRoleName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`).MatchString
+
+// GrantPrivilege adds the privilege to the role, returns false if it was already granted
+func (r *Role) GrantPrivilege(privilege RolePrivilege) bool {
+	for _, p := range r.Privileges {
+		if p == privilege {
+			return false
+		}
+	}
+
+	r.Privileges = append(r.Privileges, privilege)
+	return true
+}
+
+// RevokePrivilege removes the privilege from the role, returns false if it was not granted
+func (r *Role) RevokePrivilege(privilege RolePrivilege) bool {
+	for i, p := range r.Privileges {
+		if p == privilege {
+			r.Privileges = append(r.Privileges[:i], r.Privileges[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasPrivilege checks if the role grants privilege on the object of the database.
+// An empty object requires the privilege to be granted on all the tables or keys of the database.
+func (r *Role) HasPrivilege(database string, privilege Privilege, object string) bool {
+	for _, p := range r.Privileges {
+		if p.covers(database, privilege, object) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPrivilegesOn checks if the role grants any privilege on the database
+func (r *Role) HasPrivilegesOn(database string) bool {
+	return r.HasPrivilege(database, privilegeAny, "")
+}
+
+// Databases returns the databases the role grants privileges on
+func (r *Role) Databases() []string {
+	var dbs []string
+
+	for _, p := range r.Privileges {
+		found := false
+
+		for _, db := range dbs {
+			if db == p.Database {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			dbs = append(dbs, p.Database)
+		}
+	}
+
+	return dbs
+}
+
+// methodsPrivileges maps the methods that can be authorized by role privileges to the privilege they require.
+// Methods not accessing data only require any privilege on the database.
+var methodsPrivileges = map[string]Privilege{
+	"Get":                    PrivilegeRead,
+	"VerifiableGet":          PrivilegeRead,
+	"VerifiableGetAbsent":    PrivilegeRead,
+	"StreamGet":              PrivilegeRead,
+	"StreamVerifiableGet":    PrivilegeRead,
+	"GetAll":                 PrivilegeRead,
+	"ZScan":                  PrivilegeRead,
+	"VerifiableZScan":        PrivilegeRead,
+	"StreamZScan":            PrivilegeRead,
+	"VerifiableTxByID":       PrivilegeRead,
+	"IScan":                  PrivilegeRead,
+	"Scan":                   PrivilegeRead,
+	"VerifiableScan":         PrivilegeRead,
+	"StreamScan":             PrivilegeRead,
+	"History":                PrivilegeRead,
+	"VerifiableHistory":      PrivilegeRead,
+	"StreamHistory":          PrivilegeRead,
+	"TxByID":                 PrivilegeRead,
+	"TxScan":                 PrivilegeRead,
+	"Count":                  PrivilegeRead,
+	"CountAll":               PrivilegeRead,
+	"PrefixDigest":           PrivilegeRead,
+	"Set":                    PrivilegeWrite,
+	"Delete":                 PrivilegeWrite,
+	"VerifiableSet":          PrivilegeWrite,
+	"StreamSet":              PrivilegeWrite,
+	"StreamVerifiableSet":    PrivilegeWrite,
+	"ExecAll":                PrivilegeWrite,
+	"StreamExecAll":          PrivilegeWrite,
+	"SetReference":           PrivilegeWrite,
+	"VerifiableSetReference": PrivilegeWrite,
+	"ZAdd":                   PrivilegeWrite,
+	"VerifiableZAdd":         PrivilegeWrite,
+	"SQLQuery":               PrivilegeSelect,
+	"VerifiableSQLGet":       PrivilegeSelect,
+	"VerifiableSQLQuery":     PrivilegeSelect,
+	"DescribeTable":          PrivilegeSelect,
+	"TableDigest":            PrivilegeSelect,
+	"ExportTx":               PrivilegeReplicate,
+	"ReplicateTx":            PrivilegeReplicate,
+	"ListTables":             privilegeAny,
+	"CurrentState":           privilegeAny,
+	"CosignState":            privilegeAny,
+	"CosignedState":          privilegeAny,
+	"GetTimestampAnchor":     privilegeAny,
+	"DatabaseHealth":         privilegeAny,
+	"DatabaseSettings":       privilegeAny,
+	"UseSnapshot":            privilegeAny,
+}
+
+// PrivilegeForMethod returns the privilege required to access method name through role privileges
+func PrivilegeForMethod(method string) (Privilege, bool) {
+	privilege, ok := methodsPrivileges[method]
+	return privilege, ok
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrivilege(t *testing.T) {
+	p, err := ParsePrivilege("select")
+	require.NoError(t, err)
+	require.Equal(t, PrivilegeSelect, p)
+	require.True(t, p.IsTablePrivilege())
+
+	p, err = ParsePrivilege("Read")
+	require.NoError(t, err)
+	require.True(t, p.IsKeyPrivilege())
+
+	p, err = ParsePrivilege("REPLICATE")
+	require.NoError(t, err)
+	require.True(t, p.IsDatabasePrivilege())
+
+	_, err = ParsePrivilege("admin")
+	require.ErrorIs(t, err, ErrInvalidPrivilege)
+}
+
+func TestRolePrivilegeValidate(t *testing.T) {
+	require.NoError(t, RolePrivilege{Privilege: PrivilegeSelect, Database: "db1", Object: "table1"}.Validate())
+	require.NoError(t, RolePrivilege{Privilege: PrivilegeTruncate, Database: "db1"}.Validate())
+	require.ErrorIs(t, RolePrivilege{Privilege: PrivilegeTruncate, Database: "db1", Object: "table1"}.Validate(), ErrInvalidPrivilege)
+	require.ErrorIs(t, RolePrivilege{Privilege: PrivilegeRead}.Validate(), ErrInvalidPrivilege)
+	require.ErrorIs(t, RolePrivilege{Privilege: "ADMIN", Database: "db1"}.Validate(), ErrInvalidPrivilege)
+}
+
+func TestRole(t *testing.T) {
+	require.True(t, IsValidRoleName("analyst_1"))
+	require.False(t, IsValidRoleName("Analyst"))
+	require.False(t, IsValidRoleName("1analyst"))
+
+	r := &Role{Name: "analyst"}
+	require.False(t, r.HasPrivilegesOn("db1"))
+
+	require.True(t, r.GrantPrivilege(RolePrivilege{Privilege: PrivilegeSelect, Database: "db1", Object: "table1"}))
+	require.False(t, r.GrantPrivilege(RolePrivilege{Privilege: PrivilegeSelect, Database: "db1", Object: "table1"}))
+	require.True(t, r.GrantPrivilege(RolePrivilege{Privilege: PrivilegeRead, Database: "db1", Object: "user:"}))
+	require.True(t, r.GrantPrivilege(RolePrivilege{Privilege: PrivilegeInsert, Database: "db2"}))
+
+	require.True(t, r.HasPrivilegesOn("db1"))
+	require.False(t, r.HasPrivilegesOn("db3"))
+	require.Equal(t, []string{"db1", "db2"}, r.Databases())
+
+	require.True(t, r.HasPrivilege("db1", PrivilegeSelect, "table1"))
+	require.False(t, r.HasPrivilege("db1", PrivilegeSelect, "table2"))
+	require.False(t, r.HasPrivilege("db1", PrivilegeSelect, ""))
+	require.False(t, r.HasPrivilege("db1", PrivilegeInsert, "table1"))
+	require.True(t, r.HasPrivilege("db2", PrivilegeInsert, "table1"))
+	require.True(t, r.HasPrivilege("db2", PrivilegeInsert, ""))
+
+	require.True(t, r.HasPrivilege("db1", PrivilegeRead, "user:1"))
+	require.False(t, r.HasPrivilege("db1", PrivilegeRead, "admin:1"))
+	require.False(t, r.HasPrivilege("db1", PrivilegeRead, ""))
+	require.False(t, r.HasPrivilege("db1", PrivilegeWrite, "user:1"))
+
+	require.True(t, r.RevokePrivilege(RolePrivilege{Privilege: PrivilegeRead, Database: "db1", Object: "user:"}))
+	require.False(t, r.RevokePrivilege(RolePrivilege{Privilege: PrivilegeRead, Database: "db1", Object: "user:"}))
+	require.False(t, r.HasPrivilege("db1", PrivilegeRead, "user:1"))
+}
+
+func TestPrivilegeForMethod(t *testing.T) {
+	p, ok := PrivilegeForMethod("Get")
+	require.True(t, ok)
+	require.Equal(t, PrivilegeRead, p)
+
+	_, ok = PrivilegeForMethod("CreateDatabase")
+	require.False(t, ok)
+}
+
+func TestUserRoles(t *testing.T) {
+	u := &User{}
+	require.False(t, u.HasRole("analyst"))
+	require.True(t, u.GrantRole("analyst"))
+	require.False(t, u.GrantRole("analyst"))
+	require.True(t, u.HasRole("analyst"))
+	require.True(t, u.RevokeRole("analyst"))
+	require.False(t, u.RevokeRole("analyst"))
+}
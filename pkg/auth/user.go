@@ -33,10 +33,20 @@ type User struct {
 	Username       string       `json:"username"`
 	HashedPassword []byte       `json:"hashedpassword"`
 	Permissions    []Permission `json:"permissions"`
+	Roles          []string     `json:"roles,omitempty"`
 	Active         bool         `json:"active"`
 	IsSysAdmin     bool         `json:"-"`         //for the sysadmin we'll use this instead of adding all db and permissions to Permissions, to save some cpu cycles
 	CreatedBy      string       `json:"createdBy"` //user which created this user
 	CreatedAt      time.Time    `json:"createdat"` //time in which this user is created/updated
+	ExternalRoles  []string     `json:"-"`         //roles granted by the identity provider the user authenticated with
+	ExpiresAt      time.Time    `json:"-"`         //expiration of the external credentials the user authenticated with
+	APIKey         string       `json:"-"`         //id of the API key the user authenticated with, if any
+
+	PasswordChangedAt time.Time `json:"passwordchangedat"`         //time in which the password was last set
+	PasswordHistory   [][]byte  `json:"passwordhistory,omitempty"` //hashes of the previous passwords, most recent first
+	PasswordExpired   bool      `json:"-"`                         //the user authenticated with an expired password and must change it
+	FailedLogins      int       `json:"failedlogins,omitempty"`    //consecutive failed login attempts
+	LockedUntil       time.Time `json:"lockeduntil"`               //the user can not login until this time after too many failed attempts
 }
 
 // SysAdminUsername the system admin username
@@ -55,6 +65,7 @@ func (u *User) SetPassword(plainPassword []byte) ([]byte, error) {
 		return nil, err
 	}
 	u.HashedPassword = hashedPassword
+	u.PasswordChangedAt = time.Now()
 	return plainPassword, nil
 }
 
@@ -63,6 +74,11 @@ func (u *User) ComparePasswords(plainPassword []byte) error {
 	return ComparePasswords(u.HashedPassword, plainPassword)
 }
 
+// IsLocked returns true if the user is locked out after too many failed login attempts
+func (u *User) IsLocked() bool {
+	return time.Now().Before(u.LockedUntil)
+}
+
 // IsValidUsername is a regexp function used to check username requirements
 var IsValidUsername = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
 
@@ -121,3 +137,33 @@ func (u *User) GrantPermission(database string, permission uint32) bool {
 	u.Permissions = append(u.Permissions, perm)
 	return true
 }
+
+//HasRole checks if the role was granted to the user
+func (u *User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+//GrantRole grants the role to the user, returns false if it was already granted
+func (u *User) GrantRole(role string) bool {
+	if u.HasRole(role) {
+		return false
+	}
+	u.Roles = append(u.Roles, role)
+	return true
+}
+
+//RevokeRole revokes the role from the user, returns false if it was not granted
+func (u *User) RevokeRole(role string) bool {
+	for i, r := range u.Roles {
+		if r == role {
+			u.Roles = append(u.Roles[:i], u.Roles[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// CreateAPIKey creates a long-lived API key bound to a user and restricted to a permission on a database.
+//
+// Users can create keys for themselves, admins for the users of their databases.
+// The key is only returned by this call, the server does not store it.
+func (c *immuClient) CreateAPIKey(ctx context.Context, req *schema.CreateAPIKeyRequest) (*schema.CreateAPIKeyResponse, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	res, err := c.ServiceClient.CreateAPIKey(ctx, req)
+
+	c.Logger.Debugf("CreateAPIKey finished in %s", time.Since(start))
+
+	return res, err
+}
+
+// ListAPIKeys returns the API keys the logged in user can manage, optionally bound to username.
+func (c *immuClient) ListAPIKeys(ctx context.Context, username string) (*schema.APIKeyList, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	apiKeys, err := c.ServiceClient.ListAPIKeys(ctx, &schema.ListAPIKeysRequest{Username: username})
+
+	c.Logger.Debugf("ListAPIKeys finished in %s", time.Since(start))
+
+	return apiKeys, err
+}
+
+// RevokeAPIKey permanently invalidates an API key.
+func (c *immuClient) RevokeAPIKey(ctx context.Context, id string) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.RevokeAPIKey(ctx, &schema.RevokeAPIKeyRequest{Id: id})
+
+	c.Logger.Debugf("RevokeAPIKey finished in %s", time.Since(start))
+
+	return err
+}
+
+// APIKeyInterceptor injects the API key set in the client options into outgoing GRPC requests
+func (c *immuClient) APIKeyInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, auth.APIKeyHeader, c.Options.APIKey)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// APIKeyStreamInterceptor injects the API key set in the client options into outgoing GRPC streams
+func (c *immuClient) APIKeyStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, auth.APIKeyHeader, c.Options.APIKey)
+	return streamer(ctx, desc, cc, method, opts...)
+}
@@ -19,7 +19,7 @@ package auditor
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
+	"crypto"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -72,7 +72,8 @@ type defaultAuditor struct {
 	databases           []string
 	password            []byte
 	auditDatabases      []string
-	serverSigningPubKey *ecdsa.PublicKey
+	serverSigningPubKey crypto.PublicKey
+	witnessSigner       signer.Signer
 	notificationConfig  AuditNotificationConfig
 	serviceClient       schema.ImmuServiceClient
 	uuidProvider        state.UUIDProvider
@@ -91,7 +92,8 @@ func DefaultAuditor(
 	username string,
 	passwordBase64 string,
 	auditDatabases []string,
-	serverSigningPubKey *ecdsa.PublicKey,
+	serverSigningPubKey crypto.PublicKey,
+	witnessSigner signer.Signer,
 	notificationConfig AuditNotificationConfig,
 	serviceClient schema.ImmuServiceClient,
 	uuidProvider state.UUIDProvider,
@@ -125,6 +127,7 @@ func DefaultAuditor(
 		[]byte(password),
 		auditDatabases,
 		serverSigningPubKey,
+		witnessSigner,
 		notificationConfig,
 		serviceClient,
 		uuidProvider,
@@ -377,10 +380,21 @@ func (a *defaultAuditor) audit() error {
 			"audit #%d detected possible tampering of db %s remote state (at id %d) "+
 				"so it will not overwrite the previous local state (at id %d)",
 			a.index, dbName, state.TxId, prevState.TxId)
-	} else if prevState == nil || state.TxId != prevState.TxId {
-		if err := a.history.Set(serverID, dbName, state); err != nil {
-			a.logger.Errorf(err.Error())
-			return noErr
+	} else {
+		if prevState == nil || state.TxId != prevState.TxId {
+			if err := a.history.Set(serverID, dbName, state); err != nil {
+				a.logger.Errorf(err.Error())
+				return noErr
+			}
+		}
+
+		if a.witnessSigner != nil {
+			if err := a.cosignState(ctx, state); err != nil {
+				a.logger.Errorf("error cosigning state of db %s at tx %d: %v", dbName, state.TxId, err)
+				withError = true
+				return noErr
+			}
+			a.logger.Infof("audit #%d - state of db %s at tx %d has been cosigned", a.index, dbName, state.TxId)
 		}
 	}
 	a.logger.Infof("audit #%d finished in %s @ %s",
@@ -429,6 +443,30 @@ func (a *defaultAuditor) verifyStateSignature(
 	return nil
 }
 
+// cosignState publishes the cosignature of a verified state, so that clients can be sure
+// the auditor saw the same state of the database
+func (a *defaultAuditor) cosignState(ctx context.Context, state *schema.ImmutableState) error {
+	cosignedState := &schema.ImmutableState{
+		Db:     state.Db,
+		TxId:   state.TxId,
+		TxHash: state.TxHash,
+	}
+
+	signature, publicKey, err := a.witnessSigner.Sign(cosignedState.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = a.serviceClient.CosignState(ctx, &schema.CosignStateRequest{
+		State: cosignedState,
+		Cosignature: &schema.Signature{
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	})
+	return err
+}
+
 // Signature ...
 type Signature struct {
 	Signature string `json:"signature"`
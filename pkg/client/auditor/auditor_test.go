@@ -18,7 +18,7 @@ package auditor
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"crypto"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -53,6 +53,7 @@ func TestDefaultAuditor(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		nil,
 		nil,
@@ -83,6 +84,7 @@ func TestDefaultAuditorPasswordDecodeErr(t *testing.T) {
 		"enc:"+string([]byte{0}),
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		nil,
 		nil,
@@ -116,6 +118,7 @@ func TestDefaultAuditorLoginErr(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		&serviceClient,
 		state.NewUUIDProvider(&serviceClient),
@@ -154,6 +157,7 @@ func TestDefaultAuditorDatabaseListErr(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		&serviceClient,
 		state.NewUUIDProvider(&serviceClient),
@@ -194,6 +198,7 @@ func TestDefaultAuditorDatabaseListEmpty(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		&serviceClient,
 		state.NewUUIDProvider(&serviceClient),
@@ -237,6 +242,7 @@ func TestDefaultAuditorUseDatabaseErr(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		&serviceClient,
 		state.NewUUIDProvider(&serviceClient),
@@ -283,6 +289,7 @@ func TestDefaultAuditorCurrentRootErr(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		AuditNotificationConfig{},
 		&serviceClient,
 		state.NewUUIDProvider(&serviceClient),
@@ -310,7 +317,7 @@ func TestDefaultAuditorRunOnDbWithInvalidSignatureFromState(t *testing.T) {
 	testDefaultAuditorRunOnDbWithInvalidSignature(t, nil, true)
 }
 
-func testDefaultAuditorRunOnDbWithInvalidSignature(t *testing.T, pk *ecdsa.PublicKey, withSignedState bool) {
+func testDefaultAuditorRunOnDbWithInvalidSignature(t *testing.T, pk crypto.PublicKey, withSignedState bool) {
 	defer os.RemoveAll(dirname)
 
 	serviceClient := &clienttest.ImmuServiceClientMock{}
@@ -357,6 +364,7 @@ func testDefaultAuditorRunOnDbWithInvalidSignature(t *testing.T, pk *ecdsa.Publi
 		"immudb",
 		nil,
 		pk,
+		nil,
 		AuditNotificationConfig{},
 		serviceClient,
 		state.NewUUIDProvider(serviceClient),
@@ -20,8 +20,9 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
-	"crypto/ecdsa"
+	"crypto"
 	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
@@ -29,6 +30,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -43,12 +45,14 @@ import (
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/client/cache"
 	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/client/proofbundle"
 	"github.com/codenotary/immudb/pkg/client/state"
 	"github.com/codenotary/immudb/pkg/client/tokenservice"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/signer"
 	"github.com/codenotary/immudb/pkg/stream"
+	"github.com/codenotary/immudb/pkg/tsa"
 )
 
 // ImmuClient is an interface that represents immudb client.
@@ -176,7 +180,10 @@ type ImmuClient interface {
 	WithTokenService(tokenService tokenservice.TokenService) *immuClient
 
 	// WithServerSigningPubKey sets up public key for server's state validation.
-	WithServerSigningPubKey(serverSigningPubKey *ecdsa.PublicKey) *immuClient
+	WithServerSigningPubKey(serverSigningPubKey crypto.PublicKey) *immuClient
+
+	// WithWitnessPubKeys sets up public keys of the witnesses trusted to cosign server states.
+	WithWitnessPubKeys(witnessPubKeys []crypto.PublicKey) *immuClient
 
 	// WithStreamServiceFactory sets up stream factory for the client.
 	WithStreamServiceFactory(ssf stream.ServiceFactory) *immuClient
@@ -257,6 +264,51 @@ type ImmuClient interface {
 	// SetActiveUser activates or deactivates a user.
 	SetActiveUser(ctx context.Context, u *schema.SetActiveUserRequest) error
 
+	// UnlockUser allows a user locked out after too many failed login attempts to login again.
+	UnlockUser(ctx context.Context, username string) error
+
+	// CreateAPIKey creates a long-lived API key bound to a user and restricted to a permission on a database.
+	//
+	// Users can create keys for themselves, admins for the users of their databases.
+	// The key is only returned by this call, the server does not store it.
+	CreateAPIKey(ctx context.Context, req *schema.CreateAPIKeyRequest) (*schema.CreateAPIKeyResponse, error)
+
+	// ListAPIKeys returns the API keys the logged in user can manage, optionally bound to username.
+	ListAPIKeys(ctx context.Context, username string) (*schema.APIKeyList, error)
+
+	// RevokeAPIKey permanently invalidates an API key.
+	RevokeAPIKey(ctx context.Context, id string) error
+
+	// ListRoles returns the list of roles.
+	//
+	// SysAdmin and Admin users get all the roles, other users get the roles granted to them.
+	ListRoles(ctx context.Context) (*schema.RoleList, error)
+
+	// CreateRole creates a new role without privileges.
+	//
+	// This call requires SysAdmin or Admin permission to any database.
+	CreateRole(ctx context.Context, name string, ifNotExists bool) error
+
+	// DropRole deletes a role and revokes it from the users it was granted to.
+	//
+	// This call can only be executed by the SysAdmin user or by the creator of the role.
+	DropRole(ctx context.Context, name string) error
+
+	// ChangeRolePrivilege grants or revokes a privilege to a role.
+	//
+	// This call requires SysAdmin or admin permission to the database the privilege applies to.
+	//
+	// Table privileges (SELECT, INSERT, UPDATE, DELETE) apply to the table given as object,
+	// key privileges (READ, WRITE) apply to the keys starting with the prefix given as object.
+	// An empty object applies the privilege to all the tables or keys of the database.
+	// Database privileges (TRUNCATE, REPLICATE) do not accept any object.
+	ChangeRolePrivilege(ctx context.Context, action schema.PermissionAction, role string, privilege *schema.RolePrivilege) error
+
+	// ChangeRoleMembership grants or revokes a role to a user.
+	//
+	// This call requires SysAdmin or admin permission to all the databases the role grants privileges on.
+	ChangeRoleMembership(ctx context.Context, action schema.PermissionAction, role string, username string) error
+
 	// FlushIndex requests a flush operation from the database.
 	// This call requires SysAdmin or Admin permission to given database.
 	//
@@ -275,6 +327,22 @@ type ImmuClient interface {
 	// cleanupPercentage database option.
 	CompactIndex(ctx context.Context, req *empty.Empty) error
 
+	// RebuildIndex builds a fresh database index from the transaction log without blocking reads or writes.
+	// This call requires SysAdmin or Admin permission to given database.
+	//
+	// The progress function, if provided, is called every time progress is reported by the server.
+	// The call returns once the new index replaced the previous one.
+	RebuildIndex(ctx context.Context, progress func(p *schema.RebuildIndexProgress)) error
+
+	// BulkLoad writes entries into the database using as few transactions as possible.
+	// This call requires SysAdmin or Admin permission to given database.
+	//
+	// Keys must be provided in strictly ascending order. Loaded transactions are indexed
+	// in bulk and index flushing is deferred until all the entries were loaded.
+	//
+	// Note: loading is not atomic, entries may be partially loaded if an error occurs.
+	BulkLoad(ctx context.Context, kvs []*schema.KeyValue) (*schema.BulkLoadResponse, error)
+
 	// ServerInfo returns information about the server instance.
 	ServerInfo(ctx context.Context, req *schema.ServerInfoRequest) (*schema.ServerInfoResponse, error)
 
@@ -342,9 +410,45 @@ type ImmuClient interface {
 	// If verification does not succeed the store.ErrCorruptedData error is returned.
 	VerifiedGetAtRevision(ctx context.Context, key []byte, rev int64) (*schema.Entry, error)
 
+	// VerifiedGetLatest reads the current value of the key and verifies it is the latest one
+	// using the authenticated index committed into the transaction headers.
+	//
+	// This function requires the authenticated index to be enabled in the database.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedGetLatest(ctx context.Context, key []byte) (*schema.Entry, error)
+
+	// VerifiedGetAbsent verifies the key was never written into the database
+	// using the authenticated index committed into the transaction headers.
+	//
+	// This function requires the authenticated index to be enabled in the database.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedGetAbsent(ctx context.Context, key []byte) error
+
+	// ExportProofBundle reads a single value for given key and returns it together with the proofs
+	// linking it to a signed state of the database, so that it can be verified offline.
+	//
+	// The bundle is verified before being returned, as done by the VerifiedGet function.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	ExportProofBundle(ctx context.Context, key []byte, opts ...GetOption) (*proofbundle.ProofBundle, error)
+
 	// History returns history for a single key.
 	History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
 
+	// VerifiedHistory returns history for a single key.
+	//
+	// This function also requests server-generated proofs, verifies every entry in the transaction
+	// it was written at and verifies the signature of the signed state.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedHistory(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
+
+	// VerifiedCompleteHistory returns history for a single key, proven to be complete.
+	//
+	// This function works as VerifiedHistory does, and additionally verifies the latest revision of the key
+	// against the authenticated index, checking the returned revisions are contiguous and none is missing.
+	// The authenticated index must be enabled on the database.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedCompleteHistory(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
+
 	// ZAdd adds a new entry to sorted set.
 	// New entry is a reference to some other key's value with additional score used for ordering set members.
 	ZAdd(ctx context.Context, set []byte, score float64, key []byte) (*schema.TxHeader, error)
@@ -378,6 +482,21 @@ type ImmuClient interface {
 	// ZScan iterates over the elements of sorted set ordered by their score.
 	ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error)
 
+	// VerifiedScan iterates over the set of keys in a topological order.
+	//
+	// This function also requests server-generated proofs, verifies every entry in the transaction
+	// it was written at and verifies the signature of the signed state.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedScan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error)
+
+	// VerifiedZScan iterates over the elements of sorted set ordered by their score.
+	//
+	// This function also requests server-generated proofs, verifies both the sorted set entries and
+	// the entries they refer to in the transactions they were written at and verifies the signature
+	// of the signed state.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error)
+
 	// TxByID retrieves all entries (in a raw, unprocessed form) for given transaction.
 	//
 	// Note: In order to read keys and values, it is necessary to parse returned entries
@@ -388,6 +507,24 @@ type ImmuClient interface {
 	// and performs verification of the server-provided proof for the whole transaction.
 	VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx, error)
 
+	// TimestampAnchor returns the earliest timestamp anchor covering the given transaction.
+	TimestampAnchor(ctx context.Context, tx uint64) (*schema.TimestampAnchor, error)
+
+	// VerifiedTimestampAnchor returns the earliest timestamp anchor covering the given transaction and
+	// verifies its RFC 3161 time-stamp token was issued over the anchored transaction by an authority trusted by roots.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedTimestampAnchor(ctx context.Context, tx uint64, roots *x509.CertPool) (*schema.TimestampAnchor, *tsa.Token, error)
+
+	// PrefixDigest computes a Merkle root over the key-value entries sharing the requested prefix,
+	// ordered by key, and verifies the inclusion proofs of the requested keys into it.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error)
+
+	// TableDigest computes a Merkle root over the rows of a table, ordered by primary key,
+	// and verifies the inclusion proofs of the requested rows into it.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error)
+
 	// TxByIDWithSpec retrieves entries from given transaction according to given spec.
 	TxByIDWithSpec(ctx context.Context, req *schema.TxRequest) (*schema.Tx, error)
 
@@ -503,6 +640,14 @@ type ImmuClient interface {
 	// are in the row will be compared against the verified row retrieved from the database.
 	VerifyRow(ctx context.Context, row *schema.Row, table string, pkVals []*schema.SQLValue) error
 
+	// VerifiedSQLQuery performs a query (read-only) operation with additional validation of server-provided proofs.
+	//
+	// Rows must be selected from a single table and include all the primary key columns.
+	// Every row in the result is verified against the entry it was written in, note the
+	// completeness of the result set is not verified.
+	// If verification does not succeed the store.ErrCorruptedData error is returned.
+	VerifiedSQLQuery(ctx context.Context, sql string, params map[string]interface{}) (*schema.SQLQueryResult, error)
+
 	// NewTx starts a new transaction.
 	//
 	// Note: Currently such transaction can only be used for SQL operations.
@@ -527,11 +672,18 @@ type immuClient struct {
 	ServiceClient        schema.ImmuServiceClient
 	StateService         state.StateService
 	Tkns                 tokenservice.TokenService
-	serverSigningPubKey  *ecdsa.PublicKey
+	serverSigningPubKey  crypto.PublicKey
 	StreamServiceFactory stream.ServiceFactory
 	SessionID            string
 	HeartBeater          HeartBeater
 	errorHandler         ErrorHandler
+
+	// signing keys chained from the server signing public key through key rotations
+	rotatedSigningKeys      map[string]crypto.PublicKey
+	rotatedSigningKeysMutex sync.Mutex
+
+	// public keys of the witnesses trusted to cosign server states
+	witnessPubKeys []crypto.PublicKey
 }
 
 // Ensure immuClient implements the ImmuClient interface
@@ -573,6 +725,14 @@ func NewImmuClient(options *Options) (*immuClient, error) {
 		c.WithServerSigningPubKey(pk)
 	}
 
+	if len(options.WitnessPubKeys) > 0 {
+		pks, err := parseWitnessPubKeyFiles(options.WitnessPubKeys)
+		if err != nil {
+			return nil, err
+		}
+		c.WithWitnessPubKeys(pks)
+	}
+
 	options.DialOptions = c.SetupDialOptions(options)
 
 	if db, err := c.Tkns.GetDatabase(); err == nil && len(db) > 0 {
@@ -694,6 +854,9 @@ func (c *immuClient) SetupDialOptions(options *Options) []grpc.DialOption {
 	if c.serverSigningPubKey != nil {
 		uic = append(uic, c.SignatureVerifierInterceptor)
 	}
+	if options.MinWitnessCosignatures > 0 {
+		uic = append(uic, c.WitnessVerifierInterceptor)
+	}
 	uic = append(uic, c.IllegalStateHandlerInterceptor, c.TokenInterceptor)
 
 	if options.Auth && c.Tkns != nil {
@@ -706,6 +869,11 @@ func (c *immuClient) SetupDialOptions(options *Options) []grpc.DialOption {
 	}
 	uic = append(uic, c.SessionIDInjectorInterceptor)
 
+	if options.APIKey != "" {
+		uic = append(uic, c.APIKeyInterceptor)
+		opts = append(opts, grpc.WithChainStreamInterceptor(c.APIKeyStreamInterceptor))
+	}
+
 	opts = append(opts, grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(uic...)), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(options.MaxRecvMsgSize)))
 
 	return opts
@@ -1030,7 +1198,7 @@ func (c *immuClient) VerifiedGet(ctx context.Context, key []byte, opts ...GetOpt
 		}
 	}
 
-	return c.verifiedGet(ctx, req)
+	return c.verifiedGet(ctx, req, false)
 }
 
 // VerifiedGetSince reads a single value for given key assuming that at least transaction `tx` was indexed.
@@ -1063,6 +1231,246 @@ func (c *immuClient) VerifiedGetAtRevision(ctx context.Context, key []byte, rev
 	return c.VerifiedGet(ctx, key, AtRevision(rev))
 }
 
+// VerifiedGetLatest reads the current value of the key and verifies it is the latest one
+// using the authenticated index committed into the transaction headers.
+//
+// This function requires the authenticated index to be enabled in the database.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedGetLatest(ctx context.Context, key []byte) (*schema.Entry, error) {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("VerifiedGetLatest finished in %s", time.Since(start))
+	}()
+
+	return c.verifiedGet(ctx, &schema.KeyRequest{Key: key}, true)
+}
+
+// VerifiedGetAbsent verifies the key was never written into the database
+// using the authenticated index committed into the transaction headers.
+//
+// This function requires the authenticated index to be enabled in the database.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedGetAbsent(ctx context.Context, key []byte) error {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("VerifiedGetAbsent finished in %s", time.Since(start))
+	}()
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return err
+	}
+
+	req := &schema.VerifiableGetAbsentRequest{
+		Key:          key,
+		ProveSinceTx: state.TxId,
+	}
+
+	vIndexProof, err := c.ServiceClient.VerifiableGetAbsent(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if vIndexProof.DualProof == nil || vIndexProof.DualProof.TargetTxHeader == nil {
+		return store.ErrCorruptedData
+	}
+
+	sourceID := state.TxId
+	sourceAlh := schema.DigestFromProto(state.TxHash)
+
+	if sourceID == 0 {
+		// there is no previous state to verify against, the transaction holding the index root is trusted as is
+		sourceID = vIndexProof.DualProof.TargetTxHeader.Id
+		sourceAlh = schema.DualProofFromProto(vIndexProof.DualProof).TargetTxHeader.Alh()
+	}
+
+	return c.verifyIndexProof(ctx, vIndexProof, key, 0, sourceID, sourceAlh)
+}
+
+// ExportProofBundle reads a single value for given key and returns it together with the proofs
+// linking it to a signed state of the database, so that it can be verified offline.
+//
+// The bundle is verified before being returned, as done by the VerifiedGet function.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) ExportProofBundle(ctx context.Context, key []byte, opts ...GetOption) (*proofbundle.ProofBundle, error) {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("ExportProofBundle finished in %s", time.Since(start))
+	}()
+
+	kReq := &schema.KeyRequest{Key: key}
+	for _, opt := range opts {
+		err := opt(kReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	vEntry, err := c.ServiceClient.VerifiableGet(ctx, &schema.VerifiableGetRequest{
+		KeyRequest:   kReq,
+		ProveSinceTx: state.TxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := proofbundle.New(c.currentDatabase(), vEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	// the entry must be the one being requested
+	if (vEntry.Entry.ReferencedBy == nil && !bytes.Equal(vEntry.Entry.Key, key)) ||
+		(vEntry.Entry.ReferencedBy != nil && !bytes.Equal(vEntry.Entry.ReferencedBy.Key, key)) {
+		return nil, store.ErrCorruptedData
+	}
+
+	if c.serverSigningPubKey != nil && bundle.State.Signature != nil {
+		rootKey, err := signer.MarshalKey(c.serverSigningPubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(rootKey, bundle.State.Signature.PublicKey) {
+			// the state was signed with a rotated key
+			keyChain, err := c.ServiceClient.SigningKeyChain(ctx, &empty.Empty{})
+			if err != nil {
+				return nil, err
+			}
+
+			bundle.SigningKeyChain = schema.SigningKeyRotationsFromProto(keyChain.Rotations)
+		}
+	}
+
+	err = bundle.Verify(c.serverSigningPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the bundle must also be consistent with the local state
+	if state.TxId > 0 {
+		var expectedAlh []byte
+
+		switch state.TxId {
+		case bundle.DualProof.SourceTxHeader.Id:
+			sourceAlh := schema.TxHeaderFromProto(bundle.DualProof.SourceTxHeader).Alh()
+			expectedAlh = sourceAlh[:]
+		case bundle.DualProof.TargetTxHeader.Id:
+			expectedAlh = bundle.State.TxHash
+		default:
+			return nil, store.ErrCorruptedData
+		}
+
+		if !bytes.Equal(state.TxHash, expectedAlh) {
+			return nil, store.ErrCorruptedData
+		}
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, bundle.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// verifyIndexProof verifies the latest transaction the key was written at, or its absence when txID is zero,
+// against the index root committed into the target transaction of the dual proof. The dual proof must be
+// consistent with the source transaction and, once verified, the state is moved to the target transaction.
+// It must be called while holding the state cache lock.
+func (c *immuClient) verifyIndexProof(
+	ctx context.Context,
+	vIndexProof *schema.VerifiableIndexProof,
+	key []byte,
+	txID uint64,
+	sourceID uint64,
+	sourceAlh [sha256.Size]byte,
+) error {
+	if vIndexProof == nil || vIndexProof.DualProof == nil || vIndexProof.DualProof.TargetTxHeader == nil {
+		return store.ErrCorruptedData
+	}
+
+	dualProof := schema.DualProofFromProto(vIndexProof.DualProof)
+
+	targetID := dualProof.TargetTxHeader.ID
+	targetAlh := dualProof.TargetTxHeader.Alh()
+
+	err := c.verifyDualProof(
+		ctx,
+		dualProof,
+		sourceID,
+		targetID,
+		sourceAlh,
+		targetAlh,
+	)
+	if err != nil {
+		return err
+	}
+
+	if dualProof.TargetTxHeader.Metadata == nil || !dualProof.TargetTxHeader.Metadata.HasIndexRoot() {
+		return store.ErrCorruptedData
+	}
+
+	root, err := dualProof.TargetTxHeader.Metadata.GetIndexRoot()
+	if err != nil {
+		return err
+	}
+
+	verifies := store.VerifyIndexProof(
+		schema.IndexProofFromProto(vIndexProof),
+		database.EncodeKey(key),
+		txID,
+		root,
+	)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	newState := &schema.ImmutableState{
+		Db:        c.currentDatabase(),
+		TxId:      targetID,
+		TxHash:    targetAlh[:],
+		Signature: vIndexProof.Signature,
+	}
+
+	if c.serverSigningPubKey != nil {
+		ok, err := c.checkStateSignature(ctx, newState)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return store.ErrCorruptedData
+		}
+	}
+
+	return c.StateService.SetState(c.Options.CurrentDatabase, newState)
+}
+
 func (c *immuClient) verifyDualProof(
 	ctx context.Context,
 	dualProof *store.DualProof,
@@ -1092,7 +1500,7 @@ func (c *immuClient) verifyDualProof(
 	return nil
 }
 
-func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (vi *schema.Entry, err error) {
+func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest, proveLatest bool) (vi *schema.Entry, err error) {
 	err = c.StateService.CacheLock()
 	if err != nil {
 		return nil, err
@@ -1111,124 +1519,687 @@ func (c *immuClient) verifiedGet(ctx context.Context, kReq *schema.KeyRequest) (
 	req := &schema.VerifiableGetRequest{
 		KeyRequest:   kReq,
 		ProveSinceTx: state.TxId,
+		ProveLatest:  proveLatest,
+	}
+
+	vEntry, err := c.ServiceClient.VerifiableGet(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(int(vEntry.VerifiableTx.Tx.Header.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	inclusionProof := schema.InclusionProofFromProto(vEntry.InclusionProof)
+	dualProof := schema.DualProofFromProto(vEntry.VerifiableTx.DualProof)
+
+	var eh [sha256.Size]byte
+
+	var sourceID, targetID uint64
+	var sourceAlh, targetAlh [sha256.Size]byte
+
+	vTx := kReq.AtTx
+	var e *store.EntrySpec
+
+	if vEntry.Entry.ReferencedBy == nil {
+		if kReq.AtTx == 0 {
+			vTx = vEntry.Entry.Tx
+		}
+
+		e = database.EncodeEntrySpec(kReq.Key, schema.KVMetadataFromProto(vEntry.Entry.Metadata), vEntry.Entry.Value)
+	} else {
+		ref := vEntry.Entry.ReferencedBy
+
+		if kReq.AtTx == 0 {
+			vTx = ref.Tx
+		}
+
+		e = database.EncodeReference(kReq.Key, schema.KVMetadataFromProto(ref.Metadata), vEntry.Entry.Key, ref.AtTx)
+	}
+
+	if state.TxId <= vTx {
+		eh = schema.DigestFromProto(vEntry.VerifiableTx.DualProof.TargetTxHeader.EH)
+
+		sourceID = state.TxId
+		sourceAlh = schema.DigestFromProto(state.TxHash)
+		targetID = vTx
+		targetAlh = dualProof.TargetTxHeader.Alh()
+	} else {
+		eh = schema.DigestFromProto(vEntry.VerifiableTx.DualProof.SourceTxHeader.EH)
+
+		sourceID = vTx
+		sourceAlh = dualProof.SourceTxHeader.Alh()
+		targetID = state.TxId
+		targetAlh = schema.DigestFromProto(state.TxHash)
+	}
+
+	verifies := store.VerifyInclusion(
+		inclusionProof,
+		entrySpecDigest(e),
+		eh)
+	if !verifies {
+		return nil, store.ErrCorruptedData
+	}
+
+	if state.TxId > 0 {
+		err := c.verifyDualProof(
+			ctx,
+			dualProof,
+			sourceID,
+			targetID,
+			sourceAlh,
+			targetAlh,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if proveLatest {
+		// the index proof moves the state to the transaction holding the index root
+		err = c.verifyIndexProof(ctx, vEntry.IndexProof, kReq.Key, vTx, targetID, targetAlh)
+		if err != nil {
+			return nil, err
+		}
+
+		return vEntry.Entry, nil
+	}
+
+	newState := &schema.ImmutableState{
+		Db:        c.currentDatabase(),
+		TxId:      targetID,
+		TxHash:    targetAlh[:],
+		Signature: vEntry.VerifiableTx.Signature,
+	}
+
+	if c.serverSigningPubKey != nil {
+		ok, err := c.checkStateSignature(ctx, newState)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, store.ErrCorruptedData
+		}
+	}
+
+	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return vEntry.Entry, nil
+}
+
+// Scan iterates over the set of keys in a topological order.
+func (c *immuClient) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	return c.ServiceClient.Scan(ctx, req)
+}
+
+// ZScan iterates over the elements of sorted set ordered by their score.
+func (c *immuClient) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	return c.ServiceClient.ZScan(ctx, req)
+}
+
+// VerifiedScan iterates over the set of keys in a topological order.
+//
+// This function also requests server-generated proofs, verifies every entry in the transaction
+// it was written at and verifies the signature of the signed state.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedScan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error) {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("VerifiedScan finished in %s", time.Since(start))
+	}()
+
+	return c.verifiedEntries(ctx, func(proveSinceTx uint64) (*schema.VerifiableEntries, error) {
+		return c.ServiceClient.VerifiableScan(ctx, &schema.VerifiableScanRequest{
+			ScanRequest:   req,
+			ProveSinceTx:  proveSinceTx,
+			CompactProofs: true,
+		})
+	})
+}
+
+// VerifiedHistory returns history for a single key.
+//
+// This function also requests server-generated proofs, verifies every entry in the transaction
+// it was written at and verifies the signature of the signed state.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedHistory(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("VerifiedHistory finished in %s", time.Since(start))
+	}()
+
+	return c.verifiedEntries(ctx, func(proveSinceTx uint64) (*schema.VerifiableEntries, error) {
+		return c.ServiceClient.VerifiableHistory(ctx, &schema.VerifiableHistoryRequest{
+			HistoryRequest: req,
+			ProveSinceTx:   proveSinceTx,
+		})
+	})
+}
+
+// VerifiedCompleteHistory returns history for a single key, proven to be complete.
+//
+// This function works as VerifiedHistory does, and additionally verifies the latest revision of the key
+// against the authenticated index, checking the returned revisions are contiguous and none is missing.
+// The authenticated index must be enabled on the database.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedCompleteHistory(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("VerifiedCompleteHistory finished in %s", time.Since(start))
+	}()
+
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	vEntries, err := c.ServiceClient.VerifiableHistory(ctx, &schema.VerifiableHistoryRequest{
+		HistoryRequest: req,
+		ProveSinceTx:   state.TxId,
+		ProveComplete:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vEntries.InclusionProofs) != len(vEntries.Entries) ||
+		vEntries.IndexProof == nil ||
+		vEntries.IndexProof.DualProof == nil ||
+		vEntries.IndexProof.DualProof.TargetTxHeader == nil {
+		return nil, store.ErrCorruptedData
+	}
+
+	txHdrs, newState, err := c.verifyTxLinks(ctx, state, vEntries.TxLinkProofs, vEntries.DualProof, vEntries.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, e := range vEntries.Entries {
+		if !bytes.Equal(e.Key, req.Key) || e.ReferencedBy != nil {
+			return nil, store.ErrCorruptedData
+		}
+
+		err = verifyEntryInclusion(e, vEntries.InclusionProofs[i], txHdrs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the latest revision of the key, zero if it was never written
+	var latestTxID, latestRevision uint64
+
+	keyDigest := sha256.Sum256(database.EncodeKey(req.Key))
+
+	if leaf := vEntries.IndexProof.Leaf; leaf != nil && bytes.Equal(leaf.KeyDigest, keyDigest[:]) {
+		latestTxID = leaf.TxId
+		latestRevision = leaf.Revision
+	}
+
+	err = verifyHistoryRevisions(req, vEntries.Entries, latestTxID, latestRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	// the index proof is verified since the target transaction of the entries, moving the state forward
+	sourceID := state.TxId
+	sourceAlh := schema.DigestFromProto(state.TxHash)
+
+	if newState != nil {
+		sourceID = newState.TxId
+		sourceAlh = schema.DigestFromProto(newState.TxHash)
+	} else if sourceID == 0 {
+		// there is no previous state to verify against, the transaction holding the index root is trusted as is
+		sourceID = vEntries.IndexProof.DualProof.TargetTxHeader.Id
+		sourceAlh = schema.DualProofFromProto(vEntries.IndexProof.DualProof).TargetTxHeader.Alh()
+	}
+
+	err = c.verifyIndexProof(ctx, vEntries.IndexProof, req.Key, latestTxID, sourceID, sourceAlh)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.Entries{Entries: vEntries.Entries}, nil
+}
+
+// verifyHistoryRevisions checks the revisions committed into the entries are the ones expected for the
+// requested page of the history, given the latest revision of the key. A page shorter than the requested
+// limit, or any page when no limit was requested, must reach the end of the history.
+func verifyHistoryRevisions(req *schema.HistoryRequest, entries []*schema.Entry, latestTxID, latestRevision uint64) error {
+	if req.Offset >= latestRevision {
+		if len(entries) > 0 {
+			return store.ErrCorruptedData
+		}
+
+		return nil
+	}
+
+	revision := req.Offset + 1
+	if req.Desc {
+		revision = latestRevision - req.Offset
+	}
+
+	for _, e := range entries {
+		if revision == 0 || revision > latestRevision {
+			return store.ErrCorruptedData
+		}
+
+		if e.Metadata == nil || e.Metadata.Revision != revision || e.Revision != revision {
+			return store.ErrCorruptedData
+		}
+
+		if revision == latestRevision && e.Tx != latestTxID {
+			return store.ErrCorruptedData
+		}
+
+		if req.Desc {
+			revision--
+		} else {
+			revision++
+		}
+	}
+
+	reachedEnd := (req.Desc && revision == 0) || (!req.Desc && revision == latestRevision+1)
+
+	if len(entries) == 0 || (len(entries) < int(req.Limit) || req.Limit == 0) && !reachedEnd {
+		return store.ErrCorruptedData
+	}
+
+	return nil
+}
+
+func (c *immuClient) verifiedEntries(
+	ctx context.Context,
+	fetch func(proveSinceTx uint64) (*schema.VerifiableEntries, error),
+) (*schema.Entries, error) {
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	vEntries, err := fetch(state.TxId)
+	if err != nil {
+		return nil, err
+	}
+
+	txHdrs, newState, err := c.verifyTxLinks(ctx, state, vEntries.TxLinkProofs, vEntries.DualProof, vEntries.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vEntries.InclusionProofs) == len(vEntries.Entries) {
+		for i, e := range vEntries.Entries {
+			err = verifyEntryInclusion(e, vEntries.InclusionProofs[i], txHdrs)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// compact proofs, a single one per transaction
+		err = verifyEntriesMultiInclusion(vEntries.Entries, vEntries.InclusionLeaves, vEntries.MultiInclusionProofs, txHdrs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newState != nil {
+		err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &schema.Entries{Entries: vEntries.Entries}, nil
+}
+
+// VerifiedZScan iterates over the elements of sorted set ordered by their score.
+//
+// This function also requests server-generated proofs, verifies both the sorted set entries and
+// the entries they refer to in the transactions they were written at and verifies the signature
+// of the signed state.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error) {
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("VerifiedZScan finished in %s", time.Since(start))
+	}()
+
+	err := c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
+	if err != nil {
+		return nil, err
 	}
 
-	vEntry, err := c.ServiceClient.VerifiableGet(ctx, req)
+	vEntries, err := c.ServiceClient.VerifiableZScan(ctx, &schema.VerifiableZScanRequest{
+		ZScanRequest: req,
+		ProveSinceTx: state.TxId,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	entrySpecDigest, err := store.EntrySpecDigestFor(int(vEntry.VerifiableTx.Tx.Header.Version))
+	if len(vEntries.ZAddTxIds) != len(vEntries.Entries) ||
+		len(vEntries.ZAddInclusionProofs) != len(vEntries.Entries) ||
+		len(vEntries.InclusionProofs) != len(vEntries.Entries) {
+		return nil, store.ErrCorruptedData
+	}
+
+	txHdrs, newState, err := c.verifyTxLinks(ctx, state, vEntries.TxLinkProofs, vEntries.DualProof, vEntries.Signature)
 	if err != nil {
 		return nil, err
 	}
 
-	inclusionProof := schema.InclusionProofFromProto(vEntry.InclusionProof)
-	dualProof := schema.DualProofFromProto(vEntry.VerifiableTx.DualProof)
+	for i, ze := range vEntries.Entries {
+		if ze.Entry == nil || !bytes.Equal(ze.Set, req.Set) {
+			return nil, store.ErrCorruptedData
+		}
 
-	var eh [sha256.Size]byte
+		txHdr, ok := txHdrs[vEntries.ZAddTxIds[i]]
+		if !ok {
+			return nil, store.ErrCorruptedData
+		}
 
-	var sourceID, targetID uint64
-	var sourceAlh, targetAlh [sha256.Size]byte
+		entrySpecDigest, err := store.EntrySpecDigestFor(txHdr.Version)
+		if err != nil {
+			return nil, err
+		}
 
-	vTx := kReq.AtTx
-	var e *store.EntrySpec
+		e := database.EncodeZAdd(ze.Set, ze.Score, database.EncodeKey(ze.Key), ze.AtTx)
 
-	if vEntry.Entry.ReferencedBy == nil {
-		if kReq.AtTx == 0 {
-			vTx = vEntry.Entry.Tx
+		verifies := store.VerifyInclusion(
+			schema.InclusionProofFromProto(vEntries.ZAddInclusionProofs[i]),
+			entrySpecDigest(e),
+			txHdr.Eh,
+		)
+		if !verifies {
+			return nil, store.ErrCorruptedData
 		}
 
-		e = database.EncodeEntrySpec(kReq.Key, schema.KVMetadataFromProto(vEntry.Entry.Metadata), vEntry.Entry.Value)
-	} else {
-		ref := vEntry.Entry.ReferencedBy
+		if !bytes.Equal(ze.Key, ze.Entry.Key) && (ze.Entry.ReferencedBy == nil || !bytes.Equal(ze.Key, ze.Entry.ReferencedBy.Key)) {
+			return nil, store.ErrCorruptedData
+		}
 
-		if kReq.AtTx == 0 {
-			vTx = ref.Tx
+		err = verifyEntryInclusion(ze.Entry, vEntries.InclusionProofs[i], txHdrs)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		e = database.EncodeReference(kReq.Key, schema.KVMetadataFromProto(ref.Metadata), vEntry.Entry.Key, ref.AtTx)
+	if newState != nil {
+		err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if state.TxId <= vTx {
-		eh = schema.DigestFromProto(vEntry.VerifiableTx.DualProof.TargetTxHeader.EH)
+	return &schema.ZEntries{Entries: vEntries.Entries}, nil
+}
 
-		sourceID = state.TxId
-		sourceAlh = schema.DigestFromProto(state.TxHash)
-		targetID = vTx
-		targetAlh = dualProof.TargetTxHeader.Alh()
-	} else {
-		eh = schema.DigestFromProto(vEntry.VerifiableTx.DualProof.SourceTxHeader.EH)
+// verifyTxLinks verifies the dual proof between the current state and the target transaction,
+// and every linked transaction against the target one. It returns the verified headers of the linked
+// transactions together with the state to be set once the entries are verified.
+// A nil state is returned when there was nothing to verify.
+func (c *immuClient) verifyTxLinks(
+	ctx context.Context,
+	state *schema.ImmutableState,
+	txLinkProofs []*schema.TxLinkProof,
+	dualProof *schema.DualProof,
+	signature *schema.Signature,
+) (map[uint64]*store.TxHeader, *schema.ImmutableState, error) {
+	txHdrs := make(map[uint64]*store.TxHeader)
+
+	if dualProof == nil {
+		// the server only omits the dual proof when there is no state and no entries
+		if state.TxId > 0 || len(txLinkProofs) > 0 {
+			return nil, nil, store.ErrCorruptedData
+		}
 
-		sourceID = vTx
-		sourceAlh = dualProof.SourceTxHeader.Alh()
-		targetID = state.TxId
-		targetAlh = schema.DigestFromProto(state.TxHash)
+		return txHdrs, nil, nil
 	}
 
-	verifies := store.VerifyInclusion(
-		inclusionProof,
-		entrySpecDigest(e),
-		eh)
-	if !verifies {
-		return nil, store.ErrCorruptedData
+	if dualProof.SourceTxHeader == nil || dualProof.TargetTxHeader == nil {
+		return nil, nil, store.ErrCorruptedData
 	}
 
+	proof := schema.DualProofFromProto(dualProof)
+
+	targetTxHdr := proof.TargetTxHeader
+	targetAlh := targetTxHdr.Alh()
+
 	if state.TxId > 0 {
 		err := c.verifyDualProof(
 			ctx,
-			dualProof,
-			sourceID,
-			targetID,
-			sourceAlh,
+			proof,
+			state.TxId,
+			targetTxHdr.ID,
+			schema.DigestFromProto(state.TxHash),
 			targetAlh,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+	}
+
+	txHdrs[targetTxHdr.ID] = targetTxHdr
+
+	for _, p := range txLinkProofs {
+		if p == nil || p.TxHeader == nil {
+			return nil, nil, store.ErrCorruptedData
+		}
+
+		txLinkProof := schema.TxLinkProofFromProto(p)
+
+		if !store.VerifyTxLinkProof(txLinkProof, targetTxHdr) {
+			return nil, nil, store.ErrCorruptedData
 		}
+
+		txHdrs[txLinkProof.TxHeader.ID] = txLinkProof.TxHeader
 	}
 
 	newState := &schema.ImmutableState{
 		Db:        c.currentDatabase(),
-		TxId:      targetID,
+		TxId:      targetTxHdr.ID,
 		TxHash:    targetAlh[:],
-		Signature: vEntry.VerifiableTx.Signature,
+		Signature: signature,
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if !ok {
-			return nil, store.ErrCorruptedData
+			return nil, nil, store.ErrCorruptedData
 		}
 	}
 
-	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
-	if err != nil {
-		return nil, err
+	return txHdrs, newState, nil
+}
+
+// verifyEntryInclusion verifies the entry is included in the transaction it was written at,
+// references are verified within the transaction the reference itself was written at.
+// Values of expired entries are no longer available, thus only the transaction they were written at is checked.
+func verifyEntryInclusion(e *schema.Entry, inclusionProof *schema.InclusionProof, txHdrs map[uint64]*store.TxHeader) error {
+	if e == nil || inclusionProof == nil {
+		return store.ErrCorruptedData
 	}
 
-	return vEntry.Entry, nil
+	txHdr, digest, err := entryDigest(e, txHdrs)
+	if err != nil || e.Expired {
+		return err
+	}
+
+	verifies := store.VerifyInclusion(
+		schema.InclusionProofFromProto(inclusionProof),
+		digest,
+		txHdr.Eh,
+	)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	return nil
 }
 
-// Scan iterates over the set of keys in a topological order.
-func (c *immuClient) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error) {
-	if !c.IsConnected() {
-		return nil, errors.FromError(ErrNotConnected)
+// verifyEntriesMultiInclusion verifies the entries against the single inclusion proof of the transaction
+// they were written at, provided in the order the transactions first appear among the non-expired entries.
+// As done by verifyEntryInclusion, expired entries are not verified.
+func verifyEntriesMultiInclusion(
+	entries []*schema.Entry,
+	leaves []int32,
+	proofs []*schema.MultiInclusionProof,
+	txHdrs map[uint64]*store.TxHeader,
+) error {
+	if len(leaves) != len(entries) {
+		return store.ErrCorruptedData
 	}
 
-	return c.ServiceClient.Scan(ctx, req)
+	var txIDs []uint64
+	digestsByTx := make(map[uint64]map[int][sha256.Size]byte)
+
+	for i, e := range entries {
+		if e == nil {
+			return store.ErrCorruptedData
+		}
+
+		txHdr, digest, err := entryDigest(e, txHdrs)
+		if err != nil {
+			return err
+		}
+
+		if e.Expired {
+			continue
+		}
+
+		digests, ok := digestsByTx[txHdr.ID]
+		if !ok {
+			digests = make(map[int][sha256.Size]byte)
+			digestsByTx[txHdr.ID] = digests
+			txIDs = append(txIDs, txHdr.ID)
+		}
+
+		if _, ok := digests[int(leaves[i])]; ok {
+			return store.ErrCorruptedData
+		}
+
+		digests[int(leaves[i])] = digest
+	}
+
+	if len(proofs) != len(txIDs) {
+		return store.ErrCorruptedData
+	}
+
+	for i, txID := range txIDs {
+		if proofs[i] == nil {
+			return store.ErrCorruptedData
+		}
+
+		proof := schema.MultiInclusionProofFromProto(proofs[i])
+		digests := digestsByTx[txID]
+
+		if len(proof.Leaves) != len(digests) {
+			return store.ErrCorruptedData
+		}
+
+		leafDigests := make([][sha256.Size]byte, len(proof.Leaves))
+
+		for j, l := range proof.Leaves {
+			digest, ok := digests[l]
+			if !ok {
+				return store.ErrCorruptedData
+			}
+
+			leafDigests[j] = digest
+		}
+
+		if !store.VerifyMultiInclusion(proof, leafDigests, txHdrs[txID].Eh) {
+			return store.ErrCorruptedData
+		}
+	}
+
+	return nil
 }
 
-// ZScan iterates over the elements of sorted set ordered by their score.
-func (c *immuClient) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error) {
-	if !c.IsConnected() {
-		return nil, errors.FromError(ErrNotConnected)
+// entryDigest returns the verified header of the transaction the entry was written at together with
+// the digest of the entry, references are written at the transaction of the reference itself
+func entryDigest(e *schema.Entry, txHdrs map[uint64]*store.TxHeader) (*store.TxHeader, [sha256.Size]byte, error) {
+	var digest [sha256.Size]byte
+
+	vTx := e.Tx
+	var spec *store.EntrySpec
+
+	if e.ReferencedBy == nil {
+		md := schema.KVMetadataFromProto(e.Metadata)
+
+		spec = database.EncodeEntrySpec(e.Key, md, e.Value)
+
+		if md != nil && md.Deleted() {
+			// deleted entries are written without any value
+			spec.Value = nil
+		}
+	} else {
+		ref := e.ReferencedBy
+
+		vTx = ref.Tx
+		spec = database.EncodeReference(ref.Key, schema.KVMetadataFromProto(ref.Metadata), e.Key, ref.AtTx)
 	}
 
-	return c.ServiceClient.ZScan(ctx, req)
+	txHdr, ok := txHdrs[vTx]
+	if !ok {
+		return nil, digest, store.ErrCorruptedData
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(txHdr.Version)
+	if err != nil {
+		return nil, digest, err
+	}
+
+	return txHdr, entrySpecDigest(spec), nil
 }
 
 // Count returns count of key-value entries with given prefix.
@@ -1377,7 +2348,7 @@ func (c *immuClient) VerifiedSet(ctx context.Context, key []byte, value []byte)
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
 			return nil, err
 		}
@@ -1508,22 +2479,30 @@ func (c *immuClient) TxByIDWithSpec(ctx context.Context, req *schema.TxRequest)
 // TxByID retrieves all entries (in a raw, unprocessed form) for given transaction
 // and performs verification of the server-provided proof for the whole transaction.
 func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx, error) {
+	start := time.Now()
+	defer c.Logger.Debugf("VerifiedTxByID finished in %s", time.Since(start))
+
+	vTx, _, err := c.verifiedTxByID(ctx, tx)
+	return vTx, err
+}
+
+// verifiedTxByID returns the transaction together with its Alh, once verified to be consistent with the local state
+func (c *immuClient) verifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx, [sha256.Size]byte, error) {
+	var txAlh [sha256.Size]byte
+
 	err := c.StateService.CacheLock()
 	if err != nil {
-		return nil, err
+		return nil, txAlh, err
 	}
 	defer c.StateService.CacheUnlock()
 
 	if !c.IsConnected() {
-		return nil, errors.FromError(ErrNotConnected)
+		return nil, txAlh, errors.FromError(ErrNotConnected)
 	}
 
-	start := time.Now()
-	defer c.Logger.Debugf("VerifiedTxByID finished in %s", time.Since(start))
-
 	state, err := c.StateService.GetState(ctx, c.Options.CurrentDatabase)
 	if err != nil {
-		return nil, err
+		return nil, txAlh, err
 	}
 
 	vTx, err := c.ServiceClient.VerifiableTxById(ctx, &schema.VerifiableTxRequest{
@@ -1531,7 +2510,7 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 		ProveSinceTx: state.TxId,
 	})
 	if err != nil {
-		return nil, err
+		return nil, txAlh, err
 	}
 
 	dualProof := schema.DualProofFromProto(vTx.DualProof)
@@ -1544,9 +2523,11 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 		sourceAlh = schema.DigestFromProto(state.TxHash)
 		targetID = tx
 		targetAlh = dualProof.TargetTxHeader.Alh()
+		txAlh = targetAlh
 	} else {
 		sourceID = tx
 		sourceAlh = dualProof.SourceTxHeader.Alh()
+		txAlh = sourceAlh
 		targetID = state.TxId
 		targetAlh = schema.DigestFromProto(state.TxHash)
 	}
@@ -1561,10 +2542,15 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 			targetAlh,
 		)
 		if err != nil {
-			return nil, err
+			return nil, txAlh, err
 		}
 	}
 
+	err = verifyTxEntries(vTx, txAlh)
+	if err != nil {
+		return nil, txAlh, err
+	}
+
 	newState := &schema.ImmutableState{
 		Db:        c.currentDatabase(),
 		TxId:      targetID,
@@ -1573,23 +2559,78 @@ func (c *immuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx,
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
-			return nil, err
+			return nil, txAlh, err
 		}
 		if !ok {
-			return nil, store.ErrCorruptedData
+			return nil, txAlh, store.ErrCorruptedData
 		}
 	}
 
 	err = c.StateService.SetState(c.Options.CurrentDatabase, newState)
 	if err != nil {
-		return nil, err
+		return nil, txAlh, err
 	}
 
 	decodeTxEntries(vTx.Tx.Entries)
 
-	return vTx.Tx, nil
+	return vTx.Tx, txAlh, nil
+}
+
+// verifyTxEntries verifies the raw entries of the transaction are included in it,
+// once the header of the transaction is verified to have the given Alh
+func verifyTxEntries(vTx *schema.VerifiableTx, txAlh [sha256.Size]byte) error {
+	if vTx.Tx == nil || vTx.Tx.Header == nil {
+		return store.ErrCorruptedData
+	}
+
+	txHdr := schema.TxHeaderFromProto(vTx.Tx.Header)
+
+	if txHdr.Alh() != txAlh {
+		return store.ErrCorruptedData
+	}
+
+	if len(vTx.Tx.Entries) == 0 {
+		return nil
+	}
+
+	if vTx.EntriesInclusionProof == nil {
+		return store.ErrCorruptedData
+	}
+
+	txEntryDigest, err := txHdr.TxEntryDigest()
+	if err != nil {
+		return err
+	}
+
+	digests := make([][sha256.Size]byte, len(vTx.Tx.Entries))
+
+	for i, e := range vTx.Tx.Entries {
+		hVal := schema.DigestFromProto(e.HValue)
+
+		if len(e.Value) > 0 && sha256.Sum256(e.Value) != hVal {
+			return store.ErrCorruptedData
+		}
+
+		txe := store.NewTxEntry(e.Key, schema.KVMetadataFromProto(e.Metadata), int(e.VLen), hVal, 0)
+
+		digests[i], err = txEntryDigest(txe)
+		if err != nil {
+			return err
+		}
+	}
+
+	verifies := store.VerifyMultiInclusion(
+		schema.MultiInclusionProofFromProto(vTx.EntriesInclusionProof),
+		digests,
+		txHdr.Eh,
+	)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	return nil
 }
 
 // TxScan returns raw entries for a range of transactions.
@@ -1757,7 +2798,7 @@ func (c *immuClient) VerifiedSetReferenceAt(ctx context.Context, key []byte, ref
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
 			return nil, err
 		}
@@ -1930,7 +2971,7 @@ func (c *immuClient) VerifiedZAddAt(ctx context.Context, set []byte, score float
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
 			return nil, err
 		}
@@ -2221,6 +3262,64 @@ func (c *immuClient) CompactIndex(ctx context.Context, req *empty.Empty) error {
 	return err
 }
 
+// RebuildIndex builds a fresh database index from the transaction log without blocking reads or writes.
+// This call requires SysAdmin or Admin permission to given database.
+//
+// The progress function, if provided, is called every time progress is reported by the server.
+// The call returns once the new index replaced the previous one.
+func (c *immuClient) RebuildIndex(ctx context.Context, progress func(p *schema.RebuildIndexProgress)) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return errors.FromError(ErrNotConnected)
+	}
+
+	defer func() {
+		c.Logger.Debugf("RebuildIndex finished in %s", time.Since(start))
+	}()
+
+	stream, err := c.ServiceClient.RebuildIndex(ctx, &empty.Empty{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		p, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(p)
+		}
+
+		if p.Done {
+			return nil
+		}
+	}
+}
+
+// BulkLoad writes entries into the database using as few transactions as possible.
+// This call requires SysAdmin or Admin permission to given database.
+//
+// Keys must be provided in strictly ascending order. Loaded transactions are indexed
+// in bulk and index flushing is deferred until all the entries were loaded.
+//
+// Note: loading is not atomic, entries may be partially loaded if an error occurs.
+func (c *immuClient) BulkLoad(ctx context.Context, kvs []*schema.KeyValue) (*schema.BulkLoadResponse, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	res, err := c.ServiceClient.BulkLoad(ctx, &schema.BulkLoadRequest{KVs: kvs})
+
+	c.Logger.Debugf("BulkLoad finished in %s", time.Since(start))
+
+	return res, err
+}
+
 // ChangePermission grants or revokes permission to one database for given user.
 //
 // This call requires SysAdmin or admin permission to the database where we grant permissions.
@@ -2273,6 +3372,22 @@ func (c *immuClient) SetActiveUser(ctx context.Context, u *schema.SetActiveUserR
 	return err
 }
 
+// UnlockUser allows a user locked out after too many failed login attempts to login again.
+// This call requires SysAdmin or Admin permission.
+func (c *immuClient) UnlockUser(ctx context.Context, username string) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.UnlockUser(ctx, &schema.UnlockUserRequest{Username: username})
+
+	c.Logger.Debugf("UnlockUser finished in %s", time.Since(start))
+
+	return err
+}
+
 // Return list of databases
 //
 // Deprecated: Use DatabaseListV2
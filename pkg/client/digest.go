@@ -0,0 +1,101 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+// PrefixDigest computes a Merkle root over the key-value entries sharing the requested prefix.
+// Two databases holding the same entries under the prefix produce the same digest, the returned
+// middle key can be used as seek or end key to bisect the range when digests differ.
+func (c *immuClient) PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("PrefixDigest finished in %s", time.Since(start))
+	}()
+
+	digest, err := c.ServiceClient.PrefixDigest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyDigestProofs(digest, len(req.ProveKeys))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, proof := range digest.Proofs {
+		if !bytes.Equal(proof.Key, req.ProveKeys[i]) {
+			return nil, fmt.Errorf("%w: inclusion proof of an unexpected key", store.ErrCorruptedData)
+		}
+	}
+
+	return digest, nil
+}
+
+// TableDigest computes a Merkle root over the rows of a table.
+// Rows are identified by their encoded primary key, the returned middle key can be used as
+// seek or end key to bisect the table when digests differ.
+func (c *immuClient) TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("TableDigest finished in %s", time.Since(start))
+	}()
+
+	digest, err := c.ServiceClient.TableDigest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyDigestProofs(digest, len(req.ProveRows))
+	if err != nil {
+		return nil, err
+	}
+
+	return digest, nil
+}
+
+func verifyDigestProofs(digest *schema.ContentDigest, expectedProofs int) error {
+	if len(digest.Proofs) != expectedProofs {
+		return fmt.Errorf("%w: expected %d inclusion proofs, got %d", store.ErrCorruptedData, expectedProofs, len(digest.Proofs))
+	}
+
+	for _, proof := range digest.Proofs {
+		if !database.VerifyDigestInclusion(proof, digest.Digest) {
+			return store.ErrCorruptedData
+		}
+	}
+
+	return nil
+}
@@ -59,9 +59,14 @@ type Options struct {
 	ServerSigningPubKey string // Name of the file containing public key for server signature validations
 	StreamChunkSize     int    // Maximum size of a data chunk in bytes for streaming operations (directly affects maximum GRPC packet size)
 
+	WitnessPubKeys         []string // Names of the files containing public keys of the witnesses trusted to cosign server states
+	MinWitnessCosignatures int      // Minimum number of trusted witness cosignatures required to accept a server state
+
 	HeartBeatFrequency time.Duration // Duration between two consecutive heartbeat calls to the server for session heartbeats
 
 	DisableIdentityCheck bool // Do not validate server's identity
+
+	APIKey string `json:"-"` // API key used to authenticate requests instead of logging in or opening a session
 }
 
 // DefaultOptions ...
@@ -213,6 +218,19 @@ func (o *Options) WithServerSigningPubKey(serverSigningPubKey string) *Options {
 	return o
 }
 
+// WithWitnessPubKeys sets the public keys of the witnesses trusted to cosign server states
+func (o *Options) WithWitnessPubKeys(witnessPubKeys []string) *Options {
+	o.WitnessPubKeys = witnessPubKeys
+	return o
+}
+
+// WithMinWitnessCosignatures sets the minimum number of trusted witness cosignatures required to accept a server state.
+// If greater than zero, server states not cosigned by enough trusted witnesses are rejected
+func (o *Options) WithMinWitnessCosignatures(minWitnessCosignatures int) *Options {
+	o.MinWitnessCosignatures = minWitnessCosignatures
+	return o
+}
+
 // WithStreamChunkSize set the chunk size
 func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	o.StreamChunkSize = streamChunkSize
@@ -240,6 +258,12 @@ func (o *Options) WithDisableIdentityCheck(disableIdentityCheck bool) *Options {
 	return o
 }
 
+// WithAPIKey sets the API key used to authenticate requests instead of logging in or opening a session
+func (o *Options) WithAPIKey(apiKey string) *Options {
+	o.APIKey = apiKey
+	return o
+}
+
 // String converts options object to a json string
 func (o *Options) String() string {
 	optionsJSON, err := json.Marshal(o)
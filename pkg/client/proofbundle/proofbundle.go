@@ -0,0 +1,261 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package proofbundle provides a portable representation of the proofs of an entry,
+// so that they can be verified offline without access to the server.
+package proofbundle
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/signer"
+)
+
+// Version is the format version of the proof bundles produced by this package
+const Version = 1
+
+var ErrInvalidBundle = errors.New("invalid proof bundle")
+var ErrUnsupportedVersion = errors.New("unsupported proof bundle version")
+
+// ProofBundle holds an entry together with all the proofs required to verify it
+// against a signed state of the database.
+//
+// The transaction holding the entry is either the source or the target of the dual proof,
+// while the state always refers to the target of the dual proof.
+// The signing key chain is only included when the state was signed with a rotated key.
+type ProofBundle struct {
+	Version         int                    `json:"version"`
+	Entry           *schema.Entry          `json:"entry"`
+	TxHeader        *schema.TxHeader       `json:"txHeader"`
+	InclusionProof  *schema.InclusionProof `json:"inclusionProof"`
+	DualProof       *schema.DualProof      `json:"dualProof"`
+	State           *schema.ImmutableState `json:"state"`
+	SigningKeyChain []*signer.KeyRotation  `json:"signingKeyChain,omitempty"`
+}
+
+// New builds a proof bundle from a verifiable entry returned by the server of the database db
+func New(db string, vEntry *schema.VerifiableEntry) (*ProofBundle, error) {
+	if vEntry == nil ||
+		vEntry.Entry == nil ||
+		vEntry.InclusionProof == nil ||
+		vEntry.VerifiableTx == nil ||
+		vEntry.VerifiableTx.DualProof == nil ||
+		vEntry.VerifiableTx.DualProof.SourceTxHeader == nil ||
+		vEntry.VerifiableTx.DualProof.TargetTxHeader == nil {
+		return nil, ErrInvalidBundle
+	}
+
+	dualProof := vEntry.VerifiableTx.DualProof
+	vTx := entryTx(vEntry.Entry)
+
+	var txHdr *schema.TxHeader
+
+	switch vTx {
+	case dualProof.SourceTxHeader.Id:
+		txHdr = dualProof.SourceTxHeader
+	case dualProof.TargetTxHeader.Id:
+		txHdr = dualProof.TargetTxHeader
+	default:
+		return nil, fmt.Errorf("%w: transaction %d is not part of the dual proof", ErrInvalidBundle, vTx)
+	}
+
+	targetAlh := schema.TxHeaderFromProto(dualProof.TargetTxHeader).Alh()
+
+	return &ProofBundle{
+		Version:        Version,
+		Entry:          vEntry.Entry,
+		TxHeader:       txHdr,
+		InclusionProof: vEntry.InclusionProof,
+		DualProof:      dualProof,
+		State: &schema.ImmutableState{
+			Db:        db,
+			TxId:      dualProof.TargetTxHeader.Id,
+			TxHash:    targetAlh[:],
+			Signature: vEntry.VerifiableTx.Signature,
+		},
+	}, nil
+}
+
+// Verify checks the entry is included in its transaction and the transaction is
+// consistent with the state of the bundle.
+// The signature of the state is checked only when serverSigningPubKey is provided,
+// states signed with a key chained from it through the signing key chain are accepted as well.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (b *ProofBundle) Verify(serverSigningPubKey crypto.PublicKey) error {
+	if b.Version != Version {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, b.Version)
+	}
+
+	if b.Entry == nil ||
+		b.TxHeader == nil ||
+		b.InclusionProof == nil ||
+		b.DualProof == nil ||
+		b.DualProof.SourceTxHeader == nil ||
+		b.DualProof.TargetTxHeader == nil ||
+		b.State == nil {
+		return ErrInvalidBundle
+	}
+
+	txHdr := schema.TxHeaderFromProto(b.TxHeader)
+	if txHdr.ID != entryTx(b.Entry) {
+		return store.ErrCorruptedData
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(txHdr.Version)
+	if err != nil {
+		return err
+	}
+
+	verifies := store.VerifyInclusion(
+		schema.InclusionProofFromProto(b.InclusionProof),
+		entrySpecDigest(entrySpec(b.Entry)),
+		txHdr.Eh,
+	)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	dualProof := schema.DualProofFromProto(b.DualProof)
+
+	sourceAlh := dualProof.SourceTxHeader.Alh()
+	targetAlh := schema.DigestFromProto(b.State.TxHash)
+
+	switch txHdr.ID {
+	case dualProof.SourceTxHeader.ID:
+		if txHdr.Alh() != sourceAlh {
+			return store.ErrCorruptedData
+		}
+	case dualProof.TargetTxHeader.ID:
+		if txHdr.Alh() != targetAlh {
+			return store.ErrCorruptedData
+		}
+	default:
+		return store.ErrCorruptedData
+	}
+
+	verifies = store.VerifyDualProof(
+		dualProof,
+		dualProof.SourceTxHeader.ID,
+		b.State.TxId,
+		sourceAlh,
+		targetAlh,
+	)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	if serverSigningPubKey != nil {
+		pk := serverSigningPubKey
+
+		if b.State.Signature != nil && len(b.State.Signature.PublicKey) > 0 {
+			chained, err := signer.VerifyKeyChain(serverSigningPubKey, b.SigningKeyChain, b.State.Signature.PublicKey)
+			if err != nil {
+				return err
+			}
+
+			if chained {
+				pk, err = signer.UnmarshalKey(b.State.Signature.PublicKey)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		ok, err := b.State.CheckSignature(pk)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return store.ErrCorruptedData
+		}
+	}
+
+	return nil
+}
+
+// Encode writes the proof bundle in JSON format
+func (b *ProofBundle) Encode(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(b)
+}
+
+// Decode reads a proof bundle in JSON format
+func Decode(r io.Reader) (*ProofBundle, error) {
+	var b ProofBundle
+
+	err := json.NewDecoder(r).Decode(&b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+
+	return &b, nil
+}
+
+// WriteFile writes the proof bundle in JSON format into the specified file
+func (b *ProofBundle) WriteFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	err = b.Encode(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// ReadFile reads a proof bundle in JSON format from the specified file
+func ReadFile(filename string) (*ProofBundle, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Decode(f)
+}
+
+// entryTx returns the transaction the entry (or the reference to it) was written at
+func entryTx(e *schema.Entry) uint64 {
+	if e.ReferencedBy == nil {
+		return e.Tx
+	}
+
+	return e.ReferencedBy.Tx
+}
+
+func entrySpec(e *schema.Entry) *store.EntrySpec {
+	if e.ReferencedBy == nil {
+		return database.EncodeEntrySpec(e.Key, schema.KVMetadataFromProto(e.Metadata), e.Value)
+	}
+
+	ref := e.ReferencedBy
+
+	return database.EncodeReference(ref.Key, schema.KVMetadataFromProto(ref.Metadata), e.Key, ref.AtTx)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proofbundle
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofBundleErrors(t *testing.T) {
+	_, err := New("db", nil)
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	_, err = New("db", &schema.VerifiableEntry{
+		Entry:          &schema.Entry{Tx: 3},
+		InclusionProof: &schema.InclusionProof{},
+		VerifiableTx: &schema.VerifiableTx{
+			DualProof: &schema.DualProof{
+				SourceTxHeader: &schema.TxHeader{Id: 1},
+				TargetTxHeader: &schema.TxHeader{Id: 2},
+			},
+		},
+	})
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	err = (&ProofBundle{}).Verify(nil)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	err = (&ProofBundle{Version: Version}).Verify(nil)
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	_, err = Decode(strings.NewReader("not a bundle"))
+	require.ErrorIs(t, err, ErrInvalidBundle)
+
+	_, err = ReadFile("./missing.json")
+	require.Error(t, err)
+}
+
+func TestProofBundleEncoding(t *testing.T) {
+	b := &ProofBundle{
+		Version: Version,
+		Entry:   &schema.Entry{Tx: 1, Key: []byte("key1"), Value: []byte("value1")},
+		State:   &schema.ImmutableState{Db: "db", TxId: 1, TxHash: []byte{1, 2, 3}},
+	}
+
+	var buf bytes.Buffer
+
+	err := b.Encode(&buf)
+	require.NoError(t, err)
+
+	decoded, err := Decode(&buf)
+	require.NoError(t, err)
+	require.Equal(t, b.Version, decoded.Version)
+	require.Equal(t, b.Entry.Key, decoded.Entry.Key)
+	require.Equal(t, b.Entry.Value, decoded.Entry.Value)
+	require.Equal(t, b.State.TxHash, decoded.State.TxHash)
+}
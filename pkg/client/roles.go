@@ -0,0 +1,121 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// ListRoles returns the list of roles.
+//
+// SysAdmin and Admin users get all the roles, other users get the roles granted to them.
+func (c *immuClient) ListRoles(ctx context.Context) (*schema.RoleList, error) {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return nil, ErrNotConnected
+	}
+
+	roles, err := c.ServiceClient.ListRoles(ctx, &empty.Empty{})
+
+	c.Logger.Debugf("ListRoles finished in %s", time.Since(start))
+
+	return roles, err
+}
+
+// CreateRole creates a new role without privileges.
+//
+// This call requires SysAdmin or Admin permission to any database.
+func (c *immuClient) CreateRole(ctx context.Context, name string, ifNotExists bool) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.CreateRole(ctx, &schema.CreateRoleRequest{
+		Name:        name,
+		IfNotExists: ifNotExists,
+	})
+
+	c.Logger.Debugf("CreateRole finished in %s", time.Since(start))
+
+	return err
+}
+
+// DropRole deletes a role and revokes it from the users it was granted to.
+//
+// This call can only be executed by the SysAdmin user or by the creator of the role.
+func (c *immuClient) DropRole(ctx context.Context, name string) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.DropRole(ctx, &schema.DropRoleRequest{Name: name})
+
+	c.Logger.Debugf("DropRole finished in %s", time.Since(start))
+
+	return err
+}
+
+// ChangeRolePrivilege grants or revokes a privilege to a role.
+//
+// This call requires SysAdmin or admin permission to the database the privilege applies to.
+func (c *immuClient) ChangeRolePrivilege(ctx context.Context, action schema.PermissionAction, role string, privilege *schema.RolePrivilege) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+		Action:    action,
+		Role:      role,
+		Privilege: privilege,
+	})
+
+	c.Logger.Debugf("ChangeRolePrivilege finished in %s", time.Since(start))
+
+	return err
+}
+
+// ChangeRoleMembership grants or revokes a role to a user.
+//
+// This call requires SysAdmin or admin permission to all the databases the role grants privileges on.
+func (c *immuClient) ChangeRoleMembership(ctx context.Context, action schema.PermissionAction, role string, username string) error {
+	start := time.Now()
+
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	_, err := c.ServiceClient.ChangeRoleMembership(ctx, &schema.ChangeRoleMembershipRequest{
+		Action:   action,
+		Role:     role,
+		Username: username,
+	})
+
+	c.Logger.Debugf("ChangeRoleMembership finished in %s", time.Since(start))
+
+	return err
+}
@@ -31,6 +31,14 @@ func (c *immuClient) OpenSession(ctx context.Context, user []byte, pass []byte,
 		c.WithServerSigningPubKey(pk)
 	}
 
+	if len(c.Options.WitnessPubKeys) > 0 {
+		pks, e := parseWitnessPubKeyFiles(c.Options.WitnessPubKeys)
+		if e != nil {
+			return e
+		}
+		c.WithWitnessPubKeys(pks)
+	}
+
 	if c.Options.StreamChunkSize < stream.MinChunkSize {
 		return errors.New(stream.ErrChunkTooSmall).WithCode(errors.CodInvalidParameterValue)
 	}
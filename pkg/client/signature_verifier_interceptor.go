@@ -17,15 +17,21 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"errors"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/signer"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// SignatureVerifierInterceptor verify that provided server signature match with the public key provided
+// SignatureVerifierInterceptor verify that provided server signature match with the public key provided,
+// or with a key the provided one was rotated to
 func (c *immuClient) SignatureVerifierInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 	ris := invoker(ctx, method, req, reply, cc, opts...)
 	if c.serverSigningPubKey == nil {
@@ -33,7 +39,7 @@ func (c *immuClient) SignatureVerifierInterceptor(ctx context.Context, method st
 	}
 	if method == "/immudb.schema.ImmuService/CurrentState" {
 		state := reply.(*schema.ImmutableState)
-		ok, err := state.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, state)
 		if err != nil {
 			return status.Errorf(codes.InvalidArgument, "unable to verify signature: %s", err)
 		}
@@ -43,3 +49,74 @@ func (c *immuClient) SignatureVerifierInterceptor(ctx context.Context, method st
 	}
 	return ris
 }
+
+// checkStateSignature verifies the signature of the state with the server signing public key or,
+// when the state was signed with a rotated key, with the key chained from it
+func (c *immuClient) checkStateSignature(ctx context.Context, state *schema.ImmutableState) (bool, error) {
+	if state.Signature == nil {
+		return false, errors.New("no signature found")
+	}
+
+	pk, err := c.signingPubKeyFor(ctx, state.Signature.PublicKey)
+	if err != nil {
+		return false, err
+	}
+
+	return state.CheckSignature(pk)
+}
+
+// signingPubKeyFor returns the key signatures made with the provided public key must be verified with.
+// The provided public key is used only when it is chained from the server signing public key, the signing key
+// chain is fetched from the server when the key is not known yet.
+func (c *immuClient) signingPubKeyFor(ctx context.Context, publicKey []byte) (crypto.PublicKey, error) {
+	if c.serverSigningPubKey == nil || len(publicKey) == 0 {
+		return c.serverSigningPubKey, nil
+	}
+
+	rootKey, err := signer.MarshalKey(c.serverSigningPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(rootKey, publicKey) {
+		return c.serverSigningPubKey, nil
+	}
+
+	c.rotatedSigningKeysMutex.Lock()
+	defer c.rotatedSigningKeysMutex.Unlock()
+
+	if pk, ok := c.rotatedSigningKeys[string(publicKey)]; ok {
+		return pk, nil
+	}
+
+	if c.ServiceClient == nil {
+		// the signing key chain can not be fetched without a connection to the server
+		return c.serverSigningPubKey, nil
+	}
+
+	keyChain, err := c.ServiceClient.SigningKeyChain(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	chained, err := signer.VerifyKeyChain(c.serverSigningPubKey, schema.SigningKeyRotationsFromProto(keyChain.Rotations), publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !chained {
+		// signatures made with an untrusted key won't match the server signing public key
+		return c.serverSigningPubKey, nil
+	}
+
+	pk, err := signer.UnmarshalKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.rotatedSigningKeys == nil {
+		c.rotatedSigningKeys = make(map[string]crypto.PublicKey)
+	}
+	c.rotatedSigningKeys[string(publicKey)] = pk
+
+	return pk, nil
+}
@@ -234,7 +234,7 @@ func (c *immuClient) VerifyRow(ctx context.Context, row *schema.Row, table strin
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
 			return err
 		}
@@ -251,6 +251,169 @@ func (c *immuClient) VerifyRow(ctx context.Context, row *schema.Row, table strin
 	return nil
 }
 
+// VerifiedSQLQuery performs a query (read-only) operation with additional validation of server-provided proofs.
+//
+// Rows must be selected from a single table and include all the primary key columns.
+// Every row in the result is verified against the entry it was written in, note the
+// completeness of the result set is not verified.
+// If verification does not succeed the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedSQLQuery(ctx context.Context, sql string, params map[string]interface{}) (*schema.SQLQueryResult, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	namedParams, err := schema.EncodeParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.StateService.CacheLock()
+	if err != nil {
+		return nil, err
+	}
+	defer c.StateService.CacheUnlock()
+
+	state, err := c.StateService.GetState(ctx, c.currentDatabase())
+	if err != nil {
+		return nil, err
+	}
+
+	vRes, err := c.ServiceClient.VerifiableSQLQuery(ctx, &schema.VerifiableSQLQueryRequest{
+		SqlQueryRequest: &schema.SQLQueryRequest{Sql: sql, Params: namedParams},
+		ProveSinceTx:    state.TxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if vRes.Result == nil ||
+		len(vRes.SqlEntries) != len(vRes.Result.Rows) ||
+		len(vRes.InclusionProofs) != len(vRes.Result.Rows) {
+		return nil, store.ErrCorruptedData
+	}
+
+	txHdrs, newState, err := c.verifyTxLinks(ctx, state, vRes.TxLinkProofs, vRes.DualProof, vRes.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, row := range vRes.Result.Rows {
+		err = verifySQLQueryRow(row, vRes.SqlEntries[i], vRes.InclusionProofs[i], vRes, txHdrs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newState != nil {
+		err = c.StateService.SetState(c.currentDatabase(), newState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vRes.Result, nil
+}
+
+// verifySQLQueryRow verifies the row matches the raw entry and the entry is included in the transaction it was written at,
+// the key of the entry is built from the primary key values in the row
+func verifySQLQueryRow(
+	row *schema.Row,
+	entry *schema.SQLEntry,
+	inclusionProof *schema.InclusionProof,
+	vRes *schema.VerifiableSQLQueryResult,
+	txHdrs map[uint64]*store.TxHeader,
+) error {
+	if row == nil || entry == nil || inclusionProof == nil ||
+		len(row.Columns) == 0 || len(row.Columns) != len(row.Values) {
+		return sql.ErrCorruptedData
+	}
+
+	valuesByColID := make(map[uint32]*schema.SQLValue, len(row.Columns))
+
+	for i, colName := range row.Columns {
+		colID, ok := vRes.ColIdsByName[colName]
+		if !ok {
+			return sql.ErrColumnDoesNotExist
+		}
+
+		valuesByColID[colID] = row.Values[i]
+	}
+
+	valbuf := bytes.Buffer{}
+
+	for _, pkID := range vRes.PKIDs {
+		pkVal, ok := valuesByColID[pkID]
+		if !ok {
+			return sql.ErrCorruptedData
+		}
+
+		pkType, ok := vRes.ColTypesById[pkID]
+		if !ok {
+			return sql.ErrCorruptedData
+		}
+
+		pkLen, ok := vRes.ColLenById[pkID]
+		if !ok {
+			return sql.ErrCorruptedData
+		}
+
+		pkEncVal, err := sql.EncodeRawValueAsKey(schema.RawValue(pkVal), pkType, int(pkLen))
+		if err != nil {
+			return err
+		}
+
+		_, err = valbuf.Write(pkEncVal)
+		if err != nil {
+			return err
+		}
+	}
+
+	pkKey := sql.MapKey(
+		[]byte{SQLPrefix},
+		sql.PIndexPrefix,
+		sql.EncodeID(vRes.DatabaseId),
+		sql.EncodeID(vRes.TableId),
+		sql.EncodeID(sql.PKIndexID),
+		valbuf.Bytes())
+
+	decodedRow, err := decodeRow(entry.Value, vRes.ColTypesById)
+	if err != nil {
+		return err
+	}
+
+	err = verifyRowAgainst(row, decodedRow, vRes.ColIdsByName)
+	if err != nil {
+		return err
+	}
+
+	txHdr, ok := txHdrs[entry.Tx]
+	if !ok {
+		return store.ErrCorruptedData
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(txHdr.Version)
+	if err != nil {
+		return err
+	}
+
+	e := &store.EntrySpec{
+		Key:      pkKey,
+		Metadata: schema.KVMetadataFromProto(entry.Metadata),
+		Value:    entry.Value,
+	}
+
+	verifies := store.VerifyInclusion(
+		schema.InclusionProofFromProto(inclusionProof),
+		entrySpecDigest(e),
+		txHdr.Eh,
+	)
+	if !verifies {
+		return store.ErrCorruptedData
+	}
+
+	return nil
+}
+
 func verifyRowAgainst(row *schema.Row, decodedRow map[uint32]*schema.SQLValue, colIdsByName map[string]uint32) error {
 	for i, colName := range row.Columns {
 		colID, ok := colIdsByName[colName]
@@ -265,7 +265,7 @@ func (c *immuClient) _streamVerifiedSet(ctx context.Context, kvs []*stream.KeyVa
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
 			return nil, err
 		}
@@ -382,7 +382,7 @@ func (c *immuClient) _streamVerifiedGet(ctx context.Context, req *schema.Verifia
 	}
 
 	if c.serverSigningPubKey != nil {
-		ok, err := newState.CheckSignature(c.serverSigningPubKey)
+		ok, err := c.checkStateSignature(ctx, newState)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client/errors"
+	"github.com/codenotary/immudb/pkg/tsa"
+)
+
+// TimestampAnchor returns the earliest timestamp anchor covering the given transaction,
+// i.e. the RFC 3161 time-stamp token attesting the state as of such transaction or the closest later one.
+func (c *immuClient) TimestampAnchor(ctx context.Context, tx uint64) (*schema.TimestampAnchor, error) {
+	if !c.IsConnected() {
+		return nil, errors.FromError(ErrNotConnected)
+	}
+
+	start := time.Now()
+	defer func() {
+		c.Logger.Debugf("TimestampAnchor finished in %s", time.Since(start))
+	}()
+
+	return c.ServiceClient.GetTimestampAnchor(ctx, &schema.TimestampAnchorRequest{Tx: tx})
+}
+
+// VerifiedTimestampAnchor returns the earliest timestamp anchor covering the given transaction
+// together with its time-stamp token, proving the transaction existed no later than the time
+// the token was issued at.
+// The anchored transaction is verified to be consistent with the local state and the token
+// is verified to be issued over its Alh by a time-stamping authority trusted by roots.
+// If the token does not match the anchored transaction the store.ErrCorruptedData error is returned.
+func (c *immuClient) VerifiedTimestampAnchor(ctx context.Context, tx uint64, roots *x509.CertPool) (*schema.TimestampAnchor, *tsa.Token, error) {
+	anchor, err := c.TimestampAnchor(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if anchor.AnchoredTx < tx {
+		return nil, nil, fmt.Errorf("%w: anchored transaction %d does not cover transaction %d", store.ErrCorruptedData, anchor.AnchoredTx, tx)
+	}
+
+	token, err := tsa.ParseToken(anchor.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, alh, err := c.verifiedTxByID(ctx, anchor.AnchoredTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = token.Verify(alh[:], roots)
+	if err == tsa.ErrTokenMismatch {
+		return nil, nil, fmt.Errorf("%w: %v", store.ErrCorruptedData, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return anchor, token, nil
+}
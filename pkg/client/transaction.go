@@ -36,6 +36,16 @@ type Tx interface {
 	// Rollback rollbacks a transaction.
 	Rollback(ctx context.Context) error
 
+	// Savepoint creates a named savepoint within the transaction.
+	Savepoint(ctx context.Context, name string) error
+
+	// RollbackToSavepoint discards the changes made after the named savepoint was created.
+	// The transaction remains open and the savepoint can be used again.
+	RollbackToSavepoint(ctx context.Context, name string) error
+
+	// ReleaseSavepoint destroys the named savepoint, changes made after it was created are kept.
+	ReleaseSavepoint(ctx context.Context, name string) error
+
 	// SQLExec performs a modifying SQL query within the transaction.
 	// Such query does not return SQL result.
 	SQLExec(ctx context.Context, sql string, params map[string]interface{}) error
@@ -59,6 +69,21 @@ func (c *tx) Rollback(ctx context.Context) error {
 	return errors.FromError(err)
 }
 
+func (c *tx) Savepoint(ctx context.Context, name string) error {
+	_, err := c.ic.ServiceClient.TxSavepoint(c.populateCtx(ctx), &schema.TxSavepointRequest{Name: name})
+	return errors.FromError(err)
+}
+
+func (c *tx) RollbackToSavepoint(ctx context.Context, name string) error {
+	_, err := c.ic.ServiceClient.TxRollbackToSavepoint(c.populateCtx(ctx), &schema.TxSavepointRequest{Name: name})
+	return errors.FromError(err)
+}
+
+func (c *tx) ReleaseSavepoint(ctx context.Context, name string) error {
+	_, err := c.ic.ServiceClient.TxReleaseSavepoint(c.populateCtx(ctx), &schema.TxSavepointRequest{Name: name})
+	return errors.FromError(err)
+}
+
 func (c *immuClient) NewTx(ctx context.Context, opts ...TxOption) (Tx, error) {
 	if !c.IsConnected() {
 		return nil, errors.FromError(ErrNotConnected)
@@ -17,7 +17,7 @@ limitations under the License.
 package client
 
 import (
-	"crypto/ecdsa"
+	"crypto"
 
 	"github.com/codenotary/immudb/pkg/client/tokenservice"
 	"github.com/codenotary/immudb/pkg/stream"
@@ -59,8 +59,19 @@ func (c *immuClient) WithTokenService(tokenService tokenservice.TokenService) *i
 }
 
 // WithServerSigningPubKey sets up public key for server's state validation.
-func (c *immuClient) WithServerSigningPubKey(publicKey *ecdsa.PublicKey) *immuClient {
+func (c *immuClient) WithServerSigningPubKey(publicKey crypto.PublicKey) *immuClient {
 	c.serverSigningPubKey = publicKey
+
+	c.rotatedSigningKeysMutex.Lock()
+	c.rotatedSigningKeys = nil
+	c.rotatedSigningKeysMutex.Unlock()
+
+	return c
+}
+
+// WithWitnessPubKeys sets up public keys of the witnesses trusted to cosign server states.
+func (c *immuClient) WithWitnessPubKeys(witnessPubKeys []crypto.PublicKey) *immuClient {
+	c.witnessPubKeys = witnessPubKeys
 	return c
 }
 
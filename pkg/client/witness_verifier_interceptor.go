@@ -0,0 +1,91 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/signer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// WitnessVerifierInterceptor verify that the state provided by the server was cosigned by at least
+// the minimum number of trusted witnesses. As witnesses may not have cosigned the current state yet,
+// the most recent state cosigned by enough of them is requested otherwise.
+func (c *immuClient) WitnessVerifierInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil || method != "/immudb.schema.ImmuService/CurrentState" {
+		return err
+	}
+
+	state := reply.(*schema.ImmutableState)
+
+	cosignatures, err := state.CountWitnessSignatures(c.witnessPubKeys)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "unable to verify witness cosignatures: %s", err)
+	}
+	if cosignatures >= c.Options.MinWitnessCosignatures {
+		return nil
+	}
+
+	cosignedStateReq := &schema.CosignedStateRequest{
+		WitnessPublicKeys: make([][]byte, len(c.witnessPubKeys)),
+		MinCosignatures:   uint32(c.Options.MinWitnessCosignatures),
+	}
+
+	for i, pk := range c.witnessPubKeys {
+		cosignedStateReq.WitnessPublicKeys[i], err = signer.MarshalKey(pk)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "unable to verify witness cosignatures: %s", err)
+		}
+	}
+
+	cosignedState := &schema.ImmutableState{}
+
+	err = invoker(ctx, "/immudb.schema.ImmuService/CosignedState", cosignedStateReq, cosignedState, cc, opts...)
+	if err == nil {
+		cosignatures, err = cosignedState.CountWitnessSignatures(c.witnessPubKeys)
+	}
+	if err != nil || cosignatures < c.Options.MinWitnessCosignatures {
+		return status.Errorf(codes.FailedPrecondition,
+			"state cosigned by %d trusted witnesses while at least %d are required", cosignatures, c.Options.MinWitnessCosignatures)
+	}
+
+	proto.Reset(state)
+	proto.Merge(state, cosignedState)
+
+	return nil
+}
+
+func parseWitnessPubKeyFiles(files []string) ([]crypto.PublicKey, error) {
+	pks := make([]crypto.PublicKey, len(files))
+
+	for i, f := range files {
+		pk, err := signer.ParsePublicKeyFile(f)
+		if err != nil {
+			return nil, err
+		}
+		pks[i] = pk
+	}
+
+	return pks, nil
+}
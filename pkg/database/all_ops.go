@@ -19,15 +19,24 @@ package database
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 )
 
+var ErrInvalidCounterValue = errors.New("key does not hold a valid counter value")
+var ErrCounterOverflow = errors.New("counter overflow")
+
+const counterValueLen = 8
+
 // ExecAll like Set it permits many insertions at once.
 // The difference is that is possible to to specify a list of a mix of key value set and zAdd insertions.
 // If zAdd reference is not yet present on disk it's possible to add it as a regular key value and the reference is done onFly
+// Counters are incremented by reading their current value and writing the new one within the same transaction
 func (d *db) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	if req == nil {
 		return nil, store.ErrIllegalArguments
@@ -143,6 +152,37 @@ func (d *db) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.T
 					)
 				}
 
+			case *schema.Op_Increment:
+				if len(x.Increment.Key) == 0 {
+					return nil, nil, store.ErrIllegalArguments
+				}
+
+				if req.NoWait {
+					return nil, nil, fmt.Errorf(
+						"%w: counters can not be incremented without reading their current value",
+						ErrNoWaitOperationMustBeSelfContained)
+				}
+
+				kmap[sha256.Sum256(x.Increment.Key)] = true
+
+				key := EncodeKey(x.Increment.Key)
+
+				counter, err := readCounter(key, index)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				if (x.Increment.Delta > 0 && counter > math.MaxInt64-x.Increment.Delta) ||
+					(x.Increment.Delta < 0 && counter < math.MinInt64-x.Increment.Delta) {
+					return nil, nil, fmt.Errorf("%w: key '%s'", ErrCounterOverflow, x.Increment.Key)
+				}
+
+				e = EncodeEntrySpec(
+					x.Increment.Key,
+					nil,
+					encodeCounter(counter+x.Increment.Delta),
+				)
+
 			case *schema.Op_ZAdd:
 				if len(x.ZAdd.Set) == 0 || len(x.ZAdd.Key) == 0 {
 					return nil, nil, store.ErrIllegalArguments
@@ -212,3 +252,31 @@ func (d *db) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.T
 
 	return schema.TxHeaderToProto(hdr), nil
 }
+
+// readCounter returns the current value of the counter, a non-existent key is considered as a zero-valued counter
+func readCounter(key []byte, index store.KeyIndex) (int64, error) {
+	valRef, err := index.Get(key)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	val, err := valRef.Resolve()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(val) != 1+counterValueLen || val[0] != PlainValuePrefix {
+		return 0, fmt.Errorf("%w: key '%s'", ErrInvalidCounterValue, TrimPrefix(key))
+	}
+
+	return int64(binary.BigEndian.Uint64(TrimPrefix(val))), nil
+}
+
+func encodeCounter(counter int64) []byte {
+	val := make([]byte, counterValueLen)
+	binary.BigEndian.PutUint64(val, uint64(counter))
+	return val
+}
@@ -18,8 +18,11 @@ package database
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -1328,6 +1331,135 @@ func TestOps_Preconditions(t *testing.T) {
 	require.ErrorIs(t, err, store.ErrInvalidPrecondition,
 		"did not fail when too many preconditions were given")
 
+	t.Run("value must equal", func(t *testing.T) {
+		setValue := func(value []byte, valueHash [sha256.Size]byte) error {
+			_, err := db.ExecAll(context.Background(), &schema.ExecAllRequest{
+				Operations: []*schema.Op{{
+					Operation: &schema.Op_Kv{
+						Kv: &schema.KeyValue{
+							Key:   []byte("key"),
+							Value: value,
+						},
+					},
+				}},
+				Preconditions: []*schema.Precondition{
+					schema.PreconditionValueMustEqual([]byte("key"), valueHash[:]),
+				},
+			})
+			return err
+		}
+
+		// the hash must be calculated over the value as it's stored
+		err := setValue([]byte("value2"), sha256.Sum256([]byte("value")))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+		err = setValue([]byte("value2"), sha256.Sum256(WrapWithPrefix([]byte("value"), PlainValuePrefix)))
+		require.NoError(t, err)
+
+		err = setValue([]byte("value3"), sha256.Sum256(WrapWithPrefix([]byte("value"), PlainValuePrefix)))
+		require.ErrorIs(t, err, store.ErrPreconditionFailed)
+
+		tx, err := db.TxByID(context.Background(), &schema.TxRequest{Tx: db.st.LastCommittedTxID()})
+		require.NoError(t, err)
+
+		err = setValue([]byte("value3"), schema.DigestFromProto(tx.Entries[0].HValue))
+		require.NoError(t, err)
+	})
+}
+
+func TestOps_Increment(t *testing.T) {
+	db := makeDb(t)
+
+	increment := func(key string, delta int64) *schema.Op {
+		return &schema.Op{
+			Operation: &schema.Op_Increment{
+				Increment: &schema.IncrementRequest{
+					Key:   []byte(key),
+					Delta: delta,
+				},
+			},
+		}
+	}
+
+	counterValue := func(key string) int64 {
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: []byte(key)})
+		require.NoError(t, err)
+		require.Len(t, entry.Value, 8)
+
+		return int64(binary.BigEndian.Uint64(entry.Value))
+	}
+
+	_, err := db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("", 1)},
+	})
+	require.ErrorIs(t, err, store.ErrIllegalArguments)
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("counter1", 1)},
+		NoWait:     true,
+	})
+	require.ErrorIs(t, err, ErrNoWaitOperationMustBeSelfContained)
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("counter1", 1), increment("counter1", 1)},
+	})
+	require.ErrorIs(t, err, schema.ErrDuplicatedKeysNotSupported)
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("counter1", 5), increment("counter2", -3)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), counterValue("counter1"))
+	require.Equal(t, int64(-3), counterValue("counter2"))
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("counter1", math.MaxInt64)},
+	})
+	require.ErrorIs(t, err, ErrCounterOverflow)
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("counter2", math.MinInt64)},
+	})
+	require.ErrorIs(t, err, ErrCounterOverflow)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key"), Value: []byte("value")}}})
+	require.NoError(t, err)
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("key", 1)},
+	})
+	require.ErrorIs(t, err, ErrInvalidCounterValue)
+
+	_, err = db.SetReference(context.Background(), &schema.ReferenceRequest{Key: []byte("ref"), ReferencedKey: []byte("counter1")})
+	require.NoError(t, err)
+
+	_, err = db.ExecAll(context.Background(), &schema.ExecAllRequest{
+		Operations: []*schema.Op{increment("ref", 1)},
+	})
+	require.ErrorIs(t, err, ErrInvalidCounterValue)
+
+	t.Run("concurrent increments", func(t *testing.T) {
+		var wg sync.WaitGroup
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 10; j++ {
+					_, err := db.ExecAll(context.Background(), &schema.ExecAllRequest{
+						Operations: []*schema.Op{increment("counter1", 1)},
+					})
+					require.NoError(t, err)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		require.Equal(t, int64(105), counterValue("counter1"))
+	})
 }
 
 /*
@@ -17,6 +17,7 @@ limitations under the License.
 package database
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
@@ -25,6 +26,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -77,6 +79,7 @@ type DB interface {
 
 	Get(ctx context.Context, req *schema.KeyRequest) (*schema.Entry, error)
 	VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest) (*schema.VerifiableEntry, error)
+	VerifiableGetAbsent(ctx context.Context, req *schema.VerifiableGetAbsentRequest) (*schema.VerifiableIndexProof, error)
 	GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error)
 
 	Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error)
@@ -85,8 +88,10 @@ type DB interface {
 	VerifiableSetReference(ctx context.Context, req *schema.VerifiableReferenceRequest) (*schema.VerifiableTx, error)
 
 	Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error)
+	VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error)
 
 	History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error)
+	VerifiableHistory(ctx context.Context, req *schema.VerifiableHistoryRequest) (*schema.VerifiableEntries, error)
 
 	ExecAll(ctx context.Context, operations *schema.ExecAllRequest) (*schema.TxHeader, error)
 
@@ -96,6 +101,7 @@ type DB interface {
 	ZAdd(ctx context.Context, req *schema.ZAddRequest) (*schema.TxHeader, error)
 	VerifiableZAdd(ctx context.Context, req *schema.VerifiableZAddRequest) (*schema.VerifiableTx, error)
 	ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error)
+	VerifiableZScan(ctx context.Context, req *schema.VerifiableZScanRequest) (*schema.VerifiableZEntries, error)
 
 	// SQL-related
 	NewSQLTx(ctx context.Context, opts *sql.TxOptions) (*sql.SQLTx, error)
@@ -111,6 +117,7 @@ type DB interface {
 	SQLQueryRowReader(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error)
 
 	VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetRequest) (*schema.VerifiableSQLEntry, error)
+	VerifiableSQLQuery(ctx context.Context, req *schema.VerifiableSQLQueryRequest) (*schema.VerifiableSQLQueryResult, error)
 
 	ListTables(ctx context.Context, tx *sql.SQLTx) (*schema.SQLQueryResult, error)
 	DescribeTable(ctx context.Context, tx *sql.SQLTx, table string) (*schema.SQLQueryResult, error)
@@ -128,9 +135,20 @@ type DB interface {
 	VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error)
 	TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error)
 
+	// Timestamp anchoring
+	AddTimestampAnchor(ctx context.Context, txID uint64, token []byte) (*schema.TxHeader, error)
+	TimestampAnchor(ctx context.Context, req *schema.TimestampAnchorRequest) (*schema.TimestampAnchor, error)
+
+	// Content digests
+	PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error)
+	TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error)
+
 	// Maintenance
 	FlushIndex(req *schema.FlushIndexRequest) error
 	CompactIndex() error
+	BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadResponse, error)
+	RebuildIndex(ctx context.Context, progress func(indexedTxID, committedTxID uint64)) error
+	CheckTxIntegrity(txID uint64, prevAlh [sha256.Size]byte) (*store.TxIntegrity, error)
 
 	IsClosed() bool
 	Close() error
@@ -339,6 +357,80 @@ func (d *db) CompactIndex() error {
 	return d.st.CompactIndex()
 }
 
+// RebuildIndex builds a fresh index from the transaction log without blocking reads or writes
+func (d *db) RebuildIndex(ctx context.Context, progress func(indexedTxID, committedTxID uint64)) error {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return ErrIsReplica
+	}
+
+	return d.st.RebuildIndex(ctx, progress)
+}
+
+// CheckTxIntegrity verifies the transaction txID as stored on disk, including its values,
+// and its linking with the previous transaction and the binary linking tree
+func (d *db) CheckTxIntegrity(txID uint64, prevAlh [sha256.Size]byte) (*store.TxIntegrity, error) {
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	return d.st.CheckTxIntegrity(txID, prevAlh, tx)
+}
+
+// BulkLoad writes entries sorted by key into as few transactions as possible,
+// indexing them in bulk and deferring index flushing until all of them were loaded
+func (d *db) BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadResponse, error) {
+	if req == nil || len(req.KVs) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	loader, err := d.st.NewBulkLoader()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kv := range req.KVs {
+		if len(kv.Key) == 0 {
+			loader.Close(ctx)
+			return nil, ErrIllegalArguments
+		}
+
+		e := EncodeEntrySpec(
+			kv.Key,
+			schema.KVMetadataFromProto(kv.Metadata),
+			kv.Value,
+		)
+
+		err = loader.Add(ctx, e.Key, e.Metadata, e.Value)
+		if err != nil {
+			loader.Close(ctx)
+			return nil, err
+		}
+	}
+
+	err = loader.Close(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.BulkLoadResponse{
+		FirstTxId: loader.FirstTxID(),
+		LastTxId:  loader.LastTxID(),
+		Entries:   uint64(loader.EntriesCount()),
+	}, nil
+}
+
 // Set ...
 func (d *db) Set(ctx context.Context, req *schema.SetRequest) (*schema.TxHeader, error) {
 	d.mutex.RLock()
@@ -732,7 +824,34 @@ func (d *db) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest
 		return nil, ErrIllegalState
 	}
 
-	e, err := d.Get(ctx, req.KeyRequest)
+	kReq := req.KeyRequest
+
+	var indexTxHdr *store.TxHeader
+	var indexProof *store.IndexProof
+	var err error
+
+	if req.ProveLatest {
+		if kReq == nil || kReq.AtTx > 0 || kReq.SinceTx > 0 || kReq.AtRevision != 0 {
+			return nil, fmt.Errorf("%w: only the current value of a key can be proven to be the latest one", ErrIllegalArguments)
+		}
+
+		indexTxHdr, indexProof, err = d.st.IndexProof(EncodeKey(kReq.Key))
+		if err != nil {
+			return nil, err
+		}
+
+		if indexProof.Leaf == nil || indexProof.Leaf.KeyDigest != sha256.Sum256(EncodeKey(kReq.Key)) {
+			return nil, store.ErrKeyNotFound
+		}
+
+		// the entry is read from the transaction proven to hold the latest value of the key
+		kReq = &schema.KeyRequest{
+			Key:  kReq.Key,
+			AtTx: indexProof.Leaf.TxID,
+		}
+	}
+
+	e, err := d.Get(ctx, kReq)
 	if err != nil {
 		return nil, err
 	}
@@ -796,11 +915,255 @@ func (d *db) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest
 		DualProof: schema.DualProofToProto(dualProof),
 	}
 
-	return &schema.VerifiableEntry{
+	vEntry := &schema.VerifiableEntry{
 		Entry:          e,
 		VerifiableTx:   verifiableTx,
 		InclusionProof: schema.InclusionProofToProto(inclusionProof),
-	}, nil
+	}
+
+	if req.ProveLatest {
+		// the transaction holding the index root is at least as recent as the ones included in the dual proof
+		indexDualProof, err := d.st.DualProof(targetTxHdr, indexTxHdr)
+		if err != nil {
+			return nil, err
+		}
+
+		vEntry.IndexProof = schema.IndexProofToProto(indexProof, indexDualProof)
+	}
+
+	return vEntry, nil
+}
+
+// VerifiableGetAbsent proves the key was never written as of the last committed transaction.
+// Logically deleted keys are not absent, their deletion is proven with VerifiableGet instead.
+func (d *db) VerifiableGetAbsent(ctx context.Context, req *schema.VerifiableGetAbsentRequest) (*schema.VerifiableIndexProof, error) {
+	if req == nil || len(req.Key) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < req.ProveSinceTx {
+		return nil, ErrIllegalState
+	}
+
+	indexTxHdr, indexProof, err := d.st.IndexProof(EncodeKey(req.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	if indexProof.Leaf != nil && indexProof.Leaf.KeyDigest == sha256.Sum256(EncodeKey(req.Key)) {
+		return nil, store.ErrKeyAlreadyExists
+	}
+
+	sourceTxHdr := indexTxHdr
+
+	if req.ProveSinceTx > 0 {
+		sourceTxHdr, err = d.st.ReadTxHeader(req.ProveSinceTx, false, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dualProof, err := d.st.DualProof(sourceTxHdr, indexTxHdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.IndexProofToProto(indexProof, dualProof), nil
+}
+
+// verifiableEntries builds the inclusion proof of every entry within the transaction it was written at,
+// references are proven within the transaction the reference itself was written at.
+// Compact proofs prove all the entries written in the same transaction at once.
+func (d *db) verifiableEntries(entries []*schema.Entry, proveSinceTx uint64, compactProofs bool) (*schema.VerifiableEntries, error) {
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	vEntries := &schema.VerifiableEntries{
+		Entries: entries,
+	}
+
+	txIDs := make([]uint64, len(entries))
+	vKeys := make([][]byte, len(entries))
+
+	for i, e := range entries {
+		txIDs[i] = e.Tx
+		vKeys[i] = EncodeKey(e.Key)
+
+		if e.ReferencedBy != nil {
+			txIDs[i] = e.ReferencedBy.Tx
+			vKeys[i] = EncodeKey(e.ReferencedBy.Key)
+		}
+	}
+
+	if compactProofs {
+		vEntries.InclusionLeaves, vEntries.MultiInclusionProofs, err = d.multiInclusionProofs(entries, txIDs, vKeys, tx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		vEntries.InclusionProofs = make([]*schema.InclusionProof, len(entries))
+
+		for i := range entries {
+			vEntries.InclusionProofs[i], err = d.inclusionProofAt(txIDs[i], vKeys[i], tx)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	vEntries.TxLinkProofs, vEntries.DualProof, err = d.txLinkProofs(txIDs, proveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return vEntries, nil
+}
+
+// multiInclusionProofs returns the position of every entry within its transaction and a single inclusion proof
+// per transaction, in the order they first appear among the non-expired entries. Expired entries can not be
+// proven as their values are no longer available, thus they are not included in the proofs.
+func (d *db) multiInclusionProofs(entries []*schema.Entry, txIDs []uint64, vKeys [][]byte, tx *store.Tx) ([]int32, []*schema.MultiInclusionProof, error) {
+	leaves := make([]int32, len(entries))
+
+	var provenTxIDs []uint64
+	provenLeaves := make(map[uint64][]int)
+
+	for i, e := range entries {
+		if tx.Header().ID != txIDs[i] {
+			err := d.st.ReadTx(txIDs[i], false, tx)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		leaf, err := tx.IndexOf(vKeys[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		leaves[i] = int32(leaf)
+
+		if e.Expired {
+			continue
+		}
+
+		if _, ok := provenLeaves[txIDs[i]]; !ok {
+			provenTxIDs = append(provenTxIDs, txIDs[i])
+		}
+
+		provenLeaves[txIDs[i]] = append(provenLeaves[txIDs[i]], leaf)
+	}
+
+	proofs := make([]*schema.MultiInclusionProof, len(provenTxIDs))
+
+	for i, txID := range provenTxIDs {
+		if tx.Header().ID != txID {
+			err := d.st.ReadTx(txID, false, tx)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		txLeaves := provenLeaves[txID]
+		sort.Ints(txLeaves)
+
+		proof, err := tx.MultiProof(txLeaves)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		proofs[i] = schema.MultiInclusionProofToProto(proof)
+	}
+
+	return leaves, proofs, nil
+}
+
+// inclusionProofAt builds the inclusion proof of the key within the transaction,
+// the transaction is only read if the holder does not contain it already
+func (d *db) inclusionProofAt(txID uint64, key []byte, tx *store.Tx) (*schema.InclusionProof, error) {
+	if tx.Header().ID != txID {
+		err := d.st.ReadTx(txID, false, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inclusionProof, err := tx.Proof(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.InclusionProofToProto(inclusionProof), nil
+}
+
+// txLinkProofs links every transaction into a single target transaction, being the most recent one among them
+// and the one the proof is requested since. The dual proof proves the consistency between both.
+// Nothing needs to be proven when there are no transactions to link and there is no previous state.
+func (d *db) txLinkProofs(txIDs []uint64, proveSinceTx uint64) ([]*schema.TxLinkProof, *schema.DualProof, error) {
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < proveSinceTx {
+		return nil, nil, ErrIllegalState
+	}
+
+	targetTxID := proveSinceTx
+
+	for _, txID := range txIDs {
+		if txID > targetTxID {
+			targetTxID = txID
+		}
+	}
+
+	if targetTxID == 0 {
+		return nil, nil, nil
+	}
+
+	targetTxHdr, err := d.st.ReadTxHeader(targetTxID, false, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sourceTxHdr := targetTxHdr
+
+	if proveSinceTx > 0 {
+		sourceTxHdr, err = d.st.ReadTxHeader(proveSinceTx, false, false)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	dualProof, err := d.st.DualProof(sourceTxHdr, targetTxHdr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var txLinkProofs []*schema.TxLinkProof
+
+	linked := make(map[uint64]struct{}, len(txIDs))
+
+	for _, txID := range txIDs {
+		if _, ok := linked[txID]; ok {
+			continue
+		}
+
+		txHdr, err := d.st.ReadTxHeader(txID, false, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		txLinkProof, err := d.st.TxLinkProof(txHdr, targetTxHdr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		txLinkProofs = append(txLinkProofs, schema.TxLinkProofToProto(txLinkProof))
+		linked[txID] = struct{}{}
+	}
+
+	return txLinkProofs, schema.DualProofToProto(dualProof), nil
 }
 
 func (d *db) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error) {
@@ -927,7 +1290,7 @@ func (d *db) TxByID(ctx context.Context, req *schema.TxRequest) (*schema.Tx, err
 		return nil, err
 	}
 
-	return d.serializeTx(tx, req.EntriesSpec, snap, true)
+	return d.serializeTx(ctx, tx, req.EntriesSpec, snap, true)
 }
 
 func (d *db) snapshotSince(ctx context.Context, txID uint64) (*store.Snapshot, error) {
@@ -945,7 +1308,15 @@ func (d *db) snapshotSince(ctx context.Context, txID uint64) (*store.Snapshot, e
 	return d.st.SnapshotMustIncludeTxID(ctx, waitUntilTx)
 }
 
-func (d *db) serializeTx(tx *store.Tx, spec *schema.EntriesSpec, snap *store.Snapshot, skipIntegrityCheck bool) (*schema.Tx, error) {
+func (d *db) serializeTx(ctx context.Context, tx *store.Tx, spec *schema.EntriesSpec, snap *store.Snapshot, skipIntegrityCheck bool) (*schema.Tx, error) {
+	// keys of sql entries encode the primary key values of the rows, both digests and values are guarded
+	if spec == nil || (spec.SqlEntriesSpec != nil && spec.SqlEntriesSpec.Action != schema.EntryTypeAction_EXCLUDE) {
+		err := d.checkSQLEntriesAccess(ctx, tx.Entries())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if spec == nil {
 		return schema.TxToProto(tx), nil
 	}
@@ -1386,17 +1757,50 @@ func (d *db) VerifiableTxByID(ctx context.Context, req *schema.VerifiableTxReque
 		return nil, err
 	}
 
-	sReqTx, err := d.serializeTx(reqTx, req.EntriesSpec, snap, true)
+	sReqTx, err := d.serializeTx(ctx, reqTx, req.EntriesSpec, snap, true)
+	if err != nil {
+		return nil, err
+	}
+
+	entriesInclusionProof, err := entriesInclusionProof(reqTx, sReqTx.Entries)
 	if err != nil {
 		return nil, err
 	}
 
 	return &schema.VerifiableTx{
-		Tx:        sReqTx,
-		DualProof: schema.DualProofToProto(dualProof),
+		Tx:                    sReqTx,
+		DualProof:             schema.DualProofToProto(dualProof),
+		EntriesInclusionProof: entriesInclusionProof,
 	}, nil
 }
 
+// entriesInclusionProof builds a single inclusion proof for the raw entries serialized out of the transaction,
+// entries are serialized in the same order they were written in
+func entriesInclusionProof(tx *store.Tx, entries []*schema.TxEntry) (*schema.MultiInclusionProof, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	leaves := make([]int, 0, len(entries))
+
+	for i, e := range tx.Entries() {
+		if len(leaves) < len(entries) && bytes.Equal(e.Key(), entries[len(leaves)].Key) {
+			leaves = append(leaves, i)
+		}
+	}
+
+	if len(leaves) < len(entries) {
+		return nil, ErrIllegalState
+	}
+
+	proof, err := tx.MultiProof(leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.MultiInclusionProofToProto(proof), nil
+}
+
 // TxScan ...
 func (d *db) TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error) {
 	if req == nil {
@@ -1442,7 +1846,7 @@ func (d *db) TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxL
 			return nil, err
 		}
 
-		sTx, err := d.serializeTx(tx, req.EntriesSpec, snap, true)
+		sTx, err := d.serializeTx(ctx, tx, req.EntriesSpec, snap, true)
 		if err != nil {
 			return nil, err
 		}
@@ -1549,6 +1953,78 @@ func (d *db) History(ctx context.Context, req *schema.HistoryRequest) (*schema.E
 	return list, nil
 }
 
+// VerifiableHistory returns the history of a key together with the proofs to verify every entry
+func (d *db) VerifiableHistory(ctx context.Context, req *schema.VerifiableHistoryRequest) (*schema.VerifiableEntries, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if !req.ProveComplete {
+		entries, err := d.History(ctx, req.HistoryRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		return d.verifiableEntries(entries.Entries, req.ProveSinceTx, false)
+	}
+
+	if req.HistoryRequest == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < req.ProveSinceTx {
+		return nil, ErrIllegalState
+	}
+
+	// the latest revision is proven before reading the history so every revision up to it is already indexed
+	indexTxHdr, indexProof, err := d.st.IndexProof(EncodeKey(req.HistoryRequest.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	// the history of a key never written is proven to be empty
+	entries, err := d.History(ctx, req.HistoryRequest)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		entries, err = &schema.Entries{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// revisions written after the proven one are left out
+	provenEntries := make([]*schema.Entry, 0, len(entries.Entries))
+
+	for _, e := range entries.Entries {
+		if e.Tx <= indexTxHdr.ID {
+			provenEntries = append(provenEntries, e)
+		}
+	}
+
+	vEntries, err := d.verifiableEntries(provenEntries, req.ProveSinceTx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceTxHdr := indexTxHdr
+
+	if vEntries.DualProof != nil {
+		sourceTxHdr, err = d.st.ReadTxHeader(vEntries.DualProof.TargetTxHeader.Id, false, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	indexDualProof, err := d.st.DualProof(sourceTxHdr, indexTxHdr)
+	if err != nil {
+		return nil, err
+	}
+
+	vEntries.IndexProof = schema.IndexProofToProto(indexProof, indexDualProof)
+
+	return vEntries, nil
+}
+
 func (d *db) IsClosed() bool {
 	d.closingMutex.Lock()
 	defer d.closingMutex.Unlock()
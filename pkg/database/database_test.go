@@ -58,7 +58,7 @@ var kvs = []*schema.KeyValue{
 func makeDb(t *testing.T) *db {
 	rootPath := t.TempDir()
 
-	options := DefaultOption().WithDBRootPath(rootPath).WithCorruptionChecker(false)
+	options := DefaultOption().WithDBRootPath(rootPath)
 	options.storeOpts.WithIndexOptions(options.storeOpts.IndexOpts.WithCompactionThld(2))
 
 	return makeDbWith(t, "db", options)
@@ -93,6 +93,30 @@ func (h *dummyMultidbHandler) UseDatabase(ctx context.Context, db string) error
 	return sql.ErrNoSupported
 }
 
+func (h *dummyMultidbHandler) CreateRole(ctx context.Context, role string, ifNotExists bool) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) DropRole(ctx context.Context, role string) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) GrantPrivileges(ctx context.Context, role string, privileges []string, object *sql.PrivilegeObject) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) RevokePrivileges(ctx context.Context, role string, privileges []string, object *sql.PrivilegeObject) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) GrantRole(ctx context.Context, role string, username string) error {
+	return sql.ErrNoSupported
+}
+
+func (h *dummyMultidbHandler) RevokeRole(ctx context.Context, role string, username string) error {
+	return sql.ErrNoSupported
+}
+
 func (h *dummyMultidbHandler) ExecPreparedStmts(
 	ctx context.Context,
 	opts *sql.TxOptions,
@@ -847,6 +871,19 @@ func TestVerifiableTxByID(t *testing.T) {
 
 		hval := sha256.Sum256(vtx.Tx.Entries[0].Value)
 		require.Equal(t, vtx.Tx.Entries[0].HValue, hval[:])
+
+		require.NotNil(t, vtx.EntriesInclusionProof)
+		require.Equal(t, []int32{0}, vtx.EntriesInclusionProof.Leaves)
+	})
+
+	t.Run("no inclusion proof should be returned without entries", func(t *testing.T) {
+		vtx, err := db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+			Tx:          txhdr.Id,
+			EntriesSpec: &schema.EntriesSpec{},
+		})
+		require.NoError(t, err)
+		require.Empty(t, vtx.Tx.Entries)
+		require.Nil(t, vtx.EntriesInclusionProof)
 	})
 
 	t.Run("values should not be returned", func(t *testing.T) {
@@ -1029,6 +1066,299 @@ func TestHistory(t *testing.T) {
 	require.Empty(t, inc.Entries)
 }
 
+func TestBulkLoad(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.BulkLoad(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.BulkLoad(context.Background(), &schema.BulkLoadRequest{})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.BulkLoad(context.Background(), &schema.BulkLoadRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}, {Value: []byte("value2")}},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.BulkLoad(context.Background(), &schema.BulkLoadRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key2"), Value: []byte("value2")}, {Key: []byte("key1"), Value: []byte("value1")}},
+	})
+	require.ErrorIs(t, err, store.ErrBulkLoadKeysNotSorted)
+
+	kvs := make([]*schema.KeyValue, 100)
+	for i := range kvs {
+		kvs[i] = &schema.KeyValue{
+			Key:   []byte(fmt.Sprintf("bulk%03d", i)),
+			Value: []byte(fmt.Sprintf("value%d", i)),
+		}
+	}
+	kvs[0].Metadata = &schema.KVMetadata{NonIndexable: true}
+
+	res, err := db.BulkLoad(context.Background(), &schema.BulkLoadRequest{KVs: kvs})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(kvs)), res.Entries)
+	require.LessOrEqual(t, res.FirstTxId, res.LastTxId)
+
+	_, err = db.Get(context.Background(), &schema.KeyRequest{Key: kvs[0].Key})
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+	for _, kv := range kvs[1:] {
+		entry, err := db.Get(context.Background(), &schema.KeyRequest{Key: kv.Key})
+		require.NoError(t, err)
+		require.Equal(t, kv.Value, entry.Value)
+		require.GreaterOrEqual(t, entry.Tx, res.FirstTxId)
+		require.LessOrEqual(t, entry.Tx, res.LastTxId)
+	}
+
+	vtx, err := db.VerifiableTxByID(context.Background(), &schema.VerifiableTxRequest{
+		Tx:           res.LastTxId,
+		ProveSinceTx: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.LastTxId, vtx.Tx.Header.Id)
+}
+
+func TestCheckTxIntegrity(t *testing.T) {
+	db := makeDb(t)
+
+	alh := sha256.Sum256(nil)
+
+	for i := 0; i < 3; i++ {
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte(fmt.Sprintf("value%d", i))},
+		}})
+		require.NoError(t, err)
+	}
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+
+	for txID := uint64(1); txID <= state.TxId; txID++ {
+		integrity, err := db.CheckTxIntegrity(txID, alh)
+		require.NoError(t, err)
+		require.Zero(t, integrity.TruncatedValues)
+
+		alh = integrity.Alh
+	}
+
+	require.Equal(t, state.TxHash, alh[:])
+
+	_, err = db.CheckTxIntegrity(state.TxId, alh)
+	require.ErrorIs(t, err, store.ErrCorruptedCLog)
+}
+
+func TestVerifiableGetLatest(t *testing.T) {
+	rootPath := t.TempDir()
+
+	options := DefaultOption().WithDBRootPath(rootPath)
+	options.storeOpts.WithAuthenticatedIndex(true)
+
+	db := makeDbWith(t, "db", options)
+
+	_, err := db.VerifiableGet(context.Background(), &schema.VerifiableGetRequest{
+		KeyRequest:  &schema.KeyRequest{Key: []byte("key1")},
+		ProveLatest: true,
+	})
+	require.ErrorIs(t, err, store.ErrTxNotFound)
+
+	for i := 0; i < 3; i++ {
+		_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte(fmt.Sprintf("value%d", i))},
+		}})
+		require.NoError(t, err)
+
+		_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("key2"), Value: []byte(fmt.Sprintf("value%d", i))},
+		}})
+		require.NoError(t, err)
+	}
+
+	t.Run("the latest value should be proven against the last committed transaction", func(t *testing.T) {
+		vEntry, err := db.VerifiableGet(context.Background(), &schema.VerifiableGetRequest{
+			KeyRequest:   &schema.KeyRequest{Key: []byte("key1")},
+			ProveSinceTx: 1,
+			ProveLatest:  true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), vEntry.Entry.Value)
+		require.EqualValues(t, 5, vEntry.Entry.Tx)
+		require.NotNil(t, vEntry.IndexProof)
+
+		dualProof := schema.DualProofFromProto(vEntry.IndexProof.DualProof)
+		require.EqualValues(t, 5, dualProof.SourceTxHeader.ID)
+		require.EqualValues(t, 6, dualProof.TargetTxHeader.ID)
+
+		root, err := dualProof.TargetTxHeader.Metadata.GetIndexRoot()
+		require.NoError(t, err)
+
+		indexProof := schema.IndexProofFromProto(vEntry.IndexProof)
+		require.True(t, store.VerifyIndexProof(indexProof, EncodeKey([]byte("key1")), 5, root))
+		require.False(t, store.VerifyIndexProof(indexProof, EncodeKey([]byte("key1")), 3, root))
+	})
+
+	t.Run("only the current value should be proven to be the latest one", func(t *testing.T) {
+		_, err := db.VerifiableGet(context.Background(), &schema.VerifiableGetRequest{
+			KeyRequest:  &schema.KeyRequest{Key: []byte("key1"), AtTx: 1},
+			ProveLatest: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.VerifiableGet(context.Background(), &schema.VerifiableGetRequest{
+			KeyRequest:  &schema.KeyRequest{Key: []byte("key3")},
+			ProveLatest: true,
+		})
+		require.ErrorIs(t, err, store.ErrKeyNotFound)
+	})
+
+	t.Run("the absence of a key should be proven", func(t *testing.T) {
+		_, err := db.VerifiableGetAbsent(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.VerifiableGetAbsent(context.Background(), &schema.VerifiableGetAbsentRequest{
+			Key:          []byte("key3"),
+			ProveSinceTx: 7,
+		})
+		require.ErrorIs(t, err, ErrIllegalState)
+
+		_, err = db.VerifiableGetAbsent(context.Background(), &schema.VerifiableGetAbsentRequest{
+			Key: []byte("key1"),
+		})
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+
+		proof, err := db.VerifiableGetAbsent(context.Background(), &schema.VerifiableGetAbsentRequest{
+			Key:          []byte("key3"),
+			ProveSinceTx: 2,
+		})
+		require.NoError(t, err)
+
+		dualProof := schema.DualProofFromProto(proof.DualProof)
+		require.EqualValues(t, 2, dualProof.SourceTxHeader.ID)
+		require.EqualValues(t, 6, dualProof.TargetTxHeader.ID)
+
+		root, err := dualProof.TargetTxHeader.Metadata.GetIndexRoot()
+		require.NoError(t, err)
+
+		require.True(t, store.VerifyIndexProof(schema.IndexProofFromProto(proof), EncodeKey([]byte("key3")), 0, root))
+	})
+}
+
+func TestVerifiableHistory(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.VerifiableHistory(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	for i := 0; i < 3; i++ {
+		_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte(fmt.Sprintf("value%d", i))},
+		}})
+		require.NoError(t, err)
+	}
+
+	_, err = db.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key1")}})
+	require.NoError(t, err)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("key2"), Value: []byte("value")},
+	}})
+	require.NoError(t, err)
+
+	vEntries, err := db.VerifiableHistory(context.Background(), &schema.VerifiableHistoryRequest{
+		HistoryRequest: &schema.HistoryRequest{Key: []byte("key1")},
+		ProveSinceTx:   5,
+	})
+	require.NoError(t, err)
+	require.Len(t, vEntries.Entries, 4)
+	require.Len(t, vEntries.InclusionProofs, 4)
+	require.Len(t, vEntries.TxLinkProofs, 4)
+
+	dualProof := schema.DualProofFromProto(vEntries.DualProof)
+	require.EqualValues(t, 5, dualProof.SourceTxHeader.ID)
+	require.EqualValues(t, 5, dualProof.TargetTxHeader.ID)
+
+	for i, p := range vEntries.TxLinkProofs {
+		txLinkProof := schema.TxLinkProofFromProto(p)
+		require.True(t, store.VerifyTxLinkProof(txLinkProof, dualProof.TargetTxHeader))
+		require.Equal(t, vEntries.Entries[i].Tx, txLinkProof.TxHeader.ID)
+	}
+}
+
+func TestVerifiableCompleteHistory(t *testing.T) {
+	rootPath := t.TempDir()
+
+	options := DefaultOption().WithDBRootPath(rootPath)
+	options.storeOpts.WithAuthenticatedIndex(true)
+
+	db := makeDbWith(t, "db", options)
+
+	for i := 0; i < 3; i++ {
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte(fmt.Sprintf("value%d", i))},
+		}})
+		require.NoError(t, err)
+	}
+
+	_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("key2"), Value: []byte("value")},
+	}})
+	require.NoError(t, err)
+
+	t.Run("revisions should be assigned by the store", func(t *testing.T) {
+		_, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte("key1"), Value: []byte("value"), Metadata: &schema.KVMetadata{Revision: 7}},
+		}})
+		require.ErrorIs(t, err, store.ErrIllegalArguments)
+	})
+
+	t.Run("the latest revision should be proven together with the history", func(t *testing.T) {
+		_, err := db.VerifiableHistory(context.Background(), &schema.VerifiableHistoryRequest{
+			ProveComplete: true,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		vEntries, err := db.VerifiableHistory(context.Background(), &schema.VerifiableHistoryRequest{
+			HistoryRequest: &schema.HistoryRequest{Key: []byte("key1"), Offset: 1},
+			ProveSinceTx:   1,
+			ProveComplete:  true,
+		})
+		require.NoError(t, err)
+		require.Len(t, vEntries.Entries, 2)
+
+		for i, e := range vEntries.Entries {
+			require.EqualValues(t, i+2, e.Revision)
+			require.EqualValues(t, i+2, e.Metadata.Revision)
+		}
+
+		require.NotNil(t, vEntries.IndexProof)
+		require.EqualValues(t, 3, vEntries.IndexProof.Leaf.Revision)
+		require.EqualValues(t, 3, vEntries.IndexProof.Leaf.TxId)
+
+		dualProof := schema.DualProofFromProto(vEntries.IndexProof.DualProof)
+		require.EqualValues(t, 3, dualProof.SourceTxHeader.ID)
+		require.EqualValues(t, 4, dualProof.TargetTxHeader.ID)
+
+		root, err := dualProof.TargetTxHeader.Metadata.GetIndexRoot()
+		require.NoError(t, err)
+
+		indexProof := schema.IndexProofFromProto(vEntries.IndexProof)
+		require.True(t, store.VerifyIndexProof(indexProof, EncodeKey([]byte("key1")), 3, root))
+	})
+
+	t.Run("the absence of any revision should be proven", func(t *testing.T) {
+		vEntries, err := db.VerifiableHistory(context.Background(), &schema.VerifiableHistoryRequest{
+			HistoryRequest: &schema.HistoryRequest{Key: []byte("key3")},
+			ProveComplete:  true,
+		})
+		require.NoError(t, err)
+		require.Empty(t, vEntries.Entries)
+		require.Nil(t, vEntries.DualProof)
+
+		dualProof := schema.DualProofFromProto(vEntries.IndexProof.DualProof)
+		require.EqualValues(t, 4, dualProof.SourceTxHeader.ID)
+		require.EqualValues(t, 4, dualProof.TargetTxHeader.ID)
+	})
+}
+
 func TestPreconditionedSet(t *testing.T) {
 	db := makeDb(t)
 
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/htree"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// DigestLeaf returns the digest of an entry as included into a content digest,
+// key is relative to the digested prefix or table and hVal is the digest of the stored value
+func DigestLeaf(key []byte, hVal [sha256.Size]byte) [sha256.Size]byte {
+	b := make([]byte, len(key)+sha256.Size)
+	copy(b, key)
+	copy(b[len(key):], hVal[:])
+	return sha256.Sum256(b)
+}
+
+// VerifyDigestInclusion checks the entry described by the proof is included into the content digest
+func VerifyDigestInclusion(proof *schema.DigestInclusionProof, digest []byte) bool {
+	if proof == nil || proof.InclusionProof == nil || len(proof.ValueDigest) != sha256.Size || len(digest) != sha256.Size {
+		return false
+	}
+
+	var hVal, root [sha256.Size]byte
+	copy(hVal[:], proof.ValueDigest)
+	copy(root[:], digest)
+
+	return htree.VerifyInclusion(schema.InclusionProofFromProto(proof.InclusionProof), DigestLeaf(proof.Key, hVal), root)
+}
+
+// PrefixDigest computes a Merkle root over the key-value entries whose keys share the given prefix
+// as of the requested transaction. Entries are ordered by key, deleted entries are left out and
+// expiration is not taken into account, so the digest only depends on the indexed content.
+func (d *db) PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	var seekKey, endKey []byte
+
+	if len(req.SeekKey) > 0 {
+		seekKey = EncodeKey(req.SeekKey)
+	}
+
+	if len(req.EndKey) > 0 {
+		endKey = EncodeKey(req.EndKey)
+	}
+
+	return d.contentDigest(ctx, EncodeKey(req.Prefix), 1, req.AtTx, seekKey, endKey, req.ProveKeys)
+}
+
+// TableDigest computes a Merkle root over the rows of a table as of the requested transaction.
+// Rows are ordered by primary key and identified by their encoded primary key, so the digest
+// doesn't depend on the internal identifiers assigned to the table.
+func (d *db) TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	sqlTx, err := d.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, err
+	}
+	defer sqlTx.Cancel()
+
+	table, err := sqlTx.Catalog().GetTableByName(req.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	// row keys encode the primary key values of every row, including the ones hidden by policies
+	err = checkRowLevelSecurityBypass(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	pkPrefix := sql.MapKey(
+		[]byte{SQLPrefix},
+		sql.PIndexPrefix,
+		sql.EncodeID(1),
+		sql.EncodeID(table.ID()),
+		sql.EncodeID(sql.PKIndexID),
+	)
+
+	var seekKey, endKey []byte
+
+	if len(req.SeekKey) > 0 {
+		seekKey = append(append([]byte{}, pkPrefix...), req.SeekKey...)
+	}
+
+	if len(req.EndKey) > 0 {
+		endKey = append(append([]byte{}, pkPrefix...), req.EndKey...)
+	}
+
+	proveKeys := make([][]byte, len(req.ProveRows))
+
+	for i, row := range req.ProveRows {
+		if row == nil || len(row.Values) != len(table.PrimaryIndex().Cols()) {
+			return nil, fmt.Errorf(
+				"%w: incorrect number of primary key values, expected %d",
+				ErrIllegalArguments,
+				len(table.PrimaryIndex().Cols()),
+			)
+		}
+
+		var pk bytes.Buffer
+
+		for j, pkCol := range table.PrimaryIndex().Cols() {
+			pkEncVal, err := sql.EncodeRawValueAsKey(schema.RawValue(row.Values[j]), pkCol.Type(), pkCol.MaxLen())
+			if err != nil {
+				return nil, err
+			}
+
+			pk.Write(pkEncVal)
+		}
+
+		proveKeys[i] = pk.Bytes()
+	}
+
+	return d.contentDigest(ctx, pkPrefix, len(pkPrefix), req.AtTx, seekKey, endKey, proveKeys)
+}
+
+// contentDigest digests the entries under prefix as of atTx, keys are included into the digest
+// without their first skipLen bytes
+func (d *db) contentDigest(ctx context.Context, prefix []byte, skipLen int, atTx uint64, seekKey, endKey []byte, proveKeys [][]byte) (*schema.ContentDigest, error) {
+	currTxID, _ := d.st.CommittedAlh()
+
+	if atTx > currTxID {
+		return nil, ErrIllegalArguments
+	}
+
+	if atTx == 0 {
+		atTx = currTxID
+	}
+
+	snap, err := d.snapshotSince(ctx, atTx)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Close()
+
+	if len(seekKey) == 0 {
+		seekKey = prefix
+	}
+
+	r, err := snap.NewKeyReader(
+		store.KeyReaderSpec{
+			SeekKey:       seekKey,
+			EndKey:        endKey,
+			Prefix:        prefix,
+			InclusiveSeek: true,
+			InclusiveEnd:  true,
+			Filters:       []store.FilterFn{store.IgnoreDeleted},
+		})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var keys [][]byte
+	var hVals, digests [][sha256.Size]byte
+
+	for {
+		key, valRef, err := r.ReadBetween(0, atTx)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		hVal := valRef.HVal()
+
+		keys = append(keys, key[skipLen:])
+		hVals = append(hVals, hVal)
+		digests = append(digests, DigestLeaf(key[skipLen:], hVal))
+	}
+
+	tree, err := htree.New(len(digests))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tree.BuildWith(digests)
+	if err != nil {
+		return nil, err
+	}
+
+	root := tree.Root()
+
+	res := &schema.ContentDigest{
+		AtTx:   atTx,
+		Count:  uint64(len(digests)),
+		Digest: root[:],
+	}
+
+	if len(keys) > 0 {
+		res.MiddleKey = keys[len(keys)/2]
+	}
+
+	for _, key := range proveKeys {
+		i := sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], key) >= 0 })
+		if i == len(keys) || !bytes.Equal(keys[i], key) {
+			return nil, fmt.Errorf("%w: key '%s' is not included into the digest", store.ErrKeyNotFound, key)
+		}
+
+		proof, err := tree.InclusionProof(i)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Proofs = append(res.Proofs, &schema.DigestInclusionProof{
+			Key:            keys[i],
+			ValueDigest:    hVals[i][:],
+			InclusionProof: schema.InclusionProofToProto(proof),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixDigest(t *testing.T) {
+	db1 := makeDb(t)
+	db2 := makeDb(t)
+
+	_, err := db1.PrefixDigest(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	empty, err := db1.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k")})
+	require.NoError(t, err)
+	require.Zero(t, empty.Count)
+	require.Empty(t, empty.MiddleKey)
+
+	_, err = db1.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), AtTx: 1})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	// same content written in a different order and in a different number of transactions
+	for i := 0; i < 10; i++ {
+		_, err = db1.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte(fmt.Sprintf("k%d", i)), Value: []byte(fmt.Sprintf("v%d", i))},
+		}})
+		require.NoError(t, err)
+	}
+
+	kvs := make([]*schema.KeyValue, 10)
+	for i := 0; i < 10; i++ {
+		kvs[i] = &schema.KeyValue{Key: []byte(fmt.Sprintf("k%d", 9-i)), Value: []byte(fmt.Sprintf("v%d", 9-i))}
+	}
+
+	_, err = db2.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("other"), Value: []byte("value")}}})
+	require.NoError(t, err)
+
+	_, err = db2.Set(context.Background(), &schema.SetRequest{KVs: kvs})
+	require.NoError(t, err)
+
+	d1, err := db1.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), ProveKeys: [][]byte{[]byte("k3")}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), d1.Count)
+	require.Equal(t, uint64(10), d1.AtTx)
+	require.Equal(t, []byte("k5"), d1.MiddleKey)
+	require.Len(t, d1.Proofs, 1)
+	require.Equal(t, []byte("k3"), d1.Proofs[0].Key)
+	require.True(t, VerifyDigestInclusion(d1.Proofs[0], d1.Digest))
+	require.False(t, VerifyDigestInclusion(d1.Proofs[0], empty.Digest))
+
+	d2, err := db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k")})
+	require.NoError(t, err)
+	require.Equal(t, d1.Digest, d2.Digest)
+	require.Equal(t, d1.MiddleKey, d2.MiddleKey)
+
+	_, err = db1.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), ProveKeys: [][]byte{[]byte("other")}})
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+	// updating and deleting entries changes the digest
+	_, err = db2.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k7"), Value: []byte("changed")}}})
+	require.NoError(t, err)
+
+	d2, err = db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k")})
+	require.NoError(t, err)
+	require.NotEqual(t, d1.Digest, d2.Digest)
+
+	// bisecting the range locates the difference
+	lower1, err := db1.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), EndKey: d1.MiddleKey})
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), lower1.Count)
+
+	lower2, err := db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), EndKey: d1.MiddleKey})
+	require.NoError(t, err)
+	require.Equal(t, lower1.Digest, lower2.Digest)
+
+	upper1, err := db1.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), SeekKey: []byte("k6")})
+	require.NoError(t, err)
+
+	upper2, err := db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), SeekKey: []byte("k6")})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), upper2.Count)
+	require.NotEqual(t, upper1.Digest, upper2.Digest)
+
+	_, err = db2.Delete(context.Background(), &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("k7")}})
+	require.NoError(t, err)
+
+	d2, err = db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k")})
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), d2.Count)
+
+	_, err = db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), ProveKeys: [][]byte{[]byte("k7")}})
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+	// digests of past states are not affected by later changes
+	d2, err = db2.PrefixDigest(context.Background(), &schema.PrefixDigestRequest{Prefix: []byte("k"), AtTx: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), d2.AtTx)
+	require.Equal(t, d1.Digest, d2.Digest)
+}
+
+func TestTableDigest(t *testing.T) {
+	db1 := makeDb(t)
+	db2 := makeDb(t)
+
+	_, err := db1.TableDigest(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	// the table gets a different identifier in each database
+	_, _, err = db2.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "CREATE TABLE other(id INTEGER, PRIMARY KEY id)"})
+	require.NoError(t, err)
+
+	for _, db := range []*db{db1, db2} {
+		_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "CREATE TABLE mytable(id INTEGER, title VARCHAR, PRIMARY KEY id)"})
+		require.NoError(t, err)
+	}
+
+	_, err = db1.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "unknown"})
+	require.Error(t, err)
+
+	for i := 1; i <= 5; i++ {
+		_, _, err = db1.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: fmt.Sprintf("INSERT INTO mytable(id, title) VALUES (%d, 'title%d')", i, i)})
+		require.NoError(t, err)
+	}
+
+	_, _, err = db2.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "INSERT INTO mytable(id, title) VALUES (5, 'title5'), (4, 'title4'), (3, 'title3'), (2, 'title2'), (1, 'title1')"})
+	require.NoError(t, err)
+
+	row := &schema.PrimaryKey{Values: []*schema.SQLValue{{Value: &schema.SQLValue_N{N: 3}}}}
+
+	d1, err := db1.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable", ProveRows: []*schema.PrimaryKey{row}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), d1.Count)
+	require.Len(t, d1.Proofs, 1)
+	require.True(t, VerifyDigestInclusion(d1.Proofs[0], d1.Digest))
+
+	d2, err := db2.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable"})
+	require.NoError(t, err)
+	require.Equal(t, d1.Digest, d2.Digest)
+	require.Equal(t, d1.MiddleKey, d2.MiddleKey)
+
+	_, err = db1.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable", ProveRows: []*schema.PrimaryKey{{}}})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, _, err = db2.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: "DELETE FROM mytable WHERE id = 3"})
+	require.NoError(t, err)
+
+	d2, err = db2.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), d2.Count)
+	require.NotEqual(t, d1.Digest, d2.Digest)
+
+	_, err = db2.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable", ProveRows: []*schema.PrimaryKey{row}})
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+	lower1, err := db1.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable", EndKey: d1.Proofs[0].Key})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), lower1.Count)
+
+	upper1, err := db1.TableDigest(context.Background(), &schema.TableDigestRequest{Table: "mytable", SeekKey: d1.Proofs[0].Key})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), upper1.Count)
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package database
 
 import (
+	"crypto/sha256"
+
 	"github.com/codenotary/immudb/embedded/store"
 	"github.com/codenotary/immudb/pkg/api/schema"
 )
@@ -56,6 +58,24 @@ func PreconditionFromProto(c *schema.Precondition) (store.Precondition, error) {
 			Key:  EncodeKey(key),
 			TxID: c.KeyNotModifiedAfterTX.GetTxID(),
 		}, nil
+
+	case *schema.Precondition_ValueMustEqual:
+		key := c.ValueMustEqual.GetKey()
+		if len(key) == 0 {
+			return nil, store.ErrInvalidPreconditionNullKey
+		}
+
+		valueHash := c.ValueMustEqual.GetValueHash()
+		if len(valueHash) != sha256.Size {
+			return nil, store.ErrInvalidPreconditionInvalidValueHash
+		}
+
+		cs := &store.PreconditionValueMustEqual{
+			Key: EncodeKey(key),
+		}
+		copy(cs.ValueHash[:], valueHash)
+
+		return cs, nil
 	}
 
 	return nil, store.ErrInvalidPreconditionNull
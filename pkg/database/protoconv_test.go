@@ -17,6 +17,7 @@ limitations under the License.
 package database
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/store"
@@ -78,4 +79,24 @@ func TestPreconditionFromProto(t *testing.T) {
 		require.IsType(t, &store.PreconditionKeyNotModifiedAfterTx{}, c)
 	})
 
+	t.Run("ValueMustEqual", func(t *testing.T) {
+		valueHash := sha256.Sum256([]byte{1})
+
+		_, err := PreconditionFromProto(schema.PreconditionValueMustEqual(nil, valueHash[:]))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionNullKey)
+
+		_, err = PreconditionFromProto(schema.PreconditionValueMustEqual([]byte{1}, nil))
+		require.ErrorIs(t, err, store.ErrInvalidPrecondition)
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionInvalidValueHash)
+
+		_, err = PreconditionFromProto(schema.PreconditionValueMustEqual([]byte{1}, valueHash[1:]))
+		require.ErrorIs(t, err, store.ErrInvalidPreconditionInvalidValueHash)
+
+		c, err := PreconditionFromProto(schema.PreconditionValueMustEqual([]byte{1}, valueHash[:]))
+		require.NoError(t, err)
+		require.IsType(t, &store.PreconditionValueMustEqual{}, c)
+		require.Equal(t, valueHash, c.(*store.PreconditionValueMustEqual).ValueHash)
+	})
+
 }
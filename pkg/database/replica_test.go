@@ -59,6 +59,14 @@ func TestReadOnlyReplica(t *testing.T) {
 	)
 	require.ErrorIs(t, err, ErrIsReplica)
 
+	_, err = replica.BulkLoad(context.Background(), &schema.BulkLoadRequest{
+		KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}},
+	})
+	require.ErrorIs(t, err, ErrIsReplica)
+
+	err = replica.RebuildIndex(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIsReplica)
+
 	_, err = replica.SetReference(context.Background(), &schema.ReferenceRequest{
 		Key:           []byte("key"),
 		ReferencedKey: []byte("refkey"),
@@ -117,3 +117,17 @@ func (d *db) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries
 
 	return entries, nil
 }
+
+// VerifiableScan returns the entries of the scan together with the proofs to verify them
+func (d *db) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	entries, err := d.Scan(ctx, req.ScanRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.verifiableEntries(entries.Entries, req.ProveSinceTx, req.CompactProofs)
+}
@@ -18,6 +18,7 @@ package database
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"testing"
 
@@ -271,7 +272,7 @@ func TestStoreScanWithTruncation(t *testing.T) {
 
 	fileSize := 8
 
-	options := DefaultOption().WithDBRootPath(rootPath).WithCorruptionChecker(false)
+	options := DefaultOption().WithDBRootPath(rootPath)
 	options.storeOpts.WithIndexOptions(options.storeOpts.IndexOpts.WithCompactionThld(2)).WithFileSize(fileSize)
 	options.storeOpts.MaxIOConcurrency = 1
 	options.storeOpts.MaxConcurrency = 500
@@ -353,3 +354,123 @@ func TestStoreScanWithTruncation(t *testing.T) {
 		require.Equal(t, list.Entries[4].Key, []byte(`prefix:suffix5`))
 	})
 }
+
+func TestStoreVerifiableScan(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.VerifiableScan(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	vEntries, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+		ScanRequest: &schema.ScanRequest{Prefix: []byte("key")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, vEntries.Entries)
+	require.Nil(t, vEntries.DualProof)
+
+	for i := 0; i < 5; i++ {
+		_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+			{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte(fmt.Sprintf("value%d", i))},
+		}})
+		require.NoError(t, err)
+	}
+
+	_, err = db.SetReference(context.Background(), &schema.ReferenceRequest{
+		Key:           []byte("key5"),
+		ReferencedKey: []byte("key0"),
+	})
+	require.NoError(t, err)
+
+	_, err = db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+		ScanRequest:  &schema.ScanRequest{Prefix: []byte("key")},
+		ProveSinceTx: 7,
+	})
+	require.ErrorIs(t, err, ErrIllegalState)
+
+	for _, proveSinceTx := range []uint64{0, 2, 6} {
+		vEntries, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+			ScanRequest:  &schema.ScanRequest{Prefix: []byte("key")},
+			ProveSinceTx: proveSinceTx,
+		})
+		require.NoError(t, err)
+		require.Len(t, vEntries.Entries, 6)
+		require.Len(t, vEntries.InclusionProofs, 6)
+		require.Len(t, vEntries.TxLinkProofs, 6)
+
+		dualProof := schema.DualProofFromProto(vEntries.DualProof)
+		require.EqualValues(t, 6, dualProof.TargetTxHeader.ID)
+
+		if proveSinceTx > 0 {
+			require.Equal(t, proveSinceTx, dualProof.SourceTxHeader.ID)
+		}
+
+		for _, p := range vEntries.TxLinkProofs {
+			require.True(t, store.VerifyTxLinkProof(schema.TxLinkProofFromProto(p), dualProof.TargetTxHeader))
+		}
+	}
+
+	vEntries, err = db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+		ScanRequest: &schema.ScanRequest{Prefix: []byte("key5")},
+	})
+	require.NoError(t, err)
+	require.Len(t, vEntries.Entries, 1)
+	require.NotNil(t, vEntries.Entries[0].ReferencedBy)
+	require.Len(t, vEntries.TxLinkProofs, 1)
+	require.EqualValues(t, 6, vEntries.TxLinkProofs[0].TxHeader.Id)
+}
+
+func TestStoreVerifiableScanCompactProofs(t *testing.T) {
+	db := makeDb(t)
+
+	kvList := make([]*schema.KeyValue, 10)
+
+	for i := range kvList {
+		kvList[i] = &schema.KeyValue{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte(fmt.Sprintf("value%d", i))}
+	}
+
+	_, err := db.Set(context.Background(), &schema.SetRequest{KVs: kvList})
+	require.NoError(t, err)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("key3"), Value: []byte("value")},
+	}})
+	require.NoError(t, err)
+
+	vEntries, err := db.VerifiableScan(context.Background(), &schema.VerifiableScanRequest{
+		ScanRequest:   &schema.ScanRequest{Prefix: []byte("key")},
+		CompactProofs: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, vEntries.Entries, 10)
+	require.Empty(t, vEntries.InclusionProofs)
+	require.Len(t, vEntries.InclusionLeaves, 10)
+	require.Len(t, vEntries.MultiInclusionProofs, 2)
+
+	txHdrs := make(map[uint64]*store.TxHeader)
+
+	for _, p := range vEntries.TxLinkProofs {
+		txHdr := schema.TxHeaderFromProto(p.TxHeader)
+		txHdrs[txHdr.ID] = txHdr
+	}
+
+	digests := make(map[uint64][][sha256.Size]byte)
+
+	for i, e := range vEntries.Entries {
+		if e.Tx == 1 {
+			require.EqualValues(t, i, vEntries.InclusionLeaves[i])
+		}
+
+		entrySpecDigest, err := store.EntrySpecDigestFor(txHdrs[e.Tx].Version)
+		require.NoError(t, err)
+
+		digests[e.Tx] = append(digests[e.Tx], entrySpecDigest(EncodeEntrySpec(e.Key, schema.KVMetadataFromProto(e.Metadata), e.Value)))
+	}
+
+	require.Len(t, digests[1], 9)
+	require.Len(t, digests[2], 1)
+
+	for i, txID := range []uint64{1, 2} {
+		proof := schema.MultiInclusionProofFromProto(vEntries.MultiInclusionProofs[i])
+		require.True(t, store.VerifyMultiInclusion(proof, digests[txID], txHdrs[txID].Eh))
+	}
+}
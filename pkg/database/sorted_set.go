@@ -99,12 +99,18 @@ func (d *db) ZAdd(ctx context.Context, req *schema.ZAddRequest) (*schema.TxHeade
 
 // ZScan ...
 func (d *db) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, error) {
+	entries, _, err := d.zScan(ctx, req)
+	return entries, err
+}
+
+// zScan returns the entries of the sorted set together with the transactions the sorted set entries were written at
+func (d *db) zScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntries, []uint64, error) {
 	if req == nil || len(req.Set) == 0 {
-		return nil, store.ErrIllegalArguments
+		return nil, nil, store.ErrIllegalArguments
 	}
 
 	if req.Limit > uint64(d.maxResultSize) {
-		return nil, fmt.Errorf("%w: the specified limit (%d) is larger than the maximum allowed one (%d)",
+		return nil, nil, fmt.Errorf("%w: the specified limit (%d) is larger than the maximum allowed one (%d)",
 			ErrResultSizeLimitExceeded, req.Limit, d.maxResultSize)
 	}
 
@@ -120,7 +126,7 @@ func (d *db) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntr
 	currTxID, _ := d.st.CommittedAlh()
 
 	if req.SinceTx > currTxID {
-		return nil, ErrIllegalArguments
+		return nil, nil, ErrIllegalArguments
 	}
 
 	prefix := make([]byte, 1+setLenLen+len(req.Set))
@@ -160,7 +166,7 @@ func (d *db) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntr
 
 	snap, err := d.snapshotSince(ctx, req.SinceTx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer snap.Close()
 
@@ -174,19 +180,21 @@ func (d *db) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntr
 			Offset:        req.Offset,
 		})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer r.Close()
 
 	entries := &schema.ZEntries{}
 
+	var zAddTxIDs []uint64
+
 	for l := 1; l <= limit; l++ {
-		zKey, _, err := r.Read()
+		zKey, zValRef, err := r.Read()
 		if errors.Is(err, store.ErrNoMoreEntries) {
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// zKey = [1+setLenLen+len(req.Set)+scoreLen+keyLenLen+1+len(req.Key)+txIDLen]
@@ -218,7 +226,7 @@ func (d *db) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntr
 			continue
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		zentry := &schema.ZEntry{
@@ -230,15 +238,16 @@ func (d *db) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schema.ZEntr
 		}
 
 		entries.Entries = append(entries.Entries, zentry)
+		zAddTxIDs = append(zAddTxIDs, zValRef.Tx())
 
 		if l == d.maxResultSize {
-			return entries, fmt.Errorf("%w: found at least %d entries (the maximum limit). "+
+			return entries, zAddTxIDs, fmt.Errorf("%w: found at least %d entries (the maximum limit). "+
 				"Pagination over large results can be achieved by using the limit, seekKey, seekScore and seekAtTx arguments",
 				ErrResultSizeLimitReached, d.maxResultSize)
 		}
 	}
 
-	return entries, nil
+	return entries, zAddTxIDs, nil
 }
 
 // VerifiableZAdd ...
@@ -288,3 +297,62 @@ func (d *db) VerifiableZAdd(ctx context.Context, req *schema.VerifiableZAddReque
 		DualProof: schema.DualProofToProto(dualProof),
 	}, nil
 }
+
+// VerifiableZScan returns the entries of the sorted set together with the proofs to verify them,
+// both the sorted set entries and the entries they refer to are proven
+func (d *db) VerifiableZScan(ctx context.Context, req *schema.VerifiableZScanRequest) (*schema.VerifiableZEntries, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	zEntries, zAddTxIDs, err := d.zScan(ctx, req.ZScanRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	vEntries := &schema.VerifiableZEntries{
+		Entries:             zEntries.Entries,
+		ZAddTxIds:           zAddTxIDs,
+		ZAddInclusionProofs: make([]*schema.InclusionProof, len(zEntries.Entries)),
+		InclusionProofs:     make([]*schema.InclusionProof, len(zEntries.Entries)),
+	}
+
+	txIDs := make([]uint64, 0, 2*len(zEntries.Entries))
+
+	for i, ze := range zEntries.Entries {
+		zKey := WrapZAddReferenceAt(ze.Set, ze.Score, EncodeKey(ze.Key), ze.AtTx)
+
+		vEntries.ZAddInclusionProofs[i], err = d.inclusionProofAt(zAddTxIDs[i], zKey, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		vTxID := ze.Entry.Tx
+		vKey := ze.Entry.Key
+
+		if ze.Entry.ReferencedBy != nil {
+			vTxID = ze.Entry.ReferencedBy.Tx
+			vKey = ze.Entry.ReferencedBy.Key
+		}
+
+		vEntries.InclusionProofs[i], err = d.inclusionProofAt(vTxID, EncodeKey(vKey), tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txIDs = append(txIDs, zAddTxIDs[i], vTxID)
+	}
+
+	vEntries.TxLinkProofs, vEntries.DualProof, err = d.txLinkProofs(txIDs, req.ProveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return vEntries, nil
+}
@@ -709,3 +709,41 @@ func TestStoreVerifiableZAdd(t *testing.T) {
 	require.Equal(t, req.Key, itemList1.Entries[0].Entry.Key)
 	require.Equal(t, req.Score, itemList1.Entries[0].Score)
 }
+
+func TestStoreVerifiableZScan(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.VerifiableZScan(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("key1"), Value: []byte("value1")},
+		{Key: []byte("key2"), Value: []byte("value2")},
+	}})
+	require.NoError(t, err)
+
+	_, err = db.ZAdd(context.Background(), &schema.ZAddRequest{Set: []byte("set1"), Score: 1, Key: []byte("key1")})
+	require.NoError(t, err)
+
+	_, err = db.ZAdd(context.Background(), &schema.ZAddRequest{Set: []byte("set1"), Score: 2, Key: []byte("key2"), AtTx: 1, BoundRef: true})
+	require.NoError(t, err)
+
+	vEntries, err := db.VerifiableZScan(context.Background(), &schema.VerifiableZScanRequest{
+		ZScanRequest: &schema.ZScanRequest{Set: []byte("set1")},
+		ProveSinceTx: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, vEntries.Entries, 2)
+	require.Equal(t, []uint64{2, 3}, vEntries.ZAddTxIds)
+	require.Len(t, vEntries.ZAddInclusionProofs, 2)
+	require.Len(t, vEntries.InclusionProofs, 2)
+	require.Len(t, vEntries.TxLinkProofs, 3)
+
+	dualProof := schema.DualProofFromProto(vEntries.DualProof)
+	require.EqualValues(t, 1, dualProof.SourceTxHeader.ID)
+	require.EqualValues(t, 3, dualProof.TargetTxHeader.ID)
+
+	for _, p := range vEntries.TxLinkProofs {
+		require.True(t, store.VerifyTxLinkProof(schema.TxLinkProofFromProto(p), dualProof.TargetTxHeader))
+	}
+}
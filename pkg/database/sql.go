@@ -19,6 +19,7 @@ package database
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"strings"
 	"time"
@@ -52,6 +53,12 @@ func (d *db) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetR
 		return nil, err
 	}
 
+	// rows are fetched by primary key, bypassing row-level security policies
+	err = checkRowLevelSecurityBypass(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	valbuf := bytes.Buffer{}
 
 	if len(req.SqlGetRequest.PkValues) != len(table.PrimaryIndex().Cols()) {
@@ -137,23 +144,7 @@ func (d *db) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetR
 		DualProof: schema.DualProofToProto(dualProof),
 	}
 
-	colNamesByID := make(map[uint32]string, len(table.Cols()))
-	colIdsByName := make(map[string]uint32, len(table.ColsByName()))
-	colTypesByID := make(map[uint32]string, len(table.Cols()))
-	colLenByID := make(map[uint32]int32, len(table.Cols()))
-
-	for _, col := range table.Cols() {
-		colNamesByID[col.ID()] = col.Name()
-		colIdsByName[sql.EncodeSelector("", table.Name(), col.Name())] = col.ID()
-		colTypesByID[col.ID()] = col.Type()
-		colLenByID[col.ID()] = int32(col.MaxLen())
-	}
-
-	pkIDs := make([]uint32, len(table.PrimaryIndex().Cols()))
-
-	for i, col := range table.PrimaryIndex().Cols() {
-		pkIDs[i] = col.ID()
-	}
+	md := tableMetadataOf(table)
 
 	return &schema.VerifiableSQLEntry{
 		SqlEntry:       e,
@@ -161,14 +152,192 @@ func (d *db) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetR
 		InclusionProof: schema.InclusionProofToProto(inclusionProof),
 		DatabaseId:     1,
 		TableId:        table.ID(),
-		PKIDs:          pkIDs,
-		ColNamesById:   colNamesByID,
-		ColIdsByName:   colIdsByName,
-		ColTypesById:   colTypesByID,
-		ColLenById:     colLenByID,
+		PKIDs:          md.pkIDs,
+		ColNamesById:   md.colNamesByID,
+		ColIdsByName:   md.colIdsByName,
+		ColTypesById:   md.colTypesByID,
+		ColLenById:     md.colLenByID,
 	}, nil
 }
 
+// VerifiableSQLQuery resolves the query and returns, for every row, the raw entry holding it
+// together with the proofs to verify it. Rows must be selected from a single table and include
+// all the primary key columns so they can be mapped to the entries holding them.
+// Note the proofs verify every row was written into the table, not that the result set is complete.
+func (d *db) VerifiableSQLQuery(ctx context.Context, req *schema.VerifiableSQLQueryRequest) (*schema.VerifiableSQLQueryResult, error) {
+	if req == nil || req.SqlQueryRequest == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	lastTxID, _ := d.st.CommittedAlh()
+	if lastTxID < req.ProveSinceTx {
+		return nil, ErrIllegalState
+	}
+
+	stmts, err := sql.Parse(strings.NewReader(req.SqlQueryRequest.Sql))
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, ok := stmts[0].(sql.DataSource)
+	if !ok {
+		return nil, sql.ErrExpectingDQLStmt
+	}
+
+	sqlTx, err := d.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, err
+	}
+	defer sqlTx.Cancel()
+
+	res, colDescriptors, err := d.sqlQueryPrepared(ctx, sqlTx, stmt, req.SqlQueryRequest.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	var tableName string
+
+	for _, c := range colDescriptors {
+		if c.AggFn != "" || (tableName != "" && c.Table != tableName) {
+			return nil, fmt.Errorf("%w: only rows selected from a single table can be verified", ErrIllegalArguments)
+		}
+
+		tableName = c.Table
+	}
+
+	table, err := sqlTx.Catalog().GetTableByName(tableName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: only rows selected from a table can be verified: %v", ErrIllegalArguments, err)
+	}
+
+	for _, c := range colDescriptors {
+		_, err := table.GetColumnByName(c.Column)
+		if err != nil {
+			return nil, fmt.Errorf("%w: only table columns can be verified: %v", ErrIllegalArguments, err)
+		}
+	}
+
+	pkPositions := make([]int, len(table.PrimaryIndex().Cols()))
+
+	for i, pkCol := range table.PrimaryIndex().Cols() {
+		pkPositions[i] = -1
+
+		for j, c := range colDescriptors {
+			if c.Column == pkCol.Name() {
+				pkPositions[i] = j
+				break
+			}
+		}
+
+		if pkPositions[i] < 0 {
+			return nil, fmt.Errorf("%w: primary key column '%s' must be selected", ErrIllegalArguments, pkCol.Name())
+		}
+	}
+
+	tx, err := d.allocTx()
+	if err != nil {
+		return nil, err
+	}
+	defer d.releaseTx(tx)
+
+	vRes := &schema.VerifiableSQLQueryResult{
+		Result:          res,
+		SqlEntries:      make([]*schema.SQLEntry, len(res.Rows)),
+		InclusionProofs: make([]*schema.InclusionProof, len(res.Rows)),
+	}
+
+	txIDs := make([]uint64, len(res.Rows))
+
+	for i, row := range res.Rows {
+		valbuf := bytes.Buffer{}
+
+		for j, pkCol := range table.PrimaryIndex().Cols() {
+			pkEncVal, err := sql.EncodeRawValueAsKey(schema.RawValue(row.Values[pkPositions[j]]), pkCol.Type(), pkCol.MaxLen())
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = valbuf.Write(pkEncVal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		pkKey := sql.MapKey(
+			[]byte{SQLPrefix},
+			sql.PIndexPrefix,
+			sql.EncodeID(1),
+			sql.EncodeID(table.ID()),
+			sql.EncodeID(sql.PKIndexID),
+			valbuf.Bytes())
+
+		// rows updated after the query was resolved won't match the ones in the result,
+		// in such case verification fails and the query can be retried
+		e, err := d.sqlGetAt(pkKey, 0, d.st, true)
+		if err != nil {
+			return nil, err
+		}
+
+		vRes.SqlEntries[i] = e
+
+		vRes.InclusionProofs[i], err = d.inclusionProofAt(e.Tx, e.Key, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txIDs[i] = e.Tx
+	}
+
+	vRes.TxLinkProofs, vRes.DualProof, err = d.txLinkProofs(txIDs, req.ProveSinceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	md := tableMetadataOf(table)
+
+	vRes.DatabaseId = 1
+	vRes.TableId = table.ID()
+	vRes.PKIDs = md.pkIDs
+	vRes.ColNamesById = md.colNamesByID
+	vRes.ColIdsByName = md.colIdsByName
+	vRes.ColTypesById = md.colTypesByID
+	vRes.ColLenById = md.colLenByID
+
+	return vRes, nil
+}
+
+// tableMetadata holds the table details required by clients to validate raw row entries
+type tableMetadata struct {
+	pkIDs        []uint32
+	colNamesByID map[uint32]string
+	colIdsByName map[string]uint32
+	colTypesByID map[uint32]string
+	colLenByID   map[uint32]int32
+}
+
+func tableMetadataOf(table *sql.Table) *tableMetadata {
+	md := &tableMetadata{
+		pkIDs:        make([]uint32, len(table.PrimaryIndex().Cols())),
+		colNamesByID: make(map[uint32]string, len(table.Cols())),
+		colIdsByName: make(map[string]uint32, len(table.ColsByName())),
+		colTypesByID: make(map[uint32]string, len(table.Cols())),
+		colLenByID:   make(map[uint32]int32, len(table.Cols())),
+	}
+
+	for _, col := range table.Cols() {
+		md.colNamesByID[col.ID()] = col.Name()
+		md.colIdsByName[sql.EncodeSelector("", table.Name(), col.Name())] = col.ID()
+		md.colTypesByID[col.ID()] = col.Type()
+		md.colLenByID[col.ID()] = int32(col.MaxLen())
+	}
+
+	for i, col := range table.PrimaryIndex().Cols() {
+		md.pkIDs[i] = col.ID()
+	}
+
+	return md
+}
+
 func (d *db) sqlGetAt(key []byte, atTx uint64, index store.KeyIndex, skipIntegrityCheck bool) (entry *schema.SQLEntry, err error) {
 	var txID uint64
 	var md *store.KVMetadata
@@ -293,6 +462,11 @@ func (d *db) DescribeTable(ctx context.Context, tx *sql.SQLTx, tableName string)
 func (d *db) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (tx *sql.SQLTx, err error) {
 	txCtx, txCancel := context.WithCancel(context.Background())
 
+	// the tx may outlive ctx, only the session variables are kept from it
+	if vars := sql.SessionVariablesFromContext(ctx); vars != nil {
+		txCtx = sql.WithSessionVariables(txCtx, vars)
+	}
+
 	defer func() {
 		if err != nil {
 			txCancel()
@@ -380,6 +554,12 @@ func (d *db) SQLQuery(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRe
 }
 
 func (d *db) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, namedParams []*schema.NamedParam) (*schema.SQLQueryResult, error) {
+	res, _, err := d.sqlQueryPrepared(ctx, tx, stmt, namedParams)
+	return res, err
+}
+
+// sqlQueryPrepared resolves the query returning the descriptors of the selected columns together with the result
+func (d *db) sqlQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, namedParams []*schema.NamedParam) (*schema.SQLQueryResult, []sql.ColDescriptor, error) {
 	params := make(map[string]interface{})
 
 	for _, p := range namedParams {
@@ -388,13 +568,13 @@ func (d *db) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataS
 
 	r, err := d.SQLQueryRowReader(ctx, tx, stmt, params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer r.Close()
 
 	colDescriptors, err := r.Columns(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	cols := make([]*schema.Column, len(colDescriptors))
@@ -417,7 +597,7 @@ func (d *db) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataS
 			break
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		rrow := &schema.Row{
@@ -441,13 +621,13 @@ func (d *db) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataS
 		res.Rows = append(res.Rows, rrow)
 
 		if l == d.maxResultSize {
-			return res, fmt.Errorf("%w: found at least %d rows (the maximum limit). "+
+			return res, colDescriptors, fmt.Errorf("%w: found at least %d rows (the maximum limit). "+
 				"Query constraints can be applied using the LIMIT clause",
 				ErrResultSizeLimitReached, d.maxResultSize)
 		}
 	}
 
-	return res, nil
+	return res, colDescriptors, nil
 }
 
 func (d *db) SQLQueryRowReader(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error) {
@@ -504,3 +684,93 @@ func typedValueToRowValue(tv sql.TypedValue) *schema.SQLValue {
 	}
 	return nil
 }
+
+// checkRowLevelSecurityBypass fails if the table has row-level security policies and the session
+// is not allowed to bypass them, it must guard any access to rows not filtered by the policies
+func checkRowLevelSecurityBypass(ctx context.Context, table *sql.Table) error {
+	if len(table.Policies()) == 0 {
+		return nil
+	}
+
+	vars := sql.SessionVariablesFromContext(ctx)
+
+	if vars == nil || !vars.BypassRowLevelSecurity {
+		return fmt.Errorf("%w: table %s has row-level security policies", sql.ErrRowLevelSecurityBypassRequired, table.Name())
+	}
+
+	return nil
+}
+
+// rowLevelSecuredTables returns the tables, by id, whose rows can not be read by the session
+// without the filtering of their row-level security policies
+func (d *db) rowLevelSecuredTables(ctx context.Context) (map[uint32]*sql.Table, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	sqlTx, err := d.sqlEngine.NewTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return nil, err
+	}
+	defer sqlTx.Cancel()
+
+	secured := make(map[uint32]*sql.Table)
+
+	for _, table := range sqlTx.Catalog().GetTables() {
+		if checkRowLevelSecurityBypass(ctx, table) != nil {
+			secured[table.ID()] = table
+		}
+	}
+
+	return secured, nil
+}
+
+// checkSQLEntriesAccess fails if any of the entries is a row or index entry of a table with
+// row-level security policies the session is not allowed to bypass
+func (d *db) checkSQLEntriesAccess(ctx context.Context, entries []*store.TxEntry) error {
+	vars := sql.SessionVariablesFromContext(ctx)
+	if vars != nil && vars.BypassRowLevelSecurity {
+		return nil
+	}
+
+	// tables are only loaded if there are sql entries
+	var securedTables map[uint32]*sql.Table
+
+	for _, e := range entries {
+		tableID, ok := sqlEntryTableID(e.Key())
+		if !ok {
+			continue
+		}
+
+		if securedTables == nil {
+			tables, err := d.rowLevelSecuredTables(ctx)
+			if err != nil {
+				return err
+			}
+			securedTables = tables
+		}
+
+		if table, secured := securedTables[tableID]; secured {
+			return checkRowLevelSecurityBypass(ctx, table)
+		}
+	}
+
+	return nil
+}
+
+// sqlEntryTableID returns the id of the table a row or index entry of the SQL engine belongs to,
+// false for any other entry, such as the ones of the catalog
+func sqlEntryTableID(key []byte) (uint32, bool) {
+	if len(key) == 0 || key[0] != SQLPrefix {
+		return 0, false
+	}
+
+	mkey := key[1:]
+
+	for _, prefix := range []string{sql.PIndexPrefix, sql.SIndexPrefix, sql.UIndexPrefix} {
+		if bytes.HasPrefix(mkey, []byte(prefix)) && len(mkey) >= len(prefix)+2*sql.EncIDLen {
+			return binary.BigEndian.Uint32(mkey[len(prefix)+sql.EncIDLen:]), true
+		}
+	}
+
+	return 0, false
+}
@@ -225,3 +225,83 @@ func TestVerifiableSQLGet(t *testing.T) {
 		require.Contains(t, err.Error(), "incorrect number of primary key values")
 	})
 }
+
+func TestVerifiableSQLQuery(t *testing.T) {
+	db := makeDb(t)
+
+	_, _, err := db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE table1(
+			id INTEGER,
+			title VARCHAR,
+			PRIMARY KEY id)
+	`})
+	require.NoError(t, err)
+
+	_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		INSERT INTO table1(id, title) VALUES (1, 'title1'), (2, 'title2')
+	`})
+	require.NoError(t, err)
+
+	_, ctxs, err := db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: `
+		UPSERT INTO table1(id, title) VALUES (2, 'title22'), (3, 'title3')
+	`})
+	require.NoError(t, err)
+
+	lastTx := ctxs[0].TxHeader().ID
+
+	t.Run("verifiable query should fail with invalid arguments", func(t *testing.T) {
+		_, err := db.VerifiableSQLQuery(context.Background(), nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = db.VerifiableSQLQuery(context.Background(), &schema.VerifiableSQLQueryRequest{
+			SqlQueryRequest: &schema.SQLQueryRequest{Sql: "SELECT id, title FROM table1"},
+			ProveSinceTx:    lastTx + 1,
+		})
+		require.ErrorIs(t, err, ErrIllegalState)
+
+		for _, q := range []string{
+			"SELECT COUNT(*) FROM table1",
+			"SELECT title FROM table1",
+			"SELECT id, title AS name FROM table1",
+			"SELECT t.id, t.title FROM table1 t",
+		} {
+			_, err = db.VerifiableSQLQuery(context.Background(), &schema.VerifiableSQLQueryRequest{
+				SqlQueryRequest: &schema.SQLQueryRequest{Sql: q},
+			})
+			require.ErrorIs(t, err, ErrIllegalArguments, q)
+		}
+	})
+
+	t.Run("verifiable query should return rows with proofs", func(t *testing.T) {
+		vRes, err := db.VerifiableSQLQuery(context.Background(), &schema.VerifiableSQLQueryRequest{
+			SqlQueryRequest: &schema.SQLQueryRequest{Sql: "SELECT id, title FROM table1 WHERE id >= @id"},
+			ProveSinceTx:    1,
+		})
+		require.Error(t, err)
+		require.Nil(t, vRes)
+
+		vRes, err = db.VerifiableSQLQuery(context.Background(), &schema.VerifiableSQLQueryRequest{
+			SqlQueryRequest: &schema.SQLQueryRequest{
+				Sql:    "SELECT id, title FROM table1 WHERE id >= @id",
+				Params: []*schema.NamedParam{{Name: "id", Value: &schema.SQLValue{Value: &schema.SQLValue_N{N: 2}}}},
+			},
+			ProveSinceTx: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, vRes.Result.Rows, 2)
+		require.Len(t, vRes.SqlEntries, 2)
+		require.Len(t, vRes.InclusionProofs, 2)
+		require.Len(t, vRes.TxLinkProofs, 1)
+		require.NotNil(t, vRes.DualProof)
+		require.Equal(t, uint64(1), vRes.DualProof.SourceTxHeader.Id)
+		require.Equal(t, lastTx, vRes.DualProof.TargetTxHeader.Id)
+
+		for _, e := range vRes.SqlEntries {
+			require.Equal(t, lastTx, e.Tx)
+		}
+
+		require.Len(t, vRes.PKIDs, 1)
+		require.Equal(t, "id", vRes.ColNamesById[vRes.PKIDs[0]])
+		require.Contains(t, vRes.ColIdsByName, sql.EncodeSelector("", "table1", "title"))
+	})
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+var ErrTimestampAnchorNotFound = fmt.Errorf("%w: timestamp anchor not found", store.ErrKeyNotFound)
+
+func timestampAnchorKey(anchoredTxID uint64) []byte {
+	var b [1 + 8]byte
+	b[0] = TimestampAnchorKeyPrefix
+	binary.BigEndian.PutUint64(b[1:], anchoredTxID)
+	return b[:]
+}
+
+// AddTimestampAnchor stores the time-stamp token attesting the state of the database as of
+// the transaction txID. The token is stored into a new transaction whose metadata refers to txID.
+func (d *db) AddTimestampAnchor(ctx context.Context, txID uint64, token []byte) (*schema.TxHeader, error) {
+	if txID == 0 || len(token) == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.isReplica() {
+		return nil, ErrIsReplica
+	}
+
+	currTxID, _ := d.st.CommittedAlh()
+	if txID > currTxID {
+		return nil, ErrIllegalArguments
+	}
+
+	tx, err := d.st.NewWriteOnlyTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Cancel()
+
+	err = tx.Set(timestampAnchorKey(txID), nil, token)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.WithMetadata(store.NewTxMetadata().WithTimestampAnchoredTxID(txID))
+
+	hdr, err := tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.TxHeaderToProto(hdr), nil
+}
+
+// TimestampAnchor returns the earliest timestamp anchor covering the requested transaction,
+// i.e. the one attesting the state as of such transaction or the closest later one
+func (d *db) TimestampAnchor(ctx context.Context, req *schema.TimestampAnchorRequest) (*schema.TimestampAnchor, error) {
+	if req == nil || req.Tx == 0 {
+		return nil, ErrIllegalArguments
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	currTxID, _ := d.st.CommittedAlh()
+	if req.Tx > currTxID {
+		return nil, ErrIllegalArguments
+	}
+
+	snap, err := d.snapshotSince(ctx, currTxID)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Close()
+
+	r, err := snap.NewKeyReader(
+		store.KeyReaderSpec{
+			SeekKey:       timestampAnchorKey(req.Tx),
+			Prefix:        []byte{TimestampAnchorKeyPrefix},
+			InclusiveSeek: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	key, valRef, err := r.Read()
+	if errors.Is(err, store.ErrNoMoreEntries) {
+		return nil, ErrTimestampAnchorNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := valRef.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.TimestampAnchor{
+		AnchoredTx: binary.BigEndian.Uint64(key[1:]),
+		Tx:         valRef.Tx(),
+		Token:      token,
+	}, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampAnchor(t *testing.T) {
+	db := makeDb(t)
+
+	_, err := db.AddTimestampAnchor(context.Background(), 0, []byte("token"))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.TimestampAnchor(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	hdr1, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	_, err = db.AddTimestampAnchor(context.Background(), hdr1.Id, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.AddTimestampAnchor(context.Background(), hdr1.Id+1, []byte("token"))
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = db.TimestampAnchor(context.Background(), &schema.TimestampAnchorRequest{Tx: hdr1.Id})
+	require.ErrorIs(t, err, ErrTimestampAnchorNotFound)
+	require.ErrorIs(t, err, store.ErrKeyNotFound)
+
+	anchorHdr1, err := db.AddTimestampAnchor(context.Background(), hdr1.Id, []byte("token1"))
+	require.NoError(t, err)
+	require.Equal(t, hdr1.Id, anchorHdr1.Metadata.TimestampAnchoredTxID)
+
+	hdr2, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key2"), Value: []byte("value2")}}})
+	require.NoError(t, err)
+
+	hdr3, err := db.Set(context.Background(), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key3"), Value: []byte("value3")}}})
+	require.NoError(t, err)
+
+	anchorHdr3, err := db.AddTimestampAnchor(context.Background(), hdr3.Id, []byte("token3"))
+	require.NoError(t, err)
+
+	t.Run("transactions should be covered by the closest anchor", func(t *testing.T) {
+		anchor, err := db.TimestampAnchor(context.Background(), &schema.TimestampAnchorRequest{Tx: hdr1.Id})
+		require.NoError(t, err)
+		require.Equal(t, hdr1.Id, anchor.AnchoredTx)
+		require.Equal(t, anchorHdr1.Id, anchor.Tx)
+		require.Equal(t, []byte("token1"), anchor.Token)
+
+		anchor, err = db.TimestampAnchor(context.Background(), &schema.TimestampAnchorRequest{Tx: hdr2.Id})
+		require.NoError(t, err)
+		require.Equal(t, hdr3.Id, anchor.AnchoredTx)
+		require.Equal(t, anchorHdr3.Id, anchor.Tx)
+		require.Equal(t, []byte("token3"), anchor.Token)
+	})
+
+	t.Run("transactions after the last anchor should not be covered", func(t *testing.T) {
+		_, err := db.TimestampAnchor(context.Background(), &schema.TimestampAnchorRequest{Tx: anchorHdr3.Id})
+		require.ErrorIs(t, err, ErrTimestampAnchorNotFound)
+
+		_, err = db.TimestampAnchor(context.Background(), &schema.TimestampAnchorRequest{Tx: anchorHdr3.Id + 1})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("anchors should not be visible as regular entries", func(t *testing.T) {
+		entries, err := db.Scan(context.Background(), &schema.ScanRequest{})
+		require.NoError(t, err)
+		require.Len(t, entries.Entries, 3)
+	})
+
+	t.Run("anchors should not be added to replicas", func(t *testing.T) {
+		db.AsReplica(true, false, 0)
+		defer db.AsReplica(false, false, 0)
+
+		_, err := db.AddTimestampAnchor(context.Background(), hdr3.Id, []byte("token"))
+		require.ErrorIs(t, err, ErrIsReplica)
+	})
+}
@@ -38,6 +38,8 @@ func mapGRPcErrorCode(code Code) codes.Code {
 		return codes.NotFound
 	case CodIntegrityConstraintViolation:
 		return codes.FailedPrecondition
+	case CodInsufficientResources, CodDiskFull, CodTooManyConnections, CodConfigurationLimitExceeded:
+		return codes.ResourceExhausted
 	default:
 		return codes.Unknown
 	}
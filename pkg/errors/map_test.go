@@ -36,6 +36,10 @@ func Test_Map(t *testing.T) {
 	require.Equal(t, codes.Internal, st)
 	st = mapGRPcErrorCode(CodUndefinedFunction)
 	require.Equal(t, codes.Unimplemented, st)
+	st = mapGRPcErrorCode(CodInsufficientResources)
+	require.Equal(t, codes.ResourceExhausted, st)
+	st = mapGRPcErrorCode(CodDiskFull)
+	require.Equal(t, codes.ResourceExhausted, st)
 	st = mapGRPcErrorCode(Code("Unknown"))
 	require.Equal(t, codes.Unknown, st)
 }
@@ -33,6 +33,10 @@ const (
 	CodInvalidTransactionInitiation                  Code = "0B000"
 	CodInFailedSqlTransaction                        Code = "25P02"
 	CodIntegrityConstraintViolation                  Code = "23000"
+	CodInsufficientResources                         Code = "53000"
+	CodDiskFull                                      Code = "53100"
+	CodTooManyConnections                            Code = "53300"
+	CodConfigurationLimitExceeded                    Code = "53400"
 )
 
 var (
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestImmuClient_APIKeyAuthentication(t *testing.T) {
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(filepath.Join(t.TempDir(), "data")).
+		WithAuth(true),
+	)
+
+	bs.Start()
+	t.Cleanup(func() { bs.Stop() })
+
+	client, err := bs.NewAuthenticatedClient(ic.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { client.CloseSession(context.Background()) })
+
+	ctx := context.Background()
+
+	err = client.CreateUser(ctx, []byte("importer"), []byte("Pa$$w0rd"), auth.PermissionRW, "defaultdb")
+	require.NoError(t, err)
+
+	res, err := client.CreateAPIKey(ctx, &schema.CreateAPIKeyRequest{
+		Username:   "importer",
+		Name:       "nightly import",
+		Database:   "defaultdb",
+		Permission: auth.PermissionRW,
+	})
+	require.NoError(t, err)
+
+	apiKeyClient := func(key string) ic.ImmuClient {
+		cli, err := ic.NewImmuClient(ic.DefaultOptions().
+			WithDir(t.TempDir()).
+			WithAPIKey(key).
+			WithDialOptions([]grpc.DialOption{grpc.WithContextDialer(bs.Dialer), grpc.WithTransportCredentials(insecure.NewCredentials())}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { cli.Disconnect() })
+		return cli
+	}
+
+	t.Run("requests should be authenticated by the API key", func(t *testing.T) {
+		cli := apiKeyClient(res.Key)
+
+		_, err := cli.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+		require.NoError(t, err)
+
+		entry, err := cli.VerifiedGet(ctx, []byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), entry.Value)
+	})
+
+	t.Run("requests should be rejected once the API key is revoked", func(t *testing.T) {
+		list, err := client.ListAPIKeys(ctx, "importer")
+		require.NoError(t, err)
+		require.Len(t, list.ApiKeys, 1)
+		require.NotEmpty(t, list.ApiKeys[0].LastUsedAt)
+
+		err = client.RevokeAPIKey(ctx, res.ApiKey.Id)
+		require.NoError(t, err)
+
+		_, err = apiKeyClient(res.Key).Get(ctx, []byte("key1"))
+		require.ErrorContains(t, err, auth.ErrInvalidAPIKey.Error())
+	})
+}
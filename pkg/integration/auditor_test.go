@@ -18,7 +18,7 @@ package integration
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"crypto"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -122,6 +122,7 @@ func TestDefaultAuditorRunOnEmptyDb(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		auditor.AuditNotificationConfig{},
 		serviceClient,
 		state.NewUUIDProvider(serviceClient),
@@ -212,6 +213,7 @@ func TestDefaultAuditorRunOnDb(t *testing.T) {
 		"immudb",
 		nil,
 		nil,
+		nil,
 		auditor.AuditNotificationConfig{},
 		serviceClient,
 		state.NewUUIDProvider(serviceClient),
@@ -303,6 +305,7 @@ func TestRepeatedAuditorRunOnDb(t *testing.T) {
 		"immudb",
 		[]string{"SomeNonExistentDb", ""},
 		nil,
+		nil,
 		alertConfig,
 		serviceClient,
 		state.NewUUIDProvider(serviceClient),
@@ -334,7 +337,7 @@ func TestDefaultAuditorRunOnDbWithSignatureFromState(t *testing.T) {
 	testDefaultAuditorRunOnDbWithSignature(t, nil)
 }
 
-func testDefaultAuditorRunOnDbWithSignature(t *testing.T, pk *ecdsa.PublicKey) {
+func testDefaultAuditorRunOnDbWithSignature(t *testing.T, pk crypto.PublicKey) {
 	pKeyPath := "./../../test/signer/ec3.key"
 	bs := servertest.NewBufconnServer(
 		server.DefaultOptions().
@@ -397,6 +400,7 @@ func testDefaultAuditorRunOnDbWithSignature(t *testing.T, pk *ecdsa.PublicKey) {
 		"immudb",
 		nil,
 		pk,
+		nil,
 		auditor.AuditNotificationConfig{},
 		serviceClient,
 		state.NewUUIDProvider(serviceClient),
@@ -18,7 +18,9 @@ package integration
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -623,6 +625,12 @@ func TestImmuClientDisconnect(t *testing.T) {
 	_, err = client.FlushIndex(ctx, 100, true)
 	require.ErrorIs(t, err, ic.ErrNotConnected)
 
+	_, err = client.BulkLoad(ctx, []*schema.KeyValue{{Key: []byte("key"), Value: []byte("value")}})
+	require.ErrorIs(t, err, ic.ErrNotConnected)
+
+	err = client.RebuildIndex(ctx, nil)
+	require.ErrorIs(t, err, ic.ErrNotConnected)
+
 	_, err = client.Login(context.Background(), []byte("user"), []byte("passwd"))
 	require.True(t, errors.Is(err.(immuErrors.ImmuError), ic.ErrNotConnected))
 
@@ -944,6 +952,327 @@ func TestImmuClient_SetAll(t *testing.T) {
 	require.ErrorIs(t, err, ic.ErrNotConnected)
 }
 
+func TestImmuClient_RebuildIndex(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	for i := 0; i < 10; i++ {
+		_, err := client.Set(ctx, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	var lastProgress *schema.RebuildIndexProgress
+
+	err := client.RebuildIndex(ctx, func(p *schema.RebuildIndexProgress) {
+		lastProgress = p
+	})
+	require.NoError(t, err)
+	require.NotNil(t, lastProgress)
+	require.True(t, lastProgress.Done)
+	require.Equal(t, lastProgress.CommittedTxId, lastProgress.IndexedTxId)
+
+	for i := 0; i < 10; i++ {
+		entry, err := client.Get(ctx, []byte(fmt.Sprintf("key%d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), entry.Value)
+	}
+}
+
+func TestImmuClient_VerifiedGetLatest(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.CreateDatabaseV2(ctx, "authdb", &schema.DatabaseNullableSettings{
+		AuthenticatedIndex: &schema.NullableBool{Value: true},
+	})
+	require.NoError(t, err)
+
+	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: "authdb"})
+	require.NoError(t, err)
+
+	err = client.VerifiedGetAbsent(ctx, []byte("key1"))
+	require.ErrorContains(t, err, "tx not found")
+
+	for i := 0; i < 3; i++ {
+		_, err = client.Set(ctx, []byte("key1"), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		_, err = client.Set(ctx, []byte("key2"), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	entry, err := client.VerifiedGetLatest(ctx, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), entry.Value)
+	require.EqualValues(t, 5, entry.Tx)
+
+	state, err := client.CurrentState(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, state.TxId)
+
+	_, err = client.VerifiedGetLatest(ctx, []byte("key3"))
+	require.ErrorContains(t, err, "key not found")
+
+	err = client.VerifiedGetAbsent(ctx, []byte("key3"))
+	require.NoError(t, err)
+
+	err = client.VerifiedGetAbsent(ctx, []byte("key1"))
+	require.ErrorContains(t, err, "key already exists")
+
+	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: "defaultdb"})
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte("key1"), []byte("value"))
+	require.NoError(t, err)
+
+	_, err = client.VerifiedGetLatest(ctx, []byte("key1"))
+	require.ErrorContains(t, err, "authenticated index is not enabled")
+}
+
+func TestImmuClient_VerifiedCompleteHistory(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.CreateDatabaseV2(ctx, "authdb", &schema.DatabaseNullableSettings{
+		AuthenticatedIndex: &schema.NullableBool{Value: true},
+	})
+	require.NoError(t, err)
+
+	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: "authdb"})
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		_, err = client.Set(ctx, []byte("key1"), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+
+		_, err = client.Set(ctx, []byte("key2"), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	t.Run("the complete history should be verified", func(t *testing.T) {
+		entries, err := client.VerifiedCompleteHistory(ctx, &schema.HistoryRequest{Key: []byte("key1")})
+		require.NoError(t, err)
+		require.Len(t, entries.Entries, 4)
+
+		for i, e := range entries.Entries {
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), e.Value)
+			require.EqualValues(t, i+1, e.Metadata.Revision)
+		}
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 8, state.TxId)
+
+		entries, err = client.VerifiedCompleteHistory(ctx, &schema.HistoryRequest{Key: []byte("key1"), Offset: 1, Limit: 2, Desc: true})
+		require.NoError(t, err)
+		require.Len(t, entries.Entries, 2)
+		require.EqualValues(t, 3, entries.Entries[0].Metadata.Revision)
+		require.EqualValues(t, 2, entries.Entries[1].Metadata.Revision)
+
+		entries, err = client.VerifiedCompleteHistory(ctx, &schema.HistoryRequest{Key: []byte("key3")})
+		require.NoError(t, err)
+		require.Empty(t, entries.Entries)
+	})
+
+	t.Run("histories with missing revisions should be rejected", func(t *testing.T) {
+		bs.Server.PostVerifiableHistoryFn = func(ctx context.Context,
+			req *schema.VerifiableHistoryRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Entries = append(res.Entries[:1], res.Entries[2:]...)
+			res.InclusionProofs = append(res.InclusionProofs[:1], res.InclusionProofs[2:]...)
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostVerifiableHistoryFn = nil }()
+
+		_, err = client.VerifiedCompleteHistory(ctx, &schema.HistoryRequest{Key: []byte("key1")})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("histories missing the latest revisions should be rejected", func(t *testing.T) {
+		bs.Server.PostVerifiableHistoryFn = func(ctx context.Context,
+			req *schema.VerifiableHistoryRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Entries = res.Entries[:3]
+			res.InclusionProofs = res.InclusionProofs[:3]
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostVerifiableHistoryFn = nil }()
+
+		_, err = client.VerifiedCompleteHistory(ctx, &schema.HistoryRequest{Key: []byte("key1")})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+
+		_, err = client.VerifiedCompleteHistory(ctx, &schema.HistoryRequest{Key: []byte("key1"), Limit: 4})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
+func TestImmuClient_VerifiedTxByIDEntries(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	kvs := make([]*schema.KeyValue, 100)
+
+	for i := range kvs {
+		kvs[i] = &schema.KeyValue{Key: []byte(fmt.Sprintf("key%d", i)), Value: []byte(fmt.Sprintf("value%d", i))}
+	}
+
+	hdr, err := client.SetAll(ctx, &schema.SetRequest{KVs: kvs})
+	require.NoError(t, err)
+
+	tx, err := client.VerifiedTxByID(ctx, hdr.Id)
+	require.NoError(t, err)
+	require.Len(t, tx.Entries, 100)
+	require.Equal(t, []byte("key0"), tx.Entries[0].Key)
+
+	t.Run("tampered entries should be rejected", func(t *testing.T) {
+		bs.Server.PostVerifiableTxByIdFn = func(ctx context.Context,
+			req *schema.VerifiableTxRequest, res *schema.VerifiableTx, err error) (*schema.VerifiableTx, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			hValue := sha256.Sum256([]byte("tampered"))
+			res.Tx.Entries[10].HValue = hValue[:]
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostVerifiableTxByIdFn = nil }()
+
+		_, err = client.VerifiedTxByID(ctx, hdr.Id)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("entries without an inclusion proof should be rejected", func(t *testing.T) {
+		bs.Server.PostVerifiableTxByIdFn = func(ctx context.Context,
+			req *schema.VerifiableTxRequest, res *schema.VerifiableTx, err error) (*schema.VerifiableTx, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.EntriesInclusionProof = nil
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostVerifiableTxByIdFn = nil }()
+
+		_, err = client.VerifiedTxByID(ctx, hdr.Id)
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
+func TestImmuClient_VerifiedScan(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	entries, err := client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte("key")})
+	require.NoError(t, err)
+	require.Empty(t, entries.Entries)
+
+	for i := 0; i < 5; i++ {
+		_, err = client.Set(ctx, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	_, err = client.SetReference(ctx, []byte("key5"), []byte("key0"))
+	require.NoError(t, err)
+
+	// entries are linked into the transaction of the client state, being more recent than all of them
+	lastHdr, err := client.Set(ctx, []byte("other"), []byte("value"))
+	require.NoError(t, err)
+
+	entries, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte("key")})
+	require.NoError(t, err)
+	require.Len(t, entries.Entries, 6)
+	require.Equal(t, []byte("value0"), entries.Entries[5].Value)
+	require.Equal(t, []byte("key5"), entries.Entries[5].ReferencedBy.Key)
+
+	state, err := client.CurrentState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lastHdr.Id, state.TxId)
+
+	t.Run("verified history", func(t *testing.T) {
+		_, err = client.Set(ctx, []byte("key0"), []byte("value5"))
+		require.NoError(t, err)
+
+		hdr, err := client.Delete(ctx, &schema.DeleteKeysRequest{Keys: [][]byte{[]byte("key0")}})
+		require.NoError(t, err)
+
+		entries, err := client.VerifiedHistory(ctx, &schema.HistoryRequest{Key: []byte("key0")})
+		require.NoError(t, err)
+		require.Len(t, entries.Entries, 3)
+		require.Equal(t, []byte("value5"), entries.Entries[1].Value)
+		require.True(t, entries.Entries[2].Metadata.Deleted)
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, hdr.Id, state.TxId)
+	})
+
+	t.Run("verified zscan", func(t *testing.T) {
+		_, err = client.ZAdd(ctx, []byte("set1"), 1, []byte("key1"))
+		require.NoError(t, err)
+
+		entry, err := client.Get(ctx, []byte("key2"))
+		require.NoError(t, err)
+
+		hdr, err := client.ZAddAt(ctx, []byte("set1"), 2, []byte("key2"), entry.Tx)
+		require.NoError(t, err)
+
+		zEntries, err := client.VerifiedZScan(ctx, &schema.ZScanRequest{Set: []byte("set1")})
+		require.NoError(t, err)
+		require.Len(t, zEntries.Entries, 2)
+		require.Equal(t, []byte("value1"), zEntries.Entries[0].Entry.Value)
+		require.Equal(t, []byte("value2"), zEntries.Entries[1].Entry.Value)
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, hdr.Id, state.TxId)
+	})
+
+	t.Run("tampered entries should be rejected", func(t *testing.T) {
+		bs.Server.PostVerifiableScanFn = func(ctx context.Context,
+			req *schema.VerifiableScanRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Entries[0].Value = []byte("tampered")
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostVerifiableScanFn = nil }()
+
+		_, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte("key")})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("entries of transactions not linked should be rejected", func(t *testing.T) {
+		bs.Server.PostVerifiableScanFn = func(ctx context.Context,
+			req *schema.VerifiableScanRequest, res *schema.VerifiableEntries, err error) (*schema.VerifiableEntries, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.TxLinkProofs = res.TxLinkProofs[1:]
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostVerifiableScanFn = nil }()
+
+		_, err = client.VerifiedScan(ctx, &schema.ScanRequest{Prefix: []byte("key")})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
 func TestImmuClient_GetAll(t *testing.T) {
 	_, client, ctx := setupTestServerAndClient(t)
 
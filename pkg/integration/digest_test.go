@@ -0,0 +1,109 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmuClient_PrefixDigest(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	kvs := make([]*schema.KeyValue, 20)
+	for i := range kvs {
+		kvs[i] = &schema.KeyValue{Key: []byte(fmt.Sprintf("key%02d", i)), Value: []byte(fmt.Sprintf("value%d", i))}
+	}
+
+	_, err := client.SetAll(ctx, &schema.SetRequest{KVs: kvs})
+	require.NoError(t, err)
+
+	digest, err := client.PrefixDigest(ctx, &schema.PrefixDigestRequest{
+		Prefix:    []byte("key"),
+		ProveKeys: [][]byte{[]byte("key05"), []byte("key17")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), digest.Count)
+	require.Equal(t, []byte("key10"), digest.MiddleKey)
+	require.Len(t, digest.Proofs, 2)
+
+	t.Run("tampered proofs should be rejected", func(t *testing.T) {
+		bs.Server.PostPrefixDigestFn = func(ctx context.Context,
+			req *schema.PrefixDigestRequest, res *schema.ContentDigest, err error) (*schema.ContentDigest, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Proofs[0].ValueDigest[0] ^= 1
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostPrefixDigestFn = nil }()
+
+		_, err := client.PrefixDigest(ctx, &schema.PrefixDigestRequest{
+			Prefix:    []byte("key"),
+			ProveKeys: [][]byte{[]byte("key05")},
+		})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+
+	t.Run("proofs of unexpected keys should be rejected", func(t *testing.T) {
+		bs.Server.PostPrefixDigestFn = func(ctx context.Context,
+			req *schema.PrefixDigestRequest, res *schema.ContentDigest, err error) (*schema.ContentDigest, error) {
+
+			if err != nil {
+				return res, err
+			}
+
+			res.Proofs[0] = digest.Proofs[1]
+
+			return res, nil
+		}
+		defer func() { bs.Server.PostPrefixDigestFn = nil }()
+
+		_, err := client.PrefixDigest(ctx, &schema.PrefixDigestRequest{
+			Prefix:    []byte("key"),
+			ProveKeys: [][]byte{[]byte("key05")},
+		})
+		require.ErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
+
+func TestImmuClient_TableDigest(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	_, err := client.SQLExec(ctx, "CREATE TABLE mytable(id INTEGER, title VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	_, err = client.SQLExec(ctx, "INSERT INTO mytable(id, title) VALUES (1, 'title1'), (2, 'title2'), (3, 'title3')", nil)
+	require.NoError(t, err)
+
+	digest, err := client.TableDigest(ctx, &schema.TableDigestRequest{
+		Table:     "mytable",
+		ProveRows: []*schema.PrimaryKey{{Values: []*schema.SQLValue{{Value: &schema.SQLValue_N{N: 2}}}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), digest.Count)
+	require.Len(t, digest.Proofs, 1)
+	require.Equal(t, digest.MiddleKey, digest.Proofs[0].Key)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/require"
+)
+
+// testIssuer stands in for an SSO provider, publishing its key set and issuing ES256 signed tokens
+type testIssuer struct {
+	key *ecdsa.PrivateKey
+	srv *httptest.Server
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	issuer := &testIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "EC",
+				"kid": "sso1",
+				"use": "sig",
+				"crv": "P-256",
+				"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+				"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+			}},
+		})
+	})
+
+	issuer.srv = httptest.NewServer(mux)
+	t.Cleanup(issuer.srv.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) jwksURL() string {
+	return i.srv.URL + "/.well-known/jwks.json"
+}
+
+func (i *testIssuer) token(t *testing.T, username string, groups ...string) string {
+	header, err := json.Marshal(map[string]string{"alg": "ES256", "kid": "sso1", "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"iss":    i.srv.URL,
+		"aud":    "immudb",
+		"email":  username,
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"groups": groups,
+	})
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signed))
+
+	r, s, err := ecdsa.Sign(rand.Reader, i.key, digest[:])
+	require.NoError(t, err)
+
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestImmuClient_JWTAuthentication(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(filepath.Join(t.TempDir(), "data")).
+		WithAuth(true).
+		WithJWTOptions(&auth.JWTOptions{
+			JWKS:          issuer.jwksURL(),
+			Issuer:        issuer.srv.URL,
+			Audience:      "immudb",
+			UsernameClaim: "email",
+			RolesClaim:    "groups",
+		}),
+	)
+
+	bs.Start()
+	t.Cleanup(func() { bs.Stop() })
+
+	client, err := bs.NewAuthenticatedClient(ic.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { client.CloseSession(context.Background()) })
+
+	ctx := context.Background()
+
+	_, err = client.SQLExec(ctx, `
+		CREATE TABLE table1(id INTEGER, PRIMARY KEY id);
+		INSERT INTO table1(id) VALUES (1);
+	`, nil)
+	require.NoError(t, err)
+
+	err = client.CreateRole(ctx, "analysts", false)
+	require.NoError(t, err)
+
+	err = client.ChangeRolePrivilege(ctx, schema.PermissionAction_GRANT, "analysts", &schema.RolePrivilege{
+		Privilege: "select",
+		Database:  "defaultdb",
+		Object:    "table1",
+	})
+	require.NoError(t, err)
+
+	username := "jane@example.com"
+
+	t.Run("sessions should be opened with tokens issued by the configured provider", func(t *testing.T) {
+		jwtClient := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+		err := jwtClient.OpenSession(ctx, []byte(username), []byte(issuer.token(t, username, "analysts")), "defaultdb")
+		require.NoError(t, err)
+		defer jwtClient.CloseSession(ctx)
+
+		res, err := jwtClient.SQLQuery(ctx, "SELECT id FROM table1", nil, true)
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		_, err = jwtClient.SQLExec(ctx, "INSERT INTO table1(id) VALUES (2)", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("tokens not granting access to the database should be rejected", func(t *testing.T) {
+		jwtClient := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+		err := jwtClient.OpenSession(ctx, []byte(username), []byte(issuer.token(t, username)), "defaultdb")
+		require.Error(t, err)
+	})
+
+	t.Run("tokens issued by other providers should be rejected", func(t *testing.T) {
+		jwtClient := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+		err := jwtClient.OpenSession(ctx, []byte(username), []byte(newTestIssuer(t).token(t, username, "analysts")), "defaultdb")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/proofbundle"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmuClient_ExportProofBundle(t *testing.T) {
+	_, client, ctx := setupTestServerAndClient(t)
+
+	pubKey, err := signer.ParsePublicKeyFile("./../../test/signer/ec1.pub")
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = client.Set(ctx, []byte("key1"), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+
+	_, err = client.SetReference(ctx, []byte("ref1"), []byte("key1"))
+	require.NoError(t, err)
+
+	t.Run("export and verify the current value of a key", func(t *testing.T) {
+		bundle, err := client.ExportProofBundle(ctx, []byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), bundle.Entry.Value)
+		require.Equal(t, bundle.Entry.Tx, bundle.TxHeader.Id)
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, state.TxId, bundle.State.TxId)
+
+		bundleFile := filepath.Join(t.TempDir(), "proof.json")
+
+		err = bundle.WriteFile(bundleFile)
+		require.NoError(t, err)
+
+		readBundle, err := proofbundle.ReadFile(bundleFile)
+		require.NoError(t, err)
+
+		err = readBundle.Verify(pubKey)
+		require.NoError(t, err)
+	})
+
+	t.Run("export and verify a previous value of a key", func(t *testing.T) {
+		bundle, err := client.ExportProofBundle(ctx, []byte("key1"), ic.AtRevision(1))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value0"), bundle.Entry.Value)
+		require.Equal(t, bundle.Entry.Tx, bundle.DualProof.SourceTxHeader.Id)
+		require.Less(t, bundle.Entry.Tx, bundle.State.TxId)
+
+		err = bundle.Verify(pubKey)
+		require.NoError(t, err)
+	})
+
+	t.Run("export and verify a reference", func(t *testing.T) {
+		bundle, err := client.ExportProofBundle(ctx, []byte("ref1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("ref1"), bundle.Entry.ReferencedBy.Key)
+		require.Equal(t, bundle.Entry.ReferencedBy.Tx, bundle.TxHeader.Id)
+
+		err = bundle.Verify(pubKey)
+		require.NoError(t, err)
+	})
+
+	t.Run("tampered bundles should not pass verification", func(t *testing.T) {
+		bundle, err := client.ExportProofBundle(ctx, []byte("key1"), ic.AtRevision(2))
+		require.NoError(t, err)
+
+		value := bundle.Entry.Value
+		bundle.Entry.Value = []byte("tampered value")
+		require.ErrorIs(t, bundle.Verify(nil), store.ErrCorruptedData)
+		bundle.Entry.Value = value
+
+		bundle.TxHeader.Nentries++
+		require.ErrorIs(t, bundle.Verify(nil), store.ErrCorruptedData)
+		bundle.TxHeader.Nentries--
+
+		txHash := bundle.State.TxHash
+		bundle.State.TxHash = make([]byte, len(txHash))
+		require.ErrorIs(t, bundle.Verify(nil), store.ErrCorruptedData)
+		bundle.State.TxHash = txHash
+
+		bundle.State.Db = "otherdb"
+		require.NoError(t, bundle.Verify(nil))
+		require.ErrorIs(t, bundle.Verify(pubKey), store.ErrCorruptedData)
+		bundle.State.Db = "defaultdb"
+
+		bundle.Version = proofbundle.Version + 1
+		require.ErrorIs(t, bundle.Verify(pubKey), proofbundle.ErrUnsupportedVersion)
+		bundle.Version = proofbundle.Version
+
+		require.NoError(t, bundle.Verify(pubKey))
+	})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmuClient_Roles(t *testing.T) {
+	bs, client, ctx := setupTestServerAndClient(t)
+
+	var (
+		userName     = "analyst1"
+		userPassword = "1Password!*"
+		testDBName   = "rolesdb"
+	)
+
+	_, err := client.CreateDatabaseV2(ctx, testDBName, nil)
+	require.NoError(t, err)
+
+	err = client.CreateUser(ctx, []byte(userName), []byte(userPassword), auth.PermissionR, "defaultdb")
+	require.NoError(t, err)
+
+	err = client.CreateRole(ctx, "analyst", false)
+	require.NoError(t, err)
+
+	err = client.ChangeRolePrivilege(ctx, schema.PermissionAction_GRANT, "analyst", &schema.RolePrivilege{
+		Privilege: "read",
+		Database:  testDBName,
+		Object:    "public:",
+	})
+	require.NoError(t, err)
+
+	err = client.ChangeRolePrivilege(ctx, schema.PermissionAction_GRANT, "analyst", &schema.RolePrivilege{
+		Privilege: "select",
+		Database:  testDBName,
+		Object:    "table1",
+	})
+	require.NoError(t, err)
+
+	err = client.ChangeRoleMembership(ctx, schema.PermissionAction_GRANT, "analyst", userName)
+	require.NoError(t, err)
+
+	roles, err := client.ListRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles.Roles, 1)
+	require.Len(t, roles.Roles[0].Privileges, 2)
+
+	_, err = client.UseDatabase(ctx, &schema.Database{DatabaseName: testDBName})
+	require.NoError(t, err)
+
+	_, err = client.SQLExec(ctx, `
+		CREATE TABLE table1(id INTEGER, PRIMARY KEY id);
+		CREATE TABLE table2(id INTEGER, PRIMARY KEY id);
+		INSERT INTO table1(id) VALUES (1);
+	`, nil)
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte("public:key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	_, err = client.Set(ctx, []byte("private:key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	userClient := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+	err = userClient.OpenSession(context.Background(), []byte(userName), []byte(userPassword), testDBName)
+	require.NoError(t, err)
+	defer userClient.CloseSession(context.Background())
+
+	userCtx := context.Background()
+
+	entry, err := userClient.Get(userCtx, []byte("public:key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), entry.Value)
+
+	_, err = userClient.Get(userCtx, []byte("private:key1"))
+	require.ErrorContains(t, err, "permission denied")
+
+	_, err = userClient.Set(userCtx, []byte("public:key2"), []byte("value2"))
+	require.ErrorContains(t, err, "permission denied")
+
+	res, err := userClient.SQLQuery(userCtx, "SELECT id FROM table1", nil, true)
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+
+	_, err = userClient.SQLQuery(userCtx, "SELECT id FROM table2", nil, true)
+	require.ErrorContains(t, err, "permission denied")
+
+	t.Run("interactive transactions should be authorized by roles", func(t *testing.T) {
+		tx, err := userClient.NewTx(userCtx)
+		require.NoError(t, err)
+
+		res, err := tx.SQLQuery(userCtx, "SELECT id FROM table1", nil)
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		err = tx.SQLExec(userCtx, "INSERT INTO table1(id) VALUES (2)", nil)
+		require.ErrorContains(t, err, "permission denied")
+
+		err = tx.Rollback(userCtx)
+		require.NoError(t, err)
+	})
+
+	t.Run("revoked privileges should no longer be granted", func(t *testing.T) {
+		err = client.ChangeRolePrivilege(ctx, schema.PermissionAction_REVOKE, "analyst", &schema.RolePrivilege{
+			Privilege: "select",
+			Database:  testDBName,
+			Object:    "table1",
+		})
+		require.NoError(t, err)
+
+		_, err = userClient.SQLQuery(userCtx, "SELECT id FROM table1", nil, true)
+		require.ErrorContains(t, err, "permission denied")
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRotatedSigningKeyServer(t *testing.T) *servertest.BufconnServer {
+	rootSigner, err := signer.NewSigner("./../../test/signer/ed25519.key")
+	require.NoError(t, err)
+
+	rotatedPK, err := signer.ParsePublicKeyFile("./../../test/signer/ed25519_rotated.pub")
+	require.NoError(t, err)
+
+	rotation, err := signer.RotateKey(rootSigner, rotatedPK)
+	require.NoError(t, err)
+
+	keyChainFile := filepath.Join(t.TempDir(), "keychain.json")
+
+	err = signer.WriteKeyChainFile(keyChainFile, []*signer.KeyRotation{rotation})
+	require.NoError(t, err)
+
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(filepath.Join(t.TempDir(), "data")).
+		WithAuth(true).
+		WithSigningKey("./../../test/signer/ed25519_rotated.key").
+		WithSigningKeyChain(keyChainFile),
+	)
+
+	bs.Start()
+	t.Cleanup(func() { bs.Stop() })
+
+	return bs
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	bs := setupRotatedSigningKeyServer(t)
+
+	rootPK, err := signer.ParsePublicKeyFile("./../../test/signer/ed25519.pub")
+	require.NoError(t, err)
+
+	t.Run("the signing key chain should be provided by the server", func(t *testing.T) {
+		client := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+		err := client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+		require.NoError(t, err)
+		defer client.CloseSession(context.Background())
+
+		keyChain, err := client.GetServiceClient().SigningKeyChain(context.Background(), &empty.Empty{})
+		require.NoError(t, err)
+		require.Len(t, keyChain.Rotations, 1)
+
+		chained, err := signer.VerifyKeyChain(rootPK, schema.SigningKeyRotationsFromProto(keyChain.Rotations), keyChain.Rotations[0].PublicKey)
+		require.NoError(t, err)
+		require.True(t, chained)
+	})
+
+	t.Run("states signed with the rotated key should be accepted by clients trusting the root key", func(t *testing.T) {
+		client := bs.NewClient(ic.
+			DefaultOptions().
+			WithDir(t.TempDir()).
+			WithServerSigningPubKey("./../../test/signer/ed25519.pub"),
+		)
+
+		err := client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+		require.NoError(t, err)
+		defer client.CloseSession(context.Background())
+
+		ctx := context.Background()
+
+		_, err = client.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+		require.NoError(t, err)
+
+		entry, err := client.VerifiedGet(ctx, []byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), entry.Value)
+
+		_, err = client.CurrentState(ctx)
+		require.NoError(t, err)
+
+		bundle, err := client.ExportProofBundle(ctx, []byte("key1"))
+		require.NoError(t, err)
+		require.Len(t, bundle.SigningKeyChain, 1)
+
+		err = bundle.Verify(rootPK)
+		require.NoError(t, err)
+	})
+
+	t.Run("states signed with the rotated key should be rejected by clients trusting another key", func(t *testing.T) {
+		client := bs.NewClient(ic.
+			DefaultOptions().
+			WithDir(t.TempDir()).
+			WithServerSigningPubKey("./../../test/signer/ec1.pub"),
+		)
+
+		err := client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+		require.NoError(t, err)
+		defer client.CloseSession(context.Background())
+
+		_, err = client.CurrentState(context.Background())
+		require.ErrorContains(t, err, "unable to verify signature")
+	})
+}
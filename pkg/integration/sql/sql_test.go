@@ -186,6 +186,42 @@ func TestImmuClient_SQL(t *testing.T) {
 		require.Equal(t, "title2", res.Rows[0].Values[0].GetS())
 	})
 
+	t.Run("verified query", func(t *testing.T) {
+		res, err := client.VerifiedSQLQuery(ctx, "SELECT id, title, active FROM table1 WHERE id <= @id", params)
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+		require.Equal(t, "title1", res.Rows[0].Values[1].GetS())
+
+		res, err = client.VerifiedSQLQuery(ctx, "SELECT id, title FROM table1", nil)
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 3)
+		require.Equal(t, "title2-updated", res.Rows[1].Values[1].GetS())
+
+		_, err = client.VerifiedSQLQuery(ctx, "SELECT title FROM table1", nil)
+		require.ErrorContains(t, err, "primary key column 'id' must be selected")
+
+		_, err = client.VerifiedSQLQuery(ctx, "SELECT COUNT(*) FROM table1", nil)
+		require.ErrorContains(t, err, "illegal arguments")
+
+		t.Run("tampered rows should not pass verification", func(t *testing.T) {
+			bs.Server.PostVerifiableSQLQueryFn = func(ctx context.Context, req *schema.VerifiableSQLQueryRequest,
+				vRes *schema.VerifiableSQLQueryResult, err error) (*schema.VerifiableSQLQueryResult, error) {
+				if err != nil {
+					return vRes, err
+				}
+
+				vRes.Result.Rows[0].Values[1] = &schema.SQLValue{Value: &schema.SQLValue_S{S: "tampered title"}}
+				vRes.SqlEntries[0].Value = vRes.SqlEntries[1].Value
+
+				return vRes, nil
+			}
+			defer func() { bs.Server.PostVerifiableSQLQueryFn = nil }()
+
+			_, err = client.VerifiedSQLQuery(ctx, "SELECT id, title FROM table1", nil)
+			require.ErrorIs(t, err, sql.ErrCorruptedData)
+		})
+	})
+
 	t.Run("verify row after alter table", func(t *testing.T) {
 		t.Run("not a primary key", func(t *testing.T) {
 			_, err := client.SQLExec(ctx, `
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/codenotary/immudb/pkg/tsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampAnchoring(t *testing.T) {
+	authority, err := tsa.GenerateLocalAuthority()
+	require.NoError(t, err)
+
+	tsaSrv := httptest.NewServer(authority)
+	defer tsaSrv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(authority.Certificate())
+
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(filepath.Join(t.TempDir(), "data")).
+		WithAuth(true).
+		WithTimestampAuthorityURL(tsaSrv.URL).
+		WithTimestampAuthorityInterval(50 * time.Millisecond),
+	)
+
+	bs.Start()
+	t.Cleanup(func() { bs.Stop() })
+
+	client := bs.NewClient(ic.DefaultOptions().WithDir(t.TempDir()))
+
+	err = client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	ctx := context.Background()
+
+	hdr, err := client.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	var anchor *schema.TimestampAnchor
+	var token *tsa.Token
+
+	require.Eventually(t, func() bool {
+		anchor, token, err = client.VerifiedTimestampAnchor(ctx, hdr.Id, roots)
+		return err == nil
+	}, 10*time.Second, 50*time.Millisecond)
+
+	require.GreaterOrEqual(t, anchor.AnchoredTx, hdr.Id)
+	require.Greater(t, anchor.Tx, anchor.AnchoredTx)
+	require.WithinDuration(t, time.Now(), token.GenTime, time.Minute)
+
+	t.Run("anchored transactions should be stamped with the anchor", func(t *testing.T) {
+		tx, err := client.TxByID(ctx, anchor.Tx)
+		require.NoError(t, err)
+		require.Equal(t, anchor.AnchoredTx, tx.Header.Metadata.TimestampAnchoredTxID)
+	})
+
+	t.Run("tokens issued by untrusted authorities should be rejected", func(t *testing.T) {
+		other, err := tsa.GenerateLocalAuthority()
+		require.NoError(t, err)
+
+		otherRoots := x509.NewCertPool()
+		otherRoots.AddCert(other.Certificate())
+
+		_, _, err = client.VerifiedTimestampAnchor(ctx, hdr.Id, otherRoots)
+		require.ErrorIs(t, err, tsa.ErrInvalidToken)
+	})
+
+	t.Run("transactions after the latest anchor should not be covered", func(t *testing.T) {
+		_, _, err := client.VerifiedTimestampAnchor(ctx, anchor.Tx+1000, roots)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, store.ErrCorruptedData)
+	})
+}
@@ -123,6 +123,58 @@ func TestTransaction_Rollback(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTransaction_Savepoints(t *testing.T) {
+	_, client := setupTest(t)
+
+	_, err := client.SQLExec(context.Background(), "CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id);", nil)
+	require.NoError(t, err)
+
+	tx, err := client.NewTx(context.Background())
+	require.NoError(t, err)
+
+	err = tx.SQLExec(context.Background(), "INSERT INTO table1(id, title) VALUES (1, 'title1')", nil)
+	require.NoError(t, err)
+
+	err = tx.Savepoint(context.Background(), "sp1")
+	require.NoError(t, err)
+
+	err = tx.SQLExec(context.Background(), "INSERT INTO table1(id, title) VALUES (2, 'title2')", nil)
+	require.NoError(t, err)
+
+	err = tx.SQLExec(context.Background(), "INSERT INTO table1(id, title) VALUES (1, 'title1')", nil)
+	require.ErrorContains(t, err, "key already exists")
+
+	_, err = tx.SQLQuery(context.Background(), "SELECT * FROM table1", nil)
+	require.ErrorContains(t, err, "transaction aborted")
+
+	err = tx.RollbackToSavepoint(context.Background(), "sp2")
+	require.ErrorContains(t, err, "savepoint does not exist")
+
+	err = tx.RollbackToSavepoint(context.Background(), "sp1")
+	require.NoError(t, err)
+
+	err = tx.SQLExec(context.Background(), "SAVEPOINT sp2; INSERT INTO table1(id, title) VALUES (3, 'title3')", nil)
+	require.NoError(t, err)
+
+	err = tx.ReleaseSavepoint(context.Background(), "sp1")
+	require.NoError(t, err)
+
+	err = tx.RollbackToSavepoint(context.Background(), "sp2")
+	require.ErrorContains(t, err, "savepoint does not exist")
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	res, err := client.SQLQuery(context.Background(), "SELECT id FROM table1", nil, true)
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 2)
+	require.Equal(t, int64(1), res.Rows[0].Values[0].GetN())
+	require.Equal(t, int64(3), res.Rows[1].Values[0].GetN())
+
+	err = tx.Savepoint(context.Background(), "sp1")
+	require.ErrorContains(t, err, "no transaction found")
+}
+
 func TestTransaction_MultipleReadWriteTransactions(t *testing.T) {
 	_, client := setupTest(t)
 
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	ic "github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/client/auditor"
+	"github.com/codenotary/immudb/pkg/client/cache"
+	"github.com/codenotary/immudb/pkg/client/state"
+	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func runWitness(t *testing.T, bs *servertest.BufconnServer, witnessSigningKey string) {
+	witnessSigner, err := signer.NewSigner(witnessSigningKey)
+	require.NoError(t, err)
+
+	serverSigningPubKey, err := signer.ParsePublicKeyFile("./../../test/signer/ec1.pub")
+	require.NoError(t, err)
+
+	ds := []grpc.DialOption{
+		grpc.WithContextDialer(bs.Dialer), grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+
+	clientConn, err := grpc.Dial("add", ds...)
+	require.NoError(t, err)
+	defer clientConn.Close()
+
+	serviceClient := schema.NewImmuServiceClient(clientConn)
+
+	da, err := auditor.DefaultAuditor(
+		time.Duration(0),
+		fmt.Sprintf("%s:%d", "address", 0),
+		ds,
+		"immudb",
+		"immudb",
+		[]string{"defaultdb"},
+		serverSigningPubKey,
+		witnessSigner,
+		auditor.AuditNotificationConfig{},
+		serviceClient,
+		state.NewUUIDProvider(serviceClient),
+		cache.NewHistoryFileCache(t.TempDir()),
+		func(string, string, bool, bool, bool, *schema.ImmutableState, *schema.ImmutableState) {},
+		logger.NewSimpleLogger("test", os.Stdout),
+		nil,
+	)
+	require.NoError(t, err)
+
+	auditorDone := make(chan struct{}, 2)
+	err = da.Run(time.Duration(10), true, context.Background().Done(), auditorDone)
+	require.NoError(t, err)
+}
+
+func TestWitnessCosigning(t *testing.T) {
+	bs := servertest.NewBufconnServer(server.
+		DefaultOptions().
+		WithDir(filepath.Join(t.TempDir(), "data")).
+		WithAuth(true).
+		WithSigningKey("./../../test/signer/ec1.key"),
+	)
+
+	bs.Start()
+	t.Cleanup(func() { bs.Stop() })
+
+	witnessPubKeys := []string{
+		"./../../test/signer/ed25519.pub",
+		"./../../test/signer/ec3.pub",
+	}
+
+	newClient := func(t *testing.T, minWitnessCosignatures int) ic.ImmuClient {
+		client := bs.NewClient(ic.
+			DefaultOptions().
+			WithDir(t.TempDir()).
+			WithServerSigningPubKey("./../../test/signer/ec1.pub").
+			WithWitnessPubKeys(witnessPubKeys).
+			WithMinWitnessCosignatures(minWitnessCosignatures),
+		)
+
+		err := client.OpenSession(context.Background(), []byte(`immudb`), []byte(`immudb`), "defaultdb")
+		require.NoError(t, err)
+		t.Cleanup(func() { client.CloseSession(context.Background()) })
+
+		return client
+	}
+
+	ctx := context.Background()
+
+	writer := newClient(t, 0)
+
+	_, err := writer.VerifiedSet(ctx, []byte("key1"), []byte("value1"))
+	require.NoError(t, err)
+
+	t.Run("states not cosigned by enough witnesses should be rejected", func(t *testing.T) {
+		client := newClient(t, 1)
+
+		_, err := client.CurrentState(ctx)
+		require.ErrorContains(t, err, "state cosigned by 0 trusted witnesses while at least 1 are required")
+	})
+
+	runWitness(t, bs, "./../../test/signer/ed25519.key")
+
+	t.Run("states cosigned by enough witnesses should be accepted", func(t *testing.T) {
+		client := newClient(t, 1)
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Len(t, state.WitnessSignatures, 1)
+
+		_, err = newClient(t, 2).CurrentState(ctx)
+		require.ErrorContains(t, err, "state cosigned by 1 trusted witnesses while at least 2 are required")
+	})
+
+	// witnesses not trusted by the client are not counted
+	runWitness(t, bs, "./../../test/signer/ed25519_rotated.key")
+	runWitness(t, bs, "./../../test/signer/ec3.key")
+
+	t.Run("states cosigned by all the trusted witnesses should be accepted", func(t *testing.T) {
+		client := newClient(t, 2)
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Len(t, state.WitnessSignatures, 3)
+
+		entry, err := client.VerifiedGet(ctx, []byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), entry.Value)
+	})
+
+	t.Run("the latest cosigned state should be used when newer states are not cosigned yet", func(t *testing.T) {
+		cosignedState, err := newClient(t, 2).CurrentState(ctx)
+		require.NoError(t, err)
+
+		_, err = writer.VerifiedSet(ctx, []byte("key1"), []byte("value2"))
+		require.NoError(t, err)
+
+		client := newClient(t, 2)
+
+		state, err := client.CurrentState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, cosignedState.TxId, state.TxId)
+		require.Equal(t, cosignedState.TxHash, state.TxHash)
+
+		entry, err := client.VerifiedGet(ctx, []byte("key1"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), entry.Value)
+	})
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ldap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// classes and flags of the BER identifiers used by the LDAP protocol
+const (
+	classUniversal   = 0x00
+	classApplication = 0x40
+	classContext     = 0x80
+
+	constructedFlag = 0x20
+)
+
+// universal tags
+const (
+	tagBoolean     = 0x01
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagEnumerated  = 0x0a
+	tagSequence    = 0x10
+	tagSet         = 0x11
+)
+
+// maxPacketSize bounds the size of the LDAP messages read from the network
+const maxPacketSize = 1 << 20
+
+// maxPacketDepth bounds the nesting of the elements of the LDAP messages read from the network
+const maxPacketDepth = 32
+
+var ErrMalformedPacket = errors.New("malformed ldap packet")
+
+// packet is a BER encoded element. Primitive elements hold their contents in value,
+// constructed ones are made of the elements in children
+type packet struct {
+	class       byte
+	constructed bool
+	tag         byte
+	value       []byte
+	children    []*packet
+}
+
+func newPrimitive(class, tag byte, value []byte) *packet {
+	return &packet{class: class, tag: tag, value: value}
+}
+
+func newConstructed(class, tag byte, children ...*packet) *packet {
+	return &packet{class: class, constructed: true, tag: tag, children: children}
+}
+
+func newSequence(children ...*packet) *packet {
+	return newConstructed(classUniversal, tagSequence, children...)
+}
+
+func newSet(children ...*packet) *packet {
+	return newConstructed(classUniversal, tagSet, children...)
+}
+
+func newOctetString(s string) *packet {
+	return newPrimitive(classUniversal, tagOctetString, []byte(s))
+}
+
+func newInteger(v int64) *packet {
+	return newPrimitive(classUniversal, tagInteger, encodeInteger(v))
+}
+
+func newEnumerated(v int64) *packet {
+	return newPrimitive(classUniversal, tagEnumerated, encodeInteger(v))
+}
+
+func newBoolean(b bool) *packet {
+	if b {
+		return newPrimitive(classUniversal, tagBoolean, []byte{0xff})
+	}
+	return newPrimitive(classUniversal, tagBoolean, []byte{0x00})
+}
+
+func (p *packet) is(class, tag byte) bool {
+	return p.class == class && p.tag == tag
+}
+
+func (p *packet) str() string {
+	return string(p.value)
+}
+
+func (p *packet) int() (int64, error) {
+	if p.constructed || len(p.value) == 0 || len(p.value) > 8 {
+		return 0, fmt.Errorf("%w: invalid integer", ErrMalformedPacket)
+	}
+
+	v := int64(int8(p.value[0]))
+	for _, b := range p.value[1:] {
+		v = v<<8 | int64(b)
+	}
+
+	return v, nil
+}
+
+func (p *packet) bool() (bool, error) {
+	if p.constructed || len(p.value) != 1 {
+		return false, fmt.Errorf("%w: invalid boolean", ErrMalformedPacket)
+	}
+	return p.value[0] != 0, nil
+}
+
+func (p *packet) bytes() []byte {
+	content := p.value
+
+	if p.constructed {
+		var buf bytes.Buffer
+		for _, child := range p.children {
+			buf.Write(child.bytes())
+		}
+		content = buf.Bytes()
+	}
+
+	id := p.class | p.tag
+	if p.constructed {
+		id |= constructedFlag
+	}
+
+	b := append([]byte{id}, encodeLength(len(content))...)
+
+	return append(b, content...)
+}
+
+func encodeLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+
+	var b []byte
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+// encodeInteger returns the minimal two's complement encoding of v
+func encodeInteger(v int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+
+	for len(b) > 1 && ((b[0] == 0x00 && b[1]&0x80 == 0) || (b[0] == 0xff && b[1]&0x80 != 0)) {
+		b = b[1:]
+	}
+
+	return b
+}
+
+// readPacket reads a BER encoded element. Only the definite length form is supported
+func readPacket(r io.Reader) (*packet, error) {
+	return readPacketAt(r, 0)
+}
+
+func readPacketAt(r io.Reader, depth int) (*packet, error) {
+	if depth > maxPacketDepth {
+		return nil, fmt.Errorf("%w: packet exceeds the maximum depth", ErrMalformedPacket)
+	}
+
+	var header [2]byte
+
+	_, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return nil, err
+	}
+
+	id := header[0]
+
+	if id&0x1f == 0x1f {
+		return nil, fmt.Errorf("%w: high tag numbers are not supported", ErrMalformedPacket)
+	}
+
+	length := int(header[1])
+
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 {
+			return nil, fmt.Errorf("%w: unsupported length encoding", ErrMalformedPacket)
+		}
+
+		lb := make([]byte, n)
+
+		_, err = io.ReadFull(r, lb)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+
+		length = 0
+		for _, b := range lb {
+			length = length<<8 | int(b)
+		}
+	}
+
+	if length < 0 || length > maxPacketSize {
+		return nil, fmt.Errorf("%w: packet exceeds the maximum size", ErrMalformedPacket)
+	}
+
+	content := make([]byte, length)
+
+	_, err = io.ReadFull(r, content)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	p := &packet{
+		class:       id & 0xc0,
+		constructed: id&constructedFlag != 0,
+		tag:         id & 0x1f,
+	}
+
+	if !p.constructed {
+		p.value = content
+		return p, nil
+	}
+
+	cr := bytes.NewReader(content)
+
+	for cr.Len() > 0 {
+		child, err := readPacketAt(cr, depth+1)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+
+		p.children = append(p.children, child)
+	}
+
+	return p, nil
+}
+
+// unexpectedEOF reports a truncated packet as malformed, as the end of input is only expected between packets
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: truncated packet", ErrMalformedPacket)
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ldap
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func addPacketSeeds(f *testing.F) {
+	entry := &Entry{
+		DN: "uid=jane,ou=people,dc=example,dc=com",
+		Attributes: map[string][]string{
+			"uid":  {"jane"},
+			"mail": {"jane@example.com", "j@example.com"},
+		},
+	}
+
+	filter, err := compileFilter("(&(uid=jane)(|(mail=*)(!(cn=admin))))")
+	require.NoError(f, err)
+
+	for _, p := range []*packet{
+		newInteger(-129),
+		newBoolean(true),
+		newOctetString(string(make([]byte, 200))),
+		newSequence(newInteger(1), newSearchResultEntry(entry, nil)),
+		newSequence(newInteger(2), newResult(opSearchResultDone, ResultSizeLimitExceeded, "limit")),
+		newSequence(newInteger(3), newConstructed(classApplication, opBindRequest,
+			newInteger(protocolVersion),
+			newOctetString("cn=admin,dc=example,dc=com"),
+			newPrimitive(classContext, 0, []byte("adminpwd")),
+		)),
+		filter,
+	} {
+		f.Add(p.bytes())
+	}
+
+	f.Add([]byte{0x30, 0x80, 0x00, 0x00})
+	f.Add([]byte{0x30, 0x84, 0xff, 0xff, 0xff, 0xff})
+	f.Add([]byte{0x1f, 0x01, 0x00})
+}
+
+func FuzzReadPacket(f *testing.F) {
+	addPacketSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, err := readPacket(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		p.int()
+		p.bool()
+		p.str()
+
+		// the encoding of decoded packets is canonical, so it must be decoded back to the same packet
+		encoded := p.bytes()
+
+		decoded, err := readPacket(bytes.NewReader(encoded))
+		require.NoError(t, err)
+		require.Equal(t, encoded, decoded.bytes())
+	})
+}
+
+func FuzzParseEntry(f *testing.F) {
+	addPacketSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, err := readPacket(bytes.NewReader(data))
+		if err != nil {
+			return
+		}
+
+		entry, err := parseEntry(p)
+		if err == nil {
+			require.NotNil(t, entry)
+			require.NotNil(t, entry.Attributes)
+		}
+
+		parseResult(p, opSearchResultDone)
+
+		if len(p.children) == 2 {
+			parseEntry(p.children[1])
+			parseResult(p.children[1], opBindResponse)
+		}
+	})
+}
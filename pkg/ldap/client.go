@@ -0,0 +1,294 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ldap
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPort    = "389"
+	defaultTLSPort = "636"
+)
+
+// Conn is a connection to an LDAP server. Operations are performed one at a time
+type Conn struct {
+	conn    net.Conn
+	host    string
+	timeout time.Duration
+
+	mutex sync.Mutex
+	msgID int64
+}
+
+// Dial connects to the LDAP server at the url, either ldap://host[:port] or ldaps://host[:port].
+// The timeout bounds both the connection establishment and each of the operations.
+// Note ldap connections are not encrypted unless upgraded with StartTLS
+func Dial(serverURL string, tlsConfig *tls.Config, timeout time.Duration) (*Conn, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+
+	var conn net.Conn
+
+	switch u.Scheme {
+	case "ldap":
+		conn, err = dialer.Dial("tcp", hostPort(u, defaultPort))
+	case "ldaps":
+		conn, err = tls.DialWithDialer(dialer, "tcp", hostPort(u, defaultTLSPort), tlsConfig)
+	default:
+		return nil, fmt.Errorf("unsupported ldap url scheme '%s'", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Conn{conn: conn, host: u.Hostname(), timeout: timeout}, nil
+}
+
+func hostPort(u *url.URL, defaultPort string) string {
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+	return u.Host
+}
+
+// StartTLS upgrades the connection to TLS. The host of the url is verified
+// unless the configuration sets another server name
+func (c *Conn) StartTLS(tlsConfig *tls.Config) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.conn.(*tls.Conn); ok {
+		return fmt.Errorf("%w: tls is already established", ErrTLS)
+	}
+
+	msgID, err := c.send(newConstructed(classApplication, opExtendedRequest,
+		newPrimitive(classContext, 0, []byte(startTLSOID)),
+	))
+	if err != nil {
+		return err
+	}
+
+	op, err := c.receive(msgID)
+	if err != nil {
+		return err
+	}
+
+	err = parseResult(op, opExtendedResponse)
+	if err != nil {
+		return err
+	}
+
+	cfg := &tls.Config{}
+	if tlsConfig != nil {
+		cfg = tlsConfig.Clone()
+	}
+
+	if cfg.ServerName == "" {
+		cfg.ServerName = c.host
+	}
+
+	conn := tls.Client(c.conn, cfg)
+
+	err = conn.Handshake()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTLS, err)
+	}
+
+	c.conn = conn
+
+	return nil
+}
+
+// Bind authenticates the connection with the password of the entry named dn.
+// Note an empty password results in an unauthenticated bind, which servers may accept
+func (c *Conn) Bind(dn, password string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	msgID, err := c.send(newConstructed(classApplication, opBindRequest,
+		newInteger(protocolVersion),
+		newOctetString(dn),
+		newPrimitive(classContext, 0, []byte(password)),
+	))
+	if err != nil {
+		return err
+	}
+
+	op, err := c.receive(msgID)
+	if err != nil {
+		return err
+	}
+
+	return parseResult(op, opBindResponse)
+}
+
+// Search returns the entries selected by the request
+func (c *Conn) Search(req *SearchRequest) ([]*Entry, error) {
+	filter, err := compileFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := newSequence()
+	for _, attr := range req.Attributes {
+		attributes.children = append(attributes.children, newOctetString(attr))
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	msgID, err := c.send(newConstructed(classApplication, opSearchRequest,
+		newOctetString(req.BaseDN),
+		newEnumerated(int64(req.Scope)),
+		newEnumerated(0), // never dereference aliases
+		newInteger(int64(req.SizeLimit)),
+		newInteger(0), // no time limit
+		newBoolean(false),
+		filter,
+		attributes,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+
+	for {
+		op, err := c.receive(msgID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case op.is(classApplication, opSearchResultEntry):
+			entry, err := parseEntry(op)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, entry)
+		case op.is(classApplication, opSearchResultReference):
+			// referrals to other servers are not followed
+		default:
+			err = parseResult(op, opSearchResultDone)
+			if err != nil {
+				return nil, err
+			}
+
+			return entries, nil
+		}
+	}
+}
+
+// Close notifies the server the connection is being terminated and closes it
+func (c *Conn) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.send(newPrimitive(classApplication, opUnbindRequest, nil))
+
+	return c.conn.Close()
+}
+
+func (c *Conn) send(op *packet) (int64, error) {
+	c.msgID++
+
+	if c.timeout > 0 {
+		c.conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+
+	_, err := c.conn.Write(newSequence(newInteger(c.msgID), op).bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	return c.msgID, nil
+}
+
+func (c *Conn) receive(msgID int64) (*packet, error) {
+	msg, err := readPacket(c.conn)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.is(classUniversal, tagSequence) || len(msg.children) < 2 {
+		return nil, fmt.Errorf("%w: invalid message", ErrMalformedPacket)
+	}
+
+	id, err := msg.children[0].int()
+	if err != nil {
+		return nil, err
+	}
+
+	if id != msgID {
+		return nil, fmt.Errorf("%w: unexpected message id %d", ErrMalformedPacket, id)
+	}
+
+	return msg.children[1], nil
+}
+
+func parseResult(op *packet, tag byte) error {
+	if !op.is(classApplication, tag) || len(op.children) < 3 {
+		return fmt.Errorf("%w: unexpected response", ErrMalformedPacket)
+	}
+
+	code, err := op.children[0].int()
+	if err != nil {
+		return err
+	}
+
+	if code != ResultSuccess {
+		return &Error{ResultCode: int(code), Message: op.children[2].str()}
+	}
+
+	return nil
+}
+
+func parseEntry(op *packet) (*Entry, error) {
+	if len(op.children) < 2 {
+		return nil, fmt.Errorf("%w: invalid search result entry", ErrMalformedPacket)
+	}
+
+	entry := &Entry{
+		DN:         op.children[0].str(),
+		Attributes: make(map[string][]string),
+	}
+
+	for _, attr := range op.children[1].children {
+		if len(attr.children) < 2 {
+			return nil, fmt.Errorf("%w: invalid attribute", ErrMalformedPacket)
+		}
+
+		name := attr.children[0].str()
+
+		for _, value := range attr.children[1].children {
+			entry.Attributes[name] = append(entry.Attributes[name], value.str())
+		}
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"strings"
+	"sync"
+)
+
+// passwordAttribute is the attribute holding the passwords entries are authenticated with
+const passwordAttribute = "userPassword"
+
+// LocalDirectory is a minimal in-memory LDAP directory, meant to be used as a stand-in for an external
+// one in tests and development environments. It only supports simple binds, authenticated against
+// the plain text values of the userPassword attribute, and searches with the filters supported by the client.
+type LocalDirectory struct {
+	mutex     sync.RWMutex
+	entries   []*Entry
+	tlsConfig *tls.Config
+}
+
+// NewLocalDirectory creates an empty directory
+func NewLocalDirectory() *LocalDirectory {
+	return &LocalDirectory{}
+}
+
+// AddEntry adds an entry to the directory, replacing any existing entry with the same name
+func (d *LocalDirectory) AddEntry(dn string, attributes map[string][]string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	entry := &Entry{DN: dn, Attributes: attributes}
+
+	for i, e := range d.entries {
+		if normalizeDN(e.DN) == normalizeDN(dn) {
+			d.entries[i] = entry
+			return
+		}
+	}
+
+	d.entries = append(d.entries, entry)
+}
+
+// EnableStartTLS allows connections to be upgraded to TLS with the configuration
+func (d *LocalDirectory) EnableStartTLS(tlsConfig *tls.Config) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.tlsConfig = tlsConfig
+}
+
+// Serve serves the connections accepted by the listener, each one on its own goroutine,
+// until the listener is closed
+func (d *LocalDirectory) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go d.serveConn(conn)
+	}
+}
+
+func (d *LocalDirectory) serveConn(conn net.Conn) {
+	defer func() { conn.Close() }()
+
+	for {
+		msg, err := readPacket(conn)
+		if err != nil {
+			return
+		}
+
+		if !msg.is(classUniversal, tagSequence) || len(msg.children) < 2 {
+			return
+		}
+
+		msgID, err := msg.children[0].int()
+		if err != nil {
+			return
+		}
+
+		var responses []*packet
+
+		switch op := msg.children[1]; {
+		case op.is(classApplication, opBindRequest):
+			responses = append(responses, d.bind(op))
+		case op.is(classApplication, opSearchRequest):
+			responses = d.search(op)
+		case op.is(classApplication, opExtendedRequest):
+			resp, tlsConfig := d.extended(conn, op)
+
+			_, err = conn.Write(newSequence(newInteger(msgID), resp).bytes())
+			if err != nil {
+				return
+			}
+
+			if tlsConfig == nil {
+				continue
+			}
+
+			tlsConn := tls.Server(conn, tlsConfig)
+
+			err = tlsConn.Handshake()
+			if err != nil {
+				return
+			}
+
+			conn = tlsConn
+
+			continue
+		default:
+			return
+		}
+
+		for _, resp := range responses {
+			_, err = conn.Write(newSequence(newInteger(msgID), resp).bytes())
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// extended returns the response to the extended operation, together with the configuration
+// the connection must be upgraded with after responding to a StartTLS request
+func (d *LocalDirectory) extended(conn net.Conn, op *packet) (*packet, *tls.Config) {
+	if len(op.children) < 1 || !op.children[0].is(classContext, 0) || op.children[0].str() != startTLSOID {
+		return newResult(opExtendedResponse, ResultProtocolError, "unsupported extended operation"), nil
+	}
+
+	if _, ok := conn.(*tls.Conn); ok {
+		return newResult(opExtendedResponse, ResultOperationsError, "tls is already established"), nil
+	}
+
+	d.mutex.RLock()
+	tlsConfig := d.tlsConfig
+	d.mutex.RUnlock()
+
+	if tlsConfig == nil {
+		return newResult(opExtendedResponse, ResultUnwillingToPerform, "tls is not enabled"), nil
+	}
+
+	return newResult(opExtendedResponse, ResultSuccess, ""), tlsConfig
+}
+
+func (d *LocalDirectory) bind(op *packet) *packet {
+	if len(op.children) < 3 {
+		return newResult(opBindResponse, ResultProtocolError, "invalid bind request")
+	}
+
+	dn := op.children[1].str()
+	auth := op.children[2]
+
+	if !auth.is(classContext, 0) || auth.constructed {
+		return newResult(opBindResponse, ResultAuthMethodNotSupported, "only simple binds are supported")
+	}
+
+	password := auth.str()
+
+	if dn == "" && password == "" {
+		// anonymous bind
+		return newResult(opBindResponse, ResultSuccess, "")
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	entry := d.lookup(dn)
+
+	if entry == nil || password == "" || !containsValue(entry.Values(passwordAttribute), password) {
+		return newResult(opBindResponse, ResultInvalidCredentials, "")
+	}
+
+	return newResult(opBindResponse, ResultSuccess, "")
+}
+
+func (d *LocalDirectory) search(op *packet) []*packet {
+	if len(op.children) < 8 {
+		return []*packet{newResult(opSearchResultDone, ResultProtocolError, "invalid search request")}
+	}
+
+	baseDN := normalizeDN(op.children[0].str())
+
+	scope, err := op.children[1].int()
+	if err != nil {
+		return []*packet{newResult(opSearchResultDone, ResultProtocolError, err.Error())}
+	}
+
+	sizeLimit, err := op.children[3].int()
+	if err != nil {
+		return []*packet{newResult(opSearchResultDone, ResultProtocolError, err.Error())}
+	}
+
+	filter := op.children[6]
+
+	var attributes []string
+	for _, attr := range op.children[7].children {
+		attributes = append(attributes, attr.str())
+	}
+
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	var responses []*packet
+
+	for _, entry := range d.entries {
+		if !inScope(normalizeDN(entry.DN), baseDN, scope) {
+			continue
+		}
+
+		match, err := matchFilter(filter, entry)
+		if err != nil {
+			return []*packet{newResult(opSearchResultDone, ResultProtocolError, err.Error())}
+		}
+
+		if !match {
+			continue
+		}
+
+		if sizeLimit > 0 && int64(len(responses)) == sizeLimit {
+			return append(responses, newResult(opSearchResultDone, ResultSizeLimitExceeded, ""))
+		}
+
+		responses = append(responses, newSearchResultEntry(entry, attributes))
+	}
+
+	return append(responses, newResult(opSearchResultDone, ResultSuccess, ""))
+}
+
+func (d *LocalDirectory) lookup(dn string) *Entry {
+	for _, e := range d.entries {
+		if normalizeDN(e.DN) == normalizeDN(dn) {
+			return e
+		}
+	}
+	return nil
+}
+
+func inScope(dn, baseDN string, scope int64) bool {
+	switch scope {
+	case ScopeBaseObject:
+		return dn == baseDN
+	case ScopeSingleLevel:
+		i := strings.IndexByte(dn, ',')
+		return (i < 0 && baseDN == "") || (i >= 0 && dn[i+1:] == baseDN)
+	default:
+		return baseDN == "" || dn == baseDN || strings.HasSuffix(dn, ","+baseDN)
+	}
+}
+
+func matchFilter(filter *packet, entry *Entry) (bool, error) {
+	switch {
+	case filter.is(classContext, filterAnd):
+		for _, f := range filter.children {
+			match, err := matchFilter(f, entry)
+			if err != nil || !match {
+				return false, err
+			}
+		}
+		return true, nil
+	case filter.is(classContext, filterOr):
+		for _, f := range filter.children {
+			match, err := matchFilter(f, entry)
+			if err != nil || match {
+				return match, err
+			}
+		}
+		return false, nil
+	case filter.is(classContext, filterNot) && len(filter.children) == 1:
+		match, err := matchFilter(filter.children[0], entry)
+		return !match, err
+	case filter.is(classContext, filterEquality) && len(filter.children) == 2:
+		value := normalizeDN(filter.children[1].str())
+
+		for _, v := range entry.Values(filter.children[0].str()) {
+			if normalizeDN(v) == value {
+				return true, nil
+			}
+		}
+		return false, nil
+	case filter.is(classContext, filterPresent):
+		return strings.EqualFold(filter.str(), "objectClass") || len(entry.Values(filter.str())) > 0, nil
+	}
+
+	return false, ErrUnsupportedFilter
+}
+
+func newResult(tag byte, code int64, message string) *packet {
+	return newConstructed(classApplication, tag,
+		newEnumerated(code),
+		newOctetString(""),
+		newOctetString(message),
+	)
+}
+
+// newSearchResultEntry encodes the listed attributes of the entry, or all of them if none is listed.
+// Passwords are never returned
+func newSearchResultEntry(entry *Entry, attributes []string) *packet {
+	attrs := newSequence()
+
+	for _, name := range entry.attributeNames() {
+		if strings.EqualFold(name, passwordAttribute) {
+			continue
+		}
+
+		if len(attributes) > 0 && !containsFold(attributes, name) && !containsValue(attributes, "*") {
+			continue
+		}
+
+		values := newSet()
+		for _, v := range entry.Attributes[name] {
+			values.children = append(values.children, newOctetString(v))
+		}
+
+		attrs.children = append(attrs.children, newSequence(newOctetString(name), values))
+	}
+
+	return newConstructed(classApplication, opSearchResultEntry, newOctetString(entry.DN), attrs)
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ldap implements the subset of the LDAPv3 protocol (RFC 4511) needed to authenticate users
+// with simple binds and to look up their entries and groups, together with a minimal in-memory
+// directory to be used as a stand-in for an external one.
+package ldap
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// protocol operations, tagged with the application class
+const (
+	opBindRequest           = 0
+	opBindResponse          = 1
+	opUnbindRequest         = 2
+	opSearchRequest         = 3
+	opSearchResultEntry     = 4
+	opSearchResultDone      = 5
+	opSearchResultReference = 19
+	opExtendedRequest       = 23
+	opExtendedResponse      = 24
+)
+
+// startTLSOID names the extended operation upgrading connections to TLS (RFC 4511, section 4.14)
+const startTLSOID = "1.3.6.1.4.1.1466.20037"
+
+// filter choices, tagged with the context-specific class
+const (
+	filterAnd      = 0
+	filterOr       = 1
+	filterNot      = 2
+	filterEquality = 3
+	filterPresent  = 7
+)
+
+// protocolVersion is the version of the protocol sent in bind requests
+const protocolVersion = 3
+
+// Result codes of the operations
+const (
+	ResultSuccess                 = 0
+	ResultOperationsError         = 1
+	ResultProtocolError           = 2
+	ResultSizeLimitExceeded       = 4
+	ResultAuthMethodNotSupported  = 7
+	ResultNoSuchObject            = 32
+	ResultInvalidCredentials      = 49
+	ResultInsufficientAccessRight = 50
+	ResultUnwillingToPerform      = 53
+)
+
+// Search scopes
+const (
+	ScopeBaseObject   = 0
+	ScopeSingleLevel  = 1
+	ScopeWholeSubtree = 2
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+var ErrUnsupportedFilter = errors.New("unsupported search filter")
+var ErrTLS = errors.New("ldap tls negotiation failed")
+
+// Error is the result of an operation the server did not complete successfully
+type Error struct {
+	ResultCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ldap operation failed with result code %d", e.ResultCode)
+	}
+	return fmt.Sprintf("ldap operation failed with result code %d: %s", e.ResultCode, e.Message)
+}
+
+func (e *Error) Is(target error) bool {
+	return target == ErrInvalidCredentials && e.ResultCode == ResultInvalidCredentials
+}
+
+// SearchRequest selects the entries under BaseDN, within Scope, matching Filter.
+// Only the listed attributes of the entries are returned, or all of them if none is listed
+type SearchRequest struct {
+	BaseDN     string
+	Scope      int
+	Filter     string
+	Attributes []string
+	SizeLimit  int
+}
+
+// Entry is an entry of the directory
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Values returns the values of the attribute, whose name is matched case-insensitively
+func (e *Entry) Values(attribute string) []string {
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+func (e *Entry) attributeNames() []string {
+	names := make([]string, 0, len(e.Attributes))
+	for name := range e.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EscapeFilter escapes the characters with a special meaning in search filters, as defined by RFC 4515.
+// Values embedded in filters must always be escaped, as otherwise they could alter the filter
+func EscapeFilter(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '(', ')', '*', '\\', 0:
+			fmt.Fprintf(&b, "\\%02x", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+func unescapeFilterValue(s string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if i+2 >= len(s) {
+			return "", fmt.Errorf("%w: invalid escape sequence", ErrUnsupportedFilter)
+		}
+
+		c, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("%w: invalid escape sequence", ErrUnsupportedFilter)
+		}
+
+		b.Write(c)
+		i += 2
+	}
+
+	return b.String(), nil
+}
+
+// compileFilter encodes the string representation of a search filter.
+// Only conjunctions, disjunctions, negations, equality and presence filters are supported
+func compileFilter(filter string) (*packet, error) {
+	if filter == "" {
+		return newPrimitive(classContext, filterPresent, []byte("objectClass")), nil
+	}
+
+	p, rest, err := parseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		return nil, fmt.Errorf("%w: unexpected '%s'", ErrUnsupportedFilter, rest)
+	}
+
+	return p, nil
+}
+
+func parseFilter(s string) (*packet, string, error) {
+	if len(s) < 2 || s[0] != '(' {
+		return nil, "", fmt.Errorf("%w: filters must be enclosed in parentheses", ErrUnsupportedFilter)
+	}
+
+	s = s[1:]
+
+	switch s[0] {
+	case '&', '|', '!':
+		tag := byte(filterAnd)
+		if s[0] == '|' {
+			tag = filterOr
+		} else if s[0] == '!' {
+			tag = filterNot
+		}
+
+		s = s[1:]
+
+		var children []*packet
+
+		for strings.HasPrefix(s, "(") {
+			var child *packet
+			var err error
+
+			child, s, err = parseFilter(s)
+			if err != nil {
+				return nil, "", err
+			}
+
+			children = append(children, child)
+		}
+
+		if !strings.HasPrefix(s, ")") {
+			return nil, "", fmt.Errorf("%w: unterminated filter", ErrUnsupportedFilter)
+		}
+
+		if len(children) == 0 || (tag == filterNot && len(children) != 1) {
+			return nil, "", fmt.Errorf("%w: unexpected number of nested filters", ErrUnsupportedFilter)
+		}
+
+		return newConstructed(classContext, tag, children...), s[1:], nil
+	}
+
+	end := strings.IndexByte(s, ')')
+	if end < 0 {
+		return nil, "", fmt.Errorf("%w: unterminated filter", ErrUnsupportedFilter)
+	}
+
+	item := s[:end]
+
+	eq := strings.IndexByte(item, '=')
+	if eq <= 0 {
+		return nil, "", fmt.Errorf("%w: '%s'", ErrUnsupportedFilter, item)
+	}
+
+	attribute := item[:eq]
+	value := item[eq+1:]
+
+	if strings.ContainsAny(attribute, "~<>:()") {
+		return nil, "", fmt.Errorf("%w: '%s'", ErrUnsupportedFilter, item)
+	}
+
+	if value == "*" {
+		return newPrimitive(classContext, filterPresent, []byte(attribute)), s[end+1:], nil
+	}
+
+	if strings.Contains(value, "*") {
+		return nil, "", fmt.Errorf("%w: substring filters are not supported", ErrUnsupportedFilter)
+	}
+
+	value, err := unescapeFilterValue(value)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return newConstructed(classContext, filterEquality, newOctetString(attribute), newOctetString(value)), s[end+1:], nil
+}
+
+// normalizeDN lowercases the distinguished name, removing the spaces surrounding its separators
+func normalizeDN(dn string) string {
+	rdns := strings.Split(dn, ",")
+
+	for i, rdn := range rdns {
+		parts := strings.SplitN(rdn, "=", 2)
+		for j := range parts {
+			parts[j] = strings.TrimSpace(parts[j])
+		}
+		rdns[i] = strings.ToLower(strings.Join(parts, "="))
+	}
+
+	return strings.Join(rdns, ",")
+}
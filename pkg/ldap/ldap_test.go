@@ -0,0 +1,340 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ldap
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func startLocalDirectory(t *testing.T) (*LocalDirectory, string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	dir := NewLocalDirectory()
+	go dir.Serve(l)
+
+	dir.AddEntry("cn=admin,dc=example,dc=com", map[string][]string{
+		"objectClass":  {"person"},
+		"cn":           {"admin"},
+		"userPassword": {"adminpwd"},
+	})
+	dir.AddEntry("uid=jane,ou=people,dc=example,dc=com", map[string][]string{
+		"objectClass":  {"inetOrgPerson"},
+		"uid":          {"jane"},
+		"mail":         {"jane@example.com"},
+		"userPassword": {"janepwd"},
+	})
+	dir.AddEntry("uid=john,ou=people,dc=example,dc=com", map[string][]string{
+		"objectClass":  {"inetOrgPerson"},
+		"uid":          {"john"},
+		"userPassword": {"johnpwd"},
+	})
+	dir.AddEntry("cn=engineers,ou=groups,dc=example,dc=com", map[string][]string{
+		"objectClass": {"groupOfNames"},
+		"cn":          {"engineers"},
+		"member":      {"uid=jane,ou=people,dc=example,dc=com", "uid=john, ou=people, dc=example, dc=com"},
+	})
+	dir.AddEntry("cn=dbas,ou=groups,dc=example,dc=com", map[string][]string{
+		"objectClass": {"groupOfNames"},
+		"cn":          {"dbas"},
+		"member":      {"uid=jane,ou=people,dc=example,dc=com"},
+	})
+
+	return dir, "ldap://" + l.Addr().String()
+}
+
+func TestBER(t *testing.T) {
+	for _, v := range []int64{0, 1, 127, 128, 255, 256, -1, -128, -129, 1 << 40, -(1 << 40)} {
+		p := newInteger(v)
+
+		decoded, err := readPacket(bytes.NewReader(p.bytes()))
+		require.NoError(t, err)
+
+		i, err := decoded.int()
+		require.NoError(t, err)
+		require.Equal(t, v, i)
+	}
+
+	longValue := newOctetString(string(make([]byte, 1000)))
+
+	decoded, err := readPacket(bytes.NewReader(newSequence(longValue, newBoolean(true)).bytes()))
+	require.NoError(t, err)
+	require.True(t, decoded.is(classUniversal, tagSequence))
+	require.Len(t, decoded.children, 2)
+	require.Len(t, decoded.children[0].value, 1000)
+
+	b, err := decoded.children[1].bool()
+	require.NoError(t, err)
+	require.True(t, b)
+
+	t.Run("truncated packets should be rejected", func(t *testing.T) {
+		encoded := newSequence(longValue).bytes()
+
+		_, err := readPacket(bytes.NewReader(encoded[:len(encoded)-1]))
+		require.ErrorIs(t, err, ErrMalformedPacket)
+	})
+
+	t.Run("indefinite lengths should be rejected", func(t *testing.T) {
+		_, err := readPacket(bytes.NewReader([]byte{0x30, 0x80, 0x00, 0x00}))
+		require.ErrorIs(t, err, ErrMalformedPacket)
+	})
+
+	t.Run("deeply nested packets should be rejected", func(t *testing.T) {
+		p := newBoolean(true)
+		for i := 0; i < maxPacketDepth; i++ {
+			p = newSequence(p)
+		}
+
+		_, err := readPacket(bytes.NewReader(p.bytes()))
+		require.NoError(t, err)
+
+		_, err = readPacket(bytes.NewReader(newSequence(p).bytes()))
+		require.ErrorIs(t, err, ErrMalformedPacket)
+	})
+}
+
+func TestFilters(t *testing.T) {
+	entry := &Entry{
+		DN: "uid=jane,ou=people,dc=example,dc=com",
+		Attributes: map[string][]string{
+			"uid":  {"jane"},
+			"mail": {"jane@example.com"},
+		},
+	}
+
+	for _, tc := range []struct {
+		filter string
+		match  bool
+	}{
+		{"(uid=jane)", true},
+		{"(UID=Jane)", true},
+		{"(uid=john)", false},
+		{"(mail=*)", true},
+		{"(cn=*)", false},
+		{"(&(uid=jane)(mail=jane@example.com))", true},
+		{"(&(uid=jane)(mail=john@example.com))", false},
+		{"(|(uid=john)(mail=jane@example.com))", true},
+		{"(!(uid=john))", true},
+		{"(uid=" + EscapeFilter("jane)(uid=*") + ")", false},
+	} {
+		f, err := compileFilter(tc.filter)
+		require.NoError(t, err, tc.filter)
+
+		match, err := matchFilter(f, entry)
+		require.NoError(t, err)
+		require.Equal(t, tc.match, match, tc.filter)
+	}
+
+	for _, filter := range []string{
+		"uid=jane",
+		"(uid=jane",
+		"(uid=ja*)",
+		"(uid>=jane)",
+		"(uid=jane)(uid=john)",
+		"(!(uid=jane)(uid=john))",
+		"(&)",
+		"(uid=\\2)",
+	} {
+		_, err := compileFilter(filter)
+		require.ErrorIs(t, err, ErrUnsupportedFilter, filter)
+	}
+
+	require.Equal(t, "jane\\29\\28uid=\\2a\\5c", EscapeFilter("jane)(uid=*\\"))
+}
+
+func TestClient(t *testing.T) {
+	_, url := startLocalDirectory(t)
+
+	conn, err := Dial(url, nil, time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	t.Run("binds should be authenticated", func(t *testing.T) {
+		err := conn.Bind("uid=jane,ou=people,dc=example,dc=com", "janepwd")
+		require.NoError(t, err)
+
+		err = conn.Bind("UID=jane, OU=people, DC=example, DC=com", "janepwd")
+		require.NoError(t, err)
+
+		err = conn.Bind("uid=jane,ou=people,dc=example,dc=com", "johnpwd")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+
+		err = conn.Bind("uid=unknown,ou=people,dc=example,dc=com", "janepwd")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+
+		err = conn.Bind("uid=jane,ou=people,dc=example,dc=com", "")
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+
+		err = conn.Bind("", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("searches should return the matching entries", func(t *testing.T) {
+		err := conn.Bind("cn=admin,dc=example,dc=com", "adminpwd")
+		require.NoError(t, err)
+
+		entries, err := conn.Search(&SearchRequest{
+			BaseDN: "ou=people,dc=example,dc=com",
+			Scope:  ScopeWholeSubtree,
+			Filter: "(uid=" + EscapeFilter("jane") + ")",
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "uid=jane,ou=people,dc=example,dc=com", entries[0].DN)
+		require.Equal(t, []string{"jane@example.com"}, entries[0].Values("MAIL"))
+		require.Empty(t, entries[0].Values("userPassword"))
+
+		entries, err = conn.Search(&SearchRequest{
+			BaseDN:     "dc=example,dc=com",
+			Scope:      ScopeWholeSubtree,
+			Filter:     "(&(objectClass=groupOfNames)(member=uid=jane,ou=people,dc=example,dc=com))",
+			Attributes: []string{"cn"},
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, []string{"engineers"}, entries[0].Values("cn"))
+		require.Equal(t, []string{"dbas"}, entries[1].Values("cn"))
+		require.Empty(t, entries[0].Values("member"))
+
+		entries, err = conn.Search(&SearchRequest{
+			BaseDN: "dc=example,dc=com",
+			Scope:  ScopeWholeSubtree,
+			Filter: "(member=uid=john,ou=people,dc=example,dc=com)",
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		entries, err = conn.Search(&SearchRequest{
+			BaseDN: "dc=example,dc=com",
+			Scope:  ScopeSingleLevel,
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "cn=admin,dc=example,dc=com", entries[0].DN)
+
+		entries, err = conn.Search(&SearchRequest{
+			BaseDN: "uid=john,ou=people,dc=example,dc=com",
+			Scope:  ScopeBaseObject,
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		_, err = conn.Search(&SearchRequest{
+			BaseDN:    "ou=people,dc=example,dc=com",
+			Scope:     ScopeWholeSubtree,
+			SizeLimit: 1,
+		})
+		require.ErrorAs(t, err, new(*Error))
+
+		_, err = conn.Search(&SearchRequest{Filter: "(uid=ja*)"})
+		require.ErrorIs(t, err, ErrUnsupportedFilter)
+	})
+
+	t.Run("unsupported urls should be rejected", func(t *testing.T) {
+		_, err := Dial("http://localhost", nil, time.Second)
+		require.Error(t, err)
+	})
+}
+
+// newTestTLSConfigs returns the configuration of a server with a self-signed certificate for 127.0.0.1,
+// together with the configuration of the clients trusting it
+func newTestTLSConfigs(t *testing.T) (*tls.Config, *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ldap test server"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	serverConfig := &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+
+	return serverConfig, &tls.Config{RootCAs: roots}
+}
+
+func TestStartTLS(t *testing.T) {
+	dir, url := startLocalDirectory(t)
+
+	t.Run("tls should not be started if the directory does not support it", func(t *testing.T) {
+		conn, err := Dial(url, nil, time.Second)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		err = conn.StartTLS(nil)
+		require.ErrorAs(t, err, new(*Error))
+
+		// the connection can still be used
+		err = conn.Bind("uid=jane,ou=people,dc=example,dc=com", "janepwd")
+		require.NoError(t, err)
+	})
+
+	serverConfig, clientConfig := newTestTLSConfigs(t)
+
+	dir.EnableStartTLS(serverConfig)
+
+	t.Run("connections should be upgraded to tls", func(t *testing.T) {
+		conn, err := Dial(url, nil, time.Second)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		err = conn.StartTLS(clientConfig)
+		require.NoError(t, err)
+		require.IsType(t, &tls.Conn{}, conn.conn)
+
+		err = conn.Bind("uid=jane,ou=people,dc=example,dc=com", "janepwd")
+		require.NoError(t, err)
+
+		err = conn.StartTLS(clientConfig)
+		require.ErrorIs(t, err, ErrTLS)
+	})
+
+	t.Run("untrusted certificates should be rejected", func(t *testing.T) {
+		conn, err := Dial(url, nil, time.Second)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		err = conn.StartTLS(nil)
+		require.ErrorIs(t, err, ErrTLS)
+	})
+}
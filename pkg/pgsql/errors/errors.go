@@ -30,6 +30,9 @@ var ErrUsernameNotprovided = errors.New("user name not provided")
 var ErrPwNotprovided = errors.New("password not provided")
 var ErrDBNotExists = errors.New("selected db doesn't exists")
 var ErrUsernameNotFound = errors.New("user not found")
+var ErrUserNotActive = errors.New("user is not active")
+var ErrUserLocked = errors.New("user account is temporarily locked")
+var ErrPasswordExpired = errors.New("password has expired and must be changed")
 var ErrExpectedQueryMessage = errors.New("expected query message")
 var ErrUseDBStatementNotSupported = errors.New("SQL statement not supported. Please use `UseDatabase` operation instead")
 var ErrCreateDBStatementNotSupported = errors.New("SQL statement not supported. Please use `CreateDatabase` operation instead")
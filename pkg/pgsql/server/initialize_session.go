@@ -19,11 +19,13 @@ package server
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	pserr "github.com/codenotary/immudb/pkg/pgsql/errors"
 	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
@@ -161,16 +163,18 @@ func (s *session) HandleStartup(dbList database.DatabaseList) (err error) {
 		if !ok || pw.GetSecret() == "" {
 			return pserr.ErrPwNotprovided
 		}
-		usr, err := s.getUser([]byte(s.username))
+		usr, err := s.authenticateUser(pw.GetSecret())
 		if err != nil {
 			if strings.Contains(err.Error(), "key not found") {
 				return pserr.ErrUsernameNotFound
 			}
-		}
-		if err := usr.ComparePasswords([]byte(pw.GetSecret())); err != nil {
 			return err
 		}
+		if usr.PasswordExpired {
+			return pserr.ErrPasswordExpired
+		}
 		s.log.Debugf("authentication successful for %s", s.username)
+		s.user = usr
 		if _, err := s.writeMessage(bm.AuthenticationOk()); err != nil {
 			return err
 		}
@@ -189,6 +193,58 @@ func (s *session) HandleStartup(dbList database.DatabaseList) (err error) {
 	return nil
 }
 
+// authenticateUser validates the secret provided by the user, either a bearer token or its password
+func (s *session) authenticateUser(secret string) (*auth.User, error) {
+	if s.jwtValidator != nil && auth.IsJWT(secret) {
+		usr, err := s.getUser([]byte(s.username))
+		if err != nil {
+			return nil, err
+		}
+		if usr.IsLocked() {
+			return nil, pserr.ErrUserLocked
+		}
+		// users providing a bearer token must be defined in immudb as well
+		if err := s.validateJWT(usr, secret); err != nil {
+			return nil, err
+		}
+		return usr, nil
+	}
+
+	if s.authenticate != nil {
+		return s.authenticate(context.Background(), []byte(s.username), []byte(secret))
+	}
+
+	usr, err := s.getUser([]byte(s.username))
+	if err != nil {
+		return nil, err
+	}
+	if usr.IsLocked() {
+		return nil, pserr.ErrUserLocked
+	}
+	if err := usr.ComparePasswords([]byte(secret)); err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+// validateJWT checks the token was issued to the user
+func (s *session) validateJWT(usr *auth.User, token string) error {
+	claims, err := s.jwtValidator.Validate(token)
+	if err != nil {
+		return err
+	}
+
+	if claims.Username != usr.Username || usr.Username == auth.SysAdminUsername {
+		return fmt.Errorf("%w: token was not issued to user %s", auth.ErrInvalidJWT, usr.Username)
+	}
+
+	if !usr.Active {
+		return pserr.ErrUserNotActive
+	}
+
+	return nil
+}
+
 func parseProtocolVersion(payload []byte) string {
 	major := int(binary.BigEndian.Uint16(payload[0:2]))
 	minor := int(binary.BigEndian.Uint16(payload[2:4]))
@@ -17,8 +17,10 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"crypto/tls"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 )
@@ -61,6 +63,24 @@ func TlsConfig(tlsConfig *tls.Config) Option {
 	}
 }
 
+// JWTValidator enables the authentication of users providing a JWT bearer token as password
+func JWTValidator(jwtValidator *auth.JWTValidator) Option {
+	return func(args *srv) {
+		args.jwtValidator = jwtValidator
+	}
+}
+
+// Authenticator checks the password of a user, enforcing the same policies (lockout after failed
+// attempts, password expiration) applied to users logging in through any other interface
+type Authenticator func(ctx context.Context, username []byte, password []byte) (*auth.User, error)
+
+// PasswordAuthenticator delegates the validation of user passwords to the provided authenticator
+func PasswordAuthenticator(authenticate Authenticator) Option {
+	return func(args *srv) {
+		args.authenticate = authenticate
+	}
+}
+
 func SessFactory(sf SessionFactory) Option {
 	return func(args *srv) {
 		args.SessionFactory = sf
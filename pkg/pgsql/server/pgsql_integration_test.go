@@ -18,17 +18,24 @@ package server_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
 	"crypto/tls"
 	"database/sql"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
 	isql "github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/auth"
+	immuclient "github.com/codenotary/immudb/pkg/client"
 	"github.com/codenotary/immudb/pkg/pgsql/errors"
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 	"github.com/codenotary/immudb/pkg/server"
@@ -805,3 +812,205 @@ func TestPgsqlServer_ExtendedQueryPGMultiFieldsPreparedMultiInsertError(t *testi
 	_, err = db.Exec(context.Background(), fmt.Sprintf("INSERT INTO %s (id, amount, total, title, content, isPresent) VALUES (?, ?, ?, ?, ?, ?); INSERT INTO %s (id, amount, total, title, content, isPresent) VALUES (?, ?, ?, ?, ?, ?)", table, table), 1, 1000, 6000, "title 1", fmt.Sprintf("%s", blobContent), true, 2, 2000, 12000, "title 2", fmt.Sprintf("%s", blobContent2), true)
 	require.ErrorContains(t, err, errors.ErrMaxStmtNumberExceeded.Error())
 }
+
+func TestPgsqlServer_JWTAuthentication(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(cryptorand.Reader)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"x":   base64.RawURLEncoding.EncodeToString(pubKey),
+		}},
+	})
+	require.NoError(t, err)
+
+	td := t.TempDir()
+
+	jwksPath := filepath.Join(td, "jwks.json")
+	err = os.WriteFile(jwksPath, jwks, 0644)
+	require.NoError(t, err)
+
+	options := server.DefaultOptions().
+		WithDir(filepath.Join(td, "data")).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithJWTOptions(&auth.JWTOptions{JWKS: jwksPath, Issuer: "https://sso.example.com", Audience: "immudb"})
+
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	bs.WaitForPgsqlListener()
+
+	client, err := bs.NewAuthenticatedClient(immuclient.DefaultOptions().WithDir(td))
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	err = client.CreateUser(context.Background(), []byte("jane"), []byte("Passw0rd!"), auth.PermissionRW, "defaultdb")
+	require.NoError(t, err)
+
+	token := func(username string) string {
+		header, _ := json.Marshal(map[string]string{"alg": "EdDSA", "typ": "JWT"})
+		payload, _ := json.Marshal(map[string]interface{}{
+			"iss": "https://sso.example.com",
+			"aud": "immudb",
+			"sub": username,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+
+		signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+		return signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(privKey, []byte(signed)))
+	}
+
+	connect := func(username, password string) (*sql.DB, error) {
+		db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=%s dbname=defaultdb password=%s", bs.Server.Srv.PgsqlSrv.GetPort(), username, password))
+		require.NoError(t, err)
+
+		return db, db.Ping()
+	}
+
+	t.Run("tokens issued to immudb users should be accepted as passwords", func(t *testing.T) {
+		db, err := connect("jane", token("jane"))
+		require.NoError(t, err)
+		defer db.Close()
+
+		table := getRandomTableName()
+		_, err = db.Exec(fmt.Sprintf("CREATE TABLE %s (id INTEGER, PRIMARY KEY id)", table))
+		require.NoError(t, err)
+	})
+
+	t.Run("tokens issued to other users should be rejected", func(t *testing.T) {
+		db, err := connect("jane", token("john"))
+		require.Error(t, err)
+		db.Close()
+	})
+
+	t.Run("tokens issued to unknown users should be rejected", func(t *testing.T) {
+		db, err := connect("john", token("john"))
+		require.Error(t, err)
+		db.Close()
+	})
+
+	t.Run("passwords should still be accepted", func(t *testing.T) {
+		db, err := connect("jane", "Passw0rd!")
+		require.NoError(t, err)
+		db.Close()
+	})
+}
+
+func TestPgsqlServer_PasswordPolicy(t *testing.T) {
+	td := t.TempDir()
+
+	policy := auth.DefaultPasswordPolicy()
+	policy.MaxAge = 2 * time.Second
+
+	options := server.DefaultOptions().
+		WithDir(filepath.Join(td, "data")).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0).
+		WithPasswordPolicy(policy).
+		WithMaxFailedLogins(3).
+		WithLockoutDuration(time.Minute)
+
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	bs.WaitForPgsqlListener()
+
+	client, err := bs.NewAuthenticatedClient(immuclient.DefaultOptions().WithDir(td))
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	for _, username := range []string{"jane", "john"} {
+		err = client.CreateUser(context.Background(), []byte(username), []byte("Passw0rd!"), auth.PermissionRW, "defaultdb")
+		require.NoError(t, err)
+	}
+
+	connect := func(username, password string) error {
+		db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=%s dbname=defaultdb password=%s", bs.Server.Srv.PgsqlSrv.GetPort(), username, password))
+		require.NoError(t, err)
+		defer db.Close()
+
+		return db.Ping()
+	}
+
+	t.Run("failed logins should lock the user out", func(t *testing.T) {
+		err := connect("jane", "Passw0rd!")
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			err = connect("jane", "Wr0ngPassword!")
+			require.Error(t, err)
+		}
+
+		err = connect("jane", "Passw0rd!")
+		require.ErrorContains(t, err, "locked")
+	})
+
+	t.Run("expired passwords should be rejected", func(t *testing.T) {
+		time.Sleep(policy.MaxAge)
+
+		err := connect("john", "Passw0rd!")
+		require.ErrorContains(t, err, errors.ErrPasswordExpired.Error())
+	})
+}
+
+func TestPgsqlServer_RowLevelSecurity(t *testing.T) {
+	td := t.TempDir()
+
+	options := server.DefaultOptions().
+		WithDir(filepath.Join(td, "data")).
+		WithPgsqlServer(true).
+		WithPgsqlServerPort(0)
+
+	bs := servertest.NewBufconnServer(options)
+
+	bs.Start()
+	defer bs.Stop()
+
+	bs.WaitForPgsqlListener()
+
+	client, err := bs.NewAuthenticatedClient(immuclient.DefaultOptions().WithDir(td))
+	require.NoError(t, err)
+	defer client.CloseSession(context.Background())
+
+	for _, username := range []string{"jane", "john"} {
+		err = client.CreateUser(context.Background(), []byte(username), []byte("Passw0rd!"), auth.PermissionRW, "defaultdb")
+		require.NoError(t, err)
+	}
+
+	table := getRandomTableName()
+
+	_, err = client.SQLExec(context.Background(), fmt.Sprintf(`
+		CREATE TABLE %s (id INTEGER AUTO_INCREMENT, owner VARCHAR, PRIMARY KEY id);
+		CREATE POLICY owner_rows ON %s USING (owner = CURRENT_USER());
+		INSERT INTO %s (owner) VALUES ('jane'), ('jane'), ('john');
+	`, table, table, table), nil)
+	require.NoError(t, err)
+
+	count := func(username string) int {
+		db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=%s dbname=defaultdb password=Passw0rd!", bs.Server.Srv.PgsqlSrv.GetPort(), username))
+		require.NoError(t, err)
+		defer db.Close()
+
+		rows, err := db.Query(fmt.Sprintf("SELECT id FROM %s", table))
+		require.NoError(t, err)
+		defer rows.Close()
+
+		n := 0
+		for rows.Next() {
+			n++
+		}
+
+		return n
+	}
+
+	require.Equal(t, 2, count("jane"))
+	require.Equal(t, 1, count("john"))
+}
@@ -37,6 +37,8 @@ func (s *session) QueriesMachine(ctx context.Context) (err error) {
 	s.Lock()
 	defer s.Unlock()
 
+	ctx = sql.WithSessionVariables(ctx, s.sqlSessionVariables())
+
 	var waitForSync = false
 
 	if _, err = s.writeMessage(bm.ReadyForQuery()); err != nil {
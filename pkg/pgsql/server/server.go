@@ -24,6 +24,7 @@ import (
 	"os"
 	"sync"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 	"golang.org/x/net/netutil"
@@ -40,6 +41,8 @@ type srv struct {
 	Port           int
 	dbList         database.DatabaseList
 	sysDb          database.DB
+	jwtValidator   *auth.JWTValidator
+	authenticate   Authenticator
 	listener       net.Listener
 }
 
@@ -23,6 +23,7 @@ import (
 	"net"
 	"sync"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
@@ -38,8 +39,11 @@ type session struct {
 	log             logger.Logger
 	mr              MessageReader
 	username        string
+	user            *auth.User
 	database        database.DB
 	sysDb           database.DB
+	jwtValidator    *auth.JWTValidator
+	authenticate    Authenticator
 	connParams      map[string]string
 	protocolVersion string
 	portals         map[string]*portal
@@ -54,18 +58,34 @@ type Session interface {
 	ErrorHandle(err error)
 }
 
-func NewSession(c net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config) *session {
+func NewSession(c net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config, jwtValidator *auth.JWTValidator, authenticate Authenticator) *session {
 	s := &session{
-		tlsConfig:  tlsConfig,
-		log:        log,
-		mr:         NewMessageReader(c),
-		sysDb:      sysDb,
-		portals:    make(map[string]*portal),
-		statements: make(map[string]*statement),
+		tlsConfig:    tlsConfig,
+		log:          log,
+		mr:           NewMessageReader(c),
+		sysDb:        sysDb,
+		jwtValidator: jwtValidator,
+		authenticate: authenticate,
+		portals:      make(map[string]*portal),
+		statements:   make(map[string]*statement),
 	}
 	return s
 }
 
+// sqlSessionVariables returns the variables statements of the session are executed with,
+// row-level security policies are not enforced for administrators of the database
+func (s *session) sqlSessionVariables() *sql.SessionVariables {
+	vars := &sql.SessionVariables{User: s.username}
+
+	if s.user != nil && s.database != nil {
+		vars.BypassRowLevelSecurity = s.user.IsSysAdmin ||
+			s.user.Username == auth.SysAdminUsername ||
+			s.user.HasPermission(s.database.GetName(), auth.PermissionAdmin)
+	}
+
+	return vars
+}
+
 func (s *session) ErrorHandle(e error) {
 	if e != nil {
 		er := errors.MapPgError(e)
@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"net"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 )
@@ -27,13 +28,13 @@ import (
 type sessionFactory struct{}
 
 type SessionFactory interface {
-	NewSession(conn net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config) Session
+	NewSession(conn net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config, jwtValidator *auth.JWTValidator, authenticate Authenticator) Session
 }
 
 func NewSessionFactory() sessionFactory {
 	return sessionFactory{}
 }
 
-func (sm sessionFactory) NewSession(conn net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config) Session {
-	return NewSession(conn, log, sysDb, tlsConfig)
+func (sm sessionFactory) NewSession(conn net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config, jwtValidator *auth.JWTValidator, authenticate Authenticator) Session {
+	return NewSession(conn, log, sysDb, tlsConfig, jwtValidator, authenticate)
 }
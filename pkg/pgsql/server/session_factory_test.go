@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"net"
 
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/database"
 	"github.com/codenotary/immudb/pkg/logger"
 )
@@ -32,6 +33,6 @@ func NewSessionFactoryMock(s Session) sessionFactoryMock {
 	return sessionFactoryMock{s: s}
 }
 
-func (sm sessionFactoryMock) NewSession(conn net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config) Session {
+func (sm sessionFactoryMock) NewSession(conn net.Conn, log logger.Logger, sysDb database.DB, tlsConfig *tls.Config, jwtValidator *auth.JWTValidator, authenticate Authenticator) Session {
 	return sm.s
 }
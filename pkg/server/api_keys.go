@@ -0,0 +1,434 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// apiKeyUsageTrackingInterval is the granularity with which the last usage of API keys is persisted,
+// so that keys used by every request of a busy job don't add a write to the system database each time
+const apiKeyUsageTrackingInterval = time.Minute
+
+// CreateAPIKey creates a long-lived API key bound to a user and restricted to a permission on a database
+func (s *ImmuServer) CreateAPIKey(ctx context.Context, r *schema.CreateAPIKeyRequest) (res *schema.CreateAPIKeyResponse, err error) {
+	s.Logger.Debugf("CreateAPIKey")
+
+	defer func() {
+		target := r.GetUsername()
+		if res != nil {
+			target = fmt.Sprintf("%s/%s", res.ApiKey.Username, res.ApiKey.Id)
+		}
+		s.audit(ctx, "CreateAPIKey", r.GetDatabase(), target, err)
+	}()
+
+	user, err := s.getAPIKeyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.IsValidAPIKeyPermission(r.Permission) {
+		return nil, fmt.Errorf("%w: invalid permission %d, allowed permissions are read, readwrite and admin", ErrIllegalArguments, r.Permission)
+	}
+
+	if r.Ttl < 0 {
+		return nil, fmt.Errorf("%w: negative ttl", ErrIllegalArguments)
+	}
+
+	if r.Database == SystemDBName || s.dbList.GetId(r.Database) < 0 {
+		return nil, fmt.Errorf("database %s does not exist", r.Database)
+	}
+
+	username := r.Username
+	if username == "" {
+		username = user.Username
+	}
+
+	canManage, err := s.canManageAPIKeys(ctx, user, username, r.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canManage {
+		return nil, fmt.Errorf("%w: API keys of user %s can only be managed by the user or by admins of database %s", ErrPermissionDenied, username, r.Database)
+	}
+
+	targetUser, err := s.getUser(ctx, []byte(username))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, fmt.Errorf("user %s not found", username)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !targetUser.Active {
+		return nil, fmt.Errorf("user %s is not active", username)
+	}
+
+	if userPermission(targetUser, r.Database) < r.Permission {
+		return nil, fmt.Errorf("%w: the permission of the key exceeds the one of user %s on database %s", ErrPermissionDenied, username, r.Database)
+	}
+
+	apiKey, key, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey.Name = r.Name
+	apiKey.Username = username
+	apiKey.Database = r.Database
+	apiKey.Permission = r.Permission
+	apiKey.CreatedBy = user.Username
+
+	if r.Ttl > 0 {
+		apiKey.ExpiresAt = apiKey.CreatedAt.Add(time.Duration(r.Ttl) * time.Second)
+	}
+
+	s.apiKeysMutex.Lock()
+	defer s.apiKeysMutex.Unlock()
+
+	err = s.saveAPIKey(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("API key %s of user %s created by user %s", apiKey.ID, username, user.Username)
+
+	return &schema.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// ListAPIKeys returns the API keys the logged in user can manage, optionally filtered by the user they are bound to
+func (s *ImmuServer) ListAPIKeys(ctx context.Context, r *schema.ListAPIKeysRequest) (*schema.APIKeyList, error) {
+	s.Logger.Debugf("ListAPIKeys")
+
+	user, err := s.getAPIKeyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.listAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &schema.APIKeyList{}
+
+	for _, apiKey := range apiKeys {
+		if r.GetUsername() != "" && apiKey.Username != r.GetUsername() {
+			continue
+		}
+
+		canManage, err := s.canManageAPIKeys(ctx, user, apiKey.Username, apiKey.Database)
+		if err != nil {
+			return nil, err
+		}
+
+		if !canManage {
+			continue
+		}
+
+		list.ApiKeys = append(list.ApiKeys, apiKeyToProto(apiKey))
+	}
+
+	return list, nil
+}
+
+// RevokeAPIKey permanently invalidates an API key
+func (s *ImmuServer) RevokeAPIKey(ctx context.Context, r *schema.RevokeAPIKeyRequest) (res *empty.Empty, err error) {
+	s.Logger.Debugf("RevokeAPIKey")
+
+	var database string
+
+	defer func() {
+		s.audit(ctx, "RevokeAPIKey", database, r.GetId(), err)
+	}()
+
+	user, err := s.getAPIKeyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.apiKeysMutex.Lock()
+	defer s.apiKeysMutex.Unlock()
+
+	apiKey, err := s.getAPIKey(ctx, r.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	database = apiKey.Database
+
+	canManage, err := s.canManageAPIKeys(ctx, user, apiKey.Username, apiKey.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canManage {
+		// do not disclose the existence of keys of other users
+		return nil, fmt.Errorf("%w: %s", ErrAPIKeyNotFound, r.GetId())
+	}
+
+	if apiKey.Revoked {
+		return new(empty.Empty), nil
+	}
+
+	apiKey.Revoked = true
+
+	err = s.saveAPIKey(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("API key %s of user %s revoked by user %s", apiKey.ID, apiKey.Username, user.Username)
+
+	return new(empty.Empty), nil
+}
+
+// getAPIKeyManager returns the logged in user, API keys can only be managed by users
+// authenticated with their own credentials
+func (s *ImmuServer) getAPIKeyManager(ctx context.Context) (*auth.User, error) {
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.APIKey != "" {
+		return nil, fmt.Errorf("%w: API keys can not be managed using an API key", ErrPermissionDenied)
+	}
+
+	return user, nil
+}
+
+// canManageAPIKeys returns true if user can manage the API keys of username on database,
+// users manage their own keys and admins of the database the keys of the users they created
+func (s *ImmuServer) canManageAPIKeys(ctx context.Context, user *auth.User, username string, database string) (bool, error) {
+	if user.IsSysAdmin || user.Username == username {
+		return true, nil
+	}
+
+	if user.WhichPermission(database) != auth.PermissionAdmin {
+		return false, nil
+	}
+
+	targetUser, err := s.getUser(ctx, []byte(username))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return targetUser.CreatedBy == user.Username, nil
+}
+
+func userPermission(user *auth.User, database string) uint32 {
+	if user.Username == auth.SysAdminUsername {
+		return auth.PermissionSysAdmin
+	}
+	return user.WhichPermission(database)
+}
+
+// getAPIKeyUser authenticates an API key, returning the index of the database the key is bound to
+// and the user owning it, restricted to the permission granted to the key
+func (s *ImmuServer) getAPIKeyUser(ctx context.Context, key string) (int, *auth.User, error) {
+	id, secret, err := auth.ParseAPIKey(key)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	apiKey, err := s.getAPIKey(ctx, id)
+	if errors.Is(err, ErrAPIKeyNotFound) {
+		return -1, nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return -1, nil, err
+	}
+
+	if !apiKey.VerifySecret(secret) {
+		return -1, nil, auth.ErrInvalidAPIKey
+	}
+
+	err = apiKey.Validate()
+	if err != nil {
+		return -1, nil, err
+	}
+
+	owner, err := s.getUser(ctx, []byte(apiKey.Username))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return -1, nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return -1, nil, err
+	}
+
+	if !owner.Active {
+		return -1, nil, fmt.Errorf("%w: user %s is not active", auth.ErrInvalidAPIKey, owner.Username)
+	}
+
+	// permissions revoked from the user after the key was created are revoked from the key too
+	if userPermission(owner, apiKey.Database) < apiKey.Permission {
+		return -1, nil, fmt.Errorf("%w: user %s no longer has the permission granted to the key", auth.ErrInvalidAPIKey, owner.Username)
+	}
+
+	ind := s.dbList.GetId(apiKey.Database)
+	if ind < 0 {
+		return -1, nil, fmt.Errorf("%w: database %s does not exist", auth.ErrInvalidAPIKey, apiKey.Database)
+	}
+
+	s.trackAPIKeyUsage(ctx, apiKey)
+
+	return ind, &auth.User{
+		Username:    owner.Username,
+		Permissions: []auth.Permission{{Permission: apiKey.Permission, Database: apiKey.Database}},
+		Active:      true,
+		CreatedBy:   owner.CreatedBy,
+		CreatedAt:   owner.CreatedAt,
+		APIKey:      apiKey.ID,
+	}, nil
+}
+
+// trackAPIKeyUsage persists the time the key was used at, at most once per apiKeyUsageTrackingInterval
+func (s *ImmuServer) trackAPIKeyUsage(ctx context.Context, apiKey *auth.APIKey) {
+	if time.Since(apiKey.LastUsedAt) < apiKeyUsageTrackingInterval {
+		return
+	}
+
+	s.apiKeysMutex.Lock()
+	defer s.apiKeysMutex.Unlock()
+
+	// the key is read again so that concurrent changes, e.g. a revocation, are not overridden
+	current, err := s.getAPIKey(ctx, apiKey.ID)
+	if err != nil || time.Since(current.LastUsedAt) < apiKeyUsageTrackingInterval {
+		return
+	}
+
+	current.LastUsedAt = time.Now()
+
+	err = s.saveAPIKey(ctx, current)
+	if err != nil {
+		s.Logger.Warningf("error tracking usage of API key %s: %v", apiKey.ID, err)
+	}
+}
+
+func apiKeyKey(id string) []byte {
+	key := make([]byte, 1+len(id))
+	key[0] = KeyPrefixAPIKey
+	copy(key[1:], id)
+	return key
+}
+
+func (s *ImmuServer) getAPIKey(ctx context.Context, id string) (*auth.APIKey, error) {
+	if id == "" {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	item, err := s.sysDB.Get(ctx, &schema.KeyRequest{Key: apiKeyKey(id)})
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrAPIKeyNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apiKey auth.APIKey
+
+	err = json.Unmarshal(item.Value, &apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+func (s *ImmuServer) saveAPIKey(ctx context.Context, apiKey *auth.APIKey) error {
+	apiKeyData, err := json.Marshal(apiKey)
+	if err != nil {
+		return logErr(s.Logger, "error saving API key: %v", err)
+	}
+
+	_, err = s.sysDB.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: apiKeyKey(apiKey.ID), Value: apiKeyData}}})
+
+	return logErr(s.Logger, "error saving API key: %v", err)
+}
+
+func (s *ImmuServer) listAPIKeys(ctx context.Context) ([]*auth.APIKey, error) {
+	itemList, err := s.sysDB.Scan(ctx, &schema.ScanRequest{
+		Prefix: []byte{KeyPrefixAPIKey},
+		NoWait: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]*auth.APIKey, len(itemList.Entries))
+
+	for i, entry := range itemList.Entries {
+		var apiKey auth.APIKey
+
+		err = json.Unmarshal(entry.Value, &apiKey)
+		if err != nil {
+			return nil, err
+		}
+
+		apiKeys[i] = &apiKey
+	}
+
+	return apiKeys, nil
+}
+
+func apiKeyToProto(apiKey *auth.APIKey) *schema.APIKey {
+	res := &schema.APIKey{
+		Id:         apiKey.ID,
+		Name:       apiKey.Name,
+		Username:   apiKey.Username,
+		Database:   apiKey.Database,
+		Permission: apiKey.Permission,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt.String(),
+		Revoked:    apiKey.Revoked,
+	}
+
+	if !apiKey.ExpiresAt.IsZero() {
+		res.ExpiresAt = apiKey.ExpiresAt.String()
+	}
+
+	if !apiKey.LastUsedAt.IsZero() {
+		res.LastUsedAt = apiKey.LastUsedAt.String()
+	}
+
+	return res
+}
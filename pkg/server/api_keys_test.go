@@ -0,0 +1,256 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerAPIKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	login := func(username, password string) context.Context {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte(username), Password: []byte(password)})
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+	}
+
+	apiKeyCtx := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.APIKeyHeader, key))
+	}
+
+	adminCtx := login(auth.SysAdminUsername, auth.SysAdminPassword)
+
+	_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+		User:       []byte("jane"),
+		Password:   []byte("Pa$$w0rd"),
+		Database:   DefaultDBName,
+		Permission: auth.PermissionRW,
+	})
+	require.NoError(t, err)
+
+	_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+		User:       []byte("john"),
+		Password:   []byte("Pa$$w0rd"),
+		Database:   DefaultDBName,
+		Permission: auth.PermissionR,
+	})
+	require.NoError(t, err)
+
+	janeCtx := login("jane", "Pa$$w0rd")
+	johnCtx := login("john", "Pa$$w0rd")
+
+	var readKey, writeKey *schema.CreateAPIKeyResponse
+
+	t.Run("users can create keys restricted to their own permissions", func(t *testing.T) {
+		_, err := s.CreateAPIKey(janeCtx, &schema.CreateAPIKeyRequest{
+			Database:   DefaultDBName,
+			Permission: auth.PermissionAdmin,
+		})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.CreateAPIKey(janeCtx, &schema.CreateAPIKeyRequest{
+			Database:   DefaultDBName,
+			Permission: 3,
+		})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.CreateAPIKey(janeCtx, &schema.CreateAPIKeyRequest{
+			Database:   SystemDBName,
+			Permission: auth.PermissionR,
+		})
+		require.ErrorContains(t, err, "does not exist")
+
+		_, err = s.CreateAPIKey(johnCtx, &schema.CreateAPIKeyRequest{
+			Username:   "jane",
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		writeKey, err = s.CreateAPIKey(janeCtx, &schema.CreateAPIKeyRequest{
+			Name:       "import job",
+			Database:   DefaultDBName,
+			Permission: auth.PermissionRW,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "jane", writeKey.ApiKey.Username)
+		require.Equal(t, "jane", writeKey.ApiKey.CreatedBy)
+		require.Empty(t, writeKey.ApiKey.ExpiresAt)
+		require.NotEmpty(t, writeKey.Key)
+
+		readKey, err = s.CreateAPIKey(adminCtx, &schema.CreateAPIKeyRequest{
+			Username:   "jane",
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+			Ttl:        3600,
+		})
+		require.NoError(t, err)
+		require.Equal(t, auth.SysAdminUsername, readKey.ApiKey.CreatedBy)
+		require.NotEmpty(t, readKey.ApiKey.ExpiresAt)
+	})
+
+	t.Run("admins can only manage the keys of the users they created", func(t *testing.T) {
+		_, err := s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte("dbadmin"),
+			Password:   []byte("Pa$$w0rd"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionAdmin,
+		})
+		require.NoError(t, err)
+
+		dbAdminCtx := login("dbadmin", "Pa$$w0rd")
+
+		_, err = s.CreateUser(dbAdminCtx, &schema.CreateUserRequest{
+			User:       []byte("bob"),
+			Password:   []byte("Pa$$w0rd"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.NoError(t, err)
+
+		for _, username := range []string{"jane", auth.SysAdminUsername} {
+			_, err = s.CreateAPIKey(dbAdminCtx, &schema.CreateAPIKeyRequest{
+				Username:   username,
+				Database:   DefaultDBName,
+				Permission: auth.PermissionR,
+			})
+			require.ErrorIs(t, err, ErrPermissionDenied)
+		}
+
+		bobKey, err := s.CreateAPIKey(dbAdminCtx, &schema.CreateAPIKeyRequest{
+			Username:   "bob",
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.NoError(t, err)
+
+		list, err := s.ListAPIKeys(dbAdminCtx, &schema.ListAPIKeysRequest{})
+		require.NoError(t, err)
+		require.Len(t, list.ApiKeys, 1)
+		require.Equal(t, bobKey.ApiKey.Id, list.ApiKeys[0].Id)
+
+		_, err = s.RevokeAPIKey(dbAdminCtx, &schema.RevokeAPIKeyRequest{Id: readKey.ApiKey.Id})
+		require.ErrorIs(t, err, ErrAPIKeyNotFound)
+
+		_, err = s.RevokeAPIKey(dbAdminCtx, &schema.RevokeAPIKeyRequest{Id: bobKey.ApiKey.Id})
+		require.NoError(t, err)
+	})
+
+	t.Run("keys authenticate requests with the permission they were granted", func(t *testing.T) {
+		_, err := s.Set(apiKeyCtx(writeKey.Key), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k1"), Value: []byte("v1")}}})
+		require.NoError(t, err)
+
+		entry, err := s.Get(apiKeyCtx(readKey.Key), &schema.KeyRequest{Key: []byte("k1")})
+		require.NoError(t, err)
+		require.Equal(t, []byte("v1"), entry.Value)
+
+		_, err = s.Set(apiKeyCtx(readKey.Key), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k1"), Value: []byte("v2")}}})
+		require.Error(t, err)
+
+		tamperedKey := []byte(writeKey.Key)
+		tamperedKey[len(tamperedKey)-1] ^= 1
+
+		_, err = s.Get(apiKeyCtx(string(tamperedKey)), &schema.KeyRequest{Key: []byte("k1")})
+		require.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+		_, err = s.Get(apiKeyCtx("immudb_unknown_secret"), &schema.KeyRequest{Key: []byte("k1")})
+		require.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+		_, err = s.UseDatabase(apiKeyCtx(writeKey.Key), &schema.Database{DatabaseName: DefaultDBName})
+		require.ErrorIs(t, err, ErrNotSupported)
+	})
+
+	t.Run("keys can not be managed with keys", func(t *testing.T) {
+		_, err := s.CreateAPIKey(apiKeyCtx(writeKey.Key), &schema.CreateAPIKeyRequest{
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("last usage is tracked", func(t *testing.T) {
+		apiKey, err := s.getAPIKey(context.Background(), writeKey.ApiKey.Id)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), apiKey.LastUsedAt, time.Minute)
+
+		list, err := s.ListAPIKeys(janeCtx, &schema.ListAPIKeysRequest{})
+		require.NoError(t, err)
+		require.Len(t, list.ApiKeys, 2)
+		for _, k := range list.ApiKeys {
+			require.NotEmpty(t, k.LastUsedAt)
+		}
+
+		list, err = s.ListAPIKeys(johnCtx, &schema.ListAPIKeysRequest{})
+		require.NoError(t, err)
+		require.Empty(t, list.ApiKeys)
+	})
+
+	t.Run("keys lose the permissions revoked from their user", func(t *testing.T) {
+		_, err := s.ChangePermission(adminCtx, &schema.ChangePermissionRequest{
+			Action:     schema.PermissionAction_GRANT,
+			Username:   "jane",
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.NoError(t, err)
+
+		_, err = s.Set(apiKeyCtx(writeKey.Key), &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("k1"), Value: []byte("v2")}}})
+		require.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+		_, err = s.Get(apiKeyCtx(readKey.Key), &schema.KeyRequest{Key: []byte("k1")})
+		require.NoError(t, err)
+	})
+
+	t.Run("revoked keys are rejected", func(t *testing.T) {
+		_, err := s.RevokeAPIKey(johnCtx, &schema.RevokeAPIKeyRequest{Id: readKey.ApiKey.Id})
+		require.ErrorIs(t, err, ErrAPIKeyNotFound)
+
+		// changing the permissions of the user ended its previous login
+		janeCtx := login("jane", "Pa$$w0rd")
+
+		_, err = s.RevokeAPIKey(janeCtx, &schema.RevokeAPIKeyRequest{Id: readKey.ApiKey.Id})
+		require.NoError(t, err)
+
+		_, err = s.Get(apiKeyCtx(readKey.Key), &schema.KeyRequest{Key: []byte("k1")})
+		require.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+		list, err := s.ListAPIKeys(adminCtx, &schema.ListAPIKeysRequest{Username: "jane"})
+		require.NoError(t, err)
+		require.Len(t, list.ApiKeys, 2)
+	})
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"google.golang.org/grpc/peer"
+)
+
+// AuditTableName is the table of the audit database holding one row per recorded action
+const AuditTableName = "audit_log"
+
+const (
+	maxAuditUsernameLen = 256
+	maxAuditActionLen   = 64
+)
+
+var auditTableSQL = fmt.Sprintf(`
+	CREATE TABLE IF NOT EXISTS %[1]s (
+		id            INTEGER AUTO_INCREMENT,
+		ts            TIMESTAMP,
+		username      VARCHAR[%[2]d],
+		action        VARCHAR[%[3]d],
+		dbname        VARCHAR,
+		target        VARCHAR,
+		success       BOOLEAN,
+		error_message VARCHAR,
+		client        VARCHAR,
+		PRIMARY KEY id
+	);
+
+	CREATE INDEX IF NOT EXISTS ON %[1]s(username);
+	CREATE INDEX IF NOT EXISTS ON %[1]s(action);
+`, AuditTableName, maxAuditUsernameLen, maxAuditActionLen)
+
+var auditInsertSQL = fmt.Sprintf(`
+	INSERT INTO %s(ts, username, action, dbname, target, success, error_message, client)
+	VALUES (NOW(), @username, @action, @dbname, @target, @success, @error_message, @client)
+`, AuditTableName)
+
+// loadAuditDatabase opens the audit database, creating it when the audit log is enabled.
+// The database is always exposed read-only, entries are only written by the server itself
+func (s *ImmuServer) loadAuditDatabase(dataDir string) error {
+	auditDbRootDir := s.OS.Join(dataDir, AuditDBName)
+
+	_, err := s.OS.Stat(auditDbRootDir)
+	if err != nil && !s.OS.IsNotExist(err) {
+		return err
+	}
+
+	exists := err == nil
+
+	if !exists && !s.Options.AuditLog {
+		return nil
+	}
+
+	dbOpts, err := s.loadDBOptions(AuditDBName, true)
+	if err != nil {
+		return fmt.Errorf("%w: while loading '%s' database settings", err, AuditDBName)
+	}
+
+	var db database.DB
+
+	if exists {
+		db, err = database.OpenDB(dbOpts.Database, s.multidbHandler(), s.databaseOptionsFrom(dbOpts), s.Logger)
+	} else {
+		db, err = database.NewDB(dbOpts.Database, s.multidbHandler(), s.databaseOptionsFrom(dbOpts), s.Logger)
+	}
+	if err != nil {
+		return err
+	}
+
+	if s.Options.AuditLog {
+		_, _, err = db.SQLExec(context.Background(), nil, &schema.SQLExecRequest{Sql: auditTableSQL})
+		if err != nil {
+			db.Close()
+			return err
+		}
+
+		s.auditDB = db
+	}
+
+	s.dbList.Put(&auditDatabase{DB: db})
+
+	return nil
+}
+
+// isReservedDatabase returns true for the databases which are managed by the server itself
+func (s *ImmuServer) isReservedDatabase(name string) bool {
+	return name == s.Options.defaultDBName ||
+		name == s.Options.systemAdminDBName ||
+		name == AuditDBName
+}
+
+// audit records an action performed by the user logged in the context
+func (s *ImmuServer) audit(ctx context.Context, action, db, target string, err error) {
+	if s.auditDB == nil {
+		return
+	}
+
+	var username string
+
+	if _, user, uerr := s.getLoggedInUserdataWithExpiredPasswordFromCtx(ctx); uerr == nil {
+		username = user.Username
+	}
+
+	s.auditAs(ctx, username, action, db, target, err)
+}
+
+// auditAs records an action performed by username, it's used when the user is not yet logged in.
+// Failing to record an action is logged but does not make the action fail
+func (s *ImmuServer) auditAs(ctx context.Context, username, action, db, target string, err error) {
+	if s.auditDB == nil {
+		return
+	}
+
+	var client string
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client = p.Addr.String()
+	}
+
+	var errMsg string
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	params, perr := schema.EncodeParams(map[string]interface{}{
+		"username":      truncateString(username, maxAuditUsernameLen),
+		"action":        truncateString(action, maxAuditActionLen),
+		"dbname":        db,
+		"target":        target,
+		"success":       err == nil,
+		"error_message": errMsg,
+		"client":        client,
+	})
+	if perr == nil {
+		// entries are serialized to prevent conflicts when assigning ids
+		s.auditMutex.Lock()
+		_, _, perr = s.auditDB.SQLExec(context.Background(), nil, &schema.SQLExecRequest{
+			Sql:    auditInsertSQL,
+			Params: params,
+		})
+		s.auditMutex.Unlock()
+	}
+	if perr != nil {
+		s.Logger.Errorf("action '%s' of user '%s' could not be recorded into the audit log. Reason: %v", action, username, perr)
+	}
+}
+
+func truncateString(s string, maxLen int) string {
+	if len(s) > maxLen {
+		return s[:maxLen]
+	}
+	return s
+}
+
+// auditDatabase exposes the audit database rejecting any write not made by the server itself
+type auditDatabase struct {
+	database.DB
+}
+
+func (d *auditDatabase) Set(ctx context.Context, req *schema.SetRequest) (*schema.TxHeader, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) VerifiableSet(ctx context.Context, req *schema.VerifiableSetRequest) (*schema.VerifiableTx, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) Delete(ctx context.Context, req *schema.DeleteKeysRequest) (*schema.TxHeader, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) VerifiableSetReference(ctx context.Context, req *schema.VerifiableReferenceRequest) (*schema.VerifiableTx, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*schema.TxHeader, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) ZAdd(ctx context.Context, req *schema.ZAddRequest) (*schema.TxHeader, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) VerifiableZAdd(ctx context.Context, req *schema.VerifiableZAddRequest) (*schema.VerifiableTx, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (*sql.SQLTx, error) {
+	if opts == nil || !opts.ReadOnly {
+		return nil, ErrAuditDatabaseReadOnly
+	}
+	return d.DB.NewSQLTx(ctx, opts)
+}
+
+func (d *auditDatabase) SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (*sql.SQLTx, []*sql.SQLTx, error) {
+	return nil, nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []sql.SQLStmt, params map[string]interface{}) (*sql.SQLTx, []*sql.SQLTx, error) {
+	return nil, nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) ReplicateTx(ctx context.Context, exportedTx []byte, skipIntegrityCheck bool, waitForIndexing bool) (*schema.TxHeader, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
+
+func (d *auditDatabase) BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadResponse, error) {
+	return nil, ErrAuditDatabaseReadOnly
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerAuditLog(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithAuditLog(true)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	login := func(s *ImmuServer, database string) context.Context {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte(auth.SysAdminUsername),
+			Password: []byte(auth.SysAdminPassword),
+		})
+		require.NoError(t, err)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+		if database == "" {
+			return ctx
+		}
+
+		ur, err := s.UseDatabase(ctx, &schema.Database{DatabaseName: database})
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", ur.Token))
+	}
+
+	_, err = s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte("wrong password"),
+	})
+	require.Error(t, err)
+
+	ctx := login(s, "")
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{Name: testDatabase})
+	require.NoError(t, err)
+
+	_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       testUsername,
+		Password:   testPassword,
+		Database:   testDatabase,
+		Permission: auth.PermissionRW,
+	})
+	require.NoError(t, err)
+
+	t.Run("the audit database is reserved", func(t *testing.T) {
+		_, err := s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{Name: AuditDBName})
+		require.ErrorIs(t, err, ErrReservedDatabase)
+
+		_, err = s.DeleteDatabase(ctx, &schema.DeleteDatabaseRequest{Database: AuditDBName})
+		require.ErrorIs(t, err, ErrReservedDatabase)
+
+		_, err = s.TruncateDatabase(ctx, &schema.TruncateDatabaseRequest{Database: AuditDBName})
+		require.ErrorIs(t, err, ErrReservedDatabase)
+	})
+
+	auditCtx := login(s, AuditDBName)
+
+	t.Run("the audit database is read-only", func(t *testing.T) {
+		_, err := s.Set(auditCtx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+		require.ErrorIs(t, err, ErrAuditDatabaseReadOnly)
+
+		_, err = s.SQLExec(auditCtx, &schema.SQLExecRequest{Sql: "DELETE FROM audit_log"})
+		require.ErrorIs(t, err, ErrAuditDatabaseReadOnly)
+	})
+
+	type entry struct {
+		username string
+		action   string
+		target   string
+		success  bool
+	}
+
+	auditEntries := func(s *ImmuServer, ctx context.Context) []entry {
+		res, err := s.SQLQuery(ctx, &schema.SQLQueryRequest{
+			Sql: "SELECT username, action, target, success, client FROM audit_log ORDER BY id",
+		})
+		require.NoError(t, err)
+
+		entries := make([]entry, len(res.Rows))
+		for i, row := range res.Rows {
+			entries[i] = entry{
+				username: row.Values[0].GetS(),
+				action:   row.Values[1].GetS(),
+				target:   row.Values[2].GetS(),
+				success:  row.Values[3].GetB(),
+			}
+		}
+		return entries
+	}
+
+	expectedEntries := []entry{
+		{username: auth.SysAdminUsername, action: "Login", success: false},
+		{username: auth.SysAdminUsername, action: "Login", success: true},
+		{username: auth.SysAdminUsername, action: "CreateDatabaseV2", success: true},
+		{username: auth.SysAdminUsername, action: "CreateUser", target: string(testUsername), success: true},
+		{username: auth.SysAdminUsername, action: "CreateDatabaseV2", success: false},
+		{username: auth.SysAdminUsername, action: "DeleteDatabase", success: false},
+		{username: auth.SysAdminUsername, action: "TruncateDatabase", success: false},
+		{username: auth.SysAdminUsername, action: "Login", success: true},
+	}
+	require.Equal(t, expectedEntries, auditEntries(s, auditCtx))
+
+	err = s.CloseDatabases()
+	require.NoError(t, err)
+
+	t.Run("the audit database stays read-only when the audit log is disabled", func(t *testing.T) {
+		s := DefaultServer().WithOptions(serverOptions.WithAuditLog(false)).(*ImmuServer)
+
+		err := s.Initialize()
+		require.NoError(t, err)
+
+		defer s.CloseDatabases()
+
+		auditCtx := login(s, AuditDBName)
+
+		_, err = s.Set(auditCtx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+		require.ErrorIs(t, err, ErrAuditDatabaseReadOnly)
+
+		require.Equal(t, expectedEntries, auditEntries(s, auditCtx))
+	})
+}
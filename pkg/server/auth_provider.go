@@ -0,0 +1,61 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"github.com/codenotary/immudb/pkg/auth"
+)
+
+// authProvider validates the credentials users authenticate with
+type authProvider interface {
+	// authenticate returns the user the credentials belong to
+	authenticate(ctx context.Context, username []byte, password []byte) (*auth.User, error)
+}
+
+// localAuthProvider authenticates the users defined in immudb, with the passwords stored in systemdb.
+// It's the default provider
+type localAuthProvider struct {
+	s *ImmuServer
+}
+
+func (p *localAuthProvider) authenticate(ctx context.Context, username []byte, password []byte) (*auth.User, error) {
+	userdata, err := p.s.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if userdata.IsLocked() {
+		return nil, ErrUserLocked
+	}
+
+	err = userdata.ComparePasswords(password)
+	if err != nil {
+		p.s.registerFailedLogin(ctx, username)
+		return nil, err
+	}
+
+	err = p.s.resetFailedLogins(ctx, userdata)
+	if err != nil {
+		return nil, err
+	}
+
+	userdata.PasswordExpired = p.s.isPasswordExpired(userdata)
+
+	return userdata, nil
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Fields of the client certificates users can be mapped from
+const (
+	CertificateFieldCommonName = "cn"
+	CertificateFieldDNSName    = "dns"
+	CertificateFieldEmail      = "email"
+	CertificateFieldURI        = "uri"
+)
+
+// CertificateMappingRule maps the client certificates with a field matching Pattern to the user named Username.
+// The whole value of the field must match the pattern, and the username may refer to its submatches, e.g. $1
+type CertificateMappingRule struct {
+	Field    string
+	Pattern  string
+	Username string
+}
+
+// ParseCertificateMappingRule parses a rule in the field:pattern=username form, e.g. cn:(.+)\.svc\.example\.com=$1
+func ParseCertificateMappingRule(s string) (*CertificateMappingRule, error) {
+	i := strings.Index(s, ":")
+	j := strings.LastIndex(s, "=")
+
+	if i < 0 || j < i {
+		return nil, fmt.Errorf("%w: invalid certificate mapping rule '%s', expected field:pattern=username", ErrIllegalArguments, s)
+	}
+
+	return &CertificateMappingRule{
+		Field:    s[:i],
+		Pattern:  s[i+1 : j],
+		Username: s[j+1:],
+	}, nil
+}
+
+type compiledCertificateMappingRule struct {
+	field    string
+	pattern  *regexp.Regexp
+	username string
+}
+
+// certificateMapper derives the identity of users from their client certificates, applying the first matching rule
+type certificateMapper struct {
+	rules []*compiledCertificateMappingRule
+}
+
+func newCertificateMapper(rules []*CertificateMappingRule) (*certificateMapper, error) {
+	mapper := &certificateMapper{}
+
+	for _, rule := range rules {
+		switch rule.Field {
+		case CertificateFieldCommonName, CertificateFieldDNSName, CertificateFieldEmail, CertificateFieldURI:
+		default:
+			return nil, fmt.Errorf("%w: unknown certificate field '%s'", ErrIllegalArguments, rule.Field)
+		}
+
+		pattern, err := regexp.Compile("^(?:" + rule.Pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid certificate mapping pattern: %v", ErrIllegalArguments, err)
+		}
+
+		if rule.Username == "" {
+			return nil, fmt.Errorf("%w: certificate mapping rules must map to a username", ErrIllegalArguments)
+		}
+
+		mapper.rules = append(mapper.rules, &compiledCertificateMappingRule{
+			field:    rule.Field,
+			pattern:  pattern,
+			username: rule.Username,
+		})
+	}
+
+	return mapper, nil
+}
+
+func (m *certificateMapper) username(cert *x509.Certificate) (string, bool) {
+	for _, rule := range m.rules {
+		for _, value := range certificateField(cert, rule.field) {
+			match := rule.pattern.FindStringSubmatchIndex(value)
+			if match == nil {
+				continue
+			}
+
+			username := string(rule.pattern.ExpandString(nil, rule.username, value, match))
+			if username != "" {
+				return username, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func certificateField(cert *x509.Certificate, field string) []string {
+	switch field {
+	case CertificateFieldCommonName:
+		if cert.Subject.CommonName == "" {
+			return nil
+		}
+		return []string{cert.Subject.CommonName}
+	case CertificateFieldDNSName:
+		return cert.DNSNames
+	case CertificateFieldEmail:
+		return cert.EmailAddresses
+	case CertificateFieldURI:
+		var uris []string
+		for _, uri := range cert.URIs {
+			uris = append(uris, uri.String())
+		}
+		return uris
+	}
+
+	return nil
+}
+
+// verifiedClientCertificate returns the client certificate verified during the handshake of the connection
+func verifiedClientCertificate(ctx context.Context) (*x509.Certificate, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ErrClientCertificateRequired
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, ErrClientCertificateRequired
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0], nil
+}
+
+// getCertificateUser returns the user the verified client certificate of the connection is mapped to
+func (s *ImmuServer) getCertificateUser(ctx context.Context, username []byte) (*auth.User, error) {
+	cert, err := verifiedClientCertificate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mappedUsername, ok := s.certificateMapper.username(cert)
+	if !ok {
+		return nil, ErrUnmappedClientCertificate
+	}
+
+	if len(username) > 0 && string(username) != mappedUsername {
+		return nil, fmt.Errorf("%w: certificate was not issued to user %s", ErrUnmappedClientCertificate, username)
+	}
+
+	if mappedUsername == auth.SysAdminUsername {
+		return nil, fmt.Errorf("%w: sysadmin can not authenticate with client certificates", ErrUnmappedClientCertificate)
+	}
+
+	user, err := s.getUser(ctx, []byte(mappedUsername))
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsLocked() {
+		return nil, ErrUserLocked
+	}
+
+	if !user.Active {
+		return nil, errors.New(ErrUserNotActive)
+	}
+
+	return user, nil
+}
+
+// crlChecker rejects the client certificates revoked by the certificate revocation lists in a file,
+// either PEM or DER encoded. The file is reloaded whenever it's modified.
+// Certificates are rejected as well when the list of their issuer is past its next update
+type crlChecker struct {
+	path string
+	now  func() time.Time
+
+	mutex   sync.Mutex
+	modTime time.Time
+	crls    []*x509.RevocationList
+}
+
+func newCRLChecker(path string) (*crlChecker, error) {
+	c := &crlChecker{path: path, now: time.Now}
+
+	_, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *crlChecker) load() ([]*x509.RevocationList, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return c.crls, err
+	}
+
+	if info.ModTime().Equal(c.modTime) {
+		return c.crls, nil
+	}
+
+	data, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		return c.crls, err
+	}
+
+	var crls []*x509.RevocationList
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "X509 CRL" {
+			continue
+		}
+
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			return c.crls, fmt.Errorf("invalid certificate revocation list: %w", err)
+		}
+
+		crls = append(crls, crl)
+	}
+
+	if len(crls) == 0 {
+		crl, err := x509.ParseRevocationList(data)
+		if err != nil {
+			return c.crls, fmt.Errorf("invalid certificate revocation list: %w", err)
+		}
+
+		crls = append(crls, crl)
+	}
+
+	c.crls = crls
+	c.modTime = info.ModTime()
+
+	return crls, nil
+}
+
+// verifyPeerCertificate fails if any certificate of the verified chains, but their roots, has been revoked.
+// If the file can not be reloaded, the revocation lists previously loaded are used
+func (c *crlChecker) verifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	crls, _ := c.load()
+
+	now := c.now()
+
+	for _, chain := range verifiedChains {
+		for i := 0; i+1 < len(chain); i++ {
+			err := checkRevocation(crls, chain[i], chain[i+1], now)
+			if err != nil {
+				return fmt.Errorf("%w: certificate %s (serial %s)", err, chain[i].Subject, chain[i].SerialNumber)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkRevocation(crls []*x509.RevocationList, cert, issuer *x509.Certificate, now time.Time) error {
+	for _, crl := range crls {
+		if !bytes.Equal(crl.RawIssuer, issuer.RawSubject) {
+			continue
+		}
+
+		if crl.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+
+		// revocations issued after an expired list are unknown
+		if !crl.NextUpdate.IsZero() && now.After(crl.NextUpdate) {
+			return ErrExpiredCRL
+		}
+
+		for _, revoked := range crl.RevokedCertificateEntries {
+			if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return ErrRevokedCertificate
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testCA struct {
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	serial int64
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "immudb test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key, serial: 1}
+}
+
+func (ca *testCA) issue(t *testing.T, template *x509.Certificate) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ca.serial++
+
+	template.SerialNumber = big.NewInt(ca.serial)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func (ca *testCA) writeCRL(t *testing.T, path string, revoked ...tls.Certificate) {
+	var revokedCerts []x509.RevocationListEntry
+	for _, cert := range revoked {
+		revokedCerts = append(revokedCerts, x509.RevocationListEntry{
+			SerialNumber:   cert.Leaf.SerialNumber,
+			RevocationTime: time.Now(),
+		})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(time.Now().UnixNano()),
+		ThisUpdate:                time.Now(),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: revokedCerts,
+	}, ca.cert, ca.key)
+	require.NoError(t, err)
+
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), 0644)
+	require.NoError(t, err)
+
+	// ensure the modification is noticed regardless of the resolution of the file system timestamps
+	modTime := time.Now().Add(time.Duration(len(revoked)) * time.Minute)
+	err = os.Chtimes(path, modTime, modTime)
+	require.NoError(t, err)
+}
+
+func TestServerClientCertificateAuthentication(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newTestCA(t)
+
+	serverCert := ca.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "immudb"},
+		DNSNames:    []string{"immudb"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+
+	clientCert := func(cn string, emails ...string) tls.Certificate {
+		return ca.issue(t, &x509.Certificate{
+			Subject:        pkix.Name{CommonName: cn},
+			EmailAddresses: emails,
+			ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+	}
+
+	ordersCert := clientCert("orders.svc.example.com")
+	billingCert := clientCert("billing.svc.example.com")
+	reportsCert := clientCert("reports", "reports@example.com")
+	adminCert := clientCert("immudb.svc.example.com")
+	unmappedCert := clientCert("unknown")
+
+	crlPath := filepath.Join(dir, "clients.crl")
+	ca.writeCRL(t, crlPath, billingCert)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	l := bufconn.Listen(1024 * 1024)
+
+	serverOptions := DefaultOptions().
+		WithDir(filepath.Join(dir, "data")).
+		WithListener(l).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			ClientAuth:   tls.VerifyClientCertIfGiven,
+			ClientCAs:    pool,
+		}).
+		WithClientCRL(crlPath).
+		WithClientCertificateMapping(
+			&CertificateMappingRule{Field: CertificateFieldCommonName, Pattern: `(.+)\.svc\.example\.com`, Username: "$1"},
+			&CertificateMappingRule{Field: CertificateFieldEmail, Pattern: `(.+)@example\.com`, Username: "${1}_svc"},
+		)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	go s.GrpcServer.Serve(l)
+	defer s.GrpcServer.Stop()
+
+	lr, err := s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+	for _, username := range []string{"orders", "billing", "reports_svc"} {
+		_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+			User:       []byte(username),
+			Password:   []byte("Passw0rd!"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionRW,
+		})
+		require.NoError(t, err)
+	}
+
+	connect := func(certs ...tls.Certificate) schema.ImmuServiceClient {
+		creds := credentials.NewTLS(&tls.Config{
+			ServerName:   "immudb",
+			RootCAs:      pool,
+			Certificates: certs,
+		})
+
+		conn, err := grpc.Dial("bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return l.Dial() }),
+			grpc.WithTransportCredentials(creds),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		return schema.NewImmuServiceClient(conn)
+	}
+
+	openSession := func(client schema.ImmuServiceClient, username, password string) (*schema.OpenSessionResponse, error) {
+		return client.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte(username),
+			Password:     []byte(password),
+			DatabaseName: DefaultDBName,
+		})
+	}
+
+	peerCtx := func(certs ...tls.Certificate) context.Context {
+		var state tls.ConnectionState
+		for _, cert := range certs {
+			state.VerifiedChains = append(state.VerifiedChains, []*x509.Certificate{cert.Leaf, ca.cert})
+		}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+
+	t.Run("sessions should be opened with mapped certificates", func(t *testing.T) {
+		client := connect(ordersCert)
+
+		resp, err := openSession(client, "", "")
+		require.NoError(t, err)
+
+		sess, err := s.SessManager.GetSession(resp.SessionID)
+		require.NoError(t, err)
+		require.Equal(t, "orders", sess.GetUser().Username)
+
+		resp, err = openSession(client, "orders", "")
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.SessionID)
+
+		_, err = openSession(client, "reports_svc", "")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		resp, err = openSession(connect(reportsCert), "", "")
+		require.NoError(t, err)
+
+		sess, err = s.SessManager.GetSession(resp.SessionID)
+		require.NoError(t, err)
+		require.Equal(t, "reports_svc", sess.GetUser().Username)
+	})
+
+	t.Run("passwords should still be accepted", func(t *testing.T) {
+		_, err := openSession(connect(ordersCert), "reports_svc", "Passw0rd!")
+		require.NoError(t, err)
+
+		_, err = openSession(connect(), auth.SysAdminUsername, auth.SysAdminPassword)
+		require.NoError(t, err)
+	})
+
+	t.Run("sessions should not be opened without mapped certificates", func(t *testing.T) {
+		_, err := openSession(connect(), "orders", "")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = openSession(connect(unmappedCert), "", "")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = openSession(connect(adminCert), "", "")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = s.getCertificateUser(context.Background(), nil)
+		require.ErrorIs(t, err, ErrClientCertificateRequired)
+
+		_, err = s.getCertificateUser(peerCtx(), nil)
+		require.ErrorIs(t, err, ErrClientCertificateRequired)
+
+		_, err = s.getCertificateUser(peerCtx(unmappedCert), nil)
+		require.ErrorIs(t, err, ErrUnmappedClientCertificate)
+
+		_, err = s.getCertificateUser(peerCtx(ordersCert), []byte("billing"))
+		require.ErrorIs(t, err, ErrUnmappedClientCertificate)
+
+		_, err = s.getCertificateUser(peerCtx(adminCert), nil)
+		require.ErrorIs(t, err, ErrUnmappedClientCertificate)
+	})
+
+	t.Run("sessions should not be opened for locked or inactive users", func(t *testing.T) {
+		client := connect(ordersCert)
+
+		user, err := s.getUser(context.Background(), []byte("orders"))
+		require.NoError(t, err)
+
+		user.LockedUntil = time.Now().Add(time.Hour)
+		err = s.saveUser(context.Background(), user)
+		require.NoError(t, err)
+
+		_, err = openSession(client, "", "")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = s.getCertificateUser(peerCtx(ordersCert), nil)
+		require.ErrorIs(t, err, ErrUserLocked)
+
+		user.LockedUntil = time.Time{}
+		user.Active = false
+		err = s.saveUser(context.Background(), user)
+		require.NoError(t, err)
+
+		_, err = openSession(client, "", "")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = s.getCertificateUser(peerCtx(ordersCert), nil)
+		require.ErrorContains(t, err, ErrUserNotActive)
+
+		user.Active = true
+		err = s.saveUser(context.Background(), user)
+		require.NoError(t, err)
+
+		_, err = openSession(client, "", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("revoked certificates should be rejected on handshake", func(t *testing.T) {
+		_, err := openSession(connect(billingCert), "", "")
+		require.Error(t, err)
+
+		ca.writeCRL(t, crlPath, billingCert, ordersCert)
+
+		_, err = openSession(connect(ordersCert), "", "")
+		require.Error(t, err)
+
+		_, err = openSession(connect(reportsCert), "", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("certificates should be rejected when the revocation list has expired", func(t *testing.T) {
+		checker, err := newCRLChecker(crlPath)
+		require.NoError(t, err)
+
+		chains := [][]*x509.Certificate{{reportsCert.Leaf, ca.cert}}
+
+		err = checker.verifyPeerCertificate(nil, chains)
+		require.NoError(t, err)
+
+		checker.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+
+		err = checker.verifyPeerCertificate(nil, chains)
+		require.ErrorIs(t, err, ErrExpiredCRL)
+	})
+}
+
+func TestCertificateMapping(t *testing.T) {
+	rule, err := ParseCertificateMappingRule(`uri:spiffe://example\.com/ns/(\w+)/sa/(\w+)=${1}_$2`)
+	require.NoError(t, err)
+	require.Equal(t, &CertificateMappingRule{
+		Field:    CertificateFieldURI,
+		Pattern:  `spiffe://example\.com/ns/(\w+)/sa/(\w+)`,
+		Username: "${1}_$2",
+	}, rule)
+
+	mapper, err := newCertificateMapper([]*CertificateMappingRule{
+		rule,
+		{Field: CertificateFieldDNSName, Pattern: `(.+)\.example\.com`, Username: "$1"},
+	})
+	require.NoError(t, err)
+
+	uri, err := url.Parse("spiffe://example.com/ns/prod/sa/orders")
+	require.NoError(t, err)
+
+	username, ok := mapper.username(&x509.Certificate{URIs: []*url.URL{uri}, DNSNames: []string{"billing.example.com"}})
+	require.True(t, ok)
+	require.Equal(t, "prod_orders", username)
+
+	username, ok = mapper.username(&x509.Certificate{DNSNames: []string{"billing.example.com.evil.com", "billing.example.com"}})
+	require.True(t, ok)
+	require.Equal(t, "billing", username)
+
+	_, ok = mapper.username(&x509.Certificate{Subject: pkix.Name{CommonName: "billing.example.com"}})
+	require.False(t, ok)
+
+	for _, r := range []string{"cn", "cn:(.+)", "(.+)=$1"} {
+		_, err = ParseCertificateMappingRule(r)
+		require.ErrorIs(t, err, ErrIllegalArguments, r)
+	}
+
+	for _, r := range []*CertificateMappingRule{
+		{Field: "serial", Pattern: "1", Username: "user1"},
+		{Field: CertificateFieldCommonName, Pattern: "(", Username: "user1"},
+		{Field: CertificateFieldCommonName, Pattern: "user1"},
+	} {
+		_, err = newCertificateMapper([]*CertificateMappingRule{r})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	}
+}
@@ -96,6 +96,10 @@ func (db *closedDB) VerifiableGet(ctx context.Context, req *schema.VerifiableGet
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableGetAbsent(ctx context.Context, req *schema.VerifiableGetAbsentRequest) (*schema.VerifiableIndexProof, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -116,10 +120,18 @@ func (db *closedDB) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableHistory(ctx context.Context, req *schema.VerifiableHistoryRequest) (*schema.VerifiableEntries, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) ExecAll(ctx context.Context, operations *schema.ExecAllRequest) (*schema.TxHeader, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -144,6 +156,10 @@ func (db *closedDB) ZScan(ctx context.Context, req *schema.ZScanRequest) (*schem
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableZScan(ctx context.Context, req *schema.VerifiableZScanRequest) (*schema.VerifiableZEntries, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) NewSQLTx(ctx context.Context, _ *sql.TxOptions) (*sql.SQLTx, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -180,6 +196,10 @@ func (db *closedDB) VerifiableSQLGet(ctx context.Context, req *schema.Verifiable
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) VerifiableSQLQuery(ctx context.Context, req *schema.VerifiableSQLQueryRequest) (*schema.VerifiableSQLQueryResult, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) ListTables(ctx context.Context, tx *sql.SQLTx) (*schema.SQLQueryResult, error) {
 	return nil, store.ErrAlreadyClosed
 }
@@ -224,6 +244,22 @@ func (db *closedDB) TxScan(ctx context.Context, req *schema.TxScanRequest) (*sch
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) AddTimestampAnchor(ctx context.Context, txID uint64, token []byte) (*schema.TxHeader, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) TimestampAnchor(ctx context.Context, req *schema.TimestampAnchorRequest) (*schema.TimestampAnchor, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) FlushIndex(req *schema.FlushIndexRequest) error {
 	return store.ErrAlreadyClosed
 }
@@ -232,6 +268,18 @@ func (db *closedDB) CompactIndex() error {
 	return store.ErrAlreadyClosed
 }
 
+func (db *closedDB) RebuildIndex(ctx context.Context, progress func(indexedTxID, committedTxID uint64)) error {
+	return store.ErrAlreadyClosed
+}
+
+func (db *closedDB) CheckTxIntegrity(txID uint64, prevAlh [sha256.Size]byte) (*store.TxIntegrity, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
+func (db *closedDB) BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadResponse, error) {
+	return nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) IsClosed() bool {
 	return true
 }
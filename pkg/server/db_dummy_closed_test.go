@@ -60,6 +60,18 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.VerifiableGet(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.VerifiableGetAbsent(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.VerifiableScan(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.VerifiableHistory(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.VerifiableZScan(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.GetAll(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -123,6 +135,9 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.VerifiableSQLGet(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.VerifiableSQLQuery(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	_, err = cdb.ListTables(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
@@ -160,11 +175,32 @@ func TestDummyClosedDatabase(t *testing.T) {
 	_, err = cdb.TxScan(context.Background(), nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
+	_, err = cdb.AddTimestampAnchor(context.Background(), 1, nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.TimestampAnchor(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.PrefixDigest(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.TableDigest(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
 	err = cdb.FlushIndex(nil)
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 
 	err = cdb.CompactIndex()
 	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.BulkLoad(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	err = cdb.RebuildIndex(context.Background(), nil)
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
+
+	_, err = cdb.CheckTxIntegrity(1, sha256.Sum256(nil))
+	require.ErrorIs(t, err, store.ErrAlreadyClosed)
 	require.True(t, cdb.IsClosed())
 
 	err = cdb.Truncate(0)
@@ -37,10 +37,24 @@ func (s *ImmuServer) DatabaseHealth(ctx context.Context, _ *empty.Empty) (*schem
 
 	waitingRequests, lastReleaseAt := db.Health()
 
-	return &schema.DatabaseHealthResponse{
+	res := &schema.DatabaseHealthResponse{
 		PendingRequests:        uint32(waitingRequests),
 		LastRequestCompletedAt: unixMilli(lastReleaseAt),
-	}, nil
+	}
+
+	if sc := s.scrubber; sc != nil {
+		progress := sc.progressOf(db.GetName())
+
+		res.ScrubbedTxId = progress.TxID
+		res.CorruptedTxId = progress.CorruptedTxID
+		res.IntegrityError = progress.IntegrityError
+
+		if !progress.LastCompletedAt.IsZero() {
+			res.LastScrubCompletedAt = unixMilli(progress.LastCompletedAt)
+		}
+	}
+
+	return res, nil
 }
 
 // CurrentState ...
@@ -64,6 +78,8 @@ func (s *ImmuServer) CurrentState(ctx context.Context, _ *empty.Empty) (*schema.
 		}
 	}
 
+	state.WitnessSignatures = s.witnessCosignatures.get(state.Db, state.TxId)
+
 	return state, nil
 }
 
@@ -73,7 +89,7 @@ func (s *ImmuServer) Set(ctx context.Context, kv *schema.SetRequest) (*schema.Tx
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "Set")
+	db, err := s.getDBFromCtxForRequest(ctx, "Set", kv)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +103,7 @@ func (s *ImmuServer) VerifiableSet(ctx context.Context, req *schema.VerifiableSe
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "VerifiableSet")
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableSet", req)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +136,7 @@ func (s *ImmuServer) VerifiableSet(ctx context.Context, req *schema.VerifiableSe
 
 // Get ...
 func (s *ImmuServer) Get(ctx context.Context, req *schema.KeyRequest) (*schema.Entry, error) {
-	db, err := s.getDBFromCtx(ctx, "Get")
+	db, err := s.getDBFromCtxForRequest(ctx, "Get", req)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +146,7 @@ func (s *ImmuServer) Get(ctx context.Context, req *schema.KeyRequest) (*schema.E
 
 // VerifiableGet ...
 func (s *ImmuServer) VerifiableGet(ctx context.Context, req *schema.VerifiableGetRequest) (*schema.VerifiableEntry, error) {
-	db, err := s.getDBFromCtx(ctx, "VerifiableGet")
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableGet", req)
 	if err != nil {
 		return nil, err
 	}
@@ -156,14 +172,62 @@ func (s *ImmuServer) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 		}
 
 		vEntry.VerifiableTx.Signature = newState.Signature
+
+		if vEntry.IndexProof != nil {
+			vEntry.IndexProof.Signature, err = s.signStateAt(db.GetName(), vEntry.IndexProof.DualProof.TargetTxHeader)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return vEntry, nil
 }
 
+// VerifiableGetAbsent ...
+func (s *ImmuServer) VerifiableGetAbsent(ctx context.Context, req *schema.VerifiableGetAbsentRequest) (*schema.VerifiableIndexProof, error) {
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableGetAbsent", req)
+	if err != nil {
+		return nil, err
+	}
+
+	indexProof, err := db.VerifiableGetAbsent(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil {
+		indexProof.Signature, err = s.signStateAt(db.GetName(), indexProof.DualProof.TargetTxHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return indexProof, nil
+}
+
+// signStateAt signs the state as of the given transaction e.g. the target transaction of a dual proof
+func (s *ImmuServer) signStateAt(db string, txHdr *schema.TxHeader) (*schema.Signature, error) {
+	hdr := schema.TxHeaderFromProto(txHdr)
+	alh := hdr.Alh()
+
+	newState := &schema.ImmutableState{
+		Db:     db,
+		TxId:   hdr.ID,
+		TxHash: alh[:],
+	}
+
+	err := s.StateSigner.Sign(newState)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState.Signature, nil
+}
+
 // Scan ...
 func (s *ImmuServer) Scan(ctx context.Context, req *schema.ScanRequest) (*schema.Entries, error) {
-	db, err := s.getDBFromCtx(ctx, "Scan")
+	db, err := s.getDBFromCtxForRequest(ctx, "Scan", req)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +235,28 @@ func (s *ImmuServer) Scan(ctx context.Context, req *schema.ScanRequest) (*schema
 	return db.Scan(ctx, req)
 }
 
+// VerifiableScan ...
+func (s *ImmuServer) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableScan", req)
+	if err != nil {
+		return nil, err
+	}
+
+	vEntries, err := db.VerifiableScan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil && vEntries.DualProof != nil {
+		vEntries.Signature, err = s.signStateAt(db.GetName(), vEntries.DualProof.TargetTxHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vEntries, nil
+}
+
 // Count ...
 func (s *ImmuServer) Count(ctx context.Context, prefix *schema.KeyPrefix) (*schema.EntryCount, error) {
 	return nil, ErrNotSupported
@@ -183,22 +269,22 @@ func (s *ImmuServer) CountAll(ctx context.Context, _ *empty.Empty) (*schema.Entr
 
 // TxByID ...
 func (s *ImmuServer) TxById(ctx context.Context, req *schema.TxRequest) (*schema.Tx, error) {
-	db, err := s.getDBFromCtx(ctx, "TxByID")
+	db, err := s.getDBFromCtxForRequest(ctx, "TxByID", req)
 	if err != nil {
 		return nil, err
 	}
 
-	return db.TxByID(ctx, req)
+	return db.TxByID(s.withSQLSessionVariables(ctx, db), req)
 }
 
 // VerifiableTxByID ...
 func (s *ImmuServer) VerifiableTxById(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error) {
-	db, err := s.getDBFromCtx(ctx, "VerifiableTxByID")
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableTxByID", req)
 	if err != nil {
 		return nil, err
 	}
 
-	vtx, err := db.VerifiableTxByID(ctx, req)
+	vtx, err := db.VerifiableTxByID(s.withSQLSessionVariables(ctx, db), req)
 	if err != nil {
 		return nil, err
 	}
@@ -226,17 +312,17 @@ func (s *ImmuServer) VerifiableTxById(ctx context.Context, req *schema.Verifiabl
 
 // TxScan ...
 func (s *ImmuServer) TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error) {
-	db, err := s.getDBFromCtx(ctx, "TxScan")
+	db, err := s.getDBFromCtxForRequest(ctx, "TxScan", req)
 	if err != nil {
 		return nil, err
 	}
 
-	return db.TxScan(ctx, req)
+	return db.TxScan(s.withSQLSessionVariables(ctx, db), req)
 }
 
 // History ...
 func (s *ImmuServer) History(ctx context.Context, req *schema.HistoryRequest) (*schema.Entries, error) {
-	db, err := s.getDBFromCtx(ctx, "History")
+	db, err := s.getDBFromCtxForRequest(ctx, "History", req)
 	if err != nil {
 		return nil, err
 	}
@@ -244,13 +330,35 @@ func (s *ImmuServer) History(ctx context.Context, req *schema.HistoryRequest) (*
 	return db.History(ctx, req)
 }
 
+// VerifiableHistory ...
+func (s *ImmuServer) VerifiableHistory(ctx context.Context, req *schema.VerifiableHistoryRequest) (*schema.VerifiableEntries, error) {
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableHistory", req)
+	if err != nil {
+		return nil, err
+	}
+
+	vEntries, err := db.VerifiableHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil && vEntries.DualProof != nil {
+		vEntries.Signature, err = s.signStateAt(db.GetName(), vEntries.DualProof.TargetTxHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vEntries, nil
+}
+
 // SetReference ...
 func (s *ImmuServer) SetReference(ctx context.Context, req *schema.ReferenceRequest) (*schema.TxHeader, error) {
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "SetReference")
+	db, err := s.getDBFromCtxForRequest(ctx, "SetReference", req)
 	if err != nil {
 		return nil, err
 	}
@@ -264,7 +372,7 @@ func (s *ImmuServer) VerifiableSetReference(ctx context.Context, req *schema.Ver
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "VerifiableSetReference")
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableSetReference", req)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +409,7 @@ func (s *ImmuServer) ZAdd(ctx context.Context, req *schema.ZAddRequest) (*schema
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "ZAdd")
+	db, err := s.getDBFromCtxForRequest(ctx, "ZAdd", req)
 	if err != nil {
 		return nil, err
 	}
@@ -319,13 +427,35 @@ func (s *ImmuServer) ZScan(ctx context.Context, req *schema.ZScanRequest) (*sche
 	return db.ZScan(ctx, req)
 }
 
+// VerifiableZScan ...
+func (s *ImmuServer) VerifiableZScan(ctx context.Context, req *schema.VerifiableZScanRequest) (*schema.VerifiableZEntries, error) {
+	db, err := s.getDBFromCtx(ctx, "VerifiableZScan")
+	if err != nil {
+		return nil, err
+	}
+
+	vEntries, err := db.VerifiableZScan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil && vEntries.DualProof != nil {
+		vEntries.Signature, err = s.signStateAt(db.GetName(), vEntries.DualProof.TargetTxHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vEntries, nil
+}
+
 // VerifiableZAdd ...
 func (s *ImmuServer) VerifiableZAdd(ctx context.Context, req *schema.VerifiableZAddRequest) (*schema.VerifiableTx, error) {
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "VerifiableZAdd")
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableZAdd", req)
 	if err != nil {
 		return nil, err
 	}
@@ -379,13 +509,65 @@ func (s *ImmuServer) CompactIndex(ctx context.Context, _ *empty.Empty) (*empty.E
 	return &empty.Empty{}, err
 }
 
+func (s *ImmuServer) RebuildIndex(_ *empty.Empty, str schema.ImmuService_RebuildIndexServer) error {
+	db, err := s.getDBFromCtx(str.Context(), "RebuildIndex")
+	if err != nil {
+		return err
+	}
+
+	progress := &schema.RebuildIndexProgress{}
+
+	// a failure sending progress means the client is gone, thus rebuilding gets cancelled
+	ctx, cancel := context.WithCancel(str.Context())
+	defer cancel()
+
+	var sendErr error
+
+	err = db.RebuildIndex(ctx, func(indexedTxID, committedTxID uint64) {
+		if sendErr != nil {
+			return
+		}
+
+		progress.IndexedTxId = indexedTxID
+		progress.CommittedTxId = committedTxID
+
+		sendErr = str.Send(progress)
+		if sendErr != nil {
+			cancel()
+		}
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return err
+	}
+
+	progress.Done = true
+
+	return str.Send(progress)
+}
+
+func (s *ImmuServer) BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadResponse, error) {
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	db, err := s.getDBFromCtx(ctx, "BulkLoad")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.BulkLoad(ctx, req)
+}
+
 // GetAll ...
 func (s *ImmuServer) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	if req == nil {
 		return nil, store.ErrIllegalArguments
 	}
 
-	db, err := s.getDBFromCtx(ctx, "GetAll")
+	db, err := s.getDBFromCtxForRequest(ctx, "GetAll", req)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +580,7 @@ func (s *ImmuServer) Delete(ctx context.Context, req *schema.DeleteKeysRequest)
 		return nil, store.ErrIllegalArguments
 	}
 
-	db, err := s.getDBFromCtx(ctx, "Delete")
+	db, err := s.getDBFromCtxForRequest(ctx, "Delete", req)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +593,7 @@ func (s *ImmuServer) ExecAll(ctx context.Context, req *schema.ExecAllRequest) (*
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "ExecAll")
+	db, err := s.getDBFromCtxForRequest(ctx, "ExecAll", req)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +62,8 @@ type dbOptions struct {
 
 	ExcludeCommitTime bool `json:"excludeCommitTime"`
 
+	AuthenticatedIndex bool `json:"authenticatedIndex"`
+
 	MaxActiveTransactions int `json:"maxActiveTransactions"`
 	MVCCReadSetLimit      int `json:"mvccReadSetLimit"`
 
@@ -270,6 +272,7 @@ func (opts *dbOptions) storeOptions() *store.Options {
 		WithMaxValueLen(opts.MaxValueLen).
 		WithMaxTxEntries(opts.MaxTxEntries).
 		WithWriteTxHeaderVersion(opts.WriteTxHeaderVersion).
+		WithAuthenticatedIndex(opts.AuthenticatedIndex).
 		WithMaxActiveTransactions(opts.MaxActiveTransactions).
 		WithMVCCReadSetLimit(opts.MVCCReadSetLimit).
 		WithMaxConcurrency(opts.MaxConcurrency).
@@ -319,6 +322,8 @@ func (opts *dbOptions) databaseNullableSettings() *schema.DatabaseNullableSettin
 
 		ExcludeCommitTime: &schema.NullableBool{Value: opts.ExcludeCommitTime},
 
+		AuthenticatedIndex: &schema.NullableBool{Value: opts.AuthenticatedIndex},
+
 		MaxActiveTransactions: &schema.NullableUint32{Value: uint32(opts.MaxActiveTransactions)},
 		MvccReadSetLimit:      &schema.NullableUint32{Value: uint32(opts.MVCCReadSetLimit)},
 
@@ -539,6 +544,10 @@ func (s *ImmuServer) overwriteWith(opts *dbOptions, settings *schema.DatabaseNul
 		opts.ExcludeCommitTime = settings.ExcludeCommitTime.Value
 	}
 
+	if settings.AuthenticatedIndex != nil {
+		opts.AuthenticatedIndex = settings.AuthenticatedIndex.Value
+	}
+
 	if settings.MaxActiveTransactions != nil {
 		opts.MaxActiveTransactions = int(settings.MaxActiveTransactions.Value)
 	}
@@ -883,6 +892,7 @@ func (s *ImmuServer) logDBOptions(database string, opts *dbOptions) {
 	s.Logger.Infof("%s.MaxValueLen: %v", database, opts.MaxValueLen)
 	s.Logger.Infof("%s.MaxTxEntries: %v", database, opts.MaxTxEntries)
 	s.Logger.Infof("%s.ExcludeCommitTime: %v", database, opts.ExcludeCommitTime)
+	s.Logger.Infof("%s.AuthenticatedIndex: %v", database, opts.AuthenticatedIndex)
 	s.Logger.Infof("%s.MaxActiveTransactions: %v", database, opts.MaxActiveTransactions)
 	s.Logger.Infof("%s.MVCCReadSetLimit: %v", database, opts.MVCCReadSetLimit)
 	s.Logger.Infof("%s.MaxConcurrency: %v", database, opts.MaxConcurrency)
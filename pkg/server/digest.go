@@ -0,0 +1,43 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+)
+
+// PrefixDigest computes a Merkle root over the key-value entries sharing the requested prefix
+func (s *ImmuServer) PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error) {
+	db, err := s.getDBFromCtxForRequest(ctx, "PrefixDigest", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.PrefixDigest(ctx, req)
+}
+
+// TableDigest computes a Merkle root over the rows of the requested table
+func (s *ImmuServer) TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error) {
+	db, err := s.getDBFromCtxForRequest(ctx, "TableDigest", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.TableDigest(s.withSQLSessionVariables(ctx, db), req)
+}
@@ -56,6 +56,25 @@ var (
 	ErrDatabaseAlreadyLoaded       = errors.New("database already loaded")
 	ErrTruncatorNotNeeded          = errors.New("truncator is not needed")
 	ErrTruncatorNotInProgress      = errors.New("truncation is not in progress")
+	ErrWitnessStateMismatch        = errors.New("cosigned state does not match the state of the database")
+	ErrUntrustedWitness            = errors.New("witness is not trusted by the server")
+	ErrNoCosignedState             = errors.New("no state cosigned by enough witnesses")
+	ErrRoleNotFound                = errors.New("role not found")
+	ErrRoleAlreadyExists           = errors.New("role already exists")
+	ErrExternalCredentialsExpired  = errors.New("external credentials have expired").WithCode(errors.CodInvalidAuthorizationSpecification)
+	ErrClientCertificateRequired   = errors.New("a verified client certificate is required").WithCode(errors.CodInvalidAuthorizationSpecification)
+	ErrUnmappedClientCertificate   = errors.New("client certificate is not mapped to a user").WithCode(errors.CodInvalidAuthorizationSpecification)
+	ErrRevokedCertificate          = goerrors.New("certificate has been revoked")
+	ErrExpiredCRL                  = goerrors.New("certificate revocation list has expired")
+	ErrAuditDatabaseReadOnly       = errors.New("audit database is read-only")
+	ErrUserLocked                  = errors.New("user is locked after too many failed login attempts").WithCode(errors.CodInvalidAuthorizationSpecification)
+	ErrPasswordExpired             = errors.New("password has expired and must be changed").WithCode(errors.CodInvalidAuthorizationSpecification)
+	ErrPasswordReused              = errors.New("password was used recently and can not be reused")
+	ErrAPIKeyNotFound              = errors.New("API key not found")
+	ErrRateLimitExceeded           = errors.New("rate limit exceeded").WithCode(errors.CodInsufficientResources)
+	ErrWriteQuotaExceeded          = errors.New("write quota exceeded").WithCode(errors.CodConfigurationLimitExceeded)
+	ErrDatabaseSizeQuotaExceeded   = errors.New("database size quota exceeded").WithCode(errors.CodDiskFull)
+	ErrMaxConcurrentTxsExceeded    = errors.New("max concurrent transactions exceeded").WithCode(errors.CodTooManyConnections)
 )
 
 func mapServerError(err error) error {
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/auth"
+)
+
+// getJWTUser validates a JWT bearer token and maps its claims to an immudb user.
+// Users not defined in immudb are only granted the roles claimed by the token,
+// while locally defined users keep their permissions and roles as well.
+func (s *ImmuServer) getJWTUser(ctx context.Context, username []byte, token string) (*auth.User, error) {
+	claims, err := s.jwtValidator.Validate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(username) > 0 && string(username) != claims.Username {
+		return nil, fmt.Errorf("%w: token was not issued to user %s", auth.ErrInvalidJWT, username)
+	}
+
+	if claims.Username == auth.SysAdminUsername {
+		return nil, fmt.Errorf("%w: sysadmin can not authenticate with external tokens", auth.ErrInvalidJWT)
+	}
+
+	user, err := s.getUser(ctx, []byte(claims.Username))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		user = &auth.User{
+			Username: claims.Username,
+			Active:   true,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	user.ExternalRoles = claims.Roles
+	user.ExpiresAt = claims.ExpiresAt
+
+	return user, nil
+}
+
+// checkExternalCredentials fails once the external credentials the user authenticated with have expired
+func checkExternalCredentials(user *auth.User) error {
+	if !user.ExpiresAt.IsZero() && time.Now().After(user.ExpiresAt) {
+		return ErrExternalCredentialsExpired
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func signTestJWT(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "key1", "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signed))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestServerJWTAuthentication(t *testing.T) {
+	dir := t.TempDir()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key1",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	jwksPath := filepath.Join(dir, "jwks.json")
+	err = os.WriteFile(jwksPath, jwks, 0644)
+	require.NoError(t, err)
+
+	t.Run("the server should not start if the audience is not provided", func(t *testing.T) {
+		serverOptions := DefaultOptions().
+			WithDir(filepath.Join(dir, "unrestricted")).
+			WithPort(0).
+			WithMetricsServer(false).
+			WithJWTOptions(&auth.JWTOptions{
+				JWKS:   jwksPath,
+				Issuer: "https://sso.example.com",
+			})
+
+		err := DefaultServer().WithOptions(serverOptions).Initialize()
+		require.ErrorIs(t, err, auth.ErrInvalidJWTOptions)
+	})
+
+	serverOptions := DefaultOptions().
+		WithDir(filepath.Join(dir, "data")).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithJWTOptions(&auth.JWTOptions{
+			JWKS:     jwksPath,
+			Issuer:   "https://sso.example.com",
+			Audience: "immudb",
+		})
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	token := func(username string, expiresIn time.Duration, roles ...string) string {
+		return signTestJWT(t, key, map[string]interface{}{
+			"iss":   "https://sso.example.com",
+			"aud":   "immudb",
+			"sub":   username,
+			"exp":   time.Now().Add(expiresIn).Unix(),
+			"roles": roles,
+		})
+	}
+
+	lr, err := s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{Name: testDatabase})
+	require.NoError(t, err)
+
+	ur, err := s.UseDatabase(ctx, &schema.Database{DatabaseName: testDatabase})
+	require.NoError(t, err)
+
+	adminCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", ur.Token))
+
+	_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE table1(id INTEGER, PRIMARY KEY id);
+		INSERT INTO table1(id) VALUES (1);
+	`})
+	require.NoError(t, err)
+
+	res, err := s.SQLQuery(adminCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM table1"})
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 1)
+
+	_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: `
+		CREATE ROLE analyst;
+		GRANT SELECT ON TABLE table1 TO analyst;
+	`})
+	require.NoError(t, err)
+
+	t.Run("tokens should only be accepted for the user they were issued to", func(t *testing.T) {
+		_, err := s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte("user2"),
+			Password: []byte(token("user1", time.Hour, "analyst")),
+		})
+		require.ErrorContains(t, err, auth.ErrInvalidJWT.Error())
+	})
+
+	t.Run("sysadmin should not authenticate with tokens", func(t *testing.T) {
+		_, err := s.Login(context.Background(), &schema.LoginRequest{
+			User:     []byte(auth.SysAdminUsername),
+			Password: []byte(token(auth.SysAdminUsername, time.Hour)),
+		})
+		require.ErrorContains(t, err, auth.ErrInvalidJWT.Error())
+	})
+
+	t.Run("expired tokens should be rejected", func(t *testing.T) {
+		_, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Password:     []byte(token("user1", -time.Hour, "analyst")),
+			DatabaseName: testDatabase,
+		})
+		require.ErrorContains(t, err, auth.ErrInvalidJWT.Error())
+	})
+
+	t.Run("tokens without roles on the database should not open sessions", func(t *testing.T) {
+		_, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Password:     []byte(token("user1", time.Hour)),
+			DatabaseName: testDatabase,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("tokens should grant the claimed roles", func(t *testing.T) {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{
+			Password: []byte(token("user1", time.Hour, "analyst")),
+		})
+		require.NoError(t, err)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+		ur, err := s.UseDatabase(ctx, &schema.Database{DatabaseName: testDatabase})
+		require.NoError(t, err)
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", ur.Token))
+
+		res, err := s.SQLQuery(ctx, &schema.SQLQueryRequest{Sql: "SELECT id FROM table1"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "INSERT INTO table1(id) VALUES (2)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("sessions opened with tokens should be bound to their expiration", func(t *testing.T) {
+		resp, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte("user1"),
+			Password:     []byte(token("user1", time.Hour, "analyst")),
+			DatabaseName: testDatabase,
+		})
+		require.NoError(t, err)
+
+		sess, err := s.SessManager.GetSession(resp.SessionID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"analyst"}, sess.GetUser().ExternalRoles)
+
+		require.NoError(t, checkExternalCredentials(sess.GetUser()))
+
+		sess.GetUser().ExpiresAt = time.Now().Add(-time.Second)
+		require.ErrorIs(t, checkExternalCredentials(sess.GetUser()), ErrExternalCredentialsExpired)
+	})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/ldap"
+)
+
+// ldapAuthProvider authenticates users binding to an LDAP directory with their credentials.
+// Users are granted the permissions mapped to the groups they are members of, on top of the
+// permissions of the immudb user with the same name, if any.
+// Users not found in the directory, as well as sysadmin, are authenticated by the fallback provider
+type ldapAuthProvider struct {
+	s        *ImmuServer
+	opts     *LDAPOptions
+	fallback authProvider
+}
+
+func newLDAPAuthProvider(s *ImmuServer, opts *LDAPOptions, fallback authProvider) (*ldapAuthProvider, error) {
+	if opts.URL == "" {
+		return nil, fmt.Errorf("%w: no ldap url was provided", ErrIllegalArguments)
+	}
+
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ldap url: %v", ErrIllegalArguments, err)
+	}
+
+	if u.Scheme == "ldaps" && opts.StartTLS {
+		return nil, fmt.Errorf("%w: StartTLS can not be used with ldaps urls", ErrIllegalArguments)
+	}
+
+	// passwords are bound as they are, so they must not be sent over unencrypted connections by mistake
+	if u.Scheme == "ldap" && !opts.StartTLS {
+		if !opts.Cleartext {
+			return nil, fmt.Errorf("%w: passwords would be sent in cleartext to %s, either use StartTLS, an ldaps url or explicitly allow cleartext connections", ErrIllegalArguments, opts.URL)
+		}
+
+		if s != nil {
+			s.Logger.Warningf("passwords are sent in cleartext to the LDAP directory at %s", opts.URL)
+		}
+	}
+
+	if strings.Count(opts.UserFilter, "%s") != 1 {
+		return nil, fmt.Errorf("%w: the ldap user filter must include the username placeholder once", ErrIllegalArguments)
+	}
+
+	if opts.GroupBaseDN != "" && strings.Count(opts.GroupFilter, "%s") != 1 {
+		return nil, fmt.Errorf("%w: the ldap group filter must include the user placeholder once", ErrIllegalArguments)
+	}
+
+	for group, permissions := range opts.GroupPermissions {
+		for _, p := range permissions {
+			if p.Permission != auth.PermissionR && p.Permission != auth.PermissionRW && p.Permission != auth.PermissionAdmin {
+				return nil, fmt.Errorf("%w: invalid permission granted to ldap group %s", ErrIllegalArguments, group)
+			}
+		}
+	}
+
+	return &ldapAuthProvider{s: s, opts: opts, fallback: fallback}, nil
+}
+
+func (p *ldapAuthProvider) authenticate(ctx context.Context, username []byte, password []byte) (*auth.User, error) {
+	if string(username) == auth.SysAdminUsername {
+		return p.fallback.authenticate(ctx, username, password)
+	}
+
+	conn, err := ldap.Dial(p.opts.URL, p.opts.TLSConfig, p.opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if p.opts.StartTLS {
+		err = conn.StartTLS(p.opts.TLSConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = conn.Bind(p.opts.BindDN, p.opts.BindPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := conn.Search(&ldap.SearchRequest{
+		BaseDN:     p.opts.UserBaseDN,
+		Scope:      ldap.ScopeWholeSubtree,
+		Filter:     fmt.Sprintf(p.opts.UserFilter, ldap.EscapeFilter(string(username))),
+		Attributes: []string{"1.1"}, // no attributes
+		SizeLimit:  2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return p.fallback.authenticate(ctx, username, password)
+	}
+
+	if len(entries) > 1 {
+		return nil, fmt.Errorf("multiple ldap entries match user %s", username)
+	}
+
+	// an empty password would result in an unauthenticated bind, which directories may accept
+	if len(password) == 0 {
+		return nil, ldap.ErrInvalidCredentials
+	}
+
+	userDN := entries[0].DN
+
+	err = conn.Bind(userDN, string(password))
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := p.s.getUser(ctx, username)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		user = &auth.User{
+			Username: string(username),
+			Active:   true,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if p.opts.GroupBaseDN == "" {
+		return user, nil
+	}
+
+	// groups are looked up with the service account, as users may not be allowed to
+	err = conn.Bind(p.opts.BindDN, p.opts.BindPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := conn.Search(&ldap.SearchRequest{
+		BaseDN:     p.opts.GroupBaseDN,
+		Scope:      ldap.ScopeWholeSubtree,
+		Filter:     fmt.Sprintf(p.opts.GroupFilter, ldap.EscapeFilter(userDN)),
+		Attributes: []string{p.opts.GroupNameAttribute},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		for _, name := range group.Values(p.opts.GroupNameAttribute) {
+			for _, permission := range p.opts.GroupPermissions[name] {
+				grantPermission(user, permission)
+			}
+		}
+	}
+
+	return user, nil
+}
+
+// grantPermission grants the permission on the database, unless a higher one was already granted
+func grantPermission(user *auth.User, permission auth.Permission) {
+	for i, p := range user.Permissions {
+		if p.Database == permission.Database {
+			if permission.Permission > p.Permission {
+				user.Permissions[i].Permission = permission.Permission
+			}
+			return
+		}
+	}
+
+	user.Permissions = append(user.Permissions, permission)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/ldap"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerLDAPAuthentication(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	directory := ldap.NewLocalDirectory()
+	go directory.Serve(l)
+
+	ca := newTestCA(t)
+
+	directory.EnableStartTLS(&tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "ldap"},
+			IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})},
+	})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	directory.AddEntry("cn=immudb,ou=services,dc=example,dc=com", map[string][]string{
+		"cn":           {"immudb"},
+		"userPassword": {"servicepwd"},
+	})
+	directory.AddEntry("uid=jane,ou=people,dc=example,dc=com", map[string][]string{
+		"uid":          {"jane"},
+		"userPassword": {"janepwd"},
+	})
+	directory.AddEntry("uid=immudb,ou=people,dc=example,dc=com", map[string][]string{
+		"uid":          {"immudb"},
+		"userPassword": {"directorypwd"},
+	})
+	directory.AddEntry("cn=engineers,ou=groups,dc=example,dc=com", map[string][]string{
+		"cn":     {"engineers"},
+		"member": {"uid=jane,ou=people,dc=example,dc=com"},
+	})
+
+	ldapOptions := DefaultLDAPOptions()
+	ldapOptions.URL = "ldap://" + l.Addr().String()
+	ldapOptions.StartTLS = true
+	ldapOptions.TLSConfig = &tls.Config{RootCAs: roots}
+	ldapOptions.BindDN = "cn=immudb,ou=services,dc=example,dc=com"
+	ldapOptions.BindPassword = "servicepwd"
+	ldapOptions.UserBaseDN = "ou=people,dc=example,dc=com"
+	ldapOptions.GroupBaseDN = "ou=groups,dc=example,dc=com"
+	ldapOptions.GroupPermissions = map[string][]auth.Permission{
+		"engineers": {{Database: testDatabase, Permission: auth.PermissionR}},
+		"unknown":   {{Database: DefaultDBName, Permission: auth.PermissionAdmin}},
+	}
+
+	serverOptions := DefaultOptions().
+		WithDir(filepath.Join(t.TempDir(), "data")).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithLDAPOptions(ldapOptions)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err = s.Initialize()
+	require.NoError(t, err)
+
+	lr, err := s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{Name: testDatabase})
+	require.NoError(t, err)
+
+	_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       []byte("john"),
+		Password:   []byte("J0hnPassword!"),
+		Database:   DefaultDBName,
+		Permission: auth.PermissionRW,
+	})
+	require.NoError(t, err)
+
+	t.Run("directory users should be granted the permissions of their groups", func(t *testing.T) {
+		user, err := s.getValidatedUser(context.Background(), []byte("jane"), []byte("janepwd"))
+		require.NoError(t, err)
+		require.Equal(t, "jane", user.Username)
+		require.True(t, user.Active)
+		require.Equal(t, []auth.Permission{{Database: testDatabase, Permission: auth.PermissionR}}, user.Permissions)
+
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte("jane"), Password: []byte("janepwd")})
+		require.NoError(t, err)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+		_, err = s.UseDatabase(ctx, &schema.Database{DatabaseName: testDatabase})
+		require.NoError(t, err)
+
+		_, err = s.UseDatabase(ctx, &schema.Database{DatabaseName: DefaultDBName})
+		require.Error(t, err)
+	})
+
+	t.Run("directory users should be authenticated with their directory passwords", func(t *testing.T) {
+		_, err := s.getValidatedUser(context.Background(), []byte("jane"), []byte("wrongpwd"))
+		require.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+		_, err = s.getValidatedUser(context.Background(), []byte("jane"), nil)
+		require.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+		_, err = s.getValidatedUser(context.Background(), []byte("jane)(uid=*"), []byte("janepwd"))
+		require.Error(t, err)
+	})
+
+	t.Run("users not in the directory should be authenticated by immudb", func(t *testing.T) {
+		user, err := s.getValidatedUser(context.Background(), []byte("john"), []byte("J0hnPassword!"))
+		require.NoError(t, err)
+		require.Equal(t, []auth.Permission{{Database: DefaultDBName, Permission: auth.PermissionRW}}, user.Permissions)
+
+		_, err = s.getValidatedUser(context.Background(), []byte("john"), []byte("wrongpwd"))
+		require.Error(t, err)
+	})
+
+	t.Run("sysadmin should always be authenticated by immudb", func(t *testing.T) {
+		_, err := s.getValidatedUser(context.Background(), []byte(auth.SysAdminUsername), []byte("directorypwd"))
+		require.Error(t, err)
+
+		_, err = s.getValidatedUser(context.Background(), []byte(auth.SysAdminUsername), []byte(auth.SysAdminPassword))
+		require.NoError(t, err)
+	})
+
+	t.Run("immudb users should keep their permissions when authenticated by the directory", func(t *testing.T) {
+		directory.AddEntry("uid=john,ou=people,dc=example,dc=com", map[string][]string{
+			"uid":          {"john"},
+			"userPassword": {"johnpwd"},
+		})
+		directory.AddEntry("cn=engineers,ou=groups,dc=example,dc=com", map[string][]string{
+			"cn":     {"engineers"},
+			"member": {"uid=jane,ou=people,dc=example,dc=com", "uid=john,ou=people,dc=example,dc=com"},
+		})
+
+		_, err := s.getValidatedUser(context.Background(), []byte("john"), []byte("J0hnPassword!"))
+		require.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+		user, err := s.getValidatedUser(context.Background(), []byte("john"), []byte("johnpwd"))
+		require.NoError(t, err)
+		require.Equal(t, []auth.Permission{
+			{Database: DefaultDBName, Permission: auth.PermissionRW},
+			{Database: testDatabase, Permission: auth.PermissionR},
+		}, user.Permissions)
+	})
+
+	t.Run("directories with untrusted certificates should fail the authentication", func(t *testing.T) {
+		s.Options.LDAPOptions.TLSConfig = nil
+		defer func() { s.Options.LDAPOptions.TLSConfig = ldapOptions.TLSConfig }()
+
+		_, err := s.getValidatedUser(context.Background(), []byte("jane"), []byte("janepwd"))
+		require.ErrorIs(t, err, ldap.ErrTLS)
+	})
+
+	t.Run("unavailable directories should fail the authentication", func(t *testing.T) {
+		s.Options.LDAPOptions.URL = "ldap://127.0.0.1:1"
+		defer func() { s.Options.LDAPOptions.URL = ldapOptions.URL }()
+
+		_, err := s.getValidatedUser(context.Background(), []byte("jane"), []byte("janepwd"))
+		require.Error(t, err)
+	})
+}
+
+func TestLDAPAuthProviderOptions(t *testing.T) {
+	opts := DefaultLDAPOptions()
+
+	_, err := newLDAPAuthProvider(nil, opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.URL = "ldap://localhost"
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.Cleartext = true
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.NoError(t, err)
+
+	opts.URL = "ldaps://localhost"
+	opts.Cleartext = false
+	opts.StartTLS = true
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.URL = "ldap://localhost"
+	opts.UserFilter = "(uid=jane)"
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.UserFilter = "(uid=%s)"
+	opts.GroupBaseDN = "ou=groups,dc=example,dc=com"
+	opts.GroupFilter = "(member=jane)"
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.GroupFilter = "(member=%s)"
+	opts.GroupPermissions = map[string][]auth.Permission{"admins": {{Database: DefaultDBName, Permission: auth.PermissionSysAdmin}}}
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	opts.GroupPermissions = map[string][]auth.Permission{"admins": {{Database: DefaultDBName, Permission: auth.PermissionAdmin}}}
+
+	_, err = newLDAPAuthProvider(nil, opts, nil)
+	require.NoError(t, err)
+}
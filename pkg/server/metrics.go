@@ -59,6 +59,14 @@ type MetricsCollection struct {
 	LastMessageAtPerClientGauges *prometheus.GaugeVec
 
 	RemoteStorageKind *prometheus.GaugeVec
+
+	ScrubbedTxsCounters        *prometheus.CounterVec
+	IntegrityErrorsCounters    *prometheus.CounterVec
+	ScrubbedTxIDGauges         *prometheus.GaugeVec
+	LastScrubCompletedAtGauges *prometheus.GaugeVec
+
+	QuotaRejectionsCounters   *prometheus.CounterVec
+	QuotaWrittenBytesCounters *prometheus.CounterVec
 }
 
 var metricsNamespace = "immudb"
@@ -153,6 +161,54 @@ var Metrics = MetricsCollection{
 		},
 		[]string{"db", "kind"},
 	),
+	ScrubbedTxsCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "scrubber_verified_txs_total",
+			Help:      "Number of transactions verified by the integrity scrubber.",
+		},
+		[]string{"db"},
+	),
+	IntegrityErrorsCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "scrubber_integrity_errors_total",
+			Help:      "Number of integrity mismatches detected by the integrity scrubber.",
+		},
+		[]string{"db"},
+	),
+	ScrubbedTxIDGauges: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "scrubber_verified_tx_id",
+			Help:      "Id of the latest transaction verified by the ongoing integrity scrub.",
+		},
+		[]string{"db"},
+	),
+	LastScrubCompletedAtGauges: promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "scrubber_last_completed_at_unix_seconds",
+			Help:      "Timestamp at which the latest integrity scrub of the database was completed.",
+		},
+		[]string{"db"},
+	),
+	QuotaRejectionsCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "quota_rejected_requests_total",
+			Help:      "Number of requests rejected because a quota of the user or database was exceeded.",
+		},
+		[]string{"scope", "name", "limit"},
+	),
+	QuotaWrittenBytesCounters: promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "quota_written_bytes_total",
+			Help:      "Number of bytes written by the users and into the databases subject to quota rules.",
+		},
+		[]string{"scope", "name"},
+	),
 }
 
 // StartMetrics listens and servers the HTTP metrics server in a new goroutine.
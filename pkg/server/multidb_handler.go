@@ -72,11 +72,14 @@ func (h *multidbHandler) ExecPreparedStmts(
 	params map[string]interface{},
 ) (ntx *sql.SQLTx, committedTxs []*sql.SQLTx, err error) {
 
-	db, err := h.s.getDBFromCtx(ctx, "SQLExec")
+	db, err := h.s.getDBFromCtxForRequest(ctx, "SQLExec", stmts)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// session variables are bound to the selected database
+	ctx = h.s.withSQLSessionVariables(ctx, db)
+
 	tx, err := db.NewSQLTx(ctx, opts)
 	if err != nil {
 		return nil, nil, err
@@ -84,3 +87,104 @@ func (h *multidbHandler) ExecPreparedStmts(
 
 	return db.SQLExecPrepared(ctx, tx, stmts, params)
 }
+
+func (h *multidbHandler) CreateRole(ctx context.Context, role string, ifNotExists bool) error {
+	_, err := h.s.CreateRole(ctx, &schema.CreateRoleRequest{
+		Name:        role,
+		IfNotExists: ifNotExists,
+	})
+	return err
+}
+
+func (h *multidbHandler) DropRole(ctx context.Context, role string) error {
+	_, err := h.s.DropRole(ctx, &schema.DropRoleRequest{Name: role})
+	return err
+}
+
+func (h *multidbHandler) GrantPrivileges(ctx context.Context, role string, privileges []string, object *sql.PrivilegeObject) error {
+	return h.changePrivileges(ctx, schema.PermissionAction_GRANT, role, privileges, object)
+}
+
+func (h *multidbHandler) RevokePrivileges(ctx context.Context, role string, privileges []string, object *sql.PrivilegeObject) error {
+	return h.changePrivileges(ctx, schema.PermissionAction_REVOKE, role, privileges, object)
+}
+
+// changePrivileges grants or revokes privileges on an object of the database in use,
+// no privileges stand for all the ones applicable to the object
+func (h *multidbHandler) changePrivileges(
+	ctx context.Context,
+	action schema.PermissionAction,
+	role string,
+	privileges []string,
+	object *sql.PrivilegeObject,
+) error {
+	db, err := h.s.getDBFromCtx(ctx, "SQLExec")
+	if err != nil {
+		return err
+	}
+
+	var applicable []auth.Privilege
+
+	switch object.Type {
+	case sql.TableObject:
+		applicable = auth.TablePrivileges
+	case sql.KeyPrefixObject:
+		applicable = auth.KeyPrivileges
+	default:
+		applicable = auth.DatabasePrivileges
+	}
+
+	rolePrivileges := make([]auth.Privilege, 0, len(privileges))
+
+	for _, name := range privileges {
+		p, err := auth.ParsePrivilege(name)
+		if err != nil {
+			return err
+		}
+
+		if !auth.ContainsPrivilege(applicable, p) {
+			return fmt.Errorf("%w: %s privilege can not be granted on this object", auth.ErrInvalidPrivilege, p)
+		}
+
+		rolePrivileges = append(rolePrivileges, p)
+	}
+
+	if len(rolePrivileges) == 0 {
+		rolePrivileges = applicable
+	}
+
+	for _, p := range rolePrivileges {
+		_, err := h.s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action: action,
+			Role:   role,
+			Privilege: &schema.RolePrivilege{
+				Privilege: string(p),
+				Database:  db.GetName(),
+				Object:    object.Name,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *multidbHandler) GrantRole(ctx context.Context, role string, username string) error {
+	_, err := h.s.ChangeRoleMembership(ctx, &schema.ChangeRoleMembershipRequest{
+		Action:   schema.PermissionAction_GRANT,
+		Role:     role,
+		Username: username,
+	})
+	return err
+}
+
+func (h *multidbHandler) RevokeRole(ctx context.Context, role string, username string) error {
+	_, err := h.s.ChangeRoleMembership(ctx, &schema.ChangeRoleMembershipRequest{
+		Action:   schema.PermissionAction_REVOKE,
+		Role:     role,
+		Username: username,
+	})
+	return err
+}
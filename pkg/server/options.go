@@ -22,6 +22,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/pkg/server/sessions"
@@ -33,6 +34,7 @@ import (
 
 const SystemDBName = "systemdb"
 const DefaultDBName = "defaultdb"
+const AuditDBName = "auditdb"
 
 // Options server options list
 type Options struct {
@@ -61,6 +63,8 @@ type Options struct {
 	usingCustomListener  bool
 	maintenance          bool
 	SigningKey           string
+	SigningPlugin        string
+	SigningKeyChain      string
 	synced               bool
 	RemoteStorageOptions *RemoteStorageOptions
 	StreamChunkSize      int
@@ -71,6 +75,51 @@ type Options struct {
 	SessionsOptions      *sessions.Options
 	PProf                bool
 	LogFormat            string
+
+	// TimestampAuthorityURL is the RFC 3161 time-stamping authority used to anchor database states
+	TimestampAuthorityURL      string
+	TimestampAuthorityInterval time.Duration
+
+	// JWTOptions enables the authentication with externally issued JWT bearer tokens
+	JWTOptions *auth.JWTOptions
+
+	// LDAPOptions enables the authentication of users against an LDAP directory
+	LDAPOptions *LDAPOptions
+
+	// ClientCertificateMapping enables the authentication of users with their verified client certificates
+	ClientCertificateMapping []*CertificateMappingRule
+
+	// ClientCRL is the file holding the revocation lists client certificates are checked against during handshakes
+	ClientCRL string
+
+	// AuditLog enables recording administrative and security relevant actions into the audit database
+	AuditLog bool
+
+	// PasswordPolicy defines the requirements and expiration of the passwords of the users defined in immudb
+	PasswordPolicy *auth.PasswordPolicy
+
+	// MaxFailedLogins is the number of consecutive failed login attempts after which users are locked out,
+	// zero disables the lockout
+	MaxFailedLogins int
+
+	// LockoutDuration is how long users are locked out, doubled on every further failed attempt
+	LockoutDuration time.Duration
+
+	// Scrubber enables the background verification of the integrity of the data stored by the databases
+	Scrubber bool
+
+	// ScrubberInterval is the time between the start of consecutive integrity scrubs of a database
+	ScrubberInterval time.Duration
+
+	// ScrubberRate is the maximum number of transactions verified per second, zero means unlimited
+	ScrubberRate int
+
+	// Quotas limit the requests of users and to databases
+	Quotas []*QuotaRule
+
+	// WitnessPubKeys are the files holding the public keys of the only witnesses allowed to cosign states,
+	// any witness is allowed when empty
+	WitnessPubKeys []string
 }
 
 type RemoteStorageOptions struct {
@@ -98,6 +147,38 @@ type ReplicationOptions struct {
 	WaitForIndexing              bool   // only if IsReplica
 }
 
+// LDAPOptions configure the authentication of users against an LDAP directory.
+// Users are looked up with the service account, if any, and authenticated binding with their own credentials
+type LDAPOptions struct {
+	URL          string        // ldap://host[:port] or ldaps://host[:port]
+	StartTLS     bool          // upgrades the connections to ldap urls to TLS
+	Cleartext    bool          // explicitly allows ldap urls without StartTLS, passwords are then sent in cleartext
+	TLSConfig    *tls.Config   // for ldaps urls and StartTLS
+	Timeout      time.Duration // bounds connection establishment and each of the operations
+	BindDN       string        // service account used to look up users and groups, anonymous if empty
+	BindPassword string
+
+	UserBaseDN string
+	UserFilter string // %s is replaced with the escaped username
+
+	GroupBaseDN        string                       // groups are not looked up if empty
+	GroupFilter        string                       // %s is replaced with the escaped distinguished name of the user
+	GroupNameAttribute string                       // attribute holding the names groups are mapped by
+	GroupPermissions   map[string][]auth.Permission // permissions granted to the members of each group
+}
+
+// DefaultLDAPOptions returns the default options of the LDAP authentication, suited to directories
+// with inetOrgPerson users and groupOfNames groups
+func DefaultLDAPOptions() *LDAPOptions {
+	return &LDAPOptions{
+		Timeout:            10 * time.Second,
+		UserFilter:         "(uid=%s)",
+		GroupFilter:        "(member=%s)",
+		GroupNameAttribute: "cn",
+		GroupPermissions:   make(map[string][]auth.Permission),
+	}
+}
+
 // DefaultOptions returns default server options
 func DefaultOptions() *Options {
 	return &Options{
@@ -133,6 +214,14 @@ func DefaultOptions() *Options {
 		ReplicationOptions:   &ReplicationOptions{IsReplica: false, SyncAcks: 0},
 		SessionsOptions:      sessions.DefaultOptions(),
 		PProf:                false,
+
+		TimestampAuthorityInterval: time.Hour,
+
+		PasswordPolicy:  auth.DefaultPasswordPolicy(),
+		LockoutDuration: DefaultLockoutDuration,
+
+		ScrubberInterval: 24 * time.Hour,
+		ScrubberRate:     1000,
 	}
 }
 
@@ -302,6 +391,45 @@ func (o *Options) String() string {
 	if o.SigningKey != "" {
 		opts = append(opts, rightPad("Signing key", o.SigningKey))
 	}
+	if o.SigningPlugin != "" {
+		opts = append(opts, rightPad("Signing plugin", o.SigningPlugin))
+	}
+	if o.SigningKeyChain != "" {
+		opts = append(opts, rightPad("Signing key chain", o.SigningKeyChain))
+	}
+	if len(o.WitnessPubKeys) > 0 {
+		opts = append(opts, rightPad("Witness public keys", strings.Join(o.WitnessPubKeys, ", ")))
+	}
+	if o.TimestampAuthorityURL != "" {
+		opts = append(opts, rightPad("TSA", fmt.Sprintf("%s every %s", o.TimestampAuthorityURL, o.TimestampAuthorityInterval)))
+	}
+	if o.JWTOptions != nil {
+		opts = append(opts, rightPad("JWT key set", o.JWTOptions.JWKS))
+	}
+	if o.LDAPOptions != nil {
+		opts = append(opts, rightPad("LDAP directory", o.LDAPOptions.URL))
+	}
+	if len(o.ClientCertificateMapping) > 0 {
+		opts = append(opts, rightPad("Client certificate rules", len(o.ClientCertificateMapping)))
+	}
+	if o.ClientCRL != "" {
+		opts = append(opts, rightPad("Client CRL", o.ClientCRL))
+	}
+	if o.AuditLog {
+		opts = append(opts, rightPad("Audit log", AuditDBName))
+	}
+	if o.PasswordPolicy != nil && o.PasswordPolicy.MaxAge > 0 {
+		opts = append(opts, rightPad("Password max age", o.PasswordPolicy.MaxAge))
+	}
+	if o.MaxFailedLogins > 0 {
+		opts = append(opts, rightPad("Lockout", fmt.Sprintf("after %d failed logins for %s", o.MaxFailedLogins, o.LockoutDuration)))
+	}
+	if len(o.Quotas) > 0 {
+		opts = append(opts, rightPad("Quota rules", len(o.Quotas)))
+	}
+	if o.Scrubber {
+		opts = append(opts, rightPad("Integrity scrubber", fmt.Sprintf("every %s at %d txs/s", o.ScrubberInterval, o.ScrubberRate)))
+	}
 	if o.RemoteStorageOptions.S3Storage {
 		opts = append(opts, "S3 storage")
 		opts = append(opts, rightPad("   endpoint", o.RemoteStorageOptions.S3Endpoint))
@@ -408,6 +536,109 @@ func (o *Options) WithSigningKey(signingKey string) *Options {
 	return o
 }
 
+// WithSigningPlugin sets the external plugin used to sign states, as an alternative to the signature private key
+func (o *Options) WithSigningPlugin(signingPlugin string) *Options {
+	o.SigningPlugin = signingPlugin
+	return o
+}
+
+// WithSigningKeyChain sets the file holding the chain of rotations of the signing key
+func (o *Options) WithSigningKeyChain(signingKeyChain string) *Options {
+	o.SigningKeyChain = signingKeyChain
+	return o
+}
+
+// WithTimestampAuthorityURL sets the RFC 3161 time-stamping authority used to periodically anchor the state of the databases
+func (o *Options) WithTimestampAuthorityURL(url string) *Options {
+	o.TimestampAuthorityURL = url
+	return o
+}
+
+// WithTimestampAuthorityInterval sets how often the state of the databases is anchored
+func (o *Options) WithTimestampAuthorityInterval(interval time.Duration) *Options {
+	o.TimestampAuthorityInterval = interval
+	return o
+}
+
+// WithJWTOptions enables the authentication with JWT bearer tokens validated against a JSON Web Key Set
+func (o *Options) WithJWTOptions(jwtOptions *auth.JWTOptions) *Options {
+	o.JWTOptions = jwtOptions
+	return o
+}
+
+// WithLDAPOptions enables the authentication of users against an LDAP directory
+func (o *Options) WithLDAPOptions(ldapOptions *LDAPOptions) *Options {
+	o.LDAPOptions = ldapOptions
+	return o
+}
+
+// WithClientCertificateMapping enables the authentication of users with their verified client certificates,
+// mapped to users by the first matching rule
+func (o *Options) WithClientCertificateMapping(rules ...*CertificateMappingRule) *Options {
+	o.ClientCertificateMapping = rules
+	return o
+}
+
+// WithClientCRL sets the file holding the revocation lists client certificates are checked against
+func (o *Options) WithClientCRL(path string) *Options {
+	o.ClientCRL = path
+	return o
+}
+
+// WithAuditLog enables recording administrative and security relevant actions into the audit database
+func (o *Options) WithAuditLog(auditLog bool) *Options {
+	o.AuditLog = auditLog
+	return o
+}
+
+// WithPasswordPolicy sets the requirements and expiration of the passwords of the users defined in immudb
+func (o *Options) WithPasswordPolicy(policy *auth.PasswordPolicy) *Options {
+	o.PasswordPolicy = policy
+	return o
+}
+
+// WithMaxFailedLogins sets the number of consecutive failed login attempts after which users are locked out
+func (o *Options) WithMaxFailedLogins(maxFailedLogins int) *Options {
+	o.MaxFailedLogins = maxFailedLogins
+	return o
+}
+
+// WithLockoutDuration sets how long users are locked out after too many failed login attempts
+func (o *Options) WithLockoutDuration(lockoutDuration time.Duration) *Options {
+	o.LockoutDuration = lockoutDuration
+	return o
+}
+
+// WithQuotas sets the rules limiting the requests of users and to databases
+func (o *Options) WithQuotas(rules ...*QuotaRule) *Options {
+	o.Quotas = rules
+	return o
+}
+
+// WithWitnessPubKeys sets the files holding the public keys of the only witnesses allowed to cosign states
+func (o *Options) WithWitnessPubKeys(witnessPubKeys ...string) *Options {
+	o.WitnessPubKeys = witnessPubKeys
+	return o
+}
+
+// WithScrubber enables the background verification of the integrity of the stored data
+func (o *Options) WithScrubber(scrubber bool) *Options {
+	o.Scrubber = scrubber
+	return o
+}
+
+// WithScrubberInterval sets how often the integrity of each database is verified
+func (o *Options) WithScrubberInterval(interval time.Duration) *Options {
+	o.ScrubberInterval = interval
+	return o
+}
+
+// WithScrubberRate sets the maximum number of transactions verified per second by the scrubber
+func (o *Options) WithScrubberRate(rate int) *Options {
+	o.ScrubberRate = rate
+	return o
+}
+
 // WithStreamChunkSize set the chunk size
 func (o *Options) WithStreamChunkSize(streamChunkSize int) *Options {
 	o.StreamChunkSize = streamChunkSize
@@ -19,6 +19,7 @@ package server
 import (
 	"crypto/tls"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/logger"
@@ -123,7 +124,9 @@ func TestSetOptions(t *testing.T) {
 		WithPgsqlServer(true).
 		WithPgsqlServerPort(123456).
 		WithPProf(true).
-		WithLogFormat(logger.LogFormatJSON)
+		WithLogFormat(logger.LogFormatJSON).
+		WithTimestampAuthorityURL("http://localhost:8318").
+		WithTimestampAuthorityInterval(time.Minute)
 
 	if op.GetAuth() != false ||
 		op.Dir != "immudb_dir" ||
@@ -151,6 +154,8 @@ func TestSetOptions(t *testing.T) {
 		!op.PgsqlServer ||
 		op.PgsqlServerPort != 123456 ||
 		op.PProf != true ||
+		op.TimestampAuthorityURL != "http://localhost:8318" ||
+		op.TimestampAuthorityInterval != time.Minute ||
 		op.IsJSONLogger() != true {
 		t.Errorf("database default options mismatch")
 	}
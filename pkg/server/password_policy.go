@@ -0,0 +1,139 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/auth"
+)
+
+// DefaultLockoutDuration is how long users are first locked out after too many failed login attempts
+const DefaultLockoutDuration = 5 * time.Minute
+
+// maxLockoutDuration caps the lockout doubled on every further failed attempt
+const maxLockoutDuration = 24 * time.Hour
+
+// checkNewPassword validates the password is allowed by the policy and was not recently used by the user
+func (s *ImmuServer) checkNewPassword(user *auth.User, password []byte) error {
+	policy := s.Options.PasswordPolicy
+
+	if err := policy.Check(string(password)); err != nil {
+		return err
+	}
+
+	if policy.History == 0 {
+		return nil
+	}
+
+	if user.HashedPassword != nil && auth.ComparePasswords(user.HashedPassword, password) == nil {
+		return ErrPasswordReused
+	}
+
+	for _, hashedPassword := range user.PasswordHistory {
+		if auth.ComparePasswords(hashedPassword, password) == nil {
+			return ErrPasswordReused
+		}
+	}
+
+	return nil
+}
+
+// setPassword sets the password of the user keeping the hashes of the previous ones
+// the policy does not allow to reuse
+func (s *ImmuServer) setPassword(user *auth.User, password []byte) error {
+	previous := user.HashedPassword
+
+	if _, err := user.SetPassword(password); err != nil {
+		return err
+	}
+
+	history := s.Options.PasswordPolicy.History - 1
+
+	if previous == nil || history <= 0 {
+		user.PasswordHistory = nil
+		return nil
+	}
+
+	user.PasswordHistory = append([][]byte{previous}, user.PasswordHistory...)
+	if len(user.PasswordHistory) > history {
+		user.PasswordHistory = user.PasswordHistory[:history]
+	}
+
+	return nil
+}
+
+// isPasswordExpired returns true if the password of the user is no longer valid according to the policy,
+// users created before passwords could expire are considered to have set it on creation
+func (s *ImmuServer) isPasswordExpired(user *auth.User) bool {
+	changedAt := user.PasswordChangedAt
+	if changedAt.IsZero() {
+		changedAt = user.CreatedAt
+	}
+
+	return s.Options.PasswordPolicy.IsExpired(changedAt)
+}
+
+// registerFailedLogin counts a failed login attempt of the user, locking it out when there are too many
+func (s *ImmuServer) registerFailedLogin(ctx context.Context, username []byte) {
+	if s.Options.MaxFailedLogins <= 0 {
+		return
+	}
+
+	s.loginAttemptsMutex.Lock()
+	defer s.loginAttemptsMutex.Unlock()
+
+	user, err := s.getUser(ctx, username)
+	if err != nil {
+		return
+	}
+
+	user.FailedLogins++
+
+	if user.FailedLogins >= s.Options.MaxFailedLogins {
+		lockout := s.Options.LockoutDuration
+		for i := s.Options.MaxFailedLogins; i < user.FailedLogins && lockout < maxLockoutDuration; i++ {
+			lockout *= 2
+		}
+		if lockout > maxLockoutDuration {
+			lockout = maxLockoutDuration
+		}
+
+		user.LockedUntil = time.Now().Add(lockout)
+
+		s.Logger.Warningf("user %s is locked until %s after %d failed login attempts",
+			user.Username, user.LockedUntil.Format(time.RFC3339), user.FailedLogins)
+	}
+
+	s.saveUser(ctx, user)
+}
+
+// resetFailedLogins clears the failed login attempts of the user after a successful login
+func (s *ImmuServer) resetFailedLogins(ctx context.Context, user *auth.User) error {
+	if user.FailedLogins == 0 && user.LockedUntil.IsZero() {
+		return nil
+	}
+
+	s.loginAttemptsMutex.Lock()
+	defer s.loginAttemptsMutex.Unlock()
+
+	user.FailedLogins = 0
+	user.LockedUntil = time.Time{}
+
+	return s.saveUser(ctx, user)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServerPasswordPolicy(t *testing.T) {
+	dir := t.TempDir()
+
+	policy := &auth.PasswordPolicy{
+		MinLength:    10,
+		MaxLength:    32,
+		RequireDigit: true,
+		History:      2,
+		MaxAge:       time.Hour,
+	}
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithPasswordPolicy(policy).
+		WithMaxFailedLogins(3).
+		WithLockoutDuration(time.Minute)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	login := func(username, password string) (*schema.LoginResponse, context.Context, error) {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte(username), Password: []byte(password)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return lr, metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token)), nil
+	}
+
+	_, adminCtx, err := login(auth.SysAdminUsername, auth.SysAdminPassword)
+	require.NoError(t, err)
+
+	t.Run("new passwords must meet the policy", func(t *testing.T) {
+		_, err := s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte("jane"),
+			Password:   []byte("short1"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.ErrorContains(t, err, policy.RequirementsMsg())
+
+		_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte("jane"),
+			Password:   []byte("longpassword1"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionR,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("recent passwords can not be reused", func(t *testing.T) {
+		changePassword := func(password string) error {
+			_, err := s.ChangePassword(adminCtx, &schema.ChangePasswordRequest{
+				User:        []byte("jane"),
+				NewPassword: []byte(password),
+			})
+			return err
+		}
+
+		require.ErrorContains(t, changePassword("longpassword1"), ErrPasswordReused.Error())
+		require.ErrorContains(t, changePassword("longpassword"), policy.RequirementsMsg())
+		require.NoError(t, changePassword("longpassword2"))
+		require.ErrorContains(t, changePassword("longpassword1"), ErrPasswordReused.Error())
+		require.NoError(t, changePassword("longpassword3"))
+
+		// only the previous password is kept when the history is 2
+		require.NoError(t, changePassword("longpassword1"))
+
+		user, err := s.getUser(context.Background(), []byte("jane"))
+		require.NoError(t, err)
+		require.Len(t, user.PasswordHistory, 1)
+	})
+
+	t.Run("users are locked out after too many failed logins", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, _, err := login("jane", "wrongpassword1")
+			require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+		}
+
+		_, _, err := login("jane", "longpassword1")
+		require.ErrorContains(t, err, ErrInvalidUsernameOrPassword)
+
+		_, err = s.authProvider.authenticate(context.Background(), []byte("jane"), []byte("longpassword1"))
+		require.ErrorIs(t, err, ErrUserLocked)
+
+		user, err := s.getUser(context.Background(), []byte("jane"))
+		require.NoError(t, err)
+		require.Equal(t, 3, user.FailedLogins)
+		require.WithinDuration(t, time.Now().Add(time.Minute), user.LockedUntil, 10*time.Second)
+
+		// the lockout is doubled on every further failed attempt
+		s.registerFailedLogin(context.Background(), []byte("jane"))
+		s.registerFailedLogin(context.Background(), []byte("jane"))
+
+		user, err = s.getUser(context.Background(), []byte("jane"))
+		require.NoError(t, err)
+		require.Equal(t, 5, user.FailedLogins)
+		require.WithinDuration(t, time.Now().Add(4*time.Minute), user.LockedUntil, 10*time.Second)
+
+		_, err = s.UnlockUser(adminCtx, &schema.UnlockUserRequest{Username: "jane"})
+		require.NoError(t, err)
+
+		_, _, err = login("jane", "longpassword1")
+		require.NoError(t, err)
+
+		user, err = s.getUser(context.Background(), []byte("jane"))
+		require.NoError(t, err)
+		require.Zero(t, user.FailedLogins)
+		require.False(t, user.IsLocked())
+
+		_, err = s.UnlockUser(adminCtx, &schema.UnlockUserRequest{Username: "nonexistent"})
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("expired passwords must be changed", func(t *testing.T) {
+		user, err := s.getUser(context.Background(), []byte("jane"))
+		require.NoError(t, err)
+
+		user.PasswordChangedAt = time.Now().Add(-2 * time.Hour)
+		err = s.saveUser(context.Background(), user)
+		require.NoError(t, err)
+
+		lr, ctx, err := login("jane", "longpassword1")
+		require.NoError(t, err)
+		require.Equal(t, ErrPasswordExpired.Error(), string(lr.Warning))
+
+		_, err = s.UseDatabase(ctx, &schema.Database{DatabaseName: DefaultDBName})
+		require.ErrorIs(t, err, ErrPasswordExpired)
+
+		_, err = s.ChangePassword(ctx, &schema.ChangePasswordRequest{
+			User:        []byte("jane"),
+			OldPassword: []byte("wrongpassword1"),
+			NewPassword: []byte("longpassword4"),
+		})
+		require.ErrorContains(t, err, "old password is incorrect")
+
+		_, err = s.ChangePassword(ctx, &schema.ChangePasswordRequest{
+			User:        []byte("jane"),
+			OldPassword: []byte("longpassword1"),
+			NewPassword: []byte("longpassword4"),
+		})
+		require.NoError(t, err)
+
+		lr, ctx, err = login("jane", "longpassword4")
+		require.NoError(t, err)
+		require.Empty(t, lr.Warning)
+
+		_, err = s.UseDatabase(ctx, &schema.Database{DatabaseName: DefaultDBName})
+		require.NoError(t, err)
+
+		user, err = s.getUser(context.Background(), []byte("jane"))
+		require.NoError(t, err)
+		require.Equal(t, auth.SysAdminUsername, user.CreatedBy)
+	})
+}
+
+func TestServerInvalidPasswordPolicy(t *testing.T) {
+	s := DefaultServer().WithOptions(DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithPasswordPolicy(&auth.PasswordPolicy{MinLength: 8, MaxLength: 100}),
+	).(*ImmuServer)
+
+	err := s.Initialize()
+	require.ErrorIs(t, err, auth.ErrInvalidPasswordPolicy)
+
+	s = DefaultServer().WithOptions(DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithMaxFailedLogins(3).
+		WithLockoutDuration(0),
+	).(*ImmuServer)
+
+	err = s.Initialize()
+	require.ErrorContains(t, err, "lockout duration must be positive")
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/codenotary/immudb/pkg/server/sessions"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// quotaTxMethods are the methods starting a transaction, mapped to whether they write data
+var quotaTxMethods = map[string]bool{
+	"NewTx":                  false,
+	"Set":                    true,
+	"VerifiableSet":          true,
+	"Delete":                 true,
+	"ExecAll":                true,
+	"SetReference":           true,
+	"VerifiableSetReference": true,
+	"ZAdd":                   true,
+	"VerifiableZAdd":         true,
+	"SQLExec":                true,
+	"BulkLoad":               true,
+	"streamSet":              true,
+	"streamVerifiableSet":    true,
+	"streamExecAll":          true,
+	"replicateTx":            true,
+}
+
+// quotaWriteMethods are the methods writing data within an ongoing transaction
+var quotaWriteMethods = map[string]struct{}{
+	"TxSQLExec": {},
+}
+
+// QuotaInterceptor enforces the quotas of the logged in user and of the selected database
+func (s *ImmuServer) QuotaInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.quotas == nil {
+		return handler(ctx, req)
+	}
+
+	subjects := s.quotaSubjects(ctx)
+	if len(subjects) == 0 {
+		return handler(ctx, req)
+	}
+
+	method := quotaMethodName(info.FullMethod)
+
+	writes, newTx := quotaTxMethods[method]
+	if _, ok := quotaWriteMethods[method]; ok {
+		writes = true
+	}
+
+	var writtenBytes int64
+
+	if m, ok := req.(proto.Message); ok && writes {
+		writtenBytes = int64(proto.Size(m))
+	}
+
+	release, err := s.quotas.admit(subjects, s.quotaRequest(writtenBytes, newTx))
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+// QuotaStreamInterceptor enforces the quotas of the logged in user and of the selected database,
+// the data received by write streams is accounted as it is received
+func (s *ImmuServer) QuotaStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if s.quotas == nil {
+		return handler(srv, ss)
+	}
+
+	subjects := s.quotaSubjects(ss.Context())
+	if len(subjects) == 0 {
+		return handler(srv, ss)
+	}
+
+	writes, newTx := quotaTxMethods[quotaMethodName(info.FullMethod)]
+
+	req := s.quotaRequest(0, newTx)
+
+	release, err := s.quotas.admit(subjects, req)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if writes {
+		ss = &quotaServerStream{ServerStream: ss, quotas: s.quotas, subjects: subjects, dbSize: req.dbSize}
+	}
+
+	return handler(srv, ss)
+}
+
+type quotaServerStream struct {
+	grpc.ServerStream
+
+	quotas   *quotaManager
+	subjects []quotaSubject
+	dbSize   func(dbName string) (int64, error)
+}
+
+func (ss *quotaServerStream) RecvMsg(m interface{}) error {
+	err := ss.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	if msg, ok := m.(proto.Message); ok {
+		return ss.quotas.write(ss.subjects, quotaRequest{writtenBytes: int64(proto.Size(msg)), dbSize: ss.dbSize})
+	}
+
+	return nil
+}
+
+func quotaMethodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// quotaSubjects returns the user and the database the request is accounted to
+func (s *ImmuServer) quotaSubjects(ctx context.Context) []quotaSubject {
+	if !s.Options.auth {
+		db, err := s.dbList.GetByIndex(defaultDbIndex)
+		if err != nil {
+			return nil
+		}
+		return []quotaSubject{{scope: QuotaScopeDatabase, name: db.GetName()}}
+	}
+
+	ind, usr, err := s.getLoggedInUserdataWithExpiredPasswordFromCtx(ctx)
+	if err != nil || usr == nil {
+		return nil
+	}
+
+	subjects := []quotaSubject{{scope: QuotaScopeUser, name: usr.Username, exempt: usr.IsSysAdmin}}
+
+	if ind == sysDBIndex {
+		subjects = append(subjects, quotaSubject{scope: QuotaScopeDatabase, name: SystemDBName})
+	} else if ind >= 0 {
+		db, err := s.dbList.GetByIndex(ind)
+		if err == nil {
+			subjects = append(subjects, quotaSubject{scope: QuotaScopeDatabase, name: db.GetName()})
+		}
+	}
+
+	return subjects
+}
+
+func (s *ImmuServer) quotaRequest(writtenBytes int64, newTx bool) quotaRequest {
+	return quotaRequest{
+		writtenBytes: writtenBytes,
+		newTx:        newTx,
+		ongoingTxs: func(subject quotaSubject) int {
+			return s.SessManager.TransactionCount(func(sess *sessions.Session) bool {
+				if subject.scope == QuotaScopeUser {
+					return sess.GetUser().Username == subject.name
+				}
+				return sess.GetDatabase().GetName() == subject.name
+			})
+		},
+		dbSize: func(dbName string) (int64, error) {
+			return dirSize(filepath.Join(s.Options.Dir, dbName))
+		},
+	}
+}
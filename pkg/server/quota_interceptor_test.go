@@ -0,0 +1,182 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type quotaTestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *quotaTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *quotaTestStream) RecvMsg(m interface{}) error {
+	m.(*schema.Chunk).Content = bytes.Repeat([]byte{1}, 150)
+	return nil
+}
+
+func TestServerQuotas(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword).
+		WithQuotas(
+			&QuotaRule{Scope: QuotaScopeUser, Name: "jane", WriteBytes: 200, WritePeriod: time.Hour},
+			&QuotaRule{Scope: QuotaScopeUser, Name: "john", RequestsPerSecond: 1},
+			&QuotaRule{Scope: QuotaScopeDatabase, Name: DefaultDBName, MaxConcurrentTxs: 1},
+		)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	login := func(username, password string) context.Context {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte(username), Password: []byte(password)})
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+	}
+
+	call := func(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+		return s.QuotaInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/immudb.schema.ImmuService/" + method}, handler)
+	}
+
+	adminCtx := login(auth.SysAdminUsername, auth.SysAdminPassword)
+
+	for _, username := range []string{"jane", "john"} {
+		_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte(username),
+			Password:   []byte("Pa$$w0rd"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionRW,
+		})
+		require.NoError(t, err)
+	}
+
+	janeCtx := login("jane", "Pa$$w0rd")
+	johnCtx := login("john", "Pa$$w0rd")
+
+	set := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.Set(ctx, req.(*schema.SetRequest))
+	}
+
+	currentState := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.CurrentState(ctx, &emptypb.Empty{})
+	}
+
+	t.Run("writes should be limited by write quotas", func(t *testing.T) {
+		req := &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key"), Value: make([]byte, 100)}}}
+
+		_, err := call(janeCtx, "Set", req, set)
+		require.NoError(t, err)
+
+		_, err = call(janeCtx, "Set", req, set)
+		require.ErrorIs(t, err, ErrWriteQuotaExceeded)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// reads are not accounted to write quotas
+		_, err = call(janeCtx, "CurrentState", nil, currentState)
+		require.NoError(t, err)
+
+		// other users have their own quotas
+		_, err = call(adminCtx, "Set", req, set)
+		require.NoError(t, err)
+	})
+
+	t.Run("data received by write streams should be accounted", func(t *testing.T) {
+		var received error
+
+		err := s.QuotaStreamInterceptor(nil, &quotaTestStream{ctx: janeCtx}, &grpc.StreamServerInfo{FullMethod: "/immudb.schema.ImmuService/streamSet"},
+			func(srv interface{}, ss grpc.ServerStream) error {
+				received = ss.RecvMsg(&schema.Chunk{})
+				return received
+			})
+		require.ErrorIs(t, err, ErrWriteQuotaExceeded)
+		require.ErrorIs(t, received, ErrWriteQuotaExceeded)
+	})
+
+	t.Run("requests should be rate limited", func(t *testing.T) {
+		_, err := call(johnCtx, "CurrentState", nil, currentState)
+		require.NoError(t, err)
+
+		_, err = call(johnCtx, "CurrentState", nil, currentState)
+		require.ErrorIs(t, err, ErrRateLimitExceeded)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("concurrent transactions should be limited", func(t *testing.T) {
+		openSession := func() (string, context.Context) {
+			sess, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+				Username:     []byte("jane"),
+				Password:     []byte("Pa$$w0rd"),
+				DatabaseName: DefaultDBName,
+			})
+			require.NoError(t, err)
+
+			return sess.SessionID, metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", sess.SessionID))
+		}
+
+		newTx := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.NewTx(ctx, req.(*schema.NewTxRequest))
+		}
+
+		sessID1, sessCtx1 := openSession()
+		defer s.CloseSession(sessCtx1, &emptypb.Empty{})
+
+		_, sessCtx2 := openSession()
+		defer s.CloseSession(sessCtx2, &emptypb.Empty{})
+
+		res, err := call(sessCtx1, "NewTx", &schema.NewTxRequest{Mode: schema.TxMode_ReadOnly}, newTx)
+		require.NoError(t, err)
+
+		_, err = call(sessCtx2, "NewTx", &schema.NewTxRequest{Mode: schema.TxMode_ReadOnly}, newTx)
+		require.ErrorIs(t, err, ErrMaxConcurrentTxsExceeded)
+
+		txCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"sessionid", sessID1,
+			"transactionid", res.(*schema.NewTxResponse).TransactionID,
+		))
+
+		_, err = s.Rollback(txCtx, &emptypb.Empty{})
+		require.NoError(t, err)
+
+		_, err = call(sessCtx2, "NewTx", &schema.NewTxRequest{Mode: schema.TxMode_ReadOnly}, newTx)
+		require.NoError(t, err)
+	})
+}
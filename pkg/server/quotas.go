@@ -0,0 +1,453 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/errors"
+)
+
+const (
+	QuotaScopeUser     = "user"
+	QuotaScopeDatabase = "database"
+
+	// QuotaAnyName names the rules applying to the users or databases without a rule of their own
+	QuotaAnyName = "*"
+)
+
+// DefaultQuotaWritePeriod is the period write quotas are enforced over when none is provided
+const DefaultQuotaWritePeriod = time.Minute
+
+// quotaDBSizeRefreshPeriod bounds how often the size of databases is computed when enforcing size quotas
+const quotaDBSizeRefreshPeriod = 5 * time.Second
+
+// QuotaRule sets the limits enforced on the requests of a user or to a database, zero meaning no limit.
+// Rules named * apply to the users or databases without a rule of their own, except to sysadmin
+type QuotaRule struct {
+	Scope string
+	Name  string
+
+	RequestsPerSecond float64
+	WriteBytes        int64         // bytes written per WritePeriod
+	WritePeriod       time.Duration // only if WriteBytes > 0
+	MaxDatabaseSize   int64         // only for databases
+	MaxConcurrentTxs  int
+}
+
+// ParseQuotaRule parses a rule in the scope:name:limit=value,... form,
+// e.g. user:alice:rps=100,write-bytes=1048576,write-period=1m,max-txs=4 or database:*:max-size=1073741824
+func ParseQuotaRule(s string) (*QuotaRule, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("%w: invalid quota rule '%s', expected scope:name:limit=value,...", ErrIllegalArguments, s)
+	}
+
+	rule := &QuotaRule{
+		Scope: parts[0],
+		Name:  parts[1],
+	}
+
+	for _, limit := range strings.Split(parts[2], ",") {
+		kv := strings.SplitN(limit, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w: invalid quota limit '%s', expected limit=value", ErrIllegalArguments, limit)
+		}
+
+		var err error
+
+		switch kv[0] {
+		case "rps":
+			rule.RequestsPerSecond, err = strconv.ParseFloat(kv[1], 64)
+		case "write-bytes":
+			rule.WriteBytes, err = strconv.ParseInt(kv[1], 10, 64)
+		case "write-period":
+			rule.WritePeriod, err = time.ParseDuration(kv[1])
+		case "max-size":
+			rule.MaxDatabaseSize, err = strconv.ParseInt(kv[1], 10, 64)
+		case "max-txs":
+			rule.MaxConcurrentTxs, err = strconv.Atoi(kv[1])
+		default:
+			return nil, fmt.Errorf("%w: unknown quota limit '%s'", ErrIllegalArguments, kv[0])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid value of quota limit '%s': %v", ErrIllegalArguments, kv[0], err)
+		}
+	}
+
+	if rule.WriteBytes > 0 && rule.WritePeriod == 0 {
+		rule.WritePeriod = DefaultQuotaWritePeriod
+	}
+
+	return rule, rule.validate()
+}
+
+func (r *QuotaRule) validate() error {
+	if r.Scope != QuotaScopeUser && r.Scope != QuotaScopeDatabase {
+		return fmt.Errorf("%w: unknown quota scope '%s'", ErrIllegalArguments, r.Scope)
+	}
+
+	if r.Name == "" {
+		return fmt.Errorf("%w: quota rules must name a %s", ErrIllegalArguments, r.Scope)
+	}
+
+	if r.RequestsPerSecond < 0 || math.IsNaN(r.RequestsPerSecond) || math.IsInf(r.RequestsPerSecond, 0) ||
+		r.WriteBytes < 0 || r.MaxDatabaseSize < 0 || r.MaxConcurrentTxs < 0 {
+		return fmt.Errorf("%w: quota limits can not be negative", ErrIllegalArguments)
+	}
+
+	if r.WriteBytes > 0 && r.WritePeriod <= 0 {
+		return fmt.Errorf("%w: write quotas require a positive period", ErrIllegalArguments)
+	}
+
+	if r.MaxDatabaseSize > 0 && r.Scope != QuotaScopeDatabase {
+		return fmt.Errorf("%w: size quotas only apply to databases", ErrIllegalArguments)
+	}
+
+	return nil
+}
+
+// quotaSubject is a user or database requests are accounted to
+type quotaSubject struct {
+	scope string
+	name  string
+
+	// exempt subjects are only limited by rules of their own
+	exempt bool
+}
+
+// quotaUsage tracks the consumption of the limits of a subject
+type quotaUsage struct {
+	tokens     float64
+	refilledAt time.Time
+
+	written        int64
+	writeStartedAt time.Time
+
+	ongoingTxs int
+}
+
+// quotaManager enforces the limits set by quota rules
+type quotaManager struct {
+	rules map[string]*QuotaRule
+
+	mutex sync.Mutex
+	usage map[string]*quotaUsage
+
+	dbSizesMutex sync.Mutex
+	dbSizes      map[string]*quotaDBSize
+
+	now func() time.Time
+}
+
+type quotaDBSize struct {
+	size       int64
+	computedAt time.Time
+}
+
+func quotaKey(scope, name string) string {
+	return scope + ":" + name
+}
+
+func newQuotaManager(rules []*QuotaRule) (*quotaManager, error) {
+	m := &quotaManager{
+		rules:   make(map[string]*QuotaRule, len(rules)),
+		usage:   make(map[string]*quotaUsage),
+		dbSizes: make(map[string]*quotaDBSize),
+		now:     time.Now,
+	}
+
+	for _, rule := range rules {
+		err := rule.validate()
+		if err != nil {
+			return nil, err
+		}
+
+		key := quotaKey(rule.Scope, rule.Name)
+
+		if _, ok := m.rules[key]; ok {
+			return nil, fmt.Errorf("%w: duplicated quota rule for %s '%s'", ErrIllegalArguments, rule.Scope, rule.Name)
+		}
+
+		m.rules[key] = rule
+	}
+
+	return m, nil
+}
+
+// ruleFor returns the rule applying to the subject, if any
+func (m *quotaManager) ruleFor(subject quotaSubject) *QuotaRule {
+	rule, ok := m.rules[quotaKey(subject.scope, subject.name)]
+	if ok {
+		return rule
+	}
+
+	if subject.exempt {
+		return nil
+	}
+
+	return m.rules[quotaKey(subject.scope, QuotaAnyName)]
+}
+
+func (m *quotaManager) usageOf(subject quotaSubject, rule *QuotaRule, now time.Time) *quotaUsage {
+	key := quotaKey(subject.scope, subject.name)
+
+	usage, ok := m.usage[key]
+	if !ok {
+		usage = &quotaUsage{
+			tokens:         rule.burst(),
+			refilledAt:     now,
+			writeStartedAt: now,
+		}
+		m.usage[key] = usage
+	}
+
+	return usage
+}
+
+// burst is the number of requests which can be made at once
+func (r *QuotaRule) burst() float64 {
+	return math.Max(r.RequestsPerSecond, 1)
+}
+
+// quotaRequest describes the consumption of a request
+type quotaRequest struct {
+	// writtenBytes is the size of the data the request writes
+	writtenBytes int64
+	// newTx is set when the request starts a transaction
+	newTx bool
+	// ongoingTxs returns the number of transactions ongoing in the sessions of the subject
+	ongoingTxs func(subject quotaSubject) int
+	// dbSize returns the size of the database on disk
+	dbSize func(dbName string) (int64, error)
+}
+
+// admit checks the limits of every subject and, if none is exceeded, accounts the request to them.
+// The returned function must be called once the request was handled
+func (m *quotaManager) admit(subjects []quotaSubject, req quotaRequest) (release func(), err error) {
+	rules := make([]*QuotaRule, len(subjects))
+	ongoingTxs := make([]int, len(subjects))
+
+	for i, subject := range subjects {
+		rules[i] = m.ruleFor(subject)
+		if rules[i] == nil {
+			continue
+		}
+
+		if req.newTx && rules[i].MaxConcurrentTxs > 0 && req.ongoingTxs != nil {
+			ongoingTxs[i] = req.ongoingTxs(subject)
+		}
+
+		if req.writtenBytes > 0 {
+			err := m.checkDatabaseSize(subject, rules[i], req.dbSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.now()
+
+	for i, subject := range subjects {
+		rule := rules[i]
+		if rule == nil {
+			continue
+		}
+
+		usage := m.usageOf(subject, rule, now)
+
+		if rule.RequestsPerSecond > 0 {
+			usage.refill(rule, now)
+
+			if usage.tokens < 1 {
+				retryDelay := time.Duration((1 - usage.tokens) / rule.RequestsPerSecond * float64(time.Second))
+				return nil, m.exceeded(subject, ErrRateLimitExceeded, "rps", retryDelay)
+			}
+		}
+
+		if rule.WriteBytes > 0 && req.writtenBytes > 0 {
+			usage.resetWritePeriod(rule, now)
+
+			if usage.written+req.writtenBytes > rule.WriteBytes {
+				retryDelay := usage.writeStartedAt.Add(rule.WritePeriod).Sub(now)
+				return nil, m.exceeded(subject, ErrWriteQuotaExceeded, "write_bytes", retryDelay)
+			}
+		}
+
+		if rule.MaxConcurrentTxs > 0 && req.newTx && usage.ongoingTxs+ongoingTxs[i] >= rule.MaxConcurrentTxs {
+			return nil, m.exceeded(subject, ErrMaxConcurrentTxsExceeded, "max_txs", 0)
+		}
+	}
+
+	var admitted []*quotaUsage
+
+	for i, subject := range subjects {
+		rule := rules[i]
+		if rule == nil {
+			continue
+		}
+
+		usage := m.usageOf(subject, rule, now)
+
+		if rule.RequestsPerSecond > 0 {
+			usage.tokens--
+		}
+
+		if req.writtenBytes > 0 {
+			usage.written += req.writtenBytes
+			Metrics.QuotaWrittenBytesCounters.WithLabelValues(subject.scope, subject.name).Add(float64(req.writtenBytes))
+		}
+
+		if req.newTx {
+			usage.ongoingTxs++
+			admitted = append(admitted, usage)
+		}
+	}
+
+	return func() {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+
+		for _, usage := range admitted {
+			usage.ongoingTxs--
+		}
+	}, nil
+}
+
+// write accounts data written by an already admitted request, e.g. while receiving a stream
+func (m *quotaManager) write(subjects []quotaSubject, req quotaRequest) error {
+	writtenBytes := req.writtenBytes
+
+	for _, subject := range subjects {
+		rule := m.ruleFor(subject)
+		if rule == nil || writtenBytes == 0 {
+			continue
+		}
+
+		err := m.checkDatabaseSize(subject, rule, req.dbSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.now()
+
+	for _, subject := range subjects {
+		rule := m.ruleFor(subject)
+		if rule == nil || rule.WriteBytes == 0 {
+			continue
+		}
+
+		usage := m.usageOf(subject, rule, now)
+		usage.resetWritePeriod(rule, now)
+
+		if usage.written+writtenBytes > rule.WriteBytes {
+			retryDelay := usage.writeStartedAt.Add(rule.WritePeriod).Sub(now)
+			return m.exceeded(subject, ErrWriteQuotaExceeded, "write_bytes", retryDelay)
+		}
+	}
+
+	for _, subject := range subjects {
+		if rule := m.ruleFor(subject); rule != nil {
+			m.usageOf(subject, rule, now).written += writtenBytes
+			Metrics.QuotaWrittenBytesCounters.WithLabelValues(subject.scope, subject.name).Add(float64(writtenBytes))
+		}
+	}
+
+	return nil
+}
+
+func (u *quotaUsage) refill(rule *QuotaRule, now time.Time) {
+	elapsed := now.Sub(u.refilledAt).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	u.tokens = math.Min(rule.burst(), u.tokens+elapsed*rule.RequestsPerSecond)
+	u.refilledAt = now
+}
+
+func (u *quotaUsage) resetWritePeriod(rule *QuotaRule, now time.Time) {
+	if now.Sub(u.writeStartedAt) >= rule.WritePeriod {
+		u.written = 0
+		u.writeStartedAt = now
+	}
+}
+
+// checkDatabaseSize fails if the subject is a database which reached the size allowed by the rule
+func (m *quotaManager) checkDatabaseSize(subject quotaSubject, rule *QuotaRule, dbSize func(dbName string) (int64, error)) error {
+	if rule.MaxDatabaseSize == 0 || subject.scope != QuotaScopeDatabase || dbSize == nil {
+		return nil
+	}
+
+	size, err := m.databaseSize(subject.name, dbSize)
+	if err != nil {
+		return err
+	}
+
+	if size >= rule.MaxDatabaseSize {
+		return m.exceeded(subject, ErrDatabaseSizeQuotaExceeded, "max_size", 0)
+	}
+
+	return nil
+}
+
+// databaseSize returns the size of the database, computing it at most once per refresh period.
+// The last computed size is used if the computation fails, e.g. due to files removed meanwhile
+func (m *quotaManager) databaseSize(dbName string, dbSize func(dbName string) (int64, error)) (int64, error) {
+	m.dbSizesMutex.Lock()
+	defer m.dbSizesMutex.Unlock()
+
+	now := m.now()
+
+	size, ok := m.dbSizes[dbName]
+	if ok && now.Sub(size.computedAt) < quotaDBSizeRefreshPeriod {
+		return size.size, nil
+	}
+
+	sz, err := dbSize(dbName)
+	if err != nil && ok {
+		return size.size, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	m.dbSizes[dbName] = &quotaDBSize{size: sz, computedAt: now}
+
+	return sz, nil
+}
+
+// exceeded returns the error reporting the limit exceeded by the subject and records it in the metrics
+func (m *quotaManager) exceeded(subject quotaSubject, quotaErr errors.Error, limit string, retryDelay time.Duration) error {
+	Metrics.QuotaRejectionsCounters.WithLabelValues(subject.scope, subject.name, limit).Inc()
+
+	return errors.New(fmt.Sprintf("%s for %s '%s'", quotaErr.Message(), subject.scope, subject.name)).
+		WithCode(quotaErr.Code()).
+		WithRetryDelay(int32(retryDelay.Milliseconds()))
+}
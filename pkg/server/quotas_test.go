@@ -0,0 +1,216 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuotaRule(t *testing.T) {
+	rule, err := ParseQuotaRule("user:alice:rps=2.5,write-bytes=1024,write-period=1h,max-txs=4")
+	require.NoError(t, err)
+	require.Equal(t, &QuotaRule{
+		Scope:             QuotaScopeUser,
+		Name:              "alice",
+		RequestsPerSecond: 2.5,
+		WriteBytes:        1024,
+		WritePeriod:       time.Hour,
+		MaxConcurrentTxs:  4,
+	}, rule)
+
+	rule, err = ParseQuotaRule("database:*:max-size=1048576,write-bytes=10")
+	require.NoError(t, err)
+	require.Equal(t, &QuotaRule{
+		Scope:           QuotaScopeDatabase,
+		Name:            QuotaAnyName,
+		WriteBytes:      10,
+		WritePeriod:     DefaultQuotaWritePeriod,
+		MaxDatabaseSize: 1048576,
+	}, rule)
+
+	for _, invalid := range []string{
+		"",
+		"user:alice",
+		"user::rps=1",
+		"tenant:alice:rps=1",
+		"user:alice:rps",
+		"user:alice:rps=fast",
+		"user:alice:rps=-1",
+		"user:alice:burst=1",
+		"user:alice:max-size=1",
+		"user:alice:write-bytes=1,write-period=-1m",
+	} {
+		_, err := ParseQuotaRule(invalid)
+		require.ErrorIs(t, err, ErrIllegalArguments, invalid)
+	}
+}
+
+func TestQuotaManager(t *testing.T) {
+	_, err := newQuotaManager([]*QuotaRule{{Scope: "tenant", Name: "alice"}})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = newQuotaManager([]*QuotaRule{
+		{Scope: QuotaScopeUser, Name: "alice", RequestsPerSecond: 1},
+		{Scope: QuotaScopeUser, Name: "alice", MaxConcurrentTxs: 1},
+	})
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	m, err := newQuotaManager([]*QuotaRule{
+		{Scope: QuotaScopeUser, Name: QuotaAnyName, RequestsPerSecond: 2},
+		{Scope: QuotaScopeUser, Name: "writer", WriteBytes: 100, WritePeriod: time.Minute},
+		{Scope: QuotaScopeDatabase, Name: "db1", MaxConcurrentTxs: 2, MaxDatabaseSize: 1000},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	alice := quotaSubject{scope: QuotaScopeUser, name: "alice"}
+	writer := quotaSubject{scope: QuotaScopeUser, name: "writer"}
+	admin := quotaSubject{scope: QuotaScopeUser, name: "immudb", exempt: true}
+	db1 := quotaSubject{scope: QuotaScopeDatabase, name: "db1"}
+	db2 := quotaSubject{scope: QuotaScopeDatabase, name: "db2"}
+
+	admit := func(req quotaRequest, subjects ...quotaSubject) error {
+		release, err := m.admit(subjects, req)
+		if err == nil {
+			release()
+		}
+		return err
+	}
+
+	t.Run("requests should be rate limited", func(t *testing.T) {
+		require.NoError(t, admit(quotaRequest{}, alice, db2))
+		require.NoError(t, admit(quotaRequest{}, alice, db2))
+
+		err := admit(quotaRequest{}, alice, db2)
+		require.ErrorIs(t, err, ErrRateLimitExceeded)
+		require.Contains(t, err.Error(), "user 'alice'")
+		require.Equal(t, int32(500), err.(errors.Error).RetryDelay())
+
+		now = now.Add(500 * time.Millisecond)
+		require.NoError(t, admit(quotaRequest{}, alice, db2))
+		require.ErrorIs(t, admit(quotaRequest{}, alice, db2), ErrRateLimitExceeded)
+	})
+
+	t.Run("sysadmin should only be limited by rules of its own", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, admit(quotaRequest{}, admin, db2))
+		}
+	})
+
+	t.Run("written bytes should be limited per period", func(t *testing.T) {
+		require.NoError(t, admit(quotaRequest{writtenBytes: 60}, writer, db2))
+
+		err := admit(quotaRequest{writtenBytes: 60}, writer, db2)
+		require.ErrorIs(t, err, ErrWriteQuotaExceeded)
+		require.Equal(t, int32(time.Minute.Milliseconds()), err.(errors.Error).RetryDelay())
+
+		require.NoError(t, m.write([]quotaSubject{writer, db2}, quotaRequest{writtenBytes: 40}))
+		require.ErrorIs(t, m.write([]quotaSubject{writer, db2}, quotaRequest{writtenBytes: 1}), ErrWriteQuotaExceeded)
+
+		now = now.Add(time.Minute)
+		require.NoError(t, admit(quotaRequest{writtenBytes: 100}, writer, db2))
+	})
+
+	t.Run("database size should be limited", func(t *testing.T) {
+		size := int64(999)
+		computed := 0
+
+		req := quotaRequest{
+			writtenBytes: 1,
+			dbSize: func(dbName string) (int64, error) {
+				require.Equal(t, "db1", dbName)
+				computed++
+				return size, nil
+			},
+		}
+
+		require.NoError(t, admit(req, admin, db1))
+
+		size = 1000
+
+		// sizes are cached for a while
+		require.NoError(t, admit(req, admin, db1))
+		require.Equal(t, 1, computed)
+
+		now = now.Add(quotaDBSizeRefreshPeriod)
+		require.ErrorIs(t, admit(req, admin, db1), ErrDatabaseSizeQuotaExceeded)
+		require.Equal(t, 2, computed)
+
+		// reads are not limited by the size of the database
+		require.NoError(t, admit(quotaRequest{dbSize: req.dbSize}, admin, db1))
+
+		// data received by streams, admitted before any byte was written, is limited as well
+		require.ErrorIs(t, m.write([]quotaSubject{admin, db1}, req), ErrDatabaseSizeQuotaExceeded)
+
+		// the last computed size is used when it can't be computed again
+		size = 999
+		now = now.Add(quotaDBSizeRefreshPeriod)
+
+		req.dbSize = func(dbName string) (int64, error) {
+			computed++
+			return 0, os.ErrNotExist
+		}
+
+		require.ErrorIs(t, admit(req, admin, db1), ErrDatabaseSizeQuotaExceeded)
+		require.Equal(t, 3, computed)
+	})
+
+	t.Run("concurrent transactions should be limited", func(t *testing.T) {
+		sessionTxs := 0
+
+		req := quotaRequest{
+			newTx:      true,
+			ongoingTxs: func(subject quotaSubject) int { return sessionTxs },
+		}
+
+		release1, err := m.admit([]quotaSubject{db1}, req)
+		require.NoError(t, err)
+
+		release2, err := m.admit([]quotaSubject{db1}, req)
+		require.NoError(t, err)
+
+		_, err = m.admit([]quotaSubject{db1}, req)
+		require.ErrorIs(t, err, ErrMaxConcurrentTxsExceeded)
+
+		release1()
+		release2()
+
+		sessionTxs = 2
+		_, err = m.admit([]quotaSubject{db1}, req)
+		require.ErrorIs(t, err, ErrMaxConcurrentTxsExceeded)
+
+		sessionTxs = 1
+		require.NoError(t, admit(req, db1))
+	})
+
+	t.Run("rejected requests should not be accounted", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		require.NoError(t, admit(quotaRequest{writtenBytes: 100}, writer, db2))
+		require.ErrorIs(t, admit(quotaRequest{writtenBytes: 1}, writer, db2), ErrWriteQuotaExceeded)
+
+		usage := m.usage[quotaKey(QuotaScopeUser, "writer")]
+		require.Equal(t, int64(100), usage.written)
+	})
+}
@@ -0,0 +1,801 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListRoles returns all the roles to system admins and database admins, other users get the roles granted to them
+func (s *ImmuServer) ListRoles(ctx context.Context, _ *empty.Empty) (*schema.RoleList, error) {
+	s.Logger.Debugf("ListRoles")
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := s.listRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allRoles := user.IsSysAdmin || user.HasAtLeastOnePermission(auth.PermissionAdmin)
+
+	rolelist := &schema.RoleList{}
+
+	for _, role := range roles {
+		if !allRoles && !user.HasRole(role.Name) {
+			continue
+		}
+
+		rolelist.Roles = append(rolelist.Roles, roleToProto(role))
+	}
+
+	return rolelist, nil
+}
+
+// CreateRole creates a new role without privileges, only system admins and database admins can create roles
+func (s *ImmuServer) CreateRole(ctx context.Context, r *schema.CreateRoleRequest) (res *empty.Empty, err error) {
+	s.Logger.Debugf("CreateRole")
+
+	defer func() {
+		s.audit(ctx, "CreateRole", "", r.GetName(), err)
+	}()
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if !auth.IsValidRoleName(r.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "role name can only contain lowercase letters, digits and underscores and must start with a letter")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin && !user.HasAtLeastOnePermission(auth.PermissionAdmin) {
+		return nil, fmt.Errorf("user is not system admin nor admin in any of the databases")
+	}
+
+	s.rolesMutex.Lock()
+	defer s.rolesMutex.Unlock()
+
+	_, err = s.getRole(ctx, r.Name)
+	if err == nil {
+		if r.IfNotExists {
+			return new(empty.Empty), nil
+		}
+		return nil, ErrRoleAlreadyExists
+	}
+	if !errors.Is(err, ErrRoleNotFound) {
+		return nil, err
+	}
+
+	role := &auth.Role{
+		Name:      r.Name,
+		CreatedBy: user.Username,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.saveRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("role %s was created by user %s", role.Name, user.Username)
+
+	return new(empty.Empty), nil
+}
+
+// DropRole deletes a role and revokes it from the users it was granted to,
+// only system admins and the creator of the role can drop it
+func (s *ImmuServer) DropRole(ctx context.Context, r *schema.DropRoleRequest) (res *empty.Empty, err error) {
+	s.Logger.Debugf("DropRole")
+
+	defer func() {
+		s.audit(ctx, "DropRole", "", r.GetName(), err)
+	}()
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rolesMutex.Lock()
+	defer s.rolesMutex.Unlock()
+
+	role, err := s.getRole(ctx, r.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin && user.Username != role.CreatedBy {
+		return nil, fmt.Errorf("role %s was not created by you", r.Name)
+	}
+
+	users, err := s.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		if !u.RevokeRole(role.Name) {
+			continue
+		}
+
+		err = s.saveUser(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		s.removeUserFromLoginList(u.Username)
+	}
+
+	_, err = s.sysDB.Delete(ctx, &schema.DeleteKeysRequest{Keys: [][]byte{roleKey(role.Name)}})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("role %s was dropped by user %s", role.Name, user.Username)
+
+	return new(empty.Empty), nil
+}
+
+// ChangeRolePrivilege grants or revokes a privilege to a role,
+// only system admins and admins of the database the privilege refers to can change it
+func (s *ImmuServer) ChangeRolePrivilege(ctx context.Context, r *schema.ChangeRolePrivilegeRequest) (res *empty.Empty, err error) {
+	s.Logger.Debugf("ChangeRolePrivilege %+v", r)
+
+	defer func() {
+		s.audit(ctx, "ChangeRolePrivilege", r.GetPrivilege().GetDatabase(), r.GetRole(), err)
+	}()
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	if r == nil || r.Privilege == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if (r.Action != schema.PermissionAction_GRANT) &&
+		(r.Action != schema.PermissionAction_REVOKE) {
+		return nil, status.Errorf(codes.InvalidArgument, "action not recognized")
+	}
+
+	privilegeName, err := auth.ParsePrivilege(r.Privilege.Privilege)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	privilege := auth.RolePrivilege{
+		Privilege: privilegeName,
+		Database:  r.Privilege.Database,
+		Object:    r.Privilege.Object,
+	}
+
+	err = privilege.Validate()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if privilege.Database == SystemDBName || s.dbList.GetId(privilege.Database) < 0 {
+		return nil, fmt.Errorf("database %s does not exist", privilege.Database)
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin && !user.HasPermission(privilege.Database, auth.PermissionAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "you do not have permission on this database")
+	}
+
+	s.rolesMutex.Lock()
+	defer s.rolesMutex.Unlock()
+
+	role, err := s.getRole(ctx, r.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed bool
+
+	if r.Action == schema.PermissionAction_REVOKE {
+		changed = role.RevokePrivilege(privilege)
+	} else {
+		changed = role.GrantPrivilege(privilege)
+	}
+
+	if !changed {
+		return new(empty.Empty), nil
+	}
+
+	err = s.saveRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("privileges of role %s for database %s were changed by user %s", role.Name, privilege.Database, user.Username)
+
+	return new(empty.Empty), nil
+}
+
+// ChangeRoleMembership grants or revokes a role to a user,
+// only system admins and admins of all the databases the role grants privileges on can change it
+func (s *ImmuServer) ChangeRoleMembership(ctx context.Context, r *schema.ChangeRoleMembershipRequest) (res *empty.Empty, err error) {
+	s.Logger.Debugf("ChangeRoleMembership %+v", r)
+
+	defer func() {
+		s.audit(ctx, "ChangeRoleMembership", "", r.GetRole(), err)
+	}()
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	if r == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	if len(r.Username) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "username can not be empty")
+	}
+
+	if (r.Action != schema.PermissionAction_GRANT) &&
+		(r.Action != schema.PermissionAction_REVOKE) {
+		return nil, status.Errorf(codes.InvalidArgument, "action not recognized")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//do not allow to change own roles, user can lock itsself out
+	if r.Username == user.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "changing your own roles is not allowed")
+	}
+
+	if r.Username == auth.SysAdminUsername {
+		return nil, status.Errorf(codes.InvalidArgument, "changing sysadmin roles is not allowed")
+	}
+
+	s.rolesMutex.Lock()
+	defer s.rolesMutex.Unlock()
+
+	role, err := s.getRole(ctx, r.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin {
+		if !user.HasAtLeastOnePermission(auth.PermissionAdmin) {
+			return nil, status.Errorf(codes.PermissionDenied, "user is not system admin nor admin in any of the databases")
+		}
+
+		for _, db := range role.Databases() {
+			if !user.HasPermission(db, auth.PermissionAdmin) {
+				return nil, status.Errorf(codes.PermissionDenied, "you do not have permission on database %s", db)
+			}
+		}
+	}
+
+	targetUser, err := s.getUser(ctx, []byte(r.Username))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", r.Username)
+	}
+
+	if !targetUser.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "user %s is not active", r.Username)
+	}
+
+	var changed bool
+
+	if r.Action == schema.PermissionAction_REVOKE {
+		changed = targetUser.RevokeRole(role.Name)
+	} else {
+		changed = targetUser.GrantRole(role.Name)
+	}
+
+	if !changed {
+		return new(empty.Empty), nil
+	}
+
+	if err := s.saveUser(ctx, targetUser); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("roles of user %s were changed by user %s", targetUser.Username, user.Username)
+
+	//remove user from loggedin users
+	s.removeUserFromLoginList(targetUser.Username)
+
+	return new(empty.Empty), nil
+}
+
+func roleKey(name string) []byte {
+	key := make([]byte, 1+len(name))
+	key[0] = KeyPrefixRole
+	copy(key[1:], name)
+	return key
+}
+
+func (s *ImmuServer) getRole(ctx context.Context, name string) (*auth.Role, error) {
+	item, err := s.sysDB.Get(ctx, &schema.KeyRequest{Key: roleKey(name)})
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var role auth.Role
+
+	err = json.Unmarshal(item.Value, &role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (s *ImmuServer) saveRole(ctx context.Context, role *auth.Role) error {
+	roleData, err := json.Marshal(role)
+	if err != nil {
+		return logErr(s.Logger, "error saving role: %v", err)
+	}
+
+	_, err = s.sysDB.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: roleKey(role.Name), Value: roleData}}})
+
+	return logErr(s.Logger, "error saving role: %v", err)
+}
+
+func (s *ImmuServer) listRoles(ctx context.Context) ([]*auth.Role, error) {
+	itemList, err := s.sysDB.Scan(ctx, &schema.ScanRequest{
+		Prefix: []byte{KeyPrefixRole},
+		NoWait: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*auth.Role, len(itemList.Entries))
+
+	for i, entry := range itemList.Entries {
+		var role auth.Role
+
+		err = json.Unmarshal(entry.Value, &role)
+		if err != nil {
+			return nil, err
+		}
+
+		roles[i] = &role
+	}
+
+	return roles, nil
+}
+
+func (s *ImmuServer) listUsers(ctx context.Context) ([]*auth.User, error) {
+	itemList, err := s.sysDB.Scan(ctx, &schema.ScanRequest{
+		Prefix: []byte{KeyPrefixUser},
+		NoWait: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*auth.User, len(itemList.Entries))
+
+	for i, entry := range itemList.Entries {
+		var user auth.User
+
+		err = json.Unmarshal(entry.Value, &user)
+		if err != nil {
+			return nil, err
+		}
+
+		users[i] = &user
+	}
+
+	return users, nil
+}
+
+// getUserRoles returns the roles currently granted to the user, as sessions keep
+// the user data they were opened with, plus the ones granted by the identity provider
+// the user authenticated with. Roles dropped in the meantime are skipped
+func (s *ImmuServer) getUserRoles(ctx context.Context, user *auth.User) ([]*auth.Role, error) {
+	// API keys are restricted to the permission they were granted
+	if user.APIKey != "" {
+		return nil, nil
+	}
+
+	names := user.ExternalRoles
+
+	storedUser, err := s.getUser(ctx, []byte(user.Username))
+	if err == nil {
+		names = append(storedUser.Roles, names...)
+	} else if !errors.Is(err, store.ErrKeyNotFound) || len(user.ExternalRoles) == 0 {
+		return nil, err
+	}
+
+	var roles []*auth.Role
+
+	for _, name := range names {
+		role, err := s.getRole(ctx, name)
+		if errors.Is(err, ErrRoleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// hasRolePrivilegesOn checks if any of the roles granted to the user grants privileges on the database
+func (s *ImmuServer) hasRolePrivilegesOn(ctx context.Context, user *auth.User, database string) bool {
+	roles, err := s.getUserRoles(ctx, user)
+	if err != nil {
+		s.Logger.Warningf("error reading roles of user %s: %v", user.Username, err)
+		return false
+	}
+
+	for _, role := range roles {
+		if role.HasPrivilegesOn(database) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasRolePrivilege checks if any of the roles granted to the user grants privilege on the object of the database
+func (s *ImmuServer) hasRolePrivilege(ctx context.Context, user *auth.User, database string, privilege auth.Privilege, object string) bool {
+	roles, err := s.getUserRoles(ctx, user)
+	if err != nil {
+		s.Logger.Warningf("error reading roles of user %s: %v", user.Username, err)
+		return false
+	}
+
+	for _, role := range roles {
+		if role.HasPrivilege(database, privilege, object) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func roleToProto(role *auth.Role) *schema.Role {
+	privileges := make([]*schema.RolePrivilege, len(role.Privileges))
+
+	for i, p := range role.Privileges {
+		privileges[i] = &schema.RolePrivilege{
+			Privilege: string(p.Privilege),
+			Database:  p.Database,
+			Object:    p.Object,
+		}
+	}
+
+	return &schema.Role{
+		Name:       role.Name,
+		Privileges: privileges,
+		CreatedBy:  role.CreatedBy,
+		CreatedAt:  role.CreatedAt.String(),
+	}
+}
+
+// requiredPrivilege is a privilege needed on an object of the database to serve a request
+type requiredPrivilege struct {
+	privilege auth.Privilege
+	object    string
+}
+
+// authorizedByRoles checks if the roles granted to the user allow to serve req through methodName.
+// Requests whose accessed keys or tables are unknown require the privilege of the method on the whole database.
+func (s *ImmuServer) authorizedByRoles(ctx context.Context, user *auth.User, database string, methodName string, req interface{}) bool {
+	if len(user.Roles) == 0 && len(user.ExternalRoles) == 0 {
+		return false
+	}
+
+	required, ok := requestPrivileges(req)
+	if !ok {
+		privilege, ok := auth.PrivilegeForMethod(methodName)
+		if !ok {
+			return false
+		}
+
+		required = []requiredPrivilege{{privilege: privilege}}
+	}
+
+	roles, err := s.getUserRoles(ctx, user)
+	if err != nil {
+		s.Logger.Warningf("error reading roles of user %s: %v", user.Username, err)
+		return false
+	}
+
+	// requests not accessing any key or table, e.g. transaction statements,
+	// still require some privilege on the database
+	hasPrivilegesOn := false
+
+	for _, role := range roles {
+		if role.HasPrivilegesOn(database) {
+			hasPrivilegesOn = true
+			break
+		}
+	}
+
+	if !hasPrivilegesOn {
+		return false
+	}
+
+	for _, r := range required {
+		granted := false
+
+		for _, role := range roles {
+			if role.HasPrivilege(database, r.privilege, r.object) {
+				granted = true
+				break
+			}
+		}
+
+		if !granted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestPrivileges returns the privileges needed to serve req, false is returned
+// when the keys or tables accessed by req can not be determined
+func requestPrivileges(req interface{}) ([]requiredPrivilege, bool) {
+	read := func(key []byte) requiredPrivilege {
+		return requiredPrivilege{privilege: auth.PrivilegeRead, object: string(key)}
+	}
+
+	write := func(key []byte) requiredPrivilege {
+		return requiredPrivilege{privilege: auth.PrivilegeWrite, object: string(key)}
+	}
+
+	preconditions := func(privileges []requiredPrivilege, preconditions []*schema.Precondition) []requiredPrivilege {
+		for _, c := range preconditions {
+			switch {
+			case c.GetKeyMustExist() != nil:
+				privileges = append(privileges, read(c.GetKeyMustExist().GetKey()))
+			case c.GetKeyMustNotExist() != nil:
+				privileges = append(privileges, read(c.GetKeyMustNotExist().GetKey()))
+			case c.GetKeyNotModifiedAfterTX() != nil:
+				privileges = append(privileges, read(c.GetKeyNotModifiedAfterTX().GetKey()))
+			case c.GetValueMustEqual() != nil:
+				privileges = append(privileges, read(c.GetValueMustEqual().GetKey()))
+			}
+		}
+		return privileges
+	}
+
+	switch r := req.(type) {
+	case *schema.KeyRequest:
+		return []requiredPrivilege{read(r.Key)}, true
+	case *schema.VerifiableGetRequest:
+		return requestPrivileges(r.KeyRequest)
+	case *schema.VerifiableGetAbsentRequest:
+		return []requiredPrivilege{read(r.Key)}, true
+	case *schema.KeyListRequest:
+		privileges := make([]requiredPrivilege, len(r.Keys))
+		for i, k := range r.Keys {
+			privileges[i] = read(k)
+		}
+		return privileges, true
+	case *schema.ScanRequest:
+		return []requiredPrivilege{read(r.Prefix)}, true
+	case *schema.VerifiableScanRequest:
+		return requestPrivileges(r.ScanRequest)
+	case *schema.HistoryRequest:
+		return []requiredPrivilege{read(r.Key)}, true
+	case *schema.VerifiableHistoryRequest:
+		return requestPrivileges(r.HistoryRequest)
+	case *schema.KeyPrefix:
+		return []requiredPrivilege{read(r.Prefix)}, true
+	case *schema.PrefixDigestRequest:
+		return []requiredPrivilege{read(r.Prefix)}, true
+	case *schema.SetRequest:
+		privileges := make([]requiredPrivilege, len(r.KVs))
+		for i, kv := range r.KVs {
+			privileges[i] = write(kv.Key)
+		}
+		return preconditions(privileges, r.Preconditions), true
+	case *schema.VerifiableSetRequest:
+		return requestPrivileges(r.SetRequest)
+	case *schema.DeleteKeysRequest:
+		privileges := make([]requiredPrivilege, len(r.Keys))
+		for i, k := range r.Keys {
+			privileges[i] = write(k)
+		}
+		return privileges, true
+	case *schema.ReferenceRequest:
+		privileges := []requiredPrivilege{write(r.Key), read(r.ReferencedKey)}
+		return preconditions(privileges, r.Preconditions), true
+	case *schema.VerifiableReferenceRequest:
+		return requestPrivileges(r.ReferenceRequest)
+	case *schema.ZAddRequest:
+		return []requiredPrivilege{write(r.Set), read(r.Key)}, true
+	case *schema.VerifiableZAddRequest:
+		return requestPrivileges(r.ZAddRequest)
+	case *schema.ExecAllRequest:
+		var privileges []requiredPrivilege
+
+		for _, op := range r.Operations {
+			var opPrivileges []requiredPrivilege
+
+			switch {
+			case op.GetKv() != nil:
+				opPrivileges = []requiredPrivilege{write(op.GetKv().Key)}
+			case op.GetZAdd() != nil:
+				opPrivileges, _ = requestPrivileges(op.GetZAdd())
+			case op.GetRef() != nil:
+				opPrivileges, _ = requestPrivileges(op.GetRef())
+			case op.GetIncrement() != nil:
+				opPrivileges = []requiredPrivilege{write(op.GetIncrement().Key)}
+			default:
+				return nil, false
+			}
+
+			privileges = append(privileges, opPrivileges...)
+		}
+
+		return preconditions(privileges, r.Preconditions), true
+	case *schema.TxRequest:
+		return txPrivileges(r.EntriesSpec), true
+	case *schema.VerifiableTxRequest:
+		return txPrivileges(r.EntriesSpec), true
+	case *schema.TxScanRequest:
+		return txPrivileges(r.EntriesSpec), true
+	case *schema.Table:
+		return []requiredPrivilege{{privilege: auth.PrivilegeSelect, object: r.TableName}}, true
+	case *schema.TableDigestRequest:
+		return []requiredPrivilege{{privilege: auth.PrivilegeSelect, object: r.Table}}, true
+	case *schema.VerifiableSQLGetRequest:
+		if r.SqlGetRequest == nil {
+			return nil, false
+		}
+		return []requiredPrivilege{{privilege: auth.PrivilegeSelect, object: r.SqlGetRequest.Table}}, true
+	case *schema.SQLQueryRequest:
+		return sqlPrivileges(r.Sql)
+	case *schema.VerifiableSQLQueryRequest:
+		if r.SqlQueryRequest == nil {
+			return nil, false
+		}
+		return sqlPrivileges(r.SqlQueryRequest.Sql)
+	case *schema.SQLExecRequest:
+		return sqlPrivileges(r.Sql)
+	case []sql.SQLStmt:
+		return stmtsPrivileges(r)
+	}
+
+	return nil, false
+}
+
+// txPrivileges returns the privileges needed to read the entries of transactions, entries of any key
+// may be returned and, unless excluded, rows of any table
+func txPrivileges(spec *schema.EntriesSpec) []requiredPrivilege {
+	privileges := []requiredPrivilege{{privilege: auth.PrivilegeRead}}
+
+	if spec == nil || (spec.SqlEntriesSpec != nil && spec.SqlEntriesSpec.Action != schema.EntryTypeAction_EXCLUDE) {
+		privileges = append(privileges, requiredPrivilege{privilege: auth.PrivilegeSelect})
+	}
+
+	return privileges
+}
+
+func sqlPrivileges(sqlStmt string) ([]requiredPrivilege, bool) {
+	stmts, err := sql.ParseString(sqlStmt)
+	if err != nil {
+		return nil, false
+	}
+
+	return stmtsPrivileges(stmts)
+}
+
+func stmtsPrivileges(stmts []sql.SQLStmt) ([]requiredPrivilege, bool) {
+	tablePrivileges, err := sql.RequiredTablePrivileges(stmts)
+	if err != nil {
+		return nil, false
+	}
+
+	privileges := make([]requiredPrivilege, len(tablePrivileges))
+
+	for i, p := range tablePrivileges {
+		privileges[i] = requiredPrivilege{privilege: auth.Privilege(p.Privilege), object: p.Table}
+	}
+
+	return privileges, true
+}
+
+// authorizeSessionRequest checks the privileges granted through roles to serve req within
+// an interactive transaction, when the session user has no permission on the session database
+func (s *ImmuServer) authorizeSessionRequest(ctx context.Context, methodName string, req interface{}) error {
+	sess, err := s.SessManager.GetSessionFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	user := sess.GetUser()
+	dbName := sess.GetDatabase().GetName()
+
+	if user.IsSysAdmin || user.WhichPermission(dbName) != auth.PermissionNone {
+		return nil
+	}
+
+	if !s.authorizedByRoles(ctx, user, dbName, methodName, req) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
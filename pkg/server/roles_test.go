@@ -0,0 +1,308 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestServerRoles(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	login := func(username, password []byte, database string) context.Context {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: username, Password: password})
+		require.NoError(t, err)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+		ur, err := s.UseDatabase(ctx, &schema.Database{DatabaseName: database})
+		require.NoError(t, err)
+
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", ur.Token))
+	}
+
+	lr, err := s.Login(context.Background(), &schema.LoginRequest{
+		User:     []byte(auth.SysAdminUsername),
+		Password: []byte(auth.SysAdminPassword),
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+	_, err = s.CreateDatabaseV2(ctx, &schema.CreateDatabaseRequest{Name: testDatabase})
+	require.NoError(t, err)
+
+	_, err = s.CreateUser(ctx, &schema.CreateUserRequest{
+		User:       testUsername,
+		Password:   testPassword,
+		Database:   DefaultDBName,
+		Permission: auth.PermissionR,
+	})
+	require.NoError(t, err)
+
+	adminCtx := login([]byte(auth.SysAdminUsername), []byte(auth.SysAdminPassword), testDatabase)
+
+	_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE table1(id INTEGER, PRIMARY KEY id);
+		CREATE TABLE table2(id INTEGER, PRIMARY KEY id);
+		INSERT INTO table1(id) VALUES (1);
+		INSERT INTO table2(id) VALUES (1);
+	`})
+	require.NoError(t, err)
+
+	_, err = s.Set(adminCtx, &schema.SetRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("public:key1"), Value: []byte("value1")},
+		{Key: []byte("private:key1"), Value: []byte("value1")},
+	}})
+	require.NoError(t, err)
+
+	t.Run("role management", func(t *testing.T) {
+		_, err := s.CreateRole(ctx, &schema.CreateRoleRequest{Name: "Analyst"})
+		require.Error(t, err)
+
+		_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{Name: "analyst"})
+		require.NoError(t, err)
+
+		_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{Name: "analyst"})
+		require.ErrorIs(t, err, ErrRoleAlreadyExists)
+
+		_, err = s.CreateRole(ctx, &schema.CreateRoleRequest{Name: "analyst", IfNotExists: true})
+		require.NoError(t, err)
+
+		_, err = s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_GRANT,
+			Role:      "analyst",
+			Privilege: &schema.RolePrivilege{Privilege: "execute", Database: testDatabase},
+		})
+		require.Error(t, err)
+
+		_, err = s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_GRANT,
+			Role:      "analyst",
+			Privilege: &schema.RolePrivilege{Privilege: "read", Database: "unknown"},
+		})
+		require.Error(t, err)
+
+		_, err = s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_GRANT,
+			Role:      "unknown",
+			Privilege: &schema.RolePrivilege{Privilege: "read", Database: testDatabase},
+		})
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		_, err = s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_GRANT,
+			Role:      "analyst",
+			Privilege: &schema.RolePrivilege{Privilege: "read", Database: testDatabase, Object: "public:"},
+		})
+		require.NoError(t, err)
+
+		_, err = s.ChangeRoleMembership(ctx, &schema.ChangeRoleMembershipRequest{
+			Action:   schema.PermissionAction_GRANT,
+			Role:     "analyst",
+			Username: auth.SysAdminUsername,
+		})
+		require.Error(t, err)
+
+		_, err = s.ChangeRoleMembership(ctx, &schema.ChangeRoleMembershipRequest{
+			Action:   schema.PermissionAction_GRANT,
+			Role:     "analyst",
+			Username: string(testUsername),
+		})
+		require.NoError(t, err)
+
+		roles, err := s.ListRoles(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, roles.Roles, 1)
+		require.Equal(t, "analyst", roles.Roles[0].Name)
+		require.Len(t, roles.Roles[0].Privileges, 1)
+
+		users, err := s.ListUsers(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+
+		for _, u := range users.Users {
+			if string(u.User) == string(testUsername) {
+				require.Equal(t, []string{"analyst"}, u.Roles)
+			}
+		}
+	})
+
+	t.Run("privileges granted through SQL statements", func(t *testing.T) {
+		_, err := s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: "GRANT SELECT ON TABLE table1 TO analyst"})
+		require.NoError(t, err)
+
+		_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: "GRANT ALL ON KEY PREFIX 'public:' TO analyst"})
+		require.NoError(t, err)
+
+		_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: "REVOKE WRITE ON KEY PREFIX 'public:' FROM analyst"})
+		require.NoError(t, err)
+
+		_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: "GRANT TRUNCATE ON TABLE table1 TO analyst"})
+		require.ErrorIs(t, err, auth.ErrInvalidPrivilege)
+
+		roles, err := s.ListRoles(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, roles.Roles[0].Privileges, 2)
+	})
+
+	userCtx := login(testUsername, testPassword, testDatabase)
+
+	t.Run("key privileges", func(t *testing.T) {
+		_, err := s.Get(userCtx, &schema.KeyRequest{Key: []byte("public:key1")})
+		require.NoError(t, err)
+
+		_, err = s.Get(userCtx, &schema.KeyRequest{Key: []byte("private:key1")})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.Scan(userCtx, &schema.ScanRequest{Prefix: []byte("public:")})
+		require.NoError(t, err)
+
+		_, err = s.Scan(userCtx, &schema.ScanRequest{})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.Set(userCtx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("public:key2"), Value: []byte("value2")}}})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.CurrentState(userCtx, &emptypb.Empty{})
+		require.NoError(t, err)
+	})
+
+	t.Run("table privileges", func(t *testing.T) {
+		res, err := s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT id FROM table1"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 1)
+
+		_, err = s.SQLQuery(userCtx, &schema.SQLQueryRequest{Sql: "SELECT t1.id FROM table1 AS t1 INNER JOIN table2 AS t2 ON t1.id = t2.id"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "INSERT INTO table1(id) VALUES (2)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: "GRANT INSERT ON TABLE table1 TO analyst"})
+		require.NoError(t, err)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "INSERT INTO table1(id) VALUES (2)"})
+		require.NoError(t, err)
+
+		_, err = s.SQLExec(userCtx, &schema.SQLExecRequest{Sql: "CREATE TABLE table3(id INTEGER, PRIMARY KEY id)"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("transaction privileges", func(t *testing.T) {
+		readPrivilege := &schema.RolePrivilege{Privilege: "read", Database: testDatabase}
+
+		_, err := s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_GRANT,
+			Role:      "analyst",
+			Privilege: readPrivilege,
+		})
+		require.NoError(t, err)
+
+		defer func() {
+			_, err := s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+				Action:    schema.PermissionAction_REVOKE,
+				Role:      "analyst",
+				Privilege: readPrivilege,
+			})
+			require.NoError(t, err)
+		}()
+
+		kvSpec := &schema.EntriesSpec{KvEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RAW_VALUE}}
+		sqlSpec := &schema.EntriesSpec{SqlEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RAW_VALUE}}
+
+		_, err = s.TxById(userCtx, &schema.TxRequest{Tx: 1, EntriesSpec: kvSpec})
+		require.NoError(t, err)
+
+		// rows of tables the user can not select
+		_, err = s.TxById(userCtx, &schema.TxRequest{Tx: 1, EntriesSpec: sqlSpec})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.TxById(userCtx, &schema.TxRequest{Tx: 1})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.VerifiableTxById(userCtx, &schema.VerifiableTxRequest{Tx: 1, EntriesSpec: sqlSpec})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		_, err = s.TxScan(userCtx, &schema.TxScanRequest{InitialTx: 1, EntriesSpec: sqlSpec})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+
+		selectPrivilege := &schema.RolePrivilege{Privilege: "select", Database: testDatabase}
+
+		_, err = s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_GRANT,
+			Role:      "analyst",
+			Privilege: selectPrivilege,
+		})
+		require.NoError(t, err)
+
+		_, err = s.TxById(userCtx, &schema.TxRequest{Tx: 1, EntriesSpec: sqlSpec})
+		require.NoError(t, err)
+
+		_, err = s.ChangeRolePrivilege(ctx, &schema.ChangeRolePrivilegeRequest{
+			Action:    schema.PermissionAction_REVOKE,
+			Role:      "analyst",
+			Privilege: selectPrivilege,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("role changes by unprivileged users", func(t *testing.T) {
+		_, err := s.CreateRole(userCtx, &schema.CreateRoleRequest{Name: "role2"})
+		require.Error(t, err)
+
+		_, err = s.DropRole(userCtx, &schema.DropRoleRequest{Name: "analyst"})
+		require.Error(t, err)
+
+		roles, err := s.ListRoles(userCtx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, roles.Roles, 1)
+	})
+
+	t.Run("drop role", func(t *testing.T) {
+		_, err := s.DropRole(ctx, &schema.DropRoleRequest{Name: "analyst"})
+		require.NoError(t, err)
+
+		_, err = s.DropRole(ctx, &schema.DropRoleRequest{Name: "analyst"})
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: testUsername, Password: testPassword})
+		require.NoError(t, err)
+
+		userCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+
+		_, err = s.UseDatabase(userCtx, &schema.Database{DatabaseName: testDatabase})
+		require.Error(t, err)
+	})
+}
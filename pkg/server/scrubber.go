@@ -0,0 +1,361 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/database"
+)
+
+// scrubberProgressFile holds the progress of the integrity scrubs so that they are resumed on restart
+const scrubberProgressFile = "scrubber.json"
+
+// scrubberCheckpointTxs is the number of transactions verified between progress checkpoints
+const scrubberCheckpointTxs = 1000
+
+// scrubberMaxIdlePeriod bounds the time between checks for databases due to be scrubbed
+const scrubberMaxIdlePeriod = time.Minute
+
+// scrubProgress is the state of the integrity scrubs of a database
+type scrubProgress struct {
+	// TxID is the latest transaction verified by the ongoing scrub, zero if no scrub is in progress
+	TxID uint64 `json:"txId,omitempty"`
+	// Alh is the accumulative linear hash of TxID, the next transaction must be linked to it
+	Alh []byte `json:"alh,omitempty"`
+
+	LastCompletedAt time.Time `json:"lastCompletedAt,omitempty"`
+
+	// CorruptedTxID is the transaction that failed the latest scrub, zero if none did
+	CorruptedTxID  uint64 `json:"corruptedTxId,omitempty"`
+	IntegrityError string `json:"integrityError,omitempty"`
+}
+
+// scrubber periodically walks through the transactions of every database
+// verifying the integrity of the data stored on disk
+type scrubber struct {
+	interval     time.Duration
+	rate         int
+	progressFile string
+
+	mutex    sync.Mutex
+	progress map[string]*scrubProgress
+
+	stopch chan struct{}
+	donech chan struct{}
+}
+
+func newScrubber(interval time.Duration, rate int, progressFile string) *scrubber {
+	return &scrubber{
+		interval:     interval,
+		rate:         rate,
+		progressFile: progressFile,
+		progress:     make(map[string]*scrubProgress),
+		stopch:       make(chan struct{}),
+		donech:       make(chan struct{}),
+	}
+}
+
+func (s *ImmuServer) startScrubber() {
+	if !s.Options.Scrubber || s.scrubber != nil {
+		return
+	}
+
+	interval := s.Options.ScrubberInterval
+	if interval <= 0 {
+		interval = DefaultOptions().ScrubberInterval
+	}
+
+	sc := newScrubber(interval, s.Options.ScrubberRate, filepath.Join(s.Options.Dir, scrubberProgressFile))
+
+	err := sc.loadProgress()
+	if err != nil {
+		s.Logger.Warningf("Unable to load the progress of the integrity scrubber, databases will be scrubbed from the beginning. Reason: %v", err)
+	}
+
+	s.scrubber = sc
+
+	s.Logger.Infof("Verifying the integrity of the databases every %v", interval)
+
+	go func() {
+		defer close(sc.donech)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			select {
+			case <-sc.stopch:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		idlePeriod := interval
+		if idlePeriod > scrubberMaxIdlePeriod {
+			idlePeriod = scrubberMaxIdlePeriod
+		}
+
+		for {
+			s.scrubDatabases(ctx, sc)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(idlePeriod):
+			}
+		}
+	}()
+}
+
+func (s *ImmuServer) stopScrubber() {
+	sc := s.scrubber
+	if sc == nil {
+		return
+	}
+
+	close(sc.stopch)
+	<-sc.donech
+
+	s.scrubber = nil
+}
+
+func (s *ImmuServer) scrubDatabases(ctx context.Context, sc *scrubber) {
+	dbs := []database.DB{s.sysDB}
+
+	for i := 0; i < s.dbList.Length(); i++ {
+		db, err := s.dbList.GetByIndex(i)
+		if err == nil {
+			dbs = append(dbs, db)
+		}
+	}
+
+	for _, db := range dbs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if db == nil || db.IsClosed() || !sc.isDue(db.GetName()) {
+			continue
+		}
+
+		err := s.scrubDatabase(ctx, sc, db)
+		if err != nil && ctx.Err() == nil && !errors.Is(err, store.ErrAlreadyClosed) {
+			s.Logger.Warningf("Unable to verify the integrity of database '%s'. Reason: %v", db.GetName(), err)
+		}
+	}
+}
+
+// scrubDatabase verifies the integrity of the transactions committed into the database,
+// resuming the scrub in progress if any. A scrub completes either when all the transactions
+// committed at the time it started were verified or as soon as an integrity failure is found
+func (s *ImmuServer) scrubDatabase(ctx context.Context, sc *scrubber, db database.DB) error {
+	dbName := db.GetName()
+
+	state, err := db.CurrentState()
+	if err != nil {
+		return err
+	}
+
+	progress := sc.progressOf(dbName)
+
+	if progress.TxID > state.TxId || (progress.TxID > 0 && len(progress.Alh) != sha256.Size) {
+		s.Logger.Warningf("Discarding invalid integrity scrub progress of database '%s'", dbName)
+		progress.TxID = 0
+	}
+
+	alh := sha256.Sum256(nil)
+	if progress.TxID > 0 {
+		copy(alh[:], progress.Alh)
+	} else {
+		s.Logger.Infof("Verifying the integrity of database '%s' up to tx %d", dbName, state.TxId)
+	}
+
+	checkpoint := func() error {
+		progress.Alh = append([]byte(nil), alh[:]...)
+		sc.setProgress(dbName, progress)
+
+		Metrics.ScrubbedTxIDGauges.WithLabelValues(dbName).Set(float64(progress.TxID))
+
+		return sc.saveProgress()
+	}
+
+	start := time.Now()
+	verified := 0
+
+	for txID := progress.TxID + 1; txID <= state.TxId; txID++ {
+		if ctx.Err() != nil {
+			checkpoint()
+			return ctx.Err()
+		}
+
+		integrity, err := db.CheckTxIntegrity(txID, alh)
+		if isIntegrityError(err) {
+			s.Logger.Errorf("Integrity check of database '%s' failed at tx %d: %v", dbName, txID, err)
+
+			Metrics.IntegrityErrorsCounters.WithLabelValues(dbName).Inc()
+
+			return sc.complete(dbName, txID, err)
+		}
+		if err != nil {
+			checkpoint()
+			return err
+		}
+
+		alh = integrity.Alh
+		progress.TxID = txID
+
+		verified++
+
+		Metrics.ScrubbedTxsCounters.WithLabelValues(dbName).Inc()
+
+		if verified%scrubberCheckpointTxs == 0 {
+			err = checkpoint()
+			if err != nil {
+				s.Logger.Warningf("Unable to save the progress of the integrity scrubber. Reason: %v", err)
+			}
+		}
+
+		sc.throttle(ctx, start, verified)
+	}
+
+	s.Logger.Infof("Integrity of database '%s' verified up to tx %d", dbName, state.TxId)
+
+	return sc.complete(dbName, 0, nil)
+}
+
+// isIntegrityError returns true if err denotes data not matching its hashes
+func isIntegrityError(err error) bool {
+	return errors.Is(err, store.ErrCorruptedTxData) ||
+		errors.Is(err, store.ErrCorruptedData) ||
+		errors.Is(err, store.ErrCorruptedCLog) ||
+		errors.Is(err, store.ErrCorruptedAHtree)
+}
+
+// throttle holds the scrub so that no more than rate transactions are verified per second
+func (sc *scrubber) throttle(ctx context.Context, start time.Time, verified int) {
+	if sc.rate <= 0 {
+		return
+	}
+
+	wait := time.Duration(verified)*time.Second/time.Duration(sc.rate) - time.Since(start)
+	if wait <= 0 {
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(wait):
+	}
+}
+
+func (sc *scrubber) isDue(dbName string) bool {
+	progress := sc.progressOf(dbName)
+
+	return progress.TxID > 0 || time.Since(progress.LastCompletedAt) >= sc.interval
+}
+
+func (sc *scrubber) progressOf(dbName string) scrubProgress {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+
+	progress, ok := sc.progress[dbName]
+	if !ok {
+		return scrubProgress{}
+	}
+
+	return *progress
+}
+
+func (sc *scrubber) setProgress(dbName string, progress scrubProgress) {
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+
+	sc.progress[dbName] = &progress
+}
+
+// complete records the outcome of a scrub of the database, corruptedTxID being
+// the transaction that failed the integrity check, if any
+func (sc *scrubber) complete(dbName string, corruptedTxID uint64, integrityErr error) error {
+	progress := scrubProgress{
+		LastCompletedAt: time.Now(),
+		CorruptedTxID:   corruptedTxID,
+	}
+
+	if integrityErr != nil {
+		progress.IntegrityError = integrityErr.Error()
+	}
+
+	sc.setProgress(dbName, progress)
+
+	Metrics.ScrubbedTxIDGauges.WithLabelValues(dbName).Set(0)
+	Metrics.LastScrubCompletedAtGauges.WithLabelValues(dbName).Set(float64(progress.LastCompletedAt.Unix()))
+
+	return sc.saveProgress()
+}
+
+func (sc *scrubber) loadProgress() error {
+	data, err := ioutil.ReadFile(sc.progressFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	progress := make(map[string]*scrubProgress)
+
+	err = json.Unmarshal(data, &progress)
+	if err != nil {
+		return err
+	}
+
+	sc.mutex.Lock()
+	sc.progress = progress
+	sc.mutex.Unlock()
+
+	return nil
+}
+
+// saveProgress writes the progress of all the databases into a temporary file
+// renamed afterwards, so that a crash never leaves a partially written file
+func (sc *scrubber) saveProgress() error {
+	sc.mutex.Lock()
+	data, err := json.Marshal(sc.progress)
+	sc.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmpFile := sc.progressFile + ".tmp"
+
+	err = ioutil.WriteFile(tmpFile, data, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, sc.progressFile)
+}
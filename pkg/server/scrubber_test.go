@@ -0,0 +1,159 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerScrubber(t *testing.T) {
+	dir := t.TempDir()
+
+	s := DefaultServer()
+	s.WithOptions(DefaultOptions().WithDir(dir).WithAuth(false))
+
+	err := s.loadSystemDatabase(dir, nil, s.Options.AdminPassword, false)
+	require.NoError(t, err)
+
+	err = s.loadDefaultDatabase(dir, nil)
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key"), Value: []byte{byte(i)}}}})
+		require.NoError(t, err)
+	}
+
+	db, err := s.dbList.GetByName(DefaultDBName)
+	require.NoError(t, err)
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+
+	progressFile := filepath.Join(dir, scrubberProgressFile)
+
+	sc := newScrubber(time.Hour, 0, progressFile)
+
+	t.Run("health should not include scrub details when the scrubber is disabled", func(t *testing.T) {
+		health, err := s.DatabaseHealth(ctx, nil)
+		require.NoError(t, err)
+		require.Zero(t, health.LastScrubCompletedAt)
+	})
+
+	s.scrubber = sc
+	defer func() { s.scrubber = nil }()
+
+	t.Run("untampered databases should pass the scrub", func(t *testing.T) {
+		s.scrubDatabases(ctx, sc)
+
+		health, err := s.DatabaseHealth(ctx, nil)
+		require.NoError(t, err)
+		require.NotZero(t, health.LastScrubCompletedAt)
+		require.Zero(t, health.ScrubbedTxId)
+		require.Zero(t, health.CorruptedTxId)
+		require.Empty(t, health.IntegrityError)
+
+		require.False(t, sc.isDue(DefaultDBName))
+		require.False(t, sc.isDue(SystemDBName))
+	})
+
+	t.Run("scrubs should be resumed from the latest verified transaction", func(t *testing.T) {
+		tx, err := db.TxByID(ctx, &schema.TxRequest{Tx: 2})
+		require.NoError(t, err)
+
+		alh := schema.TxHeaderFromProto(tx.Header).Alh()
+
+		sc.setProgress(DefaultDBName, scrubProgress{TxID: 2, Alh: alh[:]})
+		require.True(t, sc.isDue(DefaultDBName))
+
+		err = s.scrubDatabase(ctx, sc, db)
+		require.NoError(t, err)
+
+		health, err := s.DatabaseHealth(ctx, nil)
+		require.NoError(t, err)
+		require.Zero(t, health.CorruptedTxId)
+	})
+
+	t.Run("broken links with the verified transactions should be reported", func(t *testing.T) {
+		sc.setProgress(DefaultDBName, scrubProgress{TxID: 2, Alh: make([]byte, 32)})
+
+		err = s.scrubDatabase(ctx, sc, db)
+		require.NoError(t, err)
+
+		health, err := s.DatabaseHealth(ctx, nil)
+		require.NoError(t, err)
+		require.NotZero(t, health.LastScrubCompletedAt)
+		require.Equal(t, uint64(3), health.CorruptedTxId)
+		require.Contains(t, health.IntegrityError, "alh chain is broken at tx 3")
+	})
+
+	t.Run("progress should be kept across restarts", func(t *testing.T) {
+		sc.setProgress(DefaultDBName, scrubProgress{TxID: state.TxId + 1, Alh: make([]byte, 32)})
+		err := sc.saveProgress()
+		require.NoError(t, err)
+
+		reloaded := newScrubber(time.Hour, 0, progressFile)
+
+		err = reloaded.loadProgress()
+		require.NoError(t, err)
+		require.Equal(t, sc.progressOf(DefaultDBName), reloaded.progressOf(DefaultDBName))
+
+		// progress beyond the latest transaction is discarded and the scrub restarted
+		err = s.scrubDatabase(ctx, reloaded, db)
+		require.NoError(t, err)
+
+		progress := reloaded.progressOf(DefaultDBName)
+		require.Zero(t, progress.TxID)
+		require.Zero(t, progress.CorruptedTxID)
+	})
+
+	t.Run("scrubs should be rate limited", func(t *testing.T) {
+		limited := newScrubber(time.Hour, 100, progressFile)
+
+		start := time.Now()
+		limited.throttle(ctx, start, 5)
+		require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+		start = time.Now()
+		sc.throttle(ctx, start, 5)
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("databases should be periodically scrubbed", func(t *testing.T) {
+		s.scrubber = nil
+
+		s.Options.WithScrubber(true).WithScrubberInterval(10 * time.Millisecond)
+
+		s.startScrubber()
+		defer s.stopScrubber()
+
+		before := s.scrubber.progressOf(DefaultDBName).LastCompletedAt
+
+		require.Eventually(t, func() bool {
+			return s.scrubber.progressOf(DefaultDBName).LastCompletedAt.After(before)
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
@@ -65,6 +65,10 @@ const (
 	KeyPrefixUser = iota + 1
 	//KeyPrefixDBSettings is used for entries related to database settings
 	KeyPrefixDBSettings
+	//KeyPrefixRole is used for entries holding roles and their privileges
+	KeyPrefixRole
+	//KeyPrefixAPIKey is used for entries holding API keys
+	KeyPrefixAPIKey
 )
 
 var startedAt time.Time
@@ -105,6 +109,18 @@ func (s *ImmuServer) Initialize() error {
 		return ErrEmptyAdminPassword
 	}
 
+	if s.Options.PasswordPolicy == nil {
+		s.Options.PasswordPolicy = auth.DefaultPasswordPolicy()
+	}
+
+	if err = s.Options.PasswordPolicy.Validate(); err != nil {
+		return logErr(s.Logger, "Unable to configure the password policy: %v", err)
+	}
+
+	if s.Options.MaxFailedLogins > 0 && s.Options.LockoutDuration <= 0 {
+		return logErr(s.Logger, "Unable to configure the login lockout: %v", errors.New("lockout duration must be positive"))
+	}
+
 	dataDir := s.Options.Dir
 	err = os.MkdirAll(dataDir, store.DefaultFileMode)
 	if err != nil {
@@ -148,6 +164,10 @@ func (s *ImmuServer) Initialize() error {
 		if err = s.loadUserDatabases(dataDir, s.remoteStorage); err != nil {
 			return logErr(s.Logger, "Unable load databases: %v", err)
 		}
+
+		if err = s.loadAuditDatabase(dataDir); err != nil {
+			return logErr(s.Logger, "Unable to load audit database: %v", err)
+		}
 	}
 
 	s.multidbmode = s.mandatoryAuth()
@@ -160,11 +180,31 @@ func (s *ImmuServer) Initialize() error {
 		return err
 	}
 
+	if s.Options.ClientCRL != "" {
+		if s.Options.TLSConfig == nil {
+			return logErr(s.Logger, "Unable to load the client certificate revocation lists: %v", errors.New("TLS is not enabled"))
+		}
+
+		checker, err := newCRLChecker(s.Options.ClientCRL)
+		if err != nil {
+			return logErr(s.Logger, "Unable to load the client certificate revocation lists: %v", err)
+		}
+
+		tlsConfig := s.Options.TLSConfig.Clone()
+		tlsConfig.VerifyPeerCertificate = checker.verifyPeerCertificate
+		s.Options.TLSConfig = tlsConfig
+	}
+
 	grpcSrvOpts := []grpc.ServerOption{}
 	if s.Options.TLSConfig != nil {
 		grpcSrvOpts = []grpc.ServerOption{grpc.Creds(credentials.NewTLS(s.Options.TLSConfig))}
 	}
 
+	if s.Options.SigningKey != "" && s.Options.SigningPlugin != "" {
+		return logErr(s.Logger, "Unable to configure the cryptographic signer: %v",
+			errors.New("signing key and signing plugin are mutually exclusive"))
+	}
+
 	if s.Options.SigningKey != "" {
 		if signer, err := signer.NewSigner(s.Options.SigningKey); err != nil {
 			return logErr(s.Logger, "Unable to configure the cryptographic signer: %v", err)
@@ -173,6 +213,68 @@ func (s *ImmuServer) Initialize() error {
 		}
 	}
 
+	if s.Options.SigningPlugin != "" {
+		if signer, err := signer.NewPluginSigner(s.Options.SigningPlugin); err != nil {
+			return logErr(s.Logger, "Unable to configure the cryptographic signer: %v", err)
+		} else {
+			s.StateSigner = NewStateSigner(signer)
+		}
+	}
+
+	if s.Options.SigningKeyChain != "" {
+		s.signingKeyChain, err = signer.ReadKeyChainFile(s.Options.SigningKeyChain)
+		if err == nil {
+			err = signer.ValidateKeyChain(s.signingKeyChain)
+		}
+		if err != nil {
+			return logErr(s.Logger, "Unable to load the signing key chain: %v", err)
+		}
+	}
+
+	if len(s.Options.WitnessPubKeys) > 0 {
+		witnessPubKeys := make([][]byte, len(s.Options.WitnessPubKeys))
+
+		for i, f := range s.Options.WitnessPubKeys {
+			pk, err := signer.ParsePublicKeyFile(f)
+			if err == nil {
+				witnessPubKeys[i], err = signer.MarshalKey(pk)
+			}
+			if err != nil {
+				return logErr(s.Logger, "Unable to load the witness public keys: %v", err)
+			}
+		}
+
+		s.witnessCosignatures.trust(witnessPubKeys)
+	}
+
+	if s.Options.JWTOptions != nil {
+		s.jwtValidator, err = auth.NewJWTValidator(*s.Options.JWTOptions)
+		if err != nil {
+			return logErr(s.Logger, "Unable to configure the JWT authentication: %v", err)
+		}
+	}
+
+	if s.Options.LDAPOptions != nil {
+		s.authProvider, err = newLDAPAuthProvider(s, s.Options.LDAPOptions, &localAuthProvider{s: s})
+		if err != nil {
+			return logErr(s.Logger, "Unable to configure the LDAP authentication: %v", err)
+		}
+	}
+
+	if len(s.Options.ClientCertificateMapping) > 0 {
+		s.certificateMapper, err = newCertificateMapper(s.Options.ClientCertificateMapping)
+		if err != nil {
+			return logErr(s.Logger, "Unable to configure the client certificate authentication: %v", err)
+		}
+	}
+
+	if len(s.Options.Quotas) > 0 {
+		s.quotas, err = newQuotaManager(s.Options.Quotas)
+		if err != nil {
+			return logErr(s.Logger, "Unable to configure the quotas: %v", err)
+		}
+	}
+
 	if s.Options.usingCustomListener {
 		s.Logger.Infof("Using custom listener")
 		s.Listener = s.Options.listener
@@ -223,6 +325,7 @@ func (s *ImmuServer) Initialize() error {
 		grpc_prometheus.UnaryServerInterceptor,
 		auth.ServerUnaryInterceptor,
 		s.SessionAuthInterceptor,
+		s.QuotaInterceptor,
 	}
 	sss := []grpc.StreamServerInterceptor{
 		ErrorMapperStream, // converts errors in gRPC ones. Need to be the first
@@ -230,6 +333,7 @@ func (s *ImmuServer) Initialize() error {
 		uuidContext.UUIDStreamContextSetter,
 		grpc_prometheus.StreamServerInterceptor,
 		auth.ServerStreamInterceptor,
+		s.QuotaStreamInterceptor,
 	}
 	grpcSrvOpts = append(
 		grpcSrvOpts,
@@ -242,13 +346,17 @@ func (s *ImmuServer) Initialize() error {
 	schema.RegisterImmuServiceServer(s.GrpcServer, s)
 	grpc_prometheus.Register(s.GrpcServer)
 
-	s.PgsqlSrv = pgsqlsrv.New(pgsqlsrv.Address(s.Options.Address), pgsqlsrv.Port(s.Options.PgsqlServerPort), pgsqlsrv.DatabaseList(s.dbList), pgsqlsrv.SysDb(s.sysDB), pgsqlsrv.TlsConfig(s.Options.TLSConfig), pgsqlsrv.Logger(s.Logger))
+	s.PgsqlSrv = pgsqlsrv.New(pgsqlsrv.Address(s.Options.Address), pgsqlsrv.Port(s.Options.PgsqlServerPort), pgsqlsrv.DatabaseList(s.dbList), pgsqlsrv.SysDb(s.sysDB), pgsqlsrv.TlsConfig(s.Options.TLSConfig), pgsqlsrv.Logger(s.Logger), pgsqlsrv.JWTValidator(s.jwtValidator), pgsqlsrv.PasswordAuthenticator(s.authProvider.authenticate))
 	if s.Options.PgsqlServer {
 		if err = s.PgsqlSrv.Initialize(); err != nil {
 			return err
 		}
 	}
 
+	s.startTimestampAnchoring()
+
+	s.startScrubber()
+
 	return err
 }
 
@@ -576,7 +684,8 @@ func (s *ImmuServer) loadUserDatabases(dataDir string, remoteStorage remotestora
 	for _, f := range files {
 		if !f.IsDir() ||
 			f.Name() == s.Options.GetSystemAdminDBName() ||
-			f.Name() == s.Options.GetDefaultDBName() {
+			f.Name() == s.Options.GetDefaultDBName() ||
+			f.Name() == AuditDBName {
 			continue
 		}
 
@@ -734,6 +843,10 @@ func (s *ImmuServer) Stop() error {
 
 // CloseDatabases closes all opened databases including the consinstency checker
 func (s *ImmuServer) CloseDatabases() error {
+	s.stopTimestampAnchoring()
+
+	s.stopScrubber()
+
 	for i := 0; i < s.dbList.Length(); i++ {
 		val, err := s.dbList.GetByIndex(i)
 		if err == nil {
@@ -797,6 +910,13 @@ func (s *ImmuServer) UpdateMTLSConfig(ctx context.Context, req *schema.MTLSConfi
 	return nil, ErrNotSupported
 }
 
+// SigningKeyChain returns the chain of rotations of the signing key
+func (s *ImmuServer) SigningKeyChain(ctx context.Context, _ *empty.Empty) (*schema.SigningKeyChainResponse, error) {
+	return &schema.SigningKeyChainResponse{
+		Rotations: schema.SigningKeyRotationsToProto(s.signingKeyChain),
+	}, nil
+}
+
 // ServerInfo returns information about the server instance.
 func (s *ImmuServer) ServerInfo(ctx context.Context, req *schema.ServerInfoRequest) (*schema.ServerInfoResponse, error) {
 	return &schema.ServerInfoResponse{Version: version.Version}, nil
@@ -866,6 +986,8 @@ func (s *ImmuServer) CreateDatabaseV2(ctx context.Context, req *schema.CreateDat
 		} else {
 			s.Logger.Infof("Database '%s' could not be created. Reason: %v", req.Name, err)
 		}
+
+		s.audit(ctx, "CreateDatabaseV2", req.Name, "", err)
 	}()
 
 	if s.Options.GetMaintenance() {
@@ -885,7 +1007,7 @@ func (s *ImmuServer) CreateDatabaseV2(ctx context.Context, req *schema.CreateDat
 		return nil, fmt.Errorf("loggedin user does not have permissions for this operation")
 	}
 
-	if req.Name == s.Options.defaultDBName || req.Name == s.Options.systemAdminDBName {
+	if s.isReservedDatabase(req.Name) {
 		return nil, ErrReservedDatabase
 	}
 
@@ -968,9 +1090,11 @@ func (s *ImmuServer) LoadDatabase(ctx context.Context, req *schema.LoadDatabaseR
 		} else {
 			s.Logger.Infof("Database '%s' could not be loaded. Reason: %v", req.Database, err)
 		}
+
+		s.audit(ctx, "LoadDatabase", req.Database, "", err)
 	}()
 
-	if req.Database == s.Options.defaultDBName || req.Database == s.Options.systemAdminDBName {
+	if s.isReservedDatabase(req.Database) {
 		return nil, ErrReservedDatabase
 	}
 
@@ -1046,9 +1170,11 @@ func (s *ImmuServer) UnloadDatabase(ctx context.Context, req *schema.UnloadDatab
 		} else {
 			s.Logger.Infof("Database '%s' could not be unloaded. Reason: %v", req.Database, err)
 		}
+
+		s.audit(ctx, "UnloadDatabase", req.Database, "", err)
 	}()
 
-	if req.Database == s.Options.defaultDBName || req.Database == s.Options.systemAdminDBName {
+	if s.isReservedDatabase(req.Database) {
 		return nil, ErrReservedDatabase
 	}
 
@@ -1121,13 +1247,15 @@ func (s *ImmuServer) DeleteDatabase(ctx context.Context, req *schema.DeleteDatab
 		} else {
 			s.Logger.Infof("Database '%s' could not be deleted. Reason: %v", req.Database, err)
 		}
+
+		s.audit(ctx, "DeleteDatabase", req.Database, "", err)
 	}()
 
 	if !s.Options.GetAuth() {
 		return nil, ErrAuthMustBeEnabled
 	}
 
-	if req.Database == s.Options.defaultDBName || req.Database == s.Options.systemAdminDBName {
+	if s.isReservedDatabase(req.Database) {
 		return nil, ErrReservedDatabase
 	}
 
@@ -1196,6 +1324,8 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 		} else {
 			s.Logger.Infof("Database '%s' could not be updated. Reason: %v", req.Database, err)
 		}
+
+		s.audit(ctx, "UpdateDatabaseV2", req.Database, "", err)
 	}()
 
 	if s.Options.GetMaintenance() {
@@ -1206,7 +1336,7 @@ func (s *ImmuServer) UpdateDatabaseV2(ctx context.Context, req *schema.UpdateDat
 		return nil, ErrAuthMustBeEnabled
 	}
 
-	if req.Database == s.Options.defaultDBName || req.Database == s.Options.systemAdminDBName {
+	if s.isReservedDatabase(req.Database) {
 		return nil, ErrReservedDatabase
 	}
 
@@ -1451,8 +1581,15 @@ func (s *ImmuServer) UseDatabase(ctx context.Context, req *schema.Database) (*sc
 			if strings.HasPrefix(fmt.Sprintf("%s", err), "token has expired") {
 				return nil, status.Error(codes.PermissionDenied, err.Error())
 			}
+			if err == ErrPasswordExpired {
+				return nil, err
+			}
 			return nil, status.Errorf(codes.Unauthenticated, "Please login")
 		}
+
+		if user.APIKey != "" {
+			return nil, fmt.Errorf("%w: API keys are bound to a single database", ErrNotSupported)
+		}
 	} else {
 		if !s.Options.GetMaintenance() {
 			return nil, fmt.Errorf("this command is available only with authentication on")
@@ -1488,7 +1625,8 @@ func (s *ImmuServer) UseDatabase(ctx context.Context, req *schema.Database) (*sc
 	if (!user.IsSysAdmin) &&
 		(!user.HasPermission(req.DatabaseName, auth.PermissionAdmin)) &&
 		(!user.HasPermission(req.DatabaseName, auth.PermissionR)) &&
-		(!user.HasPermission(req.DatabaseName, auth.PermissionRW)) {
+		(!user.HasPermission(req.DatabaseName, auth.PermissionRW)) &&
+		(!s.hasRolePrivilegesOn(ctx, user, req.DatabaseName)) {
 
 		return nil, status.Errorf(codes.PermissionDenied, "Logged in user does not have permission on this database")
 	}
@@ -1518,6 +1656,14 @@ func (s *ImmuServer) UseDatabase(ctx context.Context, req *schema.Database) (*sc
 // getDBFromCtx checks if user (loggedin from context) has access to methodName.
 // returns selected database
 func (s *ImmuServer) getDBFromCtx(ctx context.Context, methodName string) (database.DB, error) {
+	return s.getDBFromCtxForRequest(ctx, methodName, nil)
+}
+
+// getDBFromCtxForRequest checks if user (loggedin from context) has access to methodName,
+// when the permission of the user on the selected database is not enough, the privileges granted
+// through roles are checked against the keys or tables accessed by req.
+// returns selected database
+func (s *ImmuServer) getDBFromCtxForRequest(ctx context.Context, methodName string, req interface{}) (database.DB, error) {
 	//if auth is disabled and there is not user created databases returns defaultdb
 	if !s.Options.auth && !s.multidbmode && !s.Options.GetMaintenance() {
 		db, _ := s.dbList.GetByIndex(defaultDbIndex)
@@ -1563,11 +1709,15 @@ func (s *ImmuServer) getDBFromCtx(ctx context.Context, methodName string) (datab
 		return db, nil
 	}
 
-	if ok := auth.HasPermissionForMethod(usr.WhichPermission(db.GetName()), methodName); !ok {
-		return nil, ErrPermissionDenied
+	if ok := auth.HasPermissionForMethod(usr.WhichPermission(db.GetName()), methodName); ok {
+		return db, nil
+	}
+
+	if s.authorizedByRoles(ctx, usr, db.GetName(), methodName, req) {
+		return db, nil
 	}
 
-	return db, nil
+	return nil, ErrPermissionDenied
 }
 
 // isValidDBName checks if the provided database name meets the requirements
@@ -1643,13 +1793,15 @@ func (s *ImmuServer) TruncateDatabase(ctx context.Context, req *schema.TruncateD
 		} else {
 			s.Logger.Infof("Database '%s' could not be truncated. Reason: %v", req.Database, err)
 		}
+
+		s.audit(ctx, "TruncateDatabase", req.Database, "", err)
 	}()
 
 	if !s.Options.GetAuth() {
 		return nil, ErrAuthMustBeEnabled
 	}
 
-	if req.Database == s.Options.defaultDBName || req.Database == s.Options.systemAdminDBName {
+	if s.isReservedDatabase(req.Database) {
 		return nil, ErrReservedDatabase
 	}
 
@@ -1658,9 +1810,10 @@ func (s *ImmuServer) TruncateDatabase(ctx context.Context, req *schema.TruncateD
 		return nil, fmt.Errorf("could not get loggedin user data")
 	}
 
-	//if the requesting user has admin permission on this database
+	//if the requesting user has admin permission or truncate privilege on this database
 	if (!user.IsSysAdmin) &&
-		(!user.HasPermission(req.Database, auth.PermissionAdmin)) {
+		(!user.HasPermission(req.Database, auth.PermissionAdmin)) &&
+		(!s.hasRolePrivilege(ctx, user, req.Database, auth.PrivilegeTruncate, "")) {
 		return nil, fmt.Errorf("the database '%s' does not exist or you do not have admin permission on this database", req.Database)
 	}
 
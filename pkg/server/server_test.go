@@ -42,6 +42,7 @@ import (
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -878,6 +879,30 @@ func testServerSetGetBatch(ctx context.Context, s *ImmuServer, t *testing.T) {
 	_, err = s.CompactIndex(ctx, &emptypb.Empty{})
 	require.ErrorIs(t, err, tbtree.ErrCompactionThresholdNotReached)
 
+	_, err = s.BulkLoad(ctx, &schema.BulkLoadRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("Franz"), Value: []byte("Clamer")},
+		{Key: []byte("Alberto"), Value: []byte("Tomba")},
+	}})
+	require.ErrorIs(t, err, store.ErrBulkLoadKeysNotSorted)
+
+	bulkLoaded, err := s.BulkLoad(ctx, &schema.BulkLoadRequest{KVs: []*schema.KeyValue{
+		{Key: []byte("Alberto"), Value: []byte("Tomba")},
+		{Key: []byte("Franz"), Value: []byte("Clamer")},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), bulkLoaded.Entries)
+
+	rebuildStr := &immuServiceRebuildIndexServer{ctx: ctx}
+
+	err = s.RebuildIndex(&emptypb.Empty{}, rebuildStr)
+	require.NoError(t, err)
+	require.NotEmpty(t, rebuildStr.sent)
+	require.True(t, rebuildStr.sent[len(rebuildStr.sent)-1].Done)
+
+	// rebuilding is aborted if progress can not be sent
+	err = s.RebuildIndex(&emptypb.Empty{}, &immuServiceRebuildIndexServer{ctx: ctx, sendErr: errors.New("client is gone")})
+	require.ErrorContains(t, err, "client is gone")
+
 	_, err = s.GetAll(ctx, nil)
 	require.ErrorIs(t, err, store.ErrIllegalArguments)
 
@@ -2097,3 +2122,28 @@ func TestServerDatabaseTruncate(t *testing.T) {
 	_, err = s.CloseSession(ctx, &emptypb.Empty{})
 	require.NoError(t, err)
 }
+
+type immuServiceRebuildIndexServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sendErr error
+	sent    []*schema.RebuildIndexProgress
+}
+
+func (s *immuServiceRebuildIndexServer) Send(progress *schema.RebuildIndexProgress) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.sent = append(s.sent, &schema.RebuildIndexProgress{
+		IndexedTxId:   progress.IndexedTxId,
+		CommittedTxId: progress.CommittedTxId,
+		Done:          progress.Done,
+	})
+
+	return nil
+}
+
+func (s *immuServiceRebuildIndexServer) Context() context.Context {
+	return s.ctx
+}
@@ -41,6 +41,16 @@ type ServerMock struct {
 
 	PostExecAllFn func(context.Context, *schema.ExecAllRequest, *schema.TxHeader, error) (*schema.TxHeader, error)
 
+	PostVerifiableScanFn func(context.Context, *schema.VerifiableScanRequest, *schema.VerifiableEntries, error) (*schema.VerifiableEntries, error)
+
+	PostVerifiableHistoryFn func(context.Context, *schema.VerifiableHistoryRequest, *schema.VerifiableEntries, error) (*schema.VerifiableEntries, error)
+
+	PostVerifiableTxByIdFn func(context.Context, *schema.VerifiableTxRequest, *schema.VerifiableTx, error) (*schema.VerifiableTx, error)
+
+	PostPrefixDigestFn func(context.Context, *schema.PrefixDigestRequest, *schema.ContentDigest, error) (*schema.ContentDigest, error)
+
+	PostVerifiableSQLQueryFn func(context.Context, *schema.VerifiableSQLQueryRequest, *schema.VerifiableSQLQueryResult, error) (*schema.VerifiableSQLQueryResult, error)
+
 	GetDbIndexFromCtx func(context.Context, string) (int64, error)
 }
 
@@ -64,6 +74,18 @@ func (s *ServerMock) Rollback(ctx context.Context, e *empty.Empty) (*empty.Empty
 	return s.Srv.Rollback(ctx, e)
 }
 
+func (s *ServerMock) TxSavepoint(ctx context.Context, request *schema.TxSavepointRequest) (*empty.Empty, error) {
+	return s.Srv.TxSavepoint(ctx, request)
+}
+
+func (s *ServerMock) TxRollbackToSavepoint(ctx context.Context, request *schema.TxSavepointRequest) (*empty.Empty, error) {
+	return s.Srv.TxRollbackToSavepoint(ctx, request)
+}
+
+func (s *ServerMock) TxReleaseSavepoint(ctx context.Context, request *schema.TxSavepointRequest) (*empty.Empty, error) {
+	return s.Srv.TxReleaseSavepoint(ctx, request)
+}
+
 func (s *ServerMock) KeepAlive(ctx context.Context, request *empty.Empty) (*empty.Empty, error) {
 	return s.Srv.KeepAlive(ctx, request)
 }
@@ -182,6 +204,10 @@ func (s *ServerMock) VerifiableGet(ctx context.Context, req *schema.VerifiableGe
 	return s.Srv.VerifiableGet(ctx, req)
 }
 
+func (s *ServerMock) VerifiableGetAbsent(ctx context.Context, req *schema.VerifiableGetAbsentRequest) (*schema.VerifiableIndexProof, error) {
+	return s.Srv.VerifiableGetAbsent(ctx, req)
+}
+
 func (s *ServerMock) GetAll(ctx context.Context, req *schema.KeyListRequest) (*schema.Entries, error) {
 	return s.Srv.GetAll(ctx, req)
 }
@@ -203,6 +229,15 @@ func (s *ServerMock) Scan(ctx context.Context, req *schema.ScanRequest) (*schema
 	return s.Srv.Scan(ctx, req)
 }
 
+func (s *ServerMock) VerifiableScan(ctx context.Context, req *schema.VerifiableScanRequest) (*schema.VerifiableEntries, error) {
+	if s.PostVerifiableScanFn == nil {
+		return s.Srv.VerifiableScan(ctx, req)
+	}
+
+	rsp, err := s.Srv.VerifiableScan(ctx, req)
+	return s.PostVerifiableScanFn(ctx, req, rsp, err)
+}
+
 func (s *ServerMock) Count(ctx context.Context, req *schema.KeyPrefix) (*schema.EntryCount, error) {
 	return s.Srv.Count(ctx, req)
 }
@@ -216,7 +251,12 @@ func (s *ServerMock) TxById(ctx context.Context, req *schema.TxRequest) (*schema
 }
 
 func (s *ServerMock) VerifiableTxById(ctx context.Context, req *schema.VerifiableTxRequest) (*schema.VerifiableTx, error) {
-	return s.Srv.VerifiableTxById(ctx, req)
+	if s.PostVerifiableTxByIdFn == nil {
+		return s.Srv.VerifiableTxById(ctx, req)
+	}
+
+	rsp, err := s.Srv.VerifiableTxById(ctx, req)
+	return s.PostVerifiableTxByIdFn(ctx, req, rsp, err)
 }
 
 func (s *ServerMock) TxScan(ctx context.Context, req *schema.TxScanRequest) (*schema.TxList, error) {
@@ -227,10 +267,48 @@ func (s *ServerMock) History(ctx context.Context, req *schema.HistoryRequest) (*
 	return s.Srv.History(ctx, req)
 }
 
+func (s *ServerMock) VerifiableHistory(ctx context.Context, req *schema.VerifiableHistoryRequest) (*schema.VerifiableEntries, error) {
+	if s.PostVerifiableHistoryFn == nil {
+		return s.Srv.VerifiableHistory(ctx, req)
+	}
+
+	rsp, err := s.Srv.VerifiableHistory(ctx, req)
+	return s.PostVerifiableHistoryFn(ctx, req, rsp, err)
+}
+
 func (s *ServerMock) ServerInfo(ctx context.Context, req *schema.ServerInfoRequest) (*schema.ServerInfoResponse, error) {
 	return s.Srv.ServerInfo(ctx, req)
 }
 
+func (s *ServerMock) CosignState(ctx context.Context, req *schema.CosignStateRequest) (*empty.Empty, error) {
+	return s.Srv.CosignState(ctx, req)
+}
+
+func (s *ServerMock) CosignedState(ctx context.Context, req *schema.CosignedStateRequest) (*schema.ImmutableState, error) {
+	return s.Srv.CosignedState(ctx, req)
+}
+
+func (s *ServerMock) PrefixDigest(ctx context.Context, req *schema.PrefixDigestRequest) (*schema.ContentDigest, error) {
+	if s.PostPrefixDigestFn == nil {
+		return s.Srv.PrefixDigest(ctx, req)
+	}
+
+	rsp, err := s.Srv.PrefixDigest(ctx, req)
+	return s.PostPrefixDigestFn(ctx, req, rsp, err)
+}
+
+func (s *ServerMock) TableDigest(ctx context.Context, req *schema.TableDigestRequest) (*schema.ContentDigest, error) {
+	return s.Srv.TableDigest(ctx, req)
+}
+
+func (s *ServerMock) GetTimestampAnchor(ctx context.Context, req *schema.TimestampAnchorRequest) (*schema.TimestampAnchor, error) {
+	return s.Srv.GetTimestampAnchor(ctx, req)
+}
+
+func (s *ServerMock) SigningKeyChain(ctx context.Context, req *empty.Empty) (*schema.SigningKeyChainResponse, error) {
+	return s.Srv.SigningKeyChain(ctx, req)
+}
+
 func (s *ServerMock) Health(ctx context.Context, req *empty.Empty) (*schema.HealthResponse, error) {
 	return s.Srv.Health(ctx, req)
 }
@@ -279,6 +357,10 @@ func (s *ServerMock) ZScan(ctx context.Context, req *schema.ZScanRequest) (*sche
 	return s.Srv.ZScan(ctx, req)
 }
 
+func (s *ServerMock) VerifiableZScan(ctx context.Context, req *schema.VerifiableZScanRequest) (*schema.VerifiableZEntries, error) {
+	return s.Srv.VerifiableZScan(ctx, req)
+}
+
 func (s *ServerMock) CreateDatabase(ctx context.Context, req *schema.Database) (*empty.Empty, error) {
 	return s.Srv.CreateDatabase(ctx, req)
 }
@@ -343,6 +425,14 @@ func (s *ServerMock) CompactIndex(ctx context.Context, req *empty.Empty) (*empty
 	return s.Srv.CompactIndex(ctx, req)
 }
 
+func (s *ServerMock) RebuildIndex(req *empty.Empty, str schema.ImmuService_RebuildIndexServer) error {
+	return s.Srv.RebuildIndex(req, str)
+}
+
+func (s *ServerMock) BulkLoad(ctx context.Context, req *schema.BulkLoadRequest) (*schema.BulkLoadResponse, error) {
+	return s.Srv.BulkLoad(ctx, req)
+}
+
 func (s *ServerMock) ChangePermission(ctx context.Context, req *schema.ChangePermissionRequest) (*empty.Empty, error) {
 	return s.Srv.ChangePermission(ctx, req)
 }
@@ -351,6 +441,42 @@ func (s *ServerMock) SetActiveUser(ctx context.Context, req *schema.SetActiveUse
 	return s.Srv.SetActiveUser(ctx, req)
 }
 
+func (s *ServerMock) UnlockUser(ctx context.Context, req *schema.UnlockUserRequest) (*empty.Empty, error) {
+	return s.Srv.UnlockUser(ctx, req)
+}
+
+func (s *ServerMock) CreateAPIKey(ctx context.Context, req *schema.CreateAPIKeyRequest) (*schema.CreateAPIKeyResponse, error) {
+	return s.Srv.CreateAPIKey(ctx, req)
+}
+
+func (s *ServerMock) ListAPIKeys(ctx context.Context, req *schema.ListAPIKeysRequest) (*schema.APIKeyList, error) {
+	return s.Srv.ListAPIKeys(ctx, req)
+}
+
+func (s *ServerMock) RevokeAPIKey(ctx context.Context, req *schema.RevokeAPIKeyRequest) (*empty.Empty, error) {
+	return s.Srv.RevokeAPIKey(ctx, req)
+}
+
+func (s *ServerMock) ListRoles(ctx context.Context, req *empty.Empty) (*schema.RoleList, error) {
+	return s.Srv.ListRoles(ctx, req)
+}
+
+func (s *ServerMock) CreateRole(ctx context.Context, req *schema.CreateRoleRequest) (*empty.Empty, error) {
+	return s.Srv.CreateRole(ctx, req)
+}
+
+func (s *ServerMock) DropRole(ctx context.Context, req *schema.DropRoleRequest) (*empty.Empty, error) {
+	return s.Srv.DropRole(ctx, req)
+}
+
+func (s *ServerMock) ChangeRolePrivilege(ctx context.Context, req *schema.ChangeRolePrivilegeRequest) (*empty.Empty, error) {
+	return s.Srv.ChangeRolePrivilege(ctx, req)
+}
+
+func (s *ServerMock) ChangeRoleMembership(ctx context.Context, req *schema.ChangeRoleMembershipRequest) (*empty.Empty, error) {
+	return s.Srv.ChangeRoleMembership(ctx, req)
+}
+
 func (s *ServerMock) getDbIndexFromCtx(ctx context.Context, methodname string) (int64, error) {
 	return s.GetDbIndexFromCtx(ctx, methodname)
 }
@@ -383,6 +509,15 @@ func (s *ServerMock) VerifiableSQLGet(ctx context.Context, req *schema.Verifiabl
 	return s.Srv.VerifiableSQLGet(ctx, req)
 }
 
+func (s *ServerMock) VerifiableSQLQuery(ctx context.Context, req *schema.VerifiableSQLQueryRequest) (*schema.VerifiableSQLQueryResult, error) {
+	if s.PostVerifiableSQLQueryFn == nil {
+		return s.Srv.VerifiableSQLQuery(ctx, req)
+	}
+
+	rsp, err := s.Srv.VerifiableSQLQuery(ctx, req)
+	return s.PostVerifiableSQLQueryFn(ctx, req, rsp, err)
+}
+
 func (s *ServerMock) TruncateDatabase(ctx context.Context, req *schema.TruncateDatabaseRequest) (*schema.TruncateDatabaseResponse, error) {
 	return s.Srv.TruncateDatabase(ctx, req)
 }
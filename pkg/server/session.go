@@ -28,7 +28,11 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionRequest) (*schema.OpenSessionResponse, error) {
+func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionRequest) (res *schema.OpenSessionResponse, err error) {
+	defer func() {
+		s.auditAs(ctx, string(r.GetUsername()), "OpenSession", r.GetDatabaseName(), "", err)
+	}()
+
 	if r == nil {
 		return nil, ErrIllegalArguments
 	}
@@ -59,7 +63,8 @@ func (s *ImmuServer) OpenSession(ctx context.Context, r *schema.OpenSessionReque
 	if (!u.IsSysAdmin) &&
 		(!u.HasPermission(r.DatabaseName, auth.PermissionAdmin)) &&
 		(!u.HasPermission(r.DatabaseName, auth.PermissionR)) &&
-		(!u.HasPermission(r.DatabaseName, auth.PermissionRW)) {
+		(!u.HasPermission(r.DatabaseName, auth.PermissionRW)) &&
+		(!s.hasRolePrivilegesOn(ctx, u, r.DatabaseName)) {
 
 		return nil, status.Errorf(codes.PermissionDenied, "Logged in user does not have permission on this database")
 	}
@@ -30,9 +30,16 @@ func (s *ImmuServer) SessionAuthInterceptor(ctx context.Context, req interface{}
 		if err != nil {
 			return nil, err
 		}
-		if !s.SessManager.SessionPresent(sessionID) {
+		sess, err := s.SessManager.GetSession(sessionID)
+		if err != nil {
 			return nil, ErrSessionNotFound
 		}
+		// sessions opened with expired external credentials can only be closed
+		if info.FullMethod != "/immudb.schema.ImmuService/CloseSession" {
+			if err := checkExternalCredentials(sess.GetUser()); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return handler(ctx, req)
 }
@@ -42,6 +42,9 @@ type Transaction interface {
 	GetSessionID() string
 	SQLExec(ctx context.Context, request *schema.SQLExecRequest) error
 	SQLQuery(ctx context.Context, request *schema.SQLQueryRequest) (*schema.SQLQueryResult, error)
+	Savepoint(name string) error
+	RollbackToSavepoint(name string) error
+	ReleaseSavepoint(name string) error
 }
 
 func NewTransaction(ctx context.Context, opts *sql.TxOptions, db database.DB, sessionID string) (*transaction, error) {
@@ -135,3 +138,36 @@ func (tx *transaction) SQLQuery(ctx context.Context, request *schema.SQLQueryReq
 
 	return tx.db.SQLQuery(ctx, tx.sqlTx, request)
 }
+
+func (tx *transaction) Savepoint(name string) error {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+
+	if tx.sqlTx == nil || tx.sqlTx.Closed() {
+		return sql.ErrNoOngoingTx
+	}
+
+	return tx.sqlTx.Savepoint(name)
+}
+
+func (tx *transaction) RollbackToSavepoint(name string) error {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+
+	if tx.sqlTx == nil || tx.sqlTx.Closed() {
+		return sql.ErrNoOngoingTx
+	}
+
+	return tx.sqlTx.RollbackToSavepoint(name)
+}
+
+func (tx *transaction) ReleaseSavepoint(name string) error {
+	tx.mutex.Lock()
+	defer tx.mutex.Unlock()
+
+	if tx.sqlTx == nil || tx.sqlTx.Closed() {
+		return sql.ErrNoOngoingTx
+	}
+
+	return tx.sqlTx.ReleaseSavepoint(name)
+}
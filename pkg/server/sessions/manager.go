@@ -52,6 +52,7 @@ type Manager interface {
 	StopSessionsGuard() error
 	GetSession(sessionID string) (*Session, error)
 	SessionCount() int
+	TransactionCount(filter func(sess *Session) bool) int
 	GetTransactionFromContext(ctx context.Context) (transactions.Transaction, error)
 	GetSessionFromContext(ctx context.Context) (*Session, error)
 	DeleteTransaction(transactions.Transaction) error
@@ -170,6 +171,22 @@ func (sm *manager) SessionCount() int {
 	return len(sm.sessions)
 }
 
+// TransactionCount returns the number of ongoing transactions of the sessions satisfying the filter
+func (sm *manager) TransactionCount(filter func(sess *Session) bool) int {
+	sm.sessionMux.RLock()
+	defer sm.sessionMux.RUnlock()
+
+	count := 0
+
+	for _, sess := range sm.sessions {
+		if filter(sess) {
+			count += sess.TransactionCount()
+		}
+	}
+
+	return count
+}
+
 func (sm *manager) StartSessionsGuard() error {
 	sm.sessionMux.Lock()
 	defer sm.sessionMux.Unlock()
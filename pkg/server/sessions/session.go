@@ -126,6 +126,14 @@ func (s *Session) GetTransaction(transactionID string) (transactions.Transaction
 	return tx, nil
 }
 
+// TransactionCount returns the number of ongoing transactions of the session
+func (s *Session) TransactionCount() int {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	return len(s.transactions)
+}
+
 func GetSessionIDFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -21,16 +21,18 @@ import (
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
+	"github.com/codenotary/immudb/pkg/database"
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
 func (s *ImmuServer) VerifiableSQLGet(ctx context.Context, req *schema.VerifiableSQLGetRequest) (*schema.VerifiableSQLEntry, error) {
-	db, err := s.getDBFromCtx(ctx, "VerifiableSQLGet")
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableSQLGet", req)
 	if err != nil {
 		return nil, err
 	}
 
-	ventry, err := db.VerifiableSQLGet(ctx, req)
+	ventry, err := db.VerifiableSQLGet(s.withSQLSessionVariables(ctx, db), req)
 	if err != nil {
 		return nil, err
 	}
@@ -56,16 +58,39 @@ func (s *ImmuServer) VerifiableSQLGet(ctx context.Context, req *schema.Verifiabl
 	return ventry, nil
 }
 
+func (s *ImmuServer) VerifiableSQLQuery(ctx context.Context, req *schema.VerifiableSQLQueryRequest) (*schema.VerifiableSQLQueryResult, error) {
+	db, err := s.getDBFromCtxForRequest(ctx, "VerifiableSQLQuery", req)
+	if err != nil {
+		return nil, err
+	}
+
+	vRes, err := db.VerifiableSQLQuery(s.withSQLSessionVariables(ctx, db), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StateSigner != nil && vRes.DualProof != nil {
+		vRes.Signature, err = s.signStateAt(db.GetName(), vRes.DualProof.TargetTxHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vRes, nil
+}
+
 func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*schema.SQLExecResult, error) {
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
-	db, err := s.getDBFromCtx(ctx, "SQLExec")
+	db, err := s.getDBFromCtxForRequest(ctx, "SQLExec", req)
 	if err != nil {
 		return nil, err
 	}
 
+	ctx = s.withSQLSessionVariables(ctx, db)
+
 	tx, err := db.NewSQLTx(ctx, sql.DefaultTxOptions())
 	if err != nil {
 		return nil, err
@@ -109,11 +134,13 @@ func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*
 }
 
 func (s *ImmuServer) SQLQuery(ctx context.Context, req *schema.SQLQueryRequest) (*schema.SQLQueryResult, error) {
-	db, err := s.getDBFromCtx(ctx, "SQLQuery")
+	db, err := s.getDBFromCtxForRequest(ctx, "SQLQuery", req)
 	if err != nil {
 		return nil, err
 	}
 
+	ctx = s.withSQLSessionVariables(ctx, db)
+
 	tx, err := db.NewSQLTx(ctx, sql.DefaultTxOptions().WithReadOnly(true))
 	if err != nil {
 		return nil, err
@@ -137,10 +164,32 @@ func (s *ImmuServer) DescribeTable(ctx context.Context, req *schema.Table) (*sch
 		return nil, ErrIllegalArguments
 	}
 
-	db, err := s.getDBFromCtx(ctx, "DescribeTable")
+	db, err := s.getDBFromCtxForRequest(ctx, "DescribeTable", req)
 	if err != nil {
 		return nil, err
 	}
 
 	return db.DescribeTable(ctx, nil, req.TableName)
 }
+
+// withSQLSessionVariables binds the SQL statements executed on db to the user logged in ctx.
+// Row-level security policies are not enforced for administrators of the database.
+func (s *ImmuServer) withSQLSessionVariables(ctx context.Context, db database.DB) context.Context {
+	if !s.Options.auth {
+		return sql.WithSessionVariables(ctx, &sql.SessionVariables{BypassRowLevelSecurity: true})
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return sql.WithSessionVariables(ctx, &sql.SessionVariables{})
+	}
+
+	return sql.WithSessionVariables(ctx, sqlSessionVariables(user, db.GetName()))
+}
+
+func sqlSessionVariables(user *auth.User, db string) *sql.SessionVariables {
+	return &sql.SessionVariables{
+		User:                   user.Username,
+		BypassRowLevelSecurity: user.IsSysAdmin || user.HasPermission(db, auth.PermissionAdmin),
+	}
+}
@@ -184,3 +184,158 @@ func TestSQLExecCreateDatabase(t *testing.T) {
 	_, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "CREATE DATABASE db2;"})
 	require.ErrorContains(t, err, sql.ErrDatabaseAlreadyExists.Error())
 }
+
+func TestSQLRowLevelSecurity(t *testing.T) {
+	dir := t.TempDir()
+
+	serverOptions := DefaultOptions().
+		WithDir(dir).
+		WithPort(0).
+		WithMetricsServer(false).
+		WithAdminPassword(auth.SysAdminPassword)
+
+	s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+	err := s.Initialize()
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	login := func(username, password string) context.Context {
+		lr, err := s.Login(context.Background(), &schema.LoginRequest{User: []byte(username), Password: []byte(password)})
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", lr.Token))
+	}
+
+	adminCtx := login(auth.SysAdminUsername, auth.SysAdminPassword)
+
+	for _, username := range []string{"jane", "john"} {
+		_, err = s.CreateUser(adminCtx, &schema.CreateUserRequest{
+			User:       []byte(username),
+			Password:   []byte("Pa$$w0rd"),
+			Database:   DefaultDBName,
+			Permission: auth.PermissionRW,
+		})
+		require.NoError(t, err)
+	}
+
+	janeCtx := login("jane", "Pa$$w0rd")
+	johnCtx := login("john", "Pa$$w0rd")
+
+	_, err = s.SQLExec(adminCtx, &schema.SQLExecRequest{Sql: `
+		CREATE TABLE notes (id INTEGER AUTO_INCREMENT, owner VARCHAR, note VARCHAR, PRIMARY KEY id);
+		CREATE POLICY owner_notes ON notes USING (owner = CURRENT_USER());
+	`})
+	require.NoError(t, err)
+
+	_, err = s.SQLExec(janeCtx, &schema.SQLExecRequest{Sql: "DROP POLICY owner_notes ON notes"})
+	require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+	janeRes, err := s.SQLExec(janeCtx, &schema.SQLExecRequest{Sql: "INSERT INTO notes (owner, note) VALUES ('jane', 'n1'), ('jane', 'n2')"})
+	require.NoError(t, err)
+
+	_, err = s.SQLExec(johnCtx, &schema.SQLExecRequest{Sql: "INSERT INTO notes (owner, note) VALUES ('jane', 'n3')"})
+	require.ErrorIs(t, err, sql.ErrRowLevelSecurityViolation)
+
+	_, err = s.SQLExec(johnCtx, &schema.SQLExecRequest{Sql: "INSERT INTO notes (owner, note) VALUES ('john', 'n3')"})
+	require.NoError(t, err)
+
+	res, err := s.SQLQuery(janeCtx, &schema.SQLQueryRequest{Sql: "SELECT note FROM notes"})
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 2)
+
+	res, err = s.SQLQuery(adminCtx, &schema.SQLQueryRequest{Sql: "SELECT note FROM notes"})
+	require.NoError(t, err)
+	require.Len(t, res.Rows, 3)
+
+	_, err = s.VerifiableSQLGet(johnCtx, &schema.VerifiableSQLGetRequest{
+		SqlGetRequest: &schema.SQLGetRequest{Table: "notes", PkValues: []*schema.SQLValue{{Value: &schema.SQLValue_N{N: 1}}}},
+	})
+	require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+	_, err = s.VerifiableSQLGet(adminCtx, &schema.VerifiableSQLGetRequest{
+		SqlGetRequest: &schema.SQLGetRequest{Table: "notes", PkValues: []*schema.SQLValue{{Value: &schema.SQLValue_N{N: 1}}}},
+	})
+	require.NoError(t, err)
+
+	t.Run("raw rows of tables with policies can not be read through transactions", func(t *testing.T) {
+		txID := janeRes.Txs[0].Header.Id
+
+		rawSpec := &schema.EntriesSpec{SqlEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RAW_VALUE}}
+
+		_, err := s.TxById(johnCtx, &schema.TxRequest{Tx: txID, EntriesSpec: rawSpec})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		_, err = s.VerifiableTxById(johnCtx, &schema.VerifiableTxRequest{Tx: txID, EntriesSpec: rawSpec})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		_, err = s.TxScan(johnCtx, &schema.TxScanRequest{InitialTx: txID, EntriesSpec: rawSpec})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		_, err = s.TableDigest(johnCtx, &schema.TableDigestRequest{Table: "notes"})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		// keys of the entries encode the primary key values of the rows
+		_, err = s.TxById(johnCtx, &schema.TxRequest{
+			Tx:          txID,
+			EntriesSpec: &schema.EntriesSpec{SqlEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_ONLY_DIGEST}},
+		})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		_, err = s.TxById(johnCtx, &schema.TxRequest{Tx: txID})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		_, err = s.VerifiableTxById(johnCtx, &schema.VerifiableTxRequest{Tx: txID})
+		require.ErrorIs(t, err, sql.ErrRowLevelSecurityBypassRequired)
+
+		tx, err := s.TxById(johnCtx, &schema.TxRequest{
+			Tx:          txID,
+			EntriesSpec: &schema.EntriesSpec{SqlEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_EXCLUDE}},
+		})
+		require.NoError(t, err)
+		require.Empty(t, tx.Entries)
+
+		tx, err = s.TxById(adminCtx, &schema.TxRequest{Tx: txID, EntriesSpec: rawSpec})
+		require.NoError(t, err)
+		require.NotEmpty(t, tx.Entries)
+		require.NotEmpty(t, tx.Entries[0].Value)
+
+		_, err = s.TableDigest(adminCtx, &schema.TableDigestRequest{Table: "notes"})
+		require.NoError(t, err)
+	})
+
+	t.Run("policies are enforced within interactive transactions", func(t *testing.T) {
+		sess, err := s.OpenSession(context.Background(), &schema.OpenSessionRequest{
+			Username:     []byte("john"),
+			Password:     []byte("Pa$$w0rd"),
+			DatabaseName: DefaultDBName,
+		})
+		require.NoError(t, err)
+
+		sessCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("sessionid", sess.SessionID))
+
+		defer s.CloseSession(sessCtx, &emptypb.Empty{})
+
+		tx, err := s.NewTx(sessCtx, &schema.NewTxRequest{Mode: schema.TxMode_ReadWrite})
+		require.NoError(t, err)
+
+		sessCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"sessionid", sess.SessionID,
+			"transactionid", tx.TransactionID,
+		))
+
+		_, err = s.TxSQLExec(sessCtx, &schema.SQLExecRequest{Sql: "DELETE FROM notes"})
+		require.NoError(t, err)
+
+		res, err := s.TxSQLQuery(sessCtx, &schema.SQLQueryRequest{Sql: "SELECT note FROM notes"})
+		require.NoError(t, err)
+		require.Empty(t, res.Rows)
+
+		_, err = s.Commit(sessCtx, &emptypb.Empty{})
+		require.NoError(t, err)
+
+		res, err = s.SQLQuery(adminCtx, &schema.SQLQueryRequest{Sql: "SELECT note FROM notes"})
+		require.NoError(t, err)
+		require.Len(t, res.Rows, 2)
+	})
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/database"
+	"github.com/codenotary/immudb/pkg/tsa"
+)
+
+// timestampAnchorer periodically anchors the state of the databases
+// with time-stamp tokens issued by an RFC 3161 time-stamping authority
+type timestampAnchorer struct {
+	client   *tsa.Client
+	interval time.Duration
+
+	stopch chan struct{}
+	donech chan struct{}
+}
+
+func (s *ImmuServer) startTimestampAnchoring() {
+	if s.Options.TimestampAuthorityURL == "" || s.timestampAnchorer != nil {
+		return
+	}
+
+	interval := s.Options.TimestampAuthorityInterval
+	if interval <= 0 {
+		interval = DefaultOptions().TimestampAuthorityInterval
+	}
+
+	a := &timestampAnchorer{
+		client:   tsa.NewClient(s.Options.TimestampAuthorityURL, interval),
+		interval: interval,
+		stopch:   make(chan struct{}),
+		donech:   make(chan struct{}),
+	}
+
+	s.timestampAnchorer = a
+
+	s.Logger.Infof("Anchoring database states with time-stamping authority '%s' every %v", s.Options.TimestampAuthorityURL, interval)
+
+	go func() {
+		defer close(a.donech)
+
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			select {
+			case <-a.stopch:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.anchorDatabases(ctx, a.client)
+			}
+		}
+	}()
+}
+
+func (s *ImmuServer) stopTimestampAnchoring() {
+	a := s.timestampAnchorer
+	if a == nil {
+		return
+	}
+
+	close(a.stopch)
+	<-a.donech
+
+	s.timestampAnchorer = nil
+}
+
+func (s *ImmuServer) anchorDatabases(ctx context.Context, client *tsa.Client) {
+	for i := 0; i < s.dbList.Length(); i++ {
+		db, err := s.dbList.GetByIndex(i)
+		if err != nil || db.IsClosed() || db.IsReplica() {
+			continue
+		}
+
+		err = s.anchorDatabase(ctx, db, client)
+		if err != nil && ctx.Err() == nil {
+			s.Logger.Warningf("Unable to anchor the state of database '%s'. Reason: %v", db.GetName(), err)
+		}
+	}
+}
+
+// anchorDatabase requests a time-stamp token over the current state of the database,
+// unless the latest transaction is the one holding the previous anchor
+func (s *ImmuServer) anchorDatabase(ctx context.Context, db database.DB, client *tsa.Client) error {
+	state, err := db.CurrentState()
+	if err != nil {
+		return err
+	}
+	if state.TxId == 0 {
+		return nil
+	}
+
+	tx, err := db.TxByID(ctx, &schema.TxRequest{
+		Tx:                       state.TxId,
+		EntriesSpec:              &schema.EntriesSpec{},
+		KeepReferencesUnresolved: true,
+	})
+	if err != nil {
+		return err
+	}
+	if tx.Header.Metadata.GetTimestampAnchoredTxID() > 0 {
+		return nil
+	}
+
+	token, err := client.Timestamp(ctx, schema.DigestFromProto(state.TxHash))
+	if err != nil {
+		return err
+	}
+
+	hdr, err := db.AddTimestampAnchor(ctx, state.TxId, token.Raw)
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Infof("State of database '%s' at tx %d anchored at tx %d with time-stamp token issued at %v",
+		db.GetName(), state.TxId, hdr.Id, token.GenTime)
+
+	return nil
+}
+
+// GetTimestampAnchor returns the earliest timestamp anchor covering the requested transaction
+func (s *ImmuServer) GetTimestampAnchor(ctx context.Context, req *schema.TimestampAnchorRequest) (*schema.TimestampAnchor, error) {
+	db, err := s.getDBFromCtx(ctx, "GetTimestampAnchor")
+	if err != nil {
+		return nil, err
+	}
+
+	return db.TimestampAnchor(ctx, req)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/tsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerTimestampAnchoring(t *testing.T) {
+	authority, err := tsa.GenerateLocalAuthority()
+	require.NoError(t, err)
+
+	tsaSrv := httptest.NewServer(authority)
+	defer tsaSrv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(authority.Certificate())
+
+	dir := t.TempDir()
+
+	s := DefaultServer()
+	s.WithOptions(DefaultOptions().WithDir(dir).WithAuth(false))
+
+	err = s.loadSystemDatabase(dir, nil, s.Options.AdminPassword, false)
+	require.NoError(t, err)
+
+	err = s.loadDefaultDatabase(dir, nil)
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	ctx := context.Background()
+	client := tsa.NewClient(tsaSrv.URL, time.Second)
+
+	_, err = s.GetTimestampAnchor(ctx, &schema.TimestampAnchorRequest{Tx: 1})
+	require.Error(t, err)
+
+	hdr, err := s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	state, err := s.CurrentState(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, hdr.Id, state.TxId)
+
+	s.anchorDatabases(ctx, client)
+
+	anchor, err := s.GetTimestampAnchor(ctx, &schema.TimestampAnchorRequest{Tx: hdr.Id})
+	require.NoError(t, err)
+	require.Equal(t, hdr.Id, anchor.AnchoredTx)
+	require.Equal(t, hdr.Id+1, anchor.Tx)
+
+	token, err := tsa.ParseToken(anchor.Token)
+	require.NoError(t, err)
+
+	err = token.Verify(state.TxHash, roots)
+	require.NoError(t, err)
+
+	t.Run("anchors should not be made when no transaction was committed since the last one", func(t *testing.T) {
+		s.anchorDatabases(ctx, client)
+
+		state, err := s.CurrentState(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, anchor.Tx, state.TxId)
+	})
+
+	t.Run("unavailable authorities should not prevent later anchors", func(t *testing.T) {
+		hdr, err := s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key2"), Value: []byte("value2")}}})
+		require.NoError(t, err)
+
+		failingSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		defer failingSrv.Close()
+
+		s.anchorDatabases(ctx, tsa.NewClient(failingSrv.URL, time.Second))
+
+		_, err = s.GetTimestampAnchor(ctx, &schema.TimestampAnchorRequest{Tx: hdr.Id})
+		require.Error(t, err)
+
+		s.anchorDatabases(ctx, client)
+
+		anchor, err := s.GetTimestampAnchor(ctx, &schema.TimestampAnchorRequest{Tx: hdr.Id})
+		require.NoError(t, err)
+		require.Equal(t, hdr.Id, anchor.AnchoredTx)
+	})
+
+	t.Run("database states should be periodically anchored", func(t *testing.T) {
+		s.Options.WithTimestampAuthorityURL(tsaSrv.URL).WithTimestampAuthorityInterval(10 * time.Millisecond)
+
+		s.startTimestampAnchoring()
+		defer s.stopTimestampAnchoring()
+
+		hdr, err := s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key3"), Value: []byte("value3")}}})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			_, err := s.GetTimestampAnchor(ctx, &schema.TimestampAnchorRequest{Tx: hdr.Id})
+			return err == nil
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+}
@@ -45,6 +45,10 @@ func (s *ImmuServer) NewTx(ctx context.Context, request *schema.NewTxRequest) (*
 		return nil, err
 	}
 
+	if sess.GetUser().PasswordExpired {
+		return nil, ErrPasswordExpired
+	}
+
 	opts := sql.DefaultTxOptions().
 		WithReadOnly(request.Mode == schema.TxMode_ReadOnly)
 
@@ -60,6 +64,8 @@ func (s *ImmuServer) NewTx(ctx context.Context, request *schema.NewTxRequest) (*
 
 	opts.UnsafeMVCC = request.UnsafeMVCC
 
+	ctx = sql.WithSessionVariables(ctx, sqlSessionVariables(sess.GetUser(), sess.GetDatabase().GetName()))
+
 	tx, err := sess.NewTransaction(ctx, opts)
 	if err != nil {
 		return nil, err
@@ -109,6 +115,57 @@ func (s *ImmuServer) Rollback(ctx context.Context, _ *empty.Empty) (*empty.Empty
 	return new(empty.Empty), s.SessManager.RollbackTransaction(tx)
 }
 
+// TxSavepoint creates a named savepoint within the ongoing transaction
+func (s *ImmuServer) TxSavepoint(ctx context.Context, request *schema.TxSavepointRequest) (*empty.Empty, error) {
+	if request == nil {
+		return nil, ErrIllegalArguments
+	}
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	tx, err := s.SessManager.GetTransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), tx.Savepoint(request.Name)
+}
+
+// TxRollbackToSavepoint discards the changes made in the ongoing transaction after the named savepoint was created
+func (s *ImmuServer) TxRollbackToSavepoint(ctx context.Context, request *schema.TxSavepointRequest) (*empty.Empty, error) {
+	if request == nil {
+		return nil, ErrIllegalArguments
+	}
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	tx, err := s.SessManager.GetTransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), tx.RollbackToSavepoint(request.Name)
+}
+
+// TxReleaseSavepoint destroys the named savepoint, changes made after it was created are kept
+func (s *ImmuServer) TxReleaseSavepoint(ctx context.Context, request *schema.TxSavepointRequest) (*empty.Empty, error) {
+	if request == nil {
+		return nil, ErrIllegalArguments
+	}
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	tx, err := s.SessManager.GetTransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), tx.ReleaseSavepoint(request.Name)
+}
+
 func (s *ImmuServer) TxSQLExec(ctx context.Context, request *schema.SQLExecRequest) (*empty.Empty, error) {
 	if request == nil {
 		return nil, ErrIllegalArguments
@@ -118,6 +175,11 @@ func (s *ImmuServer) TxSQLExec(ctx context.Context, request *schema.SQLExecReque
 		return new(empty.Empty), ErrNotAllowedInMaintenanceMode
 	}
 
+	err := s.authorizeSessionRequest(ctx, "SQLExec", request)
+	if err != nil {
+		return new(empty.Empty), err
+	}
+
 	tx, err := s.SessManager.GetTransactionFromContext(ctx)
 	if err != nil {
 		return new(empty.Empty), err
@@ -140,6 +202,11 @@ func (s *ImmuServer) TxSQLQuery(ctx context.Context, request *schema.SQLQueryReq
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
 
+	err := s.authorizeSessionRequest(ctx, "SQLQuery", request)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := s.SessManager.GetTransactionFromContext(ctx)
 	if err != nil {
 		return nil, err
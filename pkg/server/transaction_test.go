@@ -44,6 +44,24 @@ func TestImmuServer_Transaction(t *testing.T) {
 	_, err = s.Rollback(context.Background(), &emptypb.Empty{})
 	require.ErrorIs(t, err, ErrNotAllowedInMaintenanceMode)
 
+	_, err = s.TxSavepoint(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = s.TxSavepoint(context.Background(), &schema.TxSavepointRequest{Name: "sp1"})
+	require.ErrorIs(t, err, ErrNotAllowedInMaintenanceMode)
+
+	_, err = s.TxRollbackToSavepoint(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = s.TxRollbackToSavepoint(context.Background(), &schema.TxSavepointRequest{Name: "sp1"})
+	require.ErrorIs(t, err, ErrNotAllowedInMaintenanceMode)
+
+	_, err = s.TxReleaseSavepoint(context.Background(), nil)
+	require.ErrorIs(t, err, ErrIllegalArguments)
+
+	_, err = s.TxReleaseSavepoint(context.Background(), &schema.TxSavepointRequest{Name: "sp1"})
+	require.ErrorIs(t, err, ErrNotAllowedInMaintenanceMode)
+
 	_, err = s.TxSQLExec(context.Background(), nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
@@ -39,6 +39,7 @@ import (
 	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/immuos"
 	"github.com/codenotary/immudb/pkg/logger"
+	"github.com/codenotary/immudb/pkg/signer"
 )
 
 // userDatabasePairs keeps an associacion of username to userdata
@@ -58,39 +59,60 @@ type ImmuServer struct {
 	dbList      database.DatabaseList
 	dbListMutex sync.Mutex // TODO: convert dbList into a dbManager capable of opening/closing/deleting dbs
 
+	rolesMutex sync.Mutex
+
 	replicators      map[string]*replication.TxReplicator
 	replicationMutex sync.Mutex
 
 	truncators     map[string]*truncator.Truncator
 	truncatorMutex sync.Mutex
 
-	Logger      logger.Logger
-	Options     *Options
-	Listener    net.Listener
-	GrpcServer  *grpc.Server
-	UUID        xid.ID
-	Pid         PIDFile
-	quit        chan struct{}
-	userdata    *usernameToUserdataMap
-	multidbmode bool
-	//Cc                  CorruptionChecker
+	Logger               logger.Logger
+	Options              *Options
+	Listener             net.Listener
+	GrpcServer           *grpc.Server
+	UUID                 xid.ID
+	Pid                  PIDFile
+	quit                 chan struct{}
+	userdata             *usernameToUserdataMap
+	multidbmode          bool
 	sysDB                database.DB
 	metricsServer        *http.Server
 	webServer            *http.Server
 	mux                  sync.Mutex
 	pgsqlMux             sync.Mutex
 	StateSigner          StateSigner
+	signingKeyChain      []*signer.KeyRotation
+	witnessCosignatures  witnessCosignatures
 	StreamServiceFactory stream.ServiceFactory
 	PgsqlSrv             pgsqlsrv.Server
 
 	remoteStorage remotestorage.Storage
 
+	timestampAnchorer *timestampAnchorer
+
+	scrubber *scrubber
+
+	quotas *quotaManager
+
+	jwtValidator *auth.JWTValidator
+
+	authProvider authProvider
+
+	certificateMapper *certificateMapper
+
+	auditDB    database.DB
+	auditMutex sync.Mutex
+
+	loginAttemptsMutex sync.Mutex
+	apiKeysMutex       sync.Mutex
+
 	SessManager sessions.Manager
 }
 
 // DefaultServer ...
 func DefaultServer() *ImmuServer {
-	return &ImmuServer{
+	s := &ImmuServer{
 		OS:                   immuos.NewStandardOS(),
 		dbList:               database.NewDatabaseList(),
 		replicators:          make(map[string]*replication.TxReplicator),
@@ -102,6 +124,10 @@ func DefaultServer() *ImmuServer {
 		GrpcServer:           grpc.NewServer(),
 		StreamServiceFactory: stream.NewStreamServiceFactory(DefaultOptions().StreamChunkSize),
 	}
+
+	s.authProvider = &localAuthProvider{s: s}
+
+	return s
 }
 
 type ImmuServerIf interface {
@@ -62,3 +62,15 @@ func TestWithDbList(t *testing.T) {
 	s.WithDbList(dbList)
 	require.Same(t, dbList, s.dbList)
 }
+
+type mockLogger struct{}
+
+func (l *mockLogger) Errorf(f string, v ...interface{}) {}
+
+func (l *mockLogger) Warningf(f string, v ...interface{}) {}
+
+func (l *mockLogger) Infof(f string, v ...interface{}) {}
+
+func (l *mockLogger) Debugf(f string, v ...interface{}) {}
+
+func (l *mockLogger) Close() error { return nil }
@@ -34,7 +34,11 @@ import (
 )
 
 // Login ...
-func (s *ImmuServer) Login(ctx context.Context, r *schema.LoginRequest) (*schema.LoginResponse, error) {
+func (s *ImmuServer) Login(ctx context.Context, r *schema.LoginRequest) (res *schema.LoginResponse, err error) {
+	defer func() {
+		s.auditAs(ctx, string(r.GetUser()), "Login", "", "", err)
+	}()
+
 	if !s.Options.auth {
 		return nil, errors.New(ErrAuthDisabled).WithCode(errors.CodProtocolViolation)
 	}
@@ -64,6 +68,9 @@ func (s *ImmuServer) Login(ctx context.Context, r *schema.LoginRequest) (*schema
 	if u.Username == auth.SysAdminUsername && string(r.GetPassword()) == auth.SysAdminPassword {
 		loginResponse.Warning = []byte(auth.WarnDefaultAdminPassword)
 	}
+	if u.PasswordExpired {
+		loginResponse.Warning = []byte(ErrPasswordExpired.Error())
+	}
 
 	if u.Username == auth.SysAdminUsername {
 		u.IsSysAdmin = true
@@ -81,7 +88,7 @@ func (s *ImmuServer) Logout(ctx context.Context, r *empty.Empty) (*empty.Empty,
 		return nil, errors.New(ErrAuthDisabled).WithCode(errors.CodProtocolViolation)
 	}
 
-	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	_, user, err := s.getLoggedInUserdataWithExpiredPasswordFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +103,13 @@ func (s *ImmuServer) Logout(ctx context.Context, r *empty.Empty) (*empty.Empty,
 }
 
 // CreateUser Creates a new user
-func (s *ImmuServer) CreateUser(ctx context.Context, r *schema.CreateUserRequest) (*empty.Empty, error) {
+func (s *ImmuServer) CreateUser(ctx context.Context, r *schema.CreateUserRequest) (res *empty.Empty, err error) {
 	s.Logger.Debugf("CreateUser")
 
+	defer func() {
+		s.audit(ctx, "CreateUser", r.GetDatabase(), string(r.GetUser()), err)
+	}()
+
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
@@ -226,6 +237,7 @@ func (s *ImmuServer) ListUsers(ctx context.Context, req *empty.Empty) (*schema.U
 				Createdby:   user.CreatedBy,
 				Permissions: permissions,
 				Active:      user.Active,
+				Roles:       user.Roles,
 			}
 
 			userlist.Users = append(userlist.Users, &u)
@@ -271,6 +283,7 @@ func (s *ImmuServer) ListUsers(ctx context.Context, req *empty.Empty) (*schema.U
 					Createdby:   user.CreatedBy,
 					Permissions: permissions,
 					Active:      user.Active,
+					Roles:       user.Roles,
 				}
 
 				userlist.Users = append(userlist.Users, &u)
@@ -306,9 +319,13 @@ func (s *ImmuServer) ListUsers(ctx context.Context, req *empty.Empty) (*schema.U
 }
 
 // ChangePassword ...
-func (s *ImmuServer) ChangePassword(ctx context.Context, r *schema.ChangePasswordRequest) (*empty.Empty, error) {
+func (s *ImmuServer) ChangePassword(ctx context.Context, r *schema.ChangePasswordRequest) (res *empty.Empty, err error) {
 	s.Logger.Debugf("ChangePassword")
 
+	defer func() {
+		s.audit(ctx, "ChangePassword", "", string(r.GetUser()), err)
+	}()
+
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
@@ -317,18 +334,25 @@ func (s *ImmuServer) ChangePassword(ctx context.Context, r *schema.ChangePasswor
 		return nil, fmt.Errorf("this command is available only with authentication on")
 	}
 
-	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	_, user, err := s.getLoggedInUserdataWithExpiredPasswordFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if string(r.User) == auth.SysAdminUsername {
+	// users logged in with an expired password can only change it
+	ownExpiredPassword := user.PasswordExpired && string(r.User) == user.Username
+
+	if user.PasswordExpired && !ownExpiredPassword {
+		return nil, ErrPasswordExpired
+	}
+
+	if string(r.User) == auth.SysAdminUsername || ownExpiredPassword {
 		if err = auth.ComparePasswords(user.HashedPassword, r.OldPassword); err != nil {
 			return new(empty.Empty), status.Errorf(codes.PermissionDenied, "old password is incorrect")
 		}
 	}
 
-	if !user.IsSysAdmin {
+	if !user.IsSysAdmin && !ownExpiredPassword {
 		if !user.HasAtLeastOnePermission(auth.PermissionAdmin) {
 			return nil, fmt.Errorf("user is not system admin nor admin in any of the databases")
 		}
@@ -344,18 +368,24 @@ func (s *ImmuServer) ChangePassword(ctx context.Context, r *schema.ChangePasswor
 	}
 
 	//if the user is not sys admin then let's make sure the target was created from this admin
-	if !user.IsSysAdmin {
+	if !user.IsSysAdmin && !ownExpiredPassword {
 		if user.Username != targetUser.CreatedBy {
 			return nil, fmt.Errorf("user %s was not found or it was not created by you", string(r.User))
 		}
 	}
 
-	_, err = targetUser.SetPassword(r.NewPassword)
+	if err = s.checkNewPassword(targetUser, r.NewPassword); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = s.setPassword(targetUser, r.NewPassword)
 	if err != nil {
 		return nil, err
 	}
 
-	targetUser.CreatedBy = user.Username
+	if !ownExpiredPassword {
+		targetUser.CreatedBy = user.Username
+	}
 	targetUser.CreatedAt = time.Now()
 	if err := s.saveUser(ctx, targetUser); err != nil {
 		return nil, err
@@ -373,9 +403,13 @@ func (s *ImmuServer) ChangePassword(ctx context.Context, r *schema.ChangePasswor
 }
 
 // ChangePermission grant or revoke user permissions on databases
-func (s *ImmuServer) ChangePermission(ctx context.Context, r *schema.ChangePermissionRequest) (*empty.Empty, error) {
+func (s *ImmuServer) ChangePermission(ctx context.Context, r *schema.ChangePermissionRequest) (res *empty.Empty, err error) {
 	s.Logger.Debugf("ChangePermission %+v", r)
 
+	defer func() {
+		s.audit(ctx, "ChangePermission", r.GetDatabase(), r.GetUsername(), err)
+	}()
+
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
@@ -457,9 +491,13 @@ func (s *ImmuServer) ChangePermission(ctx context.Context, r *schema.ChangePermi
 }
 
 // SetActiveUser activate or deactivate a user
-func (s *ImmuServer) SetActiveUser(ctx context.Context, r *schema.SetActiveUserRequest) (*empty.Empty, error) {
+func (s *ImmuServer) SetActiveUser(ctx context.Context, r *schema.SetActiveUserRequest) (res *empty.Empty, err error) {
 	s.Logger.Debugf("SetActiveUser")
 
+	defer func() {
+		s.audit(ctx, "SetActiveUser", "", r.GetUsername(), err)
+	}()
+
 	if s.Options.GetMaintenance() {
 		return nil, ErrNotAllowedInMaintenanceMode
 	}
@@ -516,6 +554,56 @@ func (s *ImmuServer) SetActiveUser(ctx context.Context, r *schema.SetActiveUserR
 	return new(empty.Empty), nil
 }
 
+// UnlockUser allows a user locked out after too many failed login attempts to login again
+func (s *ImmuServer) UnlockUser(ctx context.Context, r *schema.UnlockUserRequest) (res *empty.Empty, err error) {
+	s.Logger.Debugf("UnlockUser")
+
+	defer func() {
+		s.audit(ctx, "UnlockUser", "", r.GetUsername(), err)
+	}()
+
+	if s.Options.GetMaintenance() {
+		return nil, ErrNotAllowedInMaintenanceMode
+	}
+
+	if !s.Options.GetAuth() {
+		return nil, fmt.Errorf("this command is available only with authentication on")
+	}
+
+	if len(r.GetUsername()) == 0 {
+		return nil, fmt.Errorf("username can not be empty")
+	}
+
+	_, user, err := s.getLoggedInUserdataFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSysAdmin {
+		if !user.HasAtLeastOnePermission(auth.PermissionAdmin) {
+			return nil, fmt.Errorf("user is not system admin nor admin in any of the databases")
+		}
+	}
+
+	targetUser, err := s.getUser(ctx, []byte(r.Username))
+	if err != nil {
+		return nil, fmt.Errorf("user %s not found", r.Username)
+	}
+
+	//if the user is not sys admin then let's make sure the target was created from this admin
+	if !user.IsSysAdmin && user.Username != targetUser.CreatedBy {
+		return nil, fmt.Errorf("%s was not created by you", r.Username)
+	}
+
+	if err := s.resetFailedLogins(ctx, targetUser); err != nil {
+		return nil, err
+	}
+
+	s.Logger.Infof("user %s was unlocked by user %s", targetUser.Username, user.Username)
+
+	return new(empty.Empty), nil
+}
+
 // insertNewUser inserts a new user to the system database and returns username and plain text password
 // A new password is generated automatically if passed parameter is empty
 // If enforceStrongAuth is true it checks if username and password meet security criteria
@@ -529,7 +617,7 @@ func (s *ImmuServer) insertNewUser(ctx context.Context, username []byte, plainPa
 	}
 
 	if enforceStrongAuth {
-		if err := auth.IsStrongPassword(string(plainPassword)); err != nil {
+		if err := s.Options.PasswordPolicy.Check(string(plainPassword)); err != nil {
 			return nil, nil, status.Errorf(codes.InvalidArgument, "%v", err)
 		}
 	}
@@ -559,18 +647,18 @@ func (s *ImmuServer) insertNewUser(ctx context.Context, username []byte, plainPa
 	return username, plainpassword, err
 }
 
+// getValidatedUser authenticates the user with the configured provider, unless a JWT bearer token is used as password
+// or no password is provided and the user is identified by a client certificate
 func (s *ImmuServer) getValidatedUser(ctx context.Context, username []byte, password []byte) (*auth.User, error) {
-	userdata, err := s.getUser(ctx, username)
-	if err != nil {
-		return nil, err
+	if s.jwtValidator != nil && auth.IsJWT(string(password)) {
+		return s.getJWTUser(ctx, username, string(password))
 	}
 
-	err = userdata.ComparePasswords(password)
-	if err != nil {
-		return nil, err
+	if s.certificateMapper != nil && len(password) == 0 {
+		return s.getCertificateUser(ctx, username)
 	}
 
-	return userdata, nil
+	return s.authProvider.authenticate(ctx, username, password)
 }
 
 // getUser returns userdata (username,hashed password, permission, active) from username
@@ -626,7 +714,23 @@ func (s *ImmuServer) addUserToLoginList(u *auth.User) {
 	s.userdata.Userdata[u.Username] = u
 }
 
+// getLoggedInUserdataFromCtx returns the user logged in the context, unless it must change its expired password
 func (s *ImmuServer) getLoggedInUserdataFromCtx(ctx context.Context) (int, *auth.User, error) {
+	ind, user, err := s.getLoggedInUserdataWithExpiredPasswordFromCtx(ctx)
+	if err != nil {
+		return ind, nil, err
+	}
+
+	if user.PasswordExpired {
+		return ind, nil, ErrPasswordExpired
+	}
+
+	return ind, user, nil
+}
+
+// getLoggedInUserdataWithExpiredPasswordFromCtx returns the logged in user even if it authenticated
+// with an expired password, it's meant for the operations allowed until the password is changed
+func (s *ImmuServer) getLoggedInUserdataWithExpiredPasswordFromCtx(ctx context.Context) (int, *auth.User, error) {
 	if sessionID, err := sessions.GetSessionIDFromContext(ctx); err == nil {
 		sess, e := s.SessManager.GetSession(sessionID)
 		if e != nil {
@@ -639,6 +743,9 @@ func (s *ImmuServer) getLoggedInUserdataFromCtx(ctx context.Context) (int, *auth
 
 		return s.dbList.GetId(sess.GetDatabase().GetName()), sess.GetUser(), nil
 	}
+	if apiKey, ok := auth.GetAPIKeyFromContext(ctx); ok {
+		return s.getAPIKeyUser(ctx, apiKey)
+	}
 	jsUser, err := auth.GetLoggedInUser(ctx)
 	if err != nil {
 		return -1, nil, err
@@ -657,5 +764,10 @@ func (s *ImmuServer) getLoggedInUserDataFromUsername(username string) (*auth.Use
 		return nil, ErrNotLoggedIn
 	}
 
+	err := checkExternalCredentials(userdata)
+	if err != nil {
+		return nil, err
+	}
+
 	return userdata, nil
 }
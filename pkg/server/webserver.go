@@ -20,15 +20,17 @@ import (
 	"context"
 	"crypto/tls"
 	"net/http"
+	"strings"
 
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/auth"
 	"github.com/codenotary/immudb/pkg/logger"
 	"github.com/codenotary/immudb/webconsole"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
 func StartWebServer(addr string, tlsConfig *tls.Config, s schema.ImmuServiceServer, l logger.Logger) (*http.Server, error) {
-	proxyMux := runtime.NewServeMux()
+	proxyMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	err := schema.RegisterImmuServiceHandlerServer(context.Background(), proxyMux, s)
 	if err != nil {
 		return nil, err
@@ -64,3 +66,11 @@ func StartWebServer(addr string, tlsConfig *tls.Config, s schema.ImmuServiceServ
 
 	return httpServer, nil
 }
+
+// incomingHeaderMatcher forwards the API key header to the gRPC metadata, besides the headers forwarded by default
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, auth.APIKeyHeader) {
+		return auth.APIKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
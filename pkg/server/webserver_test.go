@@ -96,3 +96,15 @@ EKTcWGekdmdDPsHloRNtsiCa697B2O9IFA==
 		return err == nil
 	}, 10*time.Second, 30*time.Millisecond)
 }
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	key, ok := incomingHeaderMatcher("X-Api-Key")
+	require.True(t, ok)
+	require.Equal(t, "x-api-key", key)
+
+	_, ok = incomingHeaderMatcher("Grpc-Metadata-Sessionid")
+	require.True(t, ok)
+
+	_, ok = incomingHeaderMatcher("X-Unknown")
+	require.False(t, ok)
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// maxWitnessesPerDatabase bounds the number of witnesses whose cosignatures are kept for each database
+const maxWitnessesPerDatabase = 64
+
+// maxCosignaturesPerWitness bounds the number of recent cosignatures kept for each witness
+const maxCosignaturesPerWitness = 16
+
+type witnessCosignature struct {
+	txID      uint64
+	signature *schema.Signature
+}
+
+// witnessCosignatures holds the most recent cosignatures made by each witness, by database.
+// When trusted witnesses are configured, cosignatures made by any other witness are rejected.
+// Otherwise, the witness that cosigned least recently is evicted once the database has no slots left.
+type witnessCosignatures struct {
+	mutex            sync.RWMutex
+	trustedWitnesses map[string]struct{}
+	cosignatures     map[string]map[string][]*witnessCosignature // cosignatures of each witness sorted by txID
+}
+
+func (w *witnessCosignatures) trust(publicKeys [][]byte) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.trustedWitnesses = make(map[string]struct{}, len(publicKeys))
+
+	for _, pk := range publicKeys {
+		w.trustedWitnesses[string(pk)] = struct{}{}
+	}
+}
+
+func (w *witnessCosignatures) add(db string, txID uint64, signature *schema.Signature) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	witness := string(signature.PublicKey)
+
+	if len(w.trustedWitnesses) > 0 {
+		if _, ok := w.trustedWitnesses[witness]; !ok {
+			return ErrUntrustedWitness
+		}
+	}
+
+	if w.cosignatures == nil {
+		w.cosignatures = make(map[string]map[string][]*witnessCosignature)
+	}
+
+	dbCosignatures, ok := w.cosignatures[db]
+	if !ok {
+		dbCosignatures = make(map[string][]*witnessCosignature)
+		w.cosignatures[db] = dbCosignatures
+	}
+
+	cosignatures, ok := dbCosignatures[witness]
+	if !ok && len(dbCosignatures) >= maxWitnessesPerDatabase {
+		evictLeastRecentWitness(dbCosignatures)
+	}
+
+	i := sort.Search(len(cosignatures), func(i int) bool {
+		return cosignatures[i].txID >= txID
+	})
+
+	cosignature := &witnessCosignature{
+		txID:      txID,
+		signature: signature,
+	}
+
+	if i < len(cosignatures) && cosignatures[i].txID == txID {
+		cosignatures[i] = cosignature
+	} else {
+		cosignatures = append(cosignatures, nil)
+		copy(cosignatures[i+1:], cosignatures[i:])
+		cosignatures[i] = cosignature
+	}
+
+	if len(cosignatures) > maxCosignaturesPerWitness {
+		cosignatures = cosignatures[len(cosignatures)-maxCosignaturesPerWitness:]
+	}
+
+	dbCosignatures[witness] = cosignatures
+
+	return nil
+}
+
+// evictLeastRecentWitness removes the witness whose latest cosignature is the oldest one
+func evictLeastRecentWitness(dbCosignatures map[string][]*witnessCosignature) {
+	var evicted string
+	var evictedTxID uint64
+
+	for witness, cosignatures := range dbCosignatures {
+		txID := cosignatures[len(cosignatures)-1].txID
+
+		if evicted == "" || txID < evictedTxID || (txID == evictedTxID && witness < evicted) {
+			evicted = witness
+			evictedTxID = txID
+		}
+	}
+
+	delete(dbCosignatures, evicted)
+}
+
+// get returns the cosignatures of the state of the database at the specified transaction
+func (w *witnessCosignatures) get(db string, txID uint64) []*schema.Signature {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	var signatures []*schema.Signature
+
+	for _, cosignatures := range w.cosignatures[db] {
+		for _, c := range cosignatures {
+			if c.txID == txID {
+				signatures = append(signatures, c.signature)
+			}
+		}
+	}
+
+	sort.Slice(signatures, func(i, j int) bool {
+		return bytes.Compare(signatures[i].PublicKey, signatures[j].PublicKey) < 0
+	})
+
+	return signatures
+}
+
+// latest returns the most recent transaction cosigned by at least minCosignatures of the witnesses,
+// any witness is taken into account when no public key is specified. Zero is returned if there is none.
+func (w *witnessCosignatures) latest(db string, publicKeys [][]byte, minCosignatures int) uint64 {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	dbCosignatures := w.cosignatures[db]
+
+	witnesses := make([]string, 0, len(dbCosignatures))
+
+	if len(publicKeys) == 0 {
+		for witness := range dbCosignatures {
+			witnesses = append(witnesses, witness)
+		}
+	}
+
+	for _, pk := range publicKeys {
+		witnesses = append(witnesses, string(pk))
+	}
+
+	counts := make(map[uint64]int)
+	counted := make(map[string]struct{}, len(witnesses))
+
+	var latestTxID uint64
+
+	for _, witness := range witnesses {
+		if _, ok := counted[witness]; ok {
+			continue
+		}
+		counted[witness] = struct{}{}
+
+		for _, c := range dbCosignatures[witness] {
+			counts[c.txID]++
+
+			if counts[c.txID] >= minCosignatures && c.txID > latestTxID {
+				latestTxID = c.txID
+			}
+		}
+	}
+
+	return latestTxID
+}
+
+// CosignState stores the cosignature of a state made by a witness, once checked the state
+// matches the one of the database and the cosignature is valid
+func (s *ImmuServer) CosignState(ctx context.Context, req *schema.CosignStateRequest) (*empty.Empty, error) {
+	if req == nil ||
+		req.State == nil ||
+		req.State.TxId == 0 ||
+		req.Cosignature == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "CosignState")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.State.Db != db.GetName() {
+		return nil, ErrIllegalArguments
+	}
+
+	pk, err := signer.UnmarshalKey(req.Cosignature.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := signer.Verify(req.State.ToBytes(), req.Cosignature.Signature, pk)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrIllegalArguments
+	}
+
+	tx, err := db.TxByID(ctx, &schema.TxRequest{Tx: req.State.TxId, EntriesSpec: &schema.EntriesSpec{}, KeepReferencesUnresolved: true})
+	if err != nil {
+		return nil, err
+	}
+
+	alh := schema.TxHeaderFromProto(tx.Header).Alh()
+	if !bytes.Equal(alh[:], req.State.TxHash) {
+		s.Logger.Warningf("witness cosigned a state of database '%s' at tx %d not matching the state of the database", db.GetName(), req.State.TxId)
+		return nil, ErrWitnessStateMismatch
+	}
+
+	err = s.witnessCosignatures.add(db.GetName(), req.State.TxId, &schema.Signature{
+		PublicKey: req.Cosignature.PublicKey,
+		Signature: req.Cosignature.Signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// CosignedState returns the most recent state of the database cosigned by at least the requested number of witnesses,
+// together with their cosignatures
+func (s *ImmuServer) CosignedState(ctx context.Context, req *schema.CosignedStateRequest) (*schema.ImmutableState, error) {
+	if req == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	db, err := s.getDBFromCtx(ctx, "CosignedState")
+	if err != nil {
+		return nil, err
+	}
+
+	minCosignatures := int(req.MinCosignatures)
+	if minCosignatures == 0 {
+		minCosignatures = 1
+	}
+
+	txID := s.witnessCosignatures.latest(db.GetName(), req.WitnessPublicKeys, minCosignatures)
+	if txID == 0 {
+		return nil, ErrNoCosignedState
+	}
+
+	tx, err := db.TxByID(ctx, &schema.TxRequest{Tx: txID, EntriesSpec: &schema.EntriesSpec{}, KeepReferencesUnresolved: true})
+	if err != nil {
+		return nil, err
+	}
+
+	alh := schema.TxHeaderFromProto(tx.Header).Alh()
+
+	state := &schema.ImmutableState{
+		Db:     db.GetName(),
+		TxId:   txID,
+		TxHash: alh[:],
+	}
+
+	if s.StateSigner != nil {
+		err = s.StateSigner.Sign(state)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	state.WitnessSignatures = s.witnessCosignatures.get(state.Db, txID)
+
+	return state, nil
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/signer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func cosignState(t *testing.T, s signer.Signer, state *schema.ImmutableState) *schema.CosignStateRequest {
+	cosignedState := &schema.ImmutableState{
+		Db:     state.Db,
+		TxId:   state.TxId,
+		TxHash: state.TxHash,
+	}
+
+	signature, publicKey, err := s.Sign(cosignedState.ToBytes())
+	require.NoError(t, err)
+
+	return &schema.CosignStateRequest{
+		State: cosignedState,
+		Cosignature: &schema.Signature{
+			PublicKey: publicKey,
+			Signature: signature,
+		},
+	}
+}
+
+func TestServerCosignState(t *testing.T) {
+	dir := t.TempDir()
+
+	s := DefaultServer()
+	s.WithOptions(DefaultOptions().WithDir(dir).WithAuth(false))
+
+	err := s.loadSystemDatabase(dir, nil, s.Options.AdminPassword, false)
+	require.NoError(t, err)
+
+	err = s.loadDefaultDatabase(dir, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	witness1, err := signer.NewSigner("./../../test/signer/ec1.key")
+	require.NoError(t, err)
+
+	witness1PK, err := signer.ParsePublicKeyFile("./../../test/signer/ec1.pub")
+	require.NoError(t, err)
+
+	witness2, err := signer.NewSigner("./../../test/signer/ed25519.key")
+	require.NoError(t, err)
+
+	witness2PK, err := signer.ParsePublicKeyFile("./../../test/signer/ed25519.pub")
+	require.NoError(t, err)
+
+	untrustedPK, err := signer.ParsePublicKeyFile("./../../test/signer/ec3.pub")
+	require.NoError(t, err)
+
+	state, err := s.CurrentState(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Empty(t, state.WitnessSignatures)
+
+	t.Run("invalid cosignatures should be rejected", func(t *testing.T) {
+		_, err := s.CosignState(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = s.CosignState(ctx, &schema.CosignStateRequest{State: state})
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		req := cosignState(t, witness1, state)
+		req.State.Db = "otherdb"
+		_, err = s.CosignState(ctx, req)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		req = cosignState(t, witness1, state)
+		req.Cosignature.Signature = req.Cosignature.Signature[1:]
+		_, err = s.CosignState(ctx, req)
+		require.Error(t, err)
+
+		req = cosignState(t, witness2, state)
+		req.Cosignature.Signature[0]++
+		_, err = s.CosignState(ctx, req)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("cosignatures of a state not matching the database should be rejected", func(t *testing.T) {
+		req := cosignState(t, witness1, &schema.ImmutableState{
+			Db:     state.Db,
+			TxId:   state.TxId,
+			TxHash: make([]byte, len(state.TxHash)),
+		})
+
+		_, err := s.CosignState(ctx, req)
+		require.ErrorIs(t, err, ErrWitnessStateMismatch)
+	})
+
+	t.Run("cosignatures should be attached to the state", func(t *testing.T) {
+		_, err := s.CosignState(ctx, cosignState(t, witness1, state))
+		require.NoError(t, err)
+
+		_, err = s.CosignState(ctx, cosignState(t, witness2, state))
+		require.NoError(t, err)
+
+		cosignedState, err := s.CurrentState(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, cosignedState.WitnessSignatures, 2)
+
+		count, err := cosignedState.CountWitnessSignatures([]crypto.PublicKey{witness1PK, witness2PK, untrustedPK})
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		count, err = cosignedState.CountWitnessSignatures([]crypto.PublicKey{untrustedPK})
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("cosignatures should not be attached to newer states", func(t *testing.T) {
+		_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value2")}}})
+		require.NoError(t, err)
+
+		newState, err := s.CurrentState(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Empty(t, newState.WitnessSignatures)
+
+		_, err = s.CosignState(ctx, cosignState(t, witness1, newState))
+		require.NoError(t, err)
+
+		newState, err = s.CurrentState(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, newState.WitnessSignatures, 1)
+
+		// cosignatures of older states do not replace the ones of newer states
+		_, err = s.CosignState(ctx, cosignState(t, witness1, state))
+		require.NoError(t, err)
+
+		newState, err = s.CurrentState(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Len(t, newState.WitnessSignatures, 1)
+	})
+
+	t.Run("the latest state cosigned by enough witnesses should be returned", func(t *testing.T) {
+		_, err := s.CosignedState(ctx, nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		witness1Key, err := signer.MarshalKey(witness1PK)
+		require.NoError(t, err)
+
+		witness2Key, err := signer.MarshalKey(witness2PK)
+		require.NoError(t, err)
+
+		cosignedState, err := s.CosignedState(ctx, &schema.CosignedStateRequest{
+			WitnessPublicKeys: [][]byte{witness1Key, witness2Key},
+			MinCosignatures:   2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, state.TxId, cosignedState.TxId)
+		require.Equal(t, state.TxHash, cosignedState.TxHash)
+
+		count, err := cosignedState.CountWitnessSignatures([]crypto.PublicKey{witness1PK, witness2PK})
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		cosignedState, err = s.CosignedState(ctx, &schema.CosignedStateRequest{})
+		require.NoError(t, err)
+		require.Equal(t, state.TxId+1, cosignedState.TxId)
+
+		_, err = s.CosignedState(ctx, &schema.CosignedStateRequest{MinCosignatures: 3})
+		require.ErrorIs(t, err, ErrNoCosignedState)
+	})
+}
+
+func TestWitnessCosignaturesLimit(t *testing.T) {
+	var w witnessCosignatures
+
+	for i := 0; i < maxWitnessesPerDatabase; i++ {
+		err := w.add("db1", uint64(i+1), &schema.Signature{PublicKey: []byte(fmt.Sprintf("witness%d", i))})
+		require.NoError(t, err)
+	}
+
+	err := w.add("db1", 100, &schema.Signature{PublicKey: []byte("witness0")})
+	require.NoError(t, err)
+
+	t.Run("the witness that cosigned least recently should be evicted", func(t *testing.T) {
+		err := w.add("db1", 100, &schema.Signature{PublicKey: []byte("witness")})
+		require.NoError(t, err)
+
+		require.Len(t, w.get("db1", 100), 2)
+		require.Empty(t, w.get("db1", 2))
+		require.Len(t, w.get("db1", 1), 1)
+	})
+
+	t.Run("only the most recent cosignatures of a witness should be kept", func(t *testing.T) {
+		for i := 0; i < maxCosignaturesPerWitness; i++ {
+			err := w.add("db2", uint64(maxCosignaturesPerWitness-i), &schema.Signature{PublicKey: []byte("witness")})
+			require.NoError(t, err)
+		}
+
+		err := w.add("db2", maxCosignaturesPerWitness+1, &schema.Signature{PublicKey: []byte("witness")})
+		require.NoError(t, err)
+
+		require.Empty(t, w.get("db2", 1))
+		require.Len(t, w.get("db2", 2), 1)
+		require.Len(t, w.get("db2", maxCosignaturesPerWitness+1), 1)
+		require.Empty(t, w.get("db3", 1))
+	})
+
+	t.Run("only trusted witnesses should be allowed when configured", func(t *testing.T) {
+		w.trust([][]byte{[]byte("witness1")})
+
+		err := w.add("db1", 101, &schema.Signature{PublicKey: []byte("witness0")})
+		require.ErrorIs(t, err, ErrUntrustedWitness)
+
+		err = w.add("db1", 101, &schema.Signature{PublicKey: []byte("witness1")})
+		require.NoError(t, err)
+	})
+}
+
+func TestWitnessCosignaturesLatest(t *testing.T) {
+	var w witnessCosignatures
+
+	add := func(witness string, txIDs ...uint64) {
+		for _, txID := range txIDs {
+			err := w.add("db1", txID, &schema.Signature{PublicKey: []byte(witness)})
+			require.NoError(t, err)
+		}
+	}
+
+	add("witness1", 1, 3, 5, 7)
+	add("witness2", 1, 3, 6)
+	add("witness3", 1, 2, 6)
+
+	require.Equal(t, uint64(7), w.latest("db1", nil, 1))
+	require.Equal(t, uint64(6), w.latest("db1", nil, 2))
+	require.Equal(t, uint64(1), w.latest("db1", nil, 3))
+	require.Zero(t, w.latest("db1", nil, 4))
+	require.Zero(t, w.latest("db2", nil, 1))
+
+	require.Equal(t, uint64(3), w.latest("db1", [][]byte{[]byte("witness1"), []byte("witness2")}, 2))
+	require.Equal(t, uint64(6), w.latest("db1", [][]byte{[]byte("witness2"), []byte("unknown")}, 1))
+
+	// witnesses are counted once
+	require.Zero(t, w.latest("db1", [][]byte{[]byte("witness3"), []byte("witness3")}, 2))
+}
+
+func TestServerTrustedWitnesses(t *testing.T) {
+	newServer := func(witnessPubKeys ...string) (*ImmuServer, error) {
+		serverOptions := DefaultOptions().
+			WithDir(t.TempDir()).
+			WithPort(0).
+			WithMetricsServer(false).
+			WithAuth(false).
+			WithWitnessPubKeys(witnessPubKeys...)
+
+		s := DefaultServer().WithOptions(serverOptions).(*ImmuServer)
+
+		return s, s.Initialize()
+	}
+
+	_, err := newServer("./../../test/signer/missing.pub")
+	require.Error(t, err)
+
+	s, err := newServer("./../../test/signer/ec1.pub")
+	require.NoError(t, err)
+
+	defer s.CloseDatabases()
+
+	witness1, err := signer.NewSigner("./../../test/signer/ec1.key")
+	require.NoError(t, err)
+
+	witness2, err := signer.NewSigner("./../../test/signer/ed25519.key")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = s.Set(ctx, &schema.SetRequest{KVs: []*schema.KeyValue{{Key: []byte("key1"), Value: []byte("value1")}}})
+	require.NoError(t, err)
+
+	state, err := s.CurrentState(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	_, err = s.CosignState(ctx, cosignState(t, witness2, state))
+	require.ErrorIs(t, err, ErrUntrustedWitness)
+
+	_, err = s.CosignState(ctx, cosignState(t, witness1, state))
+	require.NoError(t, err)
+}
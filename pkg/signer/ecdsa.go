@@ -19,19 +19,12 @@ package signer
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
-	"crypto/rand"
 	"crypto/sha256"
-	"crypto/x509"
 	"encoding/asn1"
-	"encoding/pem"
-	"errors"
 	"io"
-	"io/ioutil"
 	"math/big"
 )
 
-var ErrInvalidPublicKey = errors.New("invalid public key")
-
 type signer struct {
 	rand       io.Reader
 	privateKey *ecdsa.PrivateKey
@@ -42,23 +35,6 @@ type ecdsaSignature struct {
 	S *big.Int
 }
 
-// NewSigner returns a signer object. It requires a private key file path. To generate a valid key use openssl tool. Ex: openssl ecparam -name prime256v1 -genkey -noout -out ec.key
-func NewSigner(privateKeyPath string) (Signer, error) {
-	privateKeyBytes, err := ioutil.ReadFile(privateKeyPath)
-	if err != nil {
-		return nil, err
-	}
-	privateKeyBlock, _ := pem.Decode(privateKeyBytes)
-	if privateKeyBlock == nil {
-		return nil, errors.New("no ecdsa key found in provided signing key file")
-	}
-	privateKey, err := x509.ParseECPrivateKey(privateKeyBlock.Bytes)
-	if err != nil {
-		return nil, err
-	}
-	return signer{rand: rand.Reader, privateKey: privateKey}, nil
-}
-
 // NewSignerFromPKey returns a signer from a io.Reader and a *ecdsa.PrivateKey.
 func NewSignerFromPKey(r io.Reader, pk *ecdsa.PrivateKey) Signer {
 	return signer{rand: r, privateKey: pk}
@@ -79,7 +55,7 @@ func (sig signer) Sign(payload []byte) ([]byte, []byte, error) {
 	return m, p, nil
 }
 
-func UnmarshalKey(publicKey []byte) (*ecdsa.PublicKey, error) {
+func unmarshalECDSAKey(publicKey []byte) (*ecdsa.PublicKey, error) {
 	x, y := elliptic.Unmarshal(elliptic.P256(), publicKey)
 	if x == nil {
 		return nil, ErrInvalidPublicKey
@@ -87,8 +63,8 @@ func UnmarshalKey(publicKey []byte) (*ecdsa.PublicKey, error) {
 	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
 }
 
-// verify verifies a signed payload
-func Verify(payload []byte, signature []byte, publicKey *ecdsa.PublicKey) (bool, error) {
+// verifyECDSA verifies a payload signed with an ecdsa key
+func verifyECDSA(payload []byte, signature []byte, publicKey *ecdsa.PublicKey) (bool, error) {
 	hash := sha256.Sum256(payload)
 	es := ecdsaSignature{}
 	if _, err := asn1.Unmarshal(signature, &es); err != nil {
@@ -96,19 +72,3 @@ func Verify(payload []byte, signature []byte, publicKey *ecdsa.PublicKey) (bool,
 	}
 	return ecdsa.Verify(publicKey, hash[:], es.R, es.S), nil
 }
-
-func ParsePublicKeyFile(filePath string) (*ecdsa.PublicKey, error) {
-	publicKeyBytes, err := ioutil.ReadFile(filePath)
-	if err != nil {
-		return nil, err
-	}
-	publicKeyBlock, _ := pem.Decode(publicKeyBytes)
-	if publicKeyBlock == nil {
-		return nil, errors.New("no ecdsa key found in provided public key file")
-	}
-	cert, err := x509.ParsePKIXPublicKey(publicKeyBlock.Bytes)
-	if err != nil {
-		return nil, err
-	}
-	return cert.(*ecdsa.PublicKey), nil
-}
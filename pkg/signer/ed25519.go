@@ -0,0 +1,35 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto/ed25519"
+)
+
+type ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewEd25519Signer returns a signer using the provided ed25519 private key.
+func NewEd25519Signer(pk ed25519.PrivateKey) Signer {
+	return ed25519Signer{privateKey: pk}
+}
+
+// Sign signs a payload and returns the signature together with the raw public key
+func (sig ed25519Signer) Sign(payload []byte) ([]byte, []byte, error) {
+	return ed25519.Sign(sig.privateKey, payload), sig.privateKey.Public().(ed25519.PublicKey), nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEd25519Signer(t *testing.T) {
+	s, err := NewSigner("./../../test/signer/ed25519.key")
+	require.NoError(t, err)
+	require.IsType(t, ed25519Signer{}, s)
+
+	signature, publicKey, err := s.Sign([]byte(`payload`))
+	require.NoError(t, err)
+	require.Len(t, publicKey, ed25519.PublicKeySize)
+
+	pk, err := UnmarshalKey(publicKey)
+	require.NoError(t, err)
+
+	ok, err := Verify([]byte(`payload`), signature, pk)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = Verify([]byte(`tampered`), signature, pk)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	filePK, err := ParsePublicKeyFile("./../../test/signer/ed25519.pub")
+	require.NoError(t, err)
+	require.Equal(t, pk, filePK)
+
+	marshalledPK, err := MarshalKey(filePK)
+	require.NoError(t, err)
+	require.Equal(t, publicKey, marshalledPK)
+}
+
+func TestParseKeys(t *testing.T) {
+	pk, err := ParsePublicKeyFile("./../../test/signer/ec1.pub")
+	require.NoError(t, err)
+	require.IsType(t, &ecdsa.PublicKey{}, pk)
+
+	marshalledPK, err := MarshalKey(pk)
+	require.NoError(t, err)
+
+	unmarshalledPK, err := UnmarshalKey(marshalledPK)
+	require.NoError(t, err)
+	require.Equal(t, pk, unmarshalledPK)
+
+	_, err = MarshalKey("not a key")
+	require.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+	_, err = Verify([]byte(`payload`), []byte(`signature`), "not a key")
+	require.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+	_, err = ParsePublicKeyFile("./../../test/signer/unparsable.key")
+	require.Error(t, err)
+}
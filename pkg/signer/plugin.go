@@ -0,0 +1,208 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+var ErrInvalidPluginURI = errors.New("invalid signing plugin, expected exec:<command> [args...] or unix:<socket path>")
+var ErrPluginFailure = errors.New("signing plugin failure")
+
+// PluginTimeout is the maximum time a signing plugin is given to sign a payload
+var PluginTimeout = 10 * time.Second
+
+// Signing plugins allow signing keys to be kept in an external process, e.g. one backed by a KMS.
+// Plugins receive one JSON encoded request per line and must reply with one JSON encoded response per line.
+type pluginRequest struct {
+	Payload []byte `json:"payload"`
+}
+
+type pluginResponse struct {
+	Signature []byte `json:"signature,omitempty"`
+	PublicKey []byte `json:"publicKey,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type execSigner struct {
+	path string
+	args []string
+}
+
+type socketSigner struct {
+	address string
+}
+
+// NewPluginSigner returns a signer delegating signatures to an external plugin. Supported plugins are:
+//   - exec:<command> [args...]: the command is executed for each signature, the request is written to its standard input
+//     and the response is read from its standard output
+//   - unix:<socket path>: a connection is established to the unix socket for each signature
+func NewPluginSigner(plugin string) (Signer, error) {
+	kind, target, ok := cut(plugin, ":")
+	if !ok {
+		return nil, ErrInvalidPluginURI
+	}
+
+	switch kind {
+	case "exec":
+		fields := strings.Fields(target)
+		if len(fields) == 0 {
+			return nil, ErrInvalidPluginURI
+		}
+		return execSigner{path: fields[0], args: fields[1:]}, nil
+	case "unix":
+		if target == "" {
+			return nil, ErrInvalidPluginURI
+		}
+		return socketSigner{address: target}, nil
+	}
+
+	return nil, ErrInvalidPluginURI
+}
+
+func (sig execSigner) Sign(payload []byte) ([]byte, []byte, error) {
+	req, err := json.Marshal(pluginRequest{Payload: payload})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cmd := exec.Command(sig.path, sig.args...)
+	cmd.Stdin = bytes.NewReader(append(req, '\n'))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrPluginFailure, err)
+	}
+
+	timer := time.AfterFunc(PluginTimeout, func() { cmd.Process.Kill() })
+	defer timer.Stop()
+
+	err = cmd.Wait()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v %s", ErrPluginFailure, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return readPluginResponse(&stdout)
+}
+
+func (sig socketSigner) Sign(payload []byte) ([]byte, []byte, error) {
+	conn, err := net.DialTimeout("unix", sig.address, PluginTimeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrPluginFailure, err)
+	}
+	defer conn.Close()
+
+	err = conn.SetDeadline(time.Now().Add(PluginTimeout))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = json.NewEncoder(conn).Encode(pluginRequest{Payload: payload})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrPluginFailure, err)
+	}
+
+	return readPluginResponse(conn)
+}
+
+func readPluginResponse(r io.Reader) ([]byte, []byte, error) {
+	line, err := bufio.NewReader(r).ReadBytes('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return nil, nil, fmt.Errorf("%w: %v", ErrPluginFailure, err)
+	}
+
+	var res pluginResponse
+
+	err = json.Unmarshal(line, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrPluginFailure, err)
+	}
+
+	if res.Error != "" {
+		return nil, nil, fmt.Errorf("%w: %s", ErrPluginFailure, res.Error)
+	}
+
+	if len(res.Signature) == 0 || len(res.PublicKey) == 0 {
+		return nil, nil, fmt.Errorf("%w: incomplete response", ErrPluginFailure)
+	}
+
+	return res.Signature, res.PublicKey, nil
+}
+
+// ServePlugin implements the plugin side of the protocol, serving the requests read from r with the signer s
+// and writing the responses into w until r is exhausted. It can be used to build signing plugins or local mocks.
+func ServePlugin(r io.Reader, w io.Writer, s Signer) error {
+	scanner := bufio.NewScanner(r)
+	enc := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		var req pluginRequest
+		var res pluginResponse
+
+		err := json.Unmarshal(scanner.Bytes(), &req)
+		if err == nil {
+			res.Signature, res.PublicKey, err = s.Sign(req.Payload)
+		}
+		if err != nil {
+			res = pluginResponse{Error: err.Error()}
+		}
+
+		err = enc.Encode(res)
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// ServePluginListener serves the connections accepted by the listener with the signer s,
+// it returns when the listener is closed
+func ServePluginListener(l net.Listener, s Signer) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+			ServePlugin(conn, conn, s)
+		}()
+	}
+}
+
+// cut slices s around the first instance of sep, as strings.Cut
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
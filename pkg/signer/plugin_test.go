@@ -0,0 +1,113 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const pluginMockEnv = "IMMUDB_SIGNER_PLUGIN_MOCK_KEY"
+
+// TestPluginMock is not a test but the mock plugin run by the exec signer,
+// it signs the requests received through the standard input with the key set into the environment
+func TestPluginMock(t *testing.T) {
+	key := os.Getenv(pluginMockEnv)
+	if key == "" {
+		return
+	}
+
+	s, err := NewSigner(key)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	err = ServePlugin(os.Stdin, os.Stdout, s)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func requireValidPluginSignature(t *testing.T, s Signer) {
+	signature, publicKey, err := s.Sign([]byte(`payload`))
+	require.NoError(t, err)
+
+	pk, err := UnmarshalKey(publicKey)
+	require.NoError(t, err)
+
+	ok, err := Verify([]byte(`payload`), signature, pk)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestExecPluginSigner(t *testing.T) {
+	t.Setenv(pluginMockEnv, "./../../test/signer/ed25519.key")
+
+	s, err := NewPluginSigner("exec:" + os.Args[0] + " -test.run=^TestPluginMock$")
+	require.NoError(t, err)
+
+	requireValidPluginSignature(t, s)
+
+	t.Setenv(pluginMockEnv, "./../../test/signer/unparsable.key")
+
+	_, _, err = s.Sign([]byte(`payload`))
+	require.ErrorIs(t, err, ErrPluginFailure)
+
+	s, err = NewPluginSigner("exec:./non-existent-plugin")
+	require.NoError(t, err)
+
+	_, _, err = s.Sign([]byte(`payload`))
+	require.ErrorIs(t, err, ErrPluginFailure)
+}
+
+func TestSocketPluginSigner(t *testing.T) {
+	mock, err := NewSigner("./../../test/signer/ec1.key")
+	require.NoError(t, err)
+
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer l.Close()
+
+	go ServePluginListener(l, mock)
+
+	s, err := NewPluginSigner("unix:" + socket)
+	require.NoError(t, err)
+
+	requireValidPluginSignature(t, s)
+	requireValidPluginSignature(t, s)
+
+	s, err = NewPluginSigner("unix:" + filepath.Join(t.TempDir(), "missing.sock"))
+	require.NoError(t, err)
+
+	_, _, err = s.Sign([]byte(`payload`))
+	require.ErrorIs(t, err, ErrPluginFailure)
+}
+
+func TestInvalidPluginSigner(t *testing.T) {
+	for _, plugin := range []string{"", "exec", "exec:", "unix:", "http://localhost"} {
+		_, err := NewPluginSigner(plugin)
+		require.ErrorIs(t, err, ErrInvalidPluginURI, plugin)
+	}
+}
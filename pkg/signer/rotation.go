@@ -0,0 +1,162 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+var ErrInvalidKeyChain = errors.New("invalid signing key chain")
+
+const keyRotationPrefix = "immudb-signing-key-rotation:"
+
+// KeyRotation certifies the rotation of a signing key: the public key of the new signing key
+// is signed with the signing key being rotated.
+// A chain of key rotations allows states signed with the current key to be verified by clients
+// only trusting the root key of the chain.
+type KeyRotation struct {
+	PublicKey       []byte `json:"publicKey"`
+	SignerPublicKey []byte `json:"signerPublicKey"`
+	Signature       []byte `json:"signature"`
+}
+
+func keyRotationPayload(publicKey []byte) []byte {
+	return append([]byte(keyRotationPrefix), publicKey...)
+}
+
+// RotateKey returns the key rotation from the key used by the signer s to the provided public key
+func RotateKey(s Signer, publicKey crypto.PublicKey) (*KeyRotation, error) {
+	pk, err := MarshalKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, signerPublicKey, err := s.Sign(keyRotationPayload(pk))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(pk, signerPublicKey) {
+		return nil, fmt.Errorf("%w: the signing key can not be rotated to itself", ErrInvalidKeyChain)
+	}
+
+	return &KeyRotation{
+		PublicKey:       pk,
+		SignerPublicKey: signerPublicKey,
+		Signature:       signature,
+	}, nil
+}
+
+// Verify checks the rotation was signed by the key being rotated
+func (r *KeyRotation) Verify() (bool, error) {
+	signerPublicKey, err := UnmarshalKey(r.SignerPublicKey)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = UnmarshalKey(r.PublicKey)
+	if err != nil {
+		return false, err
+	}
+
+	return Verify(keyRotationPayload(r.PublicKey), r.Signature, signerPublicKey)
+}
+
+// ValidateKeyChain checks every rotation in the chain is correctly signed
+// and rotates the key introduced by the previous one
+func ValidateKeyChain(rotations []*KeyRotation) error {
+	for i, r := range rotations {
+		if i > 0 && !bytes.Equal(r.SignerPublicKey, rotations[i-1].PublicKey) {
+			return fmt.Errorf("%w: rotation %d does not rotate the previous key", ErrInvalidKeyChain, i)
+		}
+
+		ok, err := r.Verify()
+		if err != nil {
+			return fmt.Errorf("%w: rotation %d: %v", ErrInvalidKeyChain, i, err)
+		}
+		if !ok {
+			return fmt.Errorf("%w: rotation %d is not correctly signed", ErrInvalidKeyChain, i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyKeyChain checks publicKey is either the root key or a key the root key was rotated to,
+// directly or through intermediate keys, by the provided rotations.
+// Rotations not signed by a key chained from the root key are ignored.
+func VerifyKeyChain(root crypto.PublicKey, rotations []*KeyRotation, publicKey []byte) (bool, error) {
+	current, err := MarshalKey(root)
+	if err != nil {
+		return false, err
+	}
+
+	if bytes.Equal(current, publicKey) {
+		return true, nil
+	}
+
+	for _, r := range rotations {
+		if r == nil || !bytes.Equal(r.SignerPublicKey, current) {
+			continue
+		}
+
+		ok, err := r.Verify()
+		if err != nil || !ok {
+			return false, err
+		}
+
+		current = r.PublicKey
+
+		if bytes.Equal(current, publicKey) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ReadKeyChainFile reads the signing key chain stored in JSON format in the specified file
+func ReadKeyChainFile(filename string) ([]*KeyRotation, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotations []*KeyRotation
+
+	err = json.Unmarshal(b, &rotations)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeyChain, err)
+	}
+
+	return rotations, nil
+}
+
+// WriteKeyChainFile writes the signing key chain in JSON format into the specified file
+func WriteKeyChainFile(filename string, rotations []*KeyRotation) error {
+	b, err := json.MarshalIndent(rotations, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, b, 0644)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRotation(t *testing.T) {
+	rootSigner, err := NewSigner("./../../test/signer/ec1.key")
+	require.NoError(t, err)
+
+	rootPK, err := ParsePublicKeyFile("./../../test/signer/ec1.pub")
+	require.NoError(t, err)
+
+	rotatedSigner, err := NewSigner("./../../test/signer/ed25519.key")
+	require.NoError(t, err)
+
+	rotatedPK, err := ParsePublicKeyFile("./../../test/signer/ed25519.pub")
+	require.NoError(t, err)
+
+	lastPK, err := ParsePublicKeyFile("./../../test/signer/ed25519_rotated.pub")
+	require.NoError(t, err)
+
+	untrustedPK, err := ParsePublicKeyFile("./../../test/signer/ec3.pub")
+	require.NoError(t, err)
+
+	_, err = RotateKey(rootSigner, rootPK)
+	require.ErrorIs(t, err, ErrInvalidKeyChain)
+
+	r1, err := RotateKey(rootSigner, rotatedPK)
+	require.NoError(t, err)
+
+	r2, err := RotateKey(rotatedSigner, lastPK)
+	require.NoError(t, err)
+
+	chain := []*KeyRotation{r1, r2}
+
+	require.NoError(t, ValidateKeyChain(chain))
+	require.ErrorIs(t, ValidateKeyChain([]*KeyRotation{r2, r1}), ErrInvalidKeyChain)
+
+	rootKey, _ := MarshalKey(rootPK)
+	rotatedKey, _ := MarshalKey(rotatedPK)
+	lastKey, _ := MarshalKey(lastPK)
+	untrustedKey, _ := MarshalKey(untrustedPK)
+
+	t.Run("keys chained from the root key should be accepted", func(t *testing.T) {
+		for _, key := range [][]byte{rootKey, rotatedKey, lastKey} {
+			chained, err := VerifyKeyChain(rootPK, chain, key)
+			require.NoError(t, err)
+			require.True(t, chained)
+		}
+
+		chained, err := VerifyKeyChain(rotatedPK, chain, lastKey)
+		require.NoError(t, err)
+		require.True(t, chained)
+	})
+
+	t.Run("keys not chained from the root key should be rejected", func(t *testing.T) {
+		chained, err := VerifyKeyChain(rootPK, chain, untrustedKey)
+		require.NoError(t, err)
+		require.False(t, chained)
+
+		chained, err = VerifyKeyChain(lastPK, chain, rotatedKey)
+		require.NoError(t, err)
+		require.False(t, chained)
+
+		chained, err = VerifyKeyChain(rootPK, []*KeyRotation{r2}, lastKey)
+		require.NoError(t, err)
+		require.False(t, chained)
+	})
+
+	t.Run("tampered rotations should be rejected", func(t *testing.T) {
+		tampered := &KeyRotation{
+			PublicKey:       untrustedKey,
+			SignerPublicKey: r1.SignerPublicKey,
+			Signature:       r1.Signature,
+		}
+
+		chained, err := VerifyKeyChain(rootPK, []*KeyRotation{tampered}, untrustedKey)
+		require.NoError(t, err)
+		require.False(t, chained)
+
+		require.ErrorIs(t, ValidateKeyChain([]*KeyRotation{tampered}), ErrInvalidKeyChain)
+	})
+
+	t.Run("key chain should be stored into files", func(t *testing.T) {
+		chainFile := filepath.Join(t.TempDir(), "chain.json")
+
+		err := WriteKeyChainFile(chainFile, chain)
+		require.NoError(t, err)
+
+		readChain, err := ReadKeyChainFile(chainFile)
+		require.NoError(t, err)
+		require.Equal(t, chain, readChain)
+
+		_, err = ReadKeyChainFile("./../../test/signer/ec1.pub")
+		require.ErrorIs(t, err, ErrInvalidKeyChain)
+	})
+}
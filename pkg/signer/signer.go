@@ -16,6 +16,120 @@ limitations under the License.
 
 package signer
 
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+)
+
+var ErrInvalidPublicKey = errors.New("invalid public key")
+var ErrUnsupportedKeyType = errors.New("unsupported key type")
+
 type Signer interface {
 	Sign(payload []byte) (signature []byte, publicKey []byte, err error)
 }
+
+// NewSigner returns a signer object. It requires a private key file path. ECDSA (P-256) and Ed25519 keys are supported.
+// To generate a valid key use openssl tool. Ex: openssl ecparam -name prime256v1 -genkey -noout -out ec.key
+// or openssl genpkey -algorithm ed25519 -out ed25519.key
+func NewSigner(privateKeyPath string) (Signer, error) {
+	privateKeyBytes, err := ioutil.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyBlock, _ := pem.Decode(privateKeyBytes)
+	if privateKeyBlock == nil {
+		return nil, errors.New("no ecdsa key found in provided signing key file")
+	}
+
+	if privateKeyBlock.Type != "PRIVATE KEY" {
+		privateKey, err := x509.ParseECPrivateKey(privateKeyBlock.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return signer{rand: rand.Reader, privateKey: privateKey}, nil
+	}
+
+	// PKCS #8 encoded keys
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pk := privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		return signer{rand: rand.Reader, privateKey: pk}, nil
+	case ed25519.PrivateKey:
+		return NewEd25519Signer(pk), nil
+	}
+
+	return nil, ErrUnsupportedKeyType
+}
+
+// UnmarshalKey parses a public key as returned by signers, either an ed25519 or an ecdsa (P-256) one
+func UnmarshalKey(publicKey []byte) (crypto.PublicKey, error) {
+	if len(publicKey) == ed25519.PublicKeySize {
+		return ed25519.PublicKey(publicKey), nil
+	}
+
+	pk, err := unmarshalECDSAKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
+// MarshalKey encodes a public key in the same format it's returned by signers
+func MarshalKey(publicKey crypto.PublicKey) ([]byte, error) {
+	switch pk := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return elliptic.Marshal(pk.Curve, pk.X, pk.Y), nil
+	case ed25519.PublicKey:
+		return pk, nil
+	}
+
+	return nil, ErrUnsupportedKeyType
+}
+
+// verify verifies a signed payload
+func Verify(payload []byte, signature []byte, publicKey crypto.PublicKey) (bool, error) {
+	switch pk := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return verifyECDSA(payload, signature, pk)
+	case ed25519.PublicKey:
+		return ed25519.Verify(pk, payload, signature), nil
+	}
+
+	return false, ErrUnsupportedKeyType
+}
+
+// ParsePublicKeyFile reads a PEM encoded public key, either an ed25519 or an ecdsa one
+func ParsePublicKeyFile(filePath string) (crypto.PublicKey, error) {
+	publicKeyBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	publicKeyBlock, _ := pem.Decode(publicKeyBytes)
+	if publicKeyBlock == nil {
+		return nil, errors.New("no ecdsa key found in provided public key file")
+	}
+	cert, err := x509.ParsePKIXPublicKey(publicKeyBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pk := cert.(type) {
+	case *ecdsa.PublicKey:
+		return pk, nil
+	case ed25519.PublicKey:
+		return pk, nil
+	}
+
+	return nil, ErrUnsupportedKeyType
+}
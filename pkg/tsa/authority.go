@@ -0,0 +1,281 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tsa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// localAuthorityPolicy is the policy of the tokens issued by the local authority,
+// the same used by the sample configuration of the OpenSSL time-stamping authority
+var localAuthorityPolicy = asn1.ObjectIdentifier{1, 2, 3, 4, 1}
+
+// LocalAuthority is a minimal RFC 3161 time-stamping authority, meant to be used as a stand-in
+// for an external one in tests and development environments.
+// It only supports ECDSA keys and sha256 message imprints.
+type LocalAuthority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+
+	// TimeFunc is used to get the time tokens are issued at
+	TimeFunc func() time.Time
+
+	mutex  sync.Mutex
+	serial int64
+}
+
+type essCertIDv2 struct {
+	// hashAlgorithm is omitted as it defaults to sha256
+	CertHash []byte
+}
+
+type signingCertificateV2 struct {
+	Certs []essCertIDv2
+}
+
+type signerInfoOut struct {
+	Version            int
+	SID                issuerAndSerialNumber
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type signedDataOut struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     []asn1.RawValue `asn1:"optional,set,tag:0"`
+	SignerInfos      []signerInfoOut `asn1:"set"`
+}
+
+// NewLocalAuthority creates a local time-stamping authority issuing tokens signed with
+// key, cert must be valid for time-stamping
+func NewLocalAuthority(cert *x509.Certificate, key *ecdsa.PrivateKey) *LocalAuthority {
+	return &LocalAuthority{
+		cert:     cert,
+		key:      key,
+		TimeFunc: time.Now,
+	}
+}
+
+// GenerateLocalAuthority creates a local time-stamping authority with a fresh self-signed certificate
+func GenerateLocalAuthority() (*LocalAuthority, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "immudb local time-stamping authority"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocalAuthority(cert, key), nil
+}
+
+// Certificate returns the certificate of the authority
+func (a *LocalAuthority) Certificate() *x509.Certificate {
+	return a.cert
+}
+
+// Timestamp handles a DER encoded time-stamp request and returns the DER encoded response
+func (a *LocalAuthority) Timestamp(req []byte) ([]byte, error) {
+	var tsReq timeStampReq
+
+	rest, err := asn1.Unmarshal(req, &tsReq)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%w: trailing data", ErrInvalidResponse)
+	}
+
+	if !tsReq.MessageImprint.HashAlgorithm.Algorithm.Equal(oidSHA256) ||
+		len(tsReq.MessageImprint.HashedMessage) != sha256.Size {
+		return asn1.Marshal(timeStampResp{
+			Status: pkiStatusInfo{
+				Status:       2, // rejection
+				StatusString: []string{"unsupported message imprint"},
+			},
+		})
+	}
+
+	a.mutex.Lock()
+	a.serial++
+	serial := a.serial
+	a.mutex.Unlock()
+
+	info, err := asn1.Marshal(tstInfo{
+		Version:        1,
+		Policy:         localAuthorityPolicy,
+		MessageImprint: tsReq.MessageImprint,
+		SerialNumber:   big.NewInt(serial),
+		GenTime:        a.TimeFunc().UTC().Truncate(time.Second),
+		Nonce:          tsReq.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := a.sign(info, tsReq.CertReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(timeStampResp{
+		Status:         pkiStatusInfo{Status: statusGranted},
+		TimeStampToken: asn1.RawValue{FullBytes: token},
+	})
+}
+
+func (a *LocalAuthority) sign(info []byte, includeCert bool) ([]byte, error) {
+	sha256Alg := pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}
+
+	infoDigest := sha256.Sum256(info)
+	certDigest := sha256.Sum256(a.cert.Raw)
+
+	contentType, err := asn1.Marshal(oidTSTInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	messageDigest, err := asn1.Marshal(infoDigest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	signingCert, err := asn1.Marshal(signingCertificateV2{
+		Certs: []essCertIDv2{{CertHash: certDigest[:]}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signedAttrs, err := asn1.MarshalWithParams([]attribute{
+		{Type: oidContentType, Values: []asn1.RawValue{{FullBytes: contentType}}},
+		{Type: oidMessageDigest, Values: []asn1.RawValue{{FullBytes: messageDigest}}},
+		{Type: oidSigningCertificate, Values: []asn1.RawValue{{FullBytes: signingCert}}},
+	}, "set")
+	if err != nil {
+		return nil, err
+	}
+
+	signedAttrsDigest := sha256.Sum256(signedAttrs)
+
+	signature, err := a.key.Sign(rand.Reader, signedAttrsDigest[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	// signed attributes are included with an implicit [0] tag instead of the SET OF one
+	implicitSignedAttrs := append([]byte{0xa0}, signedAttrs[1:]...)
+
+	sd := signedDataOut{
+		Version:          3,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{sha256Alg},
+		EncapContentInfo: encapsulatedContentInfo{
+			EContentType: oidTSTInfo,
+			EContent:     info,
+		},
+		SignerInfos: []signerInfoOut{{
+			Version: 1,
+			SID: issuerAndSerialNumber{
+				Issuer:       asn1.RawValue{FullBytes: a.cert.RawIssuer},
+				SerialNumber: a.cert.SerialNumber,
+			},
+			DigestAlgorithm:    sha256Alg,
+			SignedAttrs:        asn1.RawValue{FullBytes: implicitSignedAttrs},
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256},
+			Signature:          signature,
+		}},
+	}
+
+	if includeCert {
+		sd.Certificates = []asn1.RawValue{{FullBytes: a.cert.Raw}}
+	}
+
+	content, err := asn1.Marshal(sd)
+	if err != nil {
+		return nil, err
+	}
+
+	// raw values are neither wrapped nor unwrapped by explicit tags, so the content is wrapped here
+	return asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        0,
+			IsCompound: true,
+			Bytes:      content,
+		},
+	})
+}
+
+// ServeHTTP serves time-stamp requests through the HTTP transport of RFC 3161
+func (a *LocalAuthority) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := ioutil.ReadAll(io.LimitReader(r.Body, maxResponseSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := a.Timestamp(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", timestampReplyContentType)
+	w.Write(resp)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	timestampQueryContentType = "application/timestamp-query"
+	timestampReplyContentType = "application/timestamp-reply"
+
+	// maxResponseSize bounds the size of the responses read from time-stamping authorities
+	maxResponseSize = 1 << 20
+)
+
+// PKI statuses of the time-stamp responses a token is included into
+const (
+	statusGranted         = 0
+	statusGrantedWithMods = 1
+)
+
+// Client requests time-stamp tokens to a time-stamping authority through the HTTP transport of RFC 3161
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the time-stamping authority reachable at url
+func NewClient(url string, timeout time.Duration) *Client {
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Timestamp requests the time-stamping authority a token attesting the existence of the sha256 digest
+func (c *Client) Timestamp(ctx context.Context, digest [sha256.Size]byte) (*Token, error) {
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := asn1.Marshal(timeStampReq{
+		Version: 1,
+		MessageImprint: messageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+			HashedMessage: digest[:],
+		},
+		Nonce:   nonce,
+		CertReq: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", timestampQueryContentType)
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: unexpected HTTP status %s", ErrInvalidResponse, httpResp.Status)
+	}
+
+	if ct := httpResp.Header.Get("Content-Type"); !strings.HasPrefix(ct, timestampReplyContentType) {
+		return nil, fmt.Errorf("%w: unexpected content type '%s'", ErrInvalidResponse, ct)
+	}
+
+	resp, err := ioutil.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := ParseResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.HashAlgorithm != crypto.SHA256 || !bytes.Equal(token.HashedMessage, digest[:]) {
+		return nil, ErrTokenMismatch
+	}
+
+	if token.Nonce == nil || token.Nonce.Cmp(nonce) != 0 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidResponse)
+	}
+
+	return token, nil
+}
+
+// ParseResponse parses a DER encoded time-stamp response and returns the token included into it
+func ParseResponse(der []byte) (*Token, error) {
+	var resp timeStampResp
+
+	rest, err := asn1.Unmarshal(der, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if len(rest) > 0 {
+		return nil, ErrInvalidResponse
+	}
+
+	if resp.Status.Status != statusGranted && resp.Status.Status != statusGrantedWithMods {
+		return nil, fmt.Errorf("%w: request rejected with status %d %s",
+			ErrInvalidResponse, resp.Status.Status, strings.Join(resp.Status.StatusString, ", "))
+	}
+
+	if len(resp.TimeStampToken.FullBytes) == 0 {
+		return nil, fmt.Errorf("%w: time-stamp token not found", ErrInvalidResponse)
+	}
+
+	return ParseToken(resp.TimeStampToken.FullBytes)
+}
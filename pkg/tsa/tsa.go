@@ -0,0 +1,391 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tsa implements the client side of the RFC 3161 time-stamp protocol, used to get a trusted
+// authority to attest that a digest existed no later than a given time, together with a minimal
+// local time-stamping authority to be used as a stand-in for an external one.
+package tsa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+var ErrInvalidToken = errors.New("invalid time-stamp token")
+var ErrInvalidResponse = errors.New("invalid time-stamp response")
+var ErrTokenMismatch = errors.New("time-stamp token does not match the digest")
+var ErrUnsupportedAlgorithm = errors.New("unsupported algorithm")
+
+var (
+	oidSignedData         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidContentType        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSigningCertificate = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 47}
+
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+)
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type timeStampReq struct {
+	Version        int
+	MessageImprint messageImprint
+	ReqPolicy      asn1.ObjectIdentifier `asn1:"optional"`
+	Nonce          *big.Int              `asn1:"optional"`
+	CertReq        bool                  `asn1:"optional"`
+	Extensions     []pkix.Extension      `asn1:"optional,tag:0"`
+}
+
+type pkiStatusInfo struct {
+	Status       int
+	StatusString []string       `asn1:"optional"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+type timeStampResp struct {
+	Status         pkiStatusInfo
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type encapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     []asn1.RawValue `asn1:"optional,set,tag:0"`
+	CRLs             []asn1.RawValue `asn1:"optional,set,tag:1"`
+	SignerInfos      []asn1.RawValue `asn1:"set"`
+}
+
+// rawAttributes captures the DER encoding of the signed attributes, as required to verify the signature
+type rawAttributes struct {
+	Raw asn1.RawContent
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        rawAttributes `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type accuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint messageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time        `asn1:"generalized"`
+	Accuracy       accuracy         `asn1:"optional"`
+	Ordering       bool             `asn1:"optional"`
+	Nonce          *big.Int         `asn1:"optional"`
+	TSA            asn1.RawValue    `asn1:"optional,explicit,tag:0"`
+	Extensions     []pkix.Extension `asn1:"optional,tag:1"`
+}
+
+// Token is a time-stamp token issued by a time-stamping authority,
+// attesting that a digest existed no later than GenTime
+type Token struct {
+	// Raw holds the DER encoding of the token
+	Raw []byte
+
+	HashAlgorithm crypto.Hash
+	HashedMessage []byte
+	GenTime       time.Time
+	SerialNumber  *big.Int
+	Nonce         *big.Int
+	Policy        asn1.ObjectIdentifier
+
+	// Certificate of the time-stamping authority that signed the token
+	Certificate *x509.Certificate
+	// Certificates included into the token, if any
+	Certificates []*x509.Certificate
+}
+
+// ParseToken parses a DER encoded time-stamp token and checks it was signed
+// by the certificate included into it.
+// Whether such certificate is trusted is checked by Verify.
+func ParseToken(der []byte) (*Token, error) {
+	var ci contentInfo
+
+	rest, err := asn1.Unmarshal(der, &ci)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if len(rest) > 0 || !ci.ContentType.Equal(oidSignedData) {
+		return nil, ErrInvalidToken
+	}
+
+	var sd signedData
+
+	_, err = asn1.Unmarshal(ci.Content.Bytes, &sd)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) || len(sd.SignerInfos) != 1 {
+		return nil, ErrInvalidToken
+	}
+
+	var info tstInfo
+
+	_, err = asn1.Unmarshal(sd.EncapContentInfo.EContent, &info)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	hashAlg, err := hashFor(info.MessageImprint.HashAlgorithm.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]*x509.Certificate, len(sd.Certificates))
+	for i, c := range sd.Certificates {
+		certs[i], err = x509.ParseCertificate(c.FullBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+		}
+	}
+
+	var si signerInfo
+
+	_, err = asn1.Unmarshal(sd.SignerInfos[0].FullBytes, &si)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	cert, err := signerCertificate(si.SID, certs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifySignerInfo(&si, sd.EncapContentInfo.EContent, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		Raw:           der,
+		HashAlgorithm: hashAlg,
+		HashedMessage: info.MessageImprint.HashedMessage,
+		GenTime:       info.GenTime,
+		SerialNumber:  info.SerialNumber,
+		Nonce:         info.Nonce,
+		Policy:        info.Policy,
+		Certificate:   cert,
+		Certificates:  certs,
+	}, nil
+}
+
+// Verify checks the token attests the existence of the digest and it was signed by a time-stamping
+// authority whose certificate chains up to the provided roots
+func (t *Token) Verify(digest []byte, roots *x509.CertPool) error {
+	if !bytes.Equal(t.HashedMessage, digest) {
+		return ErrTokenMismatch
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, c := range t.Certificates {
+		intermediates.AddCert(c)
+	}
+
+	_, err := t.Certificate.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   t.GenTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	return nil
+}
+
+func signerCertificate(sid asn1.RawValue, certs []*x509.Certificate) (*x509.Certificate, error) {
+	if sid.Class == asn1.ClassContextSpecific && sid.Tag == 0 {
+		for _, c := range certs {
+			if bytes.Equal(c.SubjectKeyId, sid.Bytes) {
+				return c, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: signer certificate not found", ErrInvalidToken)
+	}
+
+	var ias issuerAndSerialNumber
+
+	_, err := asn1.Unmarshal(sid.FullBytes, &ias)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	for _, c := range certs {
+		if bytes.Equal(c.RawIssuer, ias.Issuer.FullBytes) && c.SerialNumber.Cmp(ias.SerialNumber) == 0 {
+			return c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: signer certificate not found", ErrInvalidToken)
+}
+
+func verifySignerInfo(si *signerInfo, content []byte, cert *x509.Certificate) error {
+	if len(si.SignedAttrs.Raw) == 0 {
+		return fmt.Errorf("%w: signed attributes not found", ErrInvalidToken)
+	}
+
+	var attrsSet asn1.RawValue
+
+	_, err := asn1.Unmarshal(si.SignedAttrs.Raw, &attrsSet)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	var attrs []attribute
+
+	_, err = asn1.UnmarshalWithParams(si.SignedAttrs.Raw, &attrs, "set,tag:0")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	hashAlg, err := hashFor(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	h := hashAlg.New()
+	h.Write(content)
+	contentDigest := h.Sum(nil)
+
+	var contentTypeFound, messageDigestFound bool
+
+	for _, attr := range attrs {
+		if len(attr.Values) != 1 {
+			continue
+		}
+
+		switch {
+		case attr.Type.Equal(oidContentType):
+			var ct asn1.ObjectIdentifier
+
+			_, err := asn1.Unmarshal(attr.Values[0].FullBytes, &ct)
+			if err != nil || !ct.Equal(oidTSTInfo) {
+				return fmt.Errorf("%w: unexpected content type", ErrInvalidToken)
+			}
+
+			contentTypeFound = true
+		case attr.Type.Equal(oidMessageDigest):
+			var md []byte
+
+			_, err := asn1.Unmarshal(attr.Values[0].FullBytes, &md)
+			if err != nil || !bytes.Equal(md, contentDigest) {
+				return fmt.Errorf("%w: message digest mismatch", ErrInvalidToken)
+			}
+
+			messageDigestFound = true
+		}
+	}
+
+	if !contentTypeFound || !messageDigestFound {
+		return fmt.Errorf("%w: mandatory signed attributes not found", ErrInvalidToken)
+	}
+
+	// the signature is computed over the DER encoding of the signed attributes as a SET OF
+	signed := append([]byte{0x31}, attrsSet.FullBytes[1:]...)
+
+	return verifySignature(cert.PublicKey, hashAlg, signed, si.Signature)
+}
+
+func verifySignature(pub crypto.PublicKey, hashAlg crypto.Hash, signed, signature []byte) error {
+	var ok bool
+
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(pub, signed, signature)
+	case *ecdsa.PublicKey:
+		h := hashAlg.New()
+		h.Write(signed)
+		ok = ecdsa.VerifyASN1(pub, h.Sum(nil), signature)
+	case *rsa.PublicKey:
+		h := hashAlg.New()
+		h.Write(signed)
+		ok = rsa.VerifyPKCS1v15(pub, hashAlg, h.Sum(nil), signature) == nil
+	default:
+		return fmt.Errorf("%w: public key of type %T", ErrUnsupportedAlgorithm, pub)
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: signature verification failed", ErrInvalidToken)
+	}
+
+	return nil
+}
+
+func hashFor(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+
+	return 0, fmt.Errorf("%w: hash algorithm %s", ErrUnsupportedAlgorithm, oid)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 Codenotary Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tsa
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuthority(t *testing.T) (*LocalAuthority, *Client) {
+	authority, err := GenerateLocalAuthority()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(authority)
+	t.Cleanup(srv.Close)
+
+	return authority, NewClient(srv.URL, 5*time.Second)
+}
+
+func TestTimestamp(t *testing.T) {
+	authority, client := newTestAuthority(t)
+
+	digest := sha256.Sum256([]byte("immudb"))
+
+	token, err := client.Timestamp(context.Background(), digest)
+	require.NoError(t, err)
+	require.Equal(t, digest[:], token.HashedMessage)
+	require.Equal(t, authority.Certificate().Raw, token.Certificate.Raw)
+	require.True(t, token.Policy.Equal(localAuthorityPolicy))
+	require.WithinDuration(t, time.Now(), token.GenTime, time.Minute)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(authority.Certificate())
+
+	t.Run("token should be verified against the trusted authority", func(t *testing.T) {
+		err := token.Verify(digest[:], roots)
+		require.NoError(t, err)
+	})
+
+	t.Run("token should not be verified against a different digest", func(t *testing.T) {
+		otherDigest := sha256.Sum256([]byte("other"))
+
+		err := token.Verify(otherDigest[:], roots)
+		require.ErrorIs(t, err, ErrTokenMismatch)
+	})
+
+	t.Run("token should not be verified against an untrusted authority", func(t *testing.T) {
+		other, err := GenerateLocalAuthority()
+		require.NoError(t, err)
+
+		otherRoots := x509.NewCertPool()
+		otherRoots.AddCert(other.Certificate())
+
+		err = token.Verify(digest[:], otherRoots)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("token should be parsed back from its raw encoding", func(t *testing.T) {
+		parsed, err := ParseToken(token.Raw)
+		require.NoError(t, err)
+		require.Equal(t, token.SerialNumber, parsed.SerialNumber)
+		require.Equal(t, token.GenTime, parsed.GenTime)
+
+		err = parsed.Verify(digest[:], roots)
+		require.NoError(t, err)
+	})
+
+	t.Run("tampered token should not be parsed", func(t *testing.T) {
+		tampered := make([]byte, len(token.Raw))
+		copy(tampered, token.Raw)
+
+		// the message imprint is part of the signed content
+		for i := 0; i+len(digest) <= len(tampered); i++ {
+			if string(tampered[i:i+len(digest)]) == string(digest[:]) {
+				tampered[i] ^= 0xff
+				break
+			}
+		}
+
+		_, err := ParseToken(tampered)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("serial numbers should be increasing", func(t *testing.T) {
+		next, err := client.Timestamp(context.Background(), digest)
+		require.NoError(t, err)
+		require.Equal(t, 1, next.SerialNumber.Cmp(token.SerialNumber))
+	})
+}
+
+func TestTimestampGenTime(t *testing.T) {
+	authority, client := newTestAuthority(t)
+
+	genTime := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+	authority.TimeFunc = func() time.Time { return genTime }
+
+	digest := sha256.Sum256([]byte("immudb"))
+
+	token, err := client.Timestamp(context.Background(), digest)
+	require.NoError(t, err)
+	require.True(t, genTime.Equal(token.GenTime))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(authority.Certificate())
+
+	// the certificate of the authority was not valid at the time the token claims to be issued at
+	err = token.Verify(digest[:], roots)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestParseInvalidResponses(t *testing.T) {
+	_, err := ParseResponse([]byte{1, 2, 3})
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	rejection, err := asn1.Marshal(timeStampResp{
+		Status: pkiStatusInfo{Status: 2, StatusString: []string{"rejected"}},
+	})
+	require.NoError(t, err)
+
+	_, err = ParseResponse(rejection)
+	require.ErrorIs(t, err, ErrInvalidResponse)
+	require.Contains(t, err.Error(), "rejected")
+
+	granted, err := asn1.Marshal(timeStampResp{
+		Status: pkiStatusInfo{Status: statusGranted},
+	})
+	require.NoError(t, err)
+
+	_, err = ParseResponse(granted)
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	_, err = ParseToken([]byte{1, 2, 3})
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestTimestampInvalidAuthority(t *testing.T) {
+	t.Run("non time-stamping endpoint", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("<html></html>"))
+		}))
+		defer srv.Close()
+
+		_, err := NewClient(srv.URL, time.Second).Timestamp(context.Background(), sha256.Sum256(nil))
+		require.ErrorIs(t, err, ErrInvalidResponse)
+	})
+
+	t.Run("failing endpoint", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		_, err := NewClient(srv.URL, time.Second).Timestamp(context.Background(), sha256.Sum256(nil))
+		require.ErrorIs(t, err, ErrInvalidResponse)
+	})
+
+	t.Run("unreachable endpoint", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+
+		_, err := NewClient(srv.URL, time.Second).Timestamp(context.Background(), sha256.Sum256(nil))
+		require.Error(t, err)
+	})
+
+	t.Run("replayed response", func(t *testing.T) {
+		authority, err := GenerateLocalAuthority()
+		require.NoError(t, err)
+
+		var replayed []byte
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if replayed == nil {
+				rec := httptest.NewRecorder()
+				authority.ServeHTTP(rec, r)
+				replayed = rec.Body.Bytes()
+			}
+
+			w.Header().Set("Content-Type", timestampReplyContentType)
+			w.Write(replayed)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, time.Second)
+
+		_, err = client.Timestamp(context.Background(), sha256.Sum256(nil))
+		require.NoError(t, err)
+
+		_, err = client.Timestamp(context.Background(), sha256.Sum256(nil))
+		require.ErrorIs(t, err, ErrInvalidResponse)
+	})
+}
+
+func TestLocalAuthorityRejectsUnsupportedImprints(t *testing.T) {
+	authority, err := GenerateLocalAuthority()
+	require.NoError(t, err)
+
+	req, err := asn1.Marshal(timeStampReq{
+		Version: 1,
+		MessageImprint: messageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA512},
+			HashedMessage: make([]byte, 64),
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := authority.Timestamp(req)
+	require.NoError(t, err)
+
+	_, err = ParseResponse(resp)
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	_, err = authority.Timestamp([]byte{1, 2, 3})
+	require.Error(t, err)
+
+	rec := httptest.NewRecorder()
+	authority.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}